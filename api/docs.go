@@ -827,6 +827,10 @@ var doc = `{
                     "type": "integer",
                     "default": 16
                 },
+                "pause_schedule": {
+                    "description": "the schedule to pause and resume the changefeed periodically",
+                    "$ref": "#/definitions/model.PauseScheduleConfig"
+                },
                 "sink_config": {
                     "$ref": "#/definitions/config.SinkConfig"
                 },
@@ -907,6 +911,23 @@ var doc = `{
                 }
             }
         },
+        "model.PauseScheduleConfig": {
+            "type": "object",
+            "properties": {
+                "pause": {
+                    "description": "cron expression of the times to pause the changefeed, e.g. \"0 2 * * *\"",
+                    "type": "string"
+                },
+                "resume": {
+                    "description": "cron expression of the times to resume the changefeed, e.g. \"0 4 * * *\"",
+                    "type": "string"
+                },
+                "time_zone": {
+                    "description": "time zone of the cron expressions, the time zone of the owner is used if it's empty",
+                    "type": "string"
+                }
+            }
+        },
         "model.ProcessorCommonInfo": {
             "type": "object",
             "properties": {
@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"runtime"
 	"sync"
 	"time"
 
@@ -92,6 +93,7 @@ func (c *Capture) reset(ctx context.Context) error {
 		ID:            uuid.New().String(),
 		AdvertiseAddr: conf.AdvertiseAddr,
 		Version:       version.ReleaseVersion,
+		Capacity:      runtime.GOMAXPROCS(0),
 	}
 	c.processorManager = c.newProcessorManager()
 	if c.session != nil {
@@ -359,7 +359,7 @@ func (h *HTTPHandler) UpdateChangefeed(c *gin.Context) {
 	}
 
 	// can only update target-ts, sink-uri
-	// filter_rules, ignore_txn_start_ts, mounter_worker_num, sink_config
+	// filter_rules, ignore_txn_start_ts, mounter_worker_num, sink_config, pause_schedule
 	var changefeedConfig model.ChangefeedConfig
 	if err = c.BindJSON(&changefeedConfig); err != nil {
 		_ = c.Error(err)
@@ -371,6 +371,19 @@ func (h *HTTPHandler) UpdateChangefeed(c *gin.Context) {
 		_ = c.Error(err)
 		return
 	}
+	// the tables are verified with the new filter and sink config as they are
+	// at the checkpoint, the changefeed is resumed from it.
+	if len(changefeedConfig.FilterRules) != 0 || changefeedConfig.SinkConfig != nil {
+		status, err := statusProvider.GetChangeFeedStatus(ctx, changefeedID)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		if _, _, err := verifyTables(newInfo.Config, h.capture.kvStorage, status.CheckpointTs); err != nil {
+			_ = c.Error(cerror.ErrChangefeedUpdateRefused.GenWithStackByCause(err))
+			return
+		}
+	}
 
 	err = h.capture.etcdClient.SaveChangeFeedInfo(ctx, newInfo, changefeedID)
 	if err != nil {
@@ -23,6 +23,8 @@ import (
 	"github.com/pingcap/ticdc/cdc/kv"
 	"github.com/pingcap/ticdc/cdc/model"
 	"github.com/pingcap/ticdc/cdc/sink"
+	"github.com/pingcap/ticdc/cdc/sink/columnselector"
+	"github.com/pingcap/ticdc/cdc/sink/dispatcher"
 	"github.com/pingcap/ticdc/pkg/config"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/filter"
@@ -78,6 +80,16 @@ func verifyCreateChangefeedConfig(ctx context.Context, changefeedConfig model.Ch
 		return nil, cerror.ErrTargetTsBeforeStartTs.GenWithStackByArgs(changefeedConfig.TargetTS, changefeedConfig.StartTS)
 	}
 
+	// verify pause_schedule
+	var pauseSchedule *model.PauseSchedule
+	if changefeedConfig.PauseSchedule != nil {
+		var err error
+		pauseSchedule, err = verifyPauseSchedule(changefeedConfig.PauseSchedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// init replicaConfig
 	replicaConfig := config.GetDefaultReplicaConfig()
 	replicaConfig.ForceReplicate = changefeedConfig.ForceReplicate
@@ -125,16 +137,15 @@ func verifyCreateChangefeedConfig(ctx context.Context, changefeedConfig model.Ch
 		SyncPointEnabled:  false,
 		SyncPointInterval: 10 * time.Minute,
 		CreatorVersion:    version.ReleaseVersion,
+		PauseSchedule:     pauseSchedule,
 	}
 
-	if !replicaConfig.ForceReplicate && !changefeedConfig.IgnoreIneligibleTable {
-		ineligibleTables, _, err := verifyTables(replicaConfig, capture.kvStorage, changefeedConfig.StartTS)
-		if err != nil {
-			return nil, err
-		}
-		if len(ineligibleTables) != 0 {
-			return nil, cerror.ErrTableIneligible.GenWithStackByArgs(ineligibleTables)
-		}
+	ineligibleTables, _, err := verifyTables(replicaConfig, capture.kvStorage, changefeedConfig.StartTS)
+	if err != nil {
+		return nil, err
+	}
+	if !replicaConfig.ForceReplicate && !changefeedConfig.IgnoreIneligibleTable && len(ineligibleTables) != 0 {
+		return nil, cerror.ErrTableIneligible.GenWithStackByArgs(ineligibleTables)
 	}
 
 	tz, err := util.GetTimezone(changefeedConfig.TimeZone)
@@ -182,6 +193,21 @@ func verifyUpdateChangefeedConfig(ctx context.Context, changefeedConfig model.Ch
 
 	if changefeedConfig.SinkConfig != nil {
 		newInfo.Config.Sink = changefeedConfig.SinkConfig
+		if err := newInfo.Config.Sink.ValidateRateLimit(); err != nil {
+			return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByCause(err)
+		}
+	}
+
+	if changefeedConfig.PauseSchedule != nil {
+		pauseSchedule, err := verifyPauseSchedule(changefeedConfig.PauseSchedule)
+		if err != nil {
+			return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByCause(err)
+		}
+		// a changefeed paused by the old schedule can be resumed by the new one
+		if pauseSchedule != nil && oldInfo.PauseSchedule != nil {
+			pauseSchedule.Paused = oldInfo.PauseSchedule.Paused
+		}
+		newInfo.PauseSchedule = pauseSchedule
 	}
 
 	// verify sink_uri
@@ -199,6 +225,24 @@ func verifyUpdateChangefeedConfig(ctx context.Context, changefeedConfig model.Ch
 	return newInfo, nil
 }
 
+// verifyPauseSchedule verifies the pause schedule against the GC TTL of the
+// server, it returns nil if the schedule is removed.
+func verifyPauseSchedule(scheduleConfig *model.PauseScheduleConfig) (*model.PauseSchedule, error) {
+	if scheduleConfig.Pause == "" && scheduleConfig.Resume == "" {
+		return nil, nil
+	}
+	schedule := &model.PauseSchedule{
+		Pause:    scheduleConfig.Pause,
+		Resume:   scheduleConfig.Resume,
+		TimeZone: scheduleConfig.TimeZone,
+	}
+	gcTTL := time.Duration(config.GetGlobalServerConfig().GcTTL) * time.Second
+	if err := schedule.Validate(gcTTL); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
 func verifyTables(replicaConfig *config.ReplicaConfig, storage tidbkv.Storage, startTs uint64) (ineligibleTables, eligibleTables []model.TableName, err error) {
 	filter, err := filter.NewFilter(replicaConfig)
 	if err != nil {
@@ -213,10 +257,21 @@ func verifyTables(replicaConfig *config.ReplicaConfig, storage tidbkv.Storage, s
 		return nil, nil, errors.Trace(err)
 	}
 
+	columnSelectors, err := columnselector.New(replicaConfig)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
 	for _, tableInfo := range snap.Tables() {
 		if filter.ShouldIgnoreTable(tableInfo.TableName.Schema, tableInfo.TableName.Table) {
 			continue
 		}
+		if err := columnSelectors.VerifyTable(tableInfo); err != nil {
+			return nil, nil, err
+		}
+		if err := dispatcher.VerifyTable(replicaConfig, tableInfo); err != nil {
+			return nil, nil, err
+		}
 		if !tableInfo.IsEligible(false /* forceReplicate */) {
 			ineligibleTables = append(ineligibleTables, tableInfo.TableName)
 		} else {
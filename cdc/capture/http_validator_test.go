@@ -49,3 +49,26 @@ func TestVerifyUpdateChangefeedConfig(t *testing.T) {
 	require.Nil(t, err)
 	require.NotNil(t, newInfo)
 }
+
+func TestVerifyUpdatePauseSchedule(t *testing.T) {
+	ctx := context.Background()
+	oldInfo := &model.ChangeFeedInfo{Config: config.GetDefaultReplicaConfig()}
+
+	// the pause longer than the GC TTL is rejected
+	changefeedConfig := model.ChangefeedConfig{PauseSchedule: &model.PauseScheduleConfig{
+		Pause: "0 0 * * 6", Resume: "0 0 * * 1",
+	}}
+	_, err := verifyUpdateChangefeedConfig(ctx, changefeedConfig, oldInfo)
+	require.Regexp(t, ".*should be shorter than the GC TTL.*", err)
+
+	changefeedConfig.PauseSchedule.Resume = "0 4 * * 6"
+	newInfo, err := verifyUpdateChangefeedConfig(ctx, changefeedConfig, oldInfo)
+	require.Nil(t, err)
+	require.Equal(t, &model.PauseSchedule{Pause: "0 0 * * 6", Resume: "0 4 * * 6"}, newInfo.PauseSchedule)
+
+	// the schedule is removed if both the pause and resume are empty
+	changefeedConfig.PauseSchedule = &model.PauseScheduleConfig{}
+	newInfo, err = verifyUpdateChangefeedConfig(ctx, changefeedConfig, newInfo)
+	require.Nil(t, err)
+	require.Nil(t, newInfo.PauseSchedule)
+}
@@ -40,6 +40,13 @@ var (
 			Name:      "total_rows_count",
 			Help:      "The total count of rows that are processed by mounter",
 		}, []string{"capture", "changefeed"})
+	ignoredDMLEventCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "mounter",
+			Name:      "ignored_dml_event_count",
+			Help:      "The total count of rows that are ignored by the event filter rules",
+		}, []string{"capture", "changefeed"})
 )
 
 // InitMetrics registers all metrics in this file
@@ -47,4 +54,5 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(mounterInputChanSizeGauge)
 	registry.MustRegister(mountDuration)
 	registry.MustRegister(totalRowsCountGauge)
+	registry.MustRegister(ignoredDMLEventCounter)
 }
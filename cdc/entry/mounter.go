@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/cdc/model"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
 	"github.com/pingcap/ticdc/pkg/util"
 	"github.com/pingcap/tidb/kv"
 	timodel "github.com/pingcap/tidb/parser/model"
@@ -33,6 +34,7 @@ import (
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -75,10 +77,15 @@ type mounterImpl struct {
 	tz               *time.Location
 	workerNum        int
 	enableOldValue   bool
+	// filter ignores the rows by the expressions of the event filter rules,
+	// it may be nil.
+	filter *filter.Filter
+
+	metricIgnoredDMLEventCounter prometheus.Counter
 }
 
 // NewMounter creates a mounter
-func NewMounter(schemaStorage SchemaStorage, workerNum int, enableOldValue bool) Mounter {
+func NewMounter(schemaStorage SchemaStorage, workerNum int, enableOldValue bool, filter *filter.Filter) Mounter {
 	if workerNum <= 0 {
 		workerNum = defaultMounterWorkerNum
 	}
@@ -91,6 +98,7 @@ func NewMounter(schemaStorage SchemaStorage, workerNum int, enableOldValue bool)
 		rawRowChangedChs: chs,
 		workerNum:        workerNum,
 		enableOldValue:   enableOldValue,
+		filter:           filter,
 	}
 }
 
@@ -98,6 +106,10 @@ const defaultMounterWorkerNum = 32
 
 func (m *mounterImpl) Run(ctx context.Context) error {
 	m.tz = util.TimezoneFromCtx(ctx)
+	captureAddr := util.CaptureAddrFromCtx(ctx)
+	changefeedID := util.ChangefeedIDFromCtx(ctx)
+	m.metricIgnoredDMLEventCounter = ignoredDMLEventCounter.WithLabelValues(captureAddr, changefeedID)
+	defer ignoredDMLEventCounter.DeleteLabelValues(captureAddr, changefeedID)
 	errg, ctx := errgroup.WithContext(ctx)
 	errg.Go(func() error {
 		m.collectMetrics(ctx)
@@ -211,7 +223,25 @@ func (m *mounterImpl) unmarshalAndMountRowChanged(ctx context.Context, raw *mode
 			if rowKV == nil {
 				return nil, nil
 			}
-			return m.mountRowKVEntry(tableInfo, rowKV, raw.ApproximateSize())
+			row, err := m.mountRowKVEntry(tableInfo, rowKV, raw.ApproximateSize())
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if m.filter != nil {
+				ignore, err := m.filter.ShouldIgnoreDMLEventByExpr(row, tableInfo)
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				if ignore {
+					log.Debug("skip the DML by the event filter",
+						zap.Uint64("ts", raw.CRTs), zap.Int64("tableID", physicalTableID))
+					if m.metricIgnoredDMLEventCounter != nil {
+						m.metricIgnoredDMLEventCounter.Inc()
+					}
+					return nil, nil
+				}
+			}
+			return row, nil
 		}
 		return nil, nil
 	}()
@@ -56,6 +56,8 @@ type ChangefeedResp struct {
 	TSO          uint64              `json:"tso"`
 	Checkpoint   string              `json:"checkpoint"`
 	RunningError *model.RunningError `json:"error"`
+	// PauseSchedule is the schedule to pause and resume the changefeed periodically
+	PauseSchedule *model.PauseSchedule `json:"pause-schedule,omitempty"`
 }
 
 // MarshalJSON use to marshal ChangefeedResp
@@ -242,6 +244,7 @@ func (s *Server) handleChangefeedQuery(w http.ResponseWriter, req *http.Request)
 	if cfInfo != nil {
 		resp.FeedState = string(cfInfo.State)
 		resp.RunningError = cfInfo.Error
+		resp.PauseSchedule = cfInfo.PauseSchedule
 	}
 	if cfStatus != nil {
 		resp.TSO = cfStatus.CheckpointTs
@@ -1356,12 +1356,13 @@ func assembleRowEvent(regionID uint64, entry *cdcpb.Event_Row, enableOldValue bo
 	revent := model.RegionFeedEvent{
 		RegionID: regionID,
 		Val: &model.RawKVEntry{
-			OpType:   opType,
-			Key:      entry.Key,
-			Value:    entry.GetValue(),
-			StartTs:  entry.StartTs,
-			CRTs:     entry.CommitTs,
-			RegionID: regionID,
+			OpType:    opType,
+			Key:       entry.Key,
+			Value:     entry.GetValue(),
+			StartTs:   entry.StartTs,
+			CRTs:      entry.CommitTs,
+			RegionID:  regionID,
+			TxnSource: getTxnSource(entry),
 		},
 	}
 
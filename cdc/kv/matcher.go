@@ -62,6 +62,10 @@ func (m *matcher) matchRow(row *cdcpb.Event_Row) bool {
 	if value, exist := m.unmatchedValue[newMatchKey(row)]; exist {
 		row.Value = value.GetValue()
 		row.OldValue = value.GetOldValue()
+		// the transaction source may be only sent with the prewrite event.
+		if getTxnSource(row) == 0 {
+			row.XXX_unrecognized = value.XXX_unrecognized
+		}
 		delete(m.unmatchedValue, newMatchKey(row))
 		return true
 	}
@@ -173,3 +173,46 @@ func (s *matcherSuite) TestMatchMatchCachedRow(c *check.C) {
 		OldValue: []byte("ov2"),
 	}})
 }
+
+func (s *matcherSuite) TestMatchRowTxnSource(c *check.C) {
+	defer testleak.AfterTest(c)()
+	matcher := newMatcher()
+	// the txn source of TiDB is encoded as the field 9 of the row
+	txnSource := []byte{9 << 3, 2}
+	matcher.putPrewriteRow(&cdcpb.Event_Row{
+		StartTs:          1,
+		Key:              []byte("k1"),
+		Value:            []byte("v1"),
+		XXX_unrecognized: txnSource,
+	})
+	commitRow := &cdcpb.Event_Row{
+		StartTs:  1,
+		CommitTs: 2,
+		Key:      []byte("k1"),
+	}
+	ok := matcher.matchRow(commitRow)
+	c.Assert(ok, check.IsTrue)
+	c.Assert(getTxnSource(commitRow), check.Equals, uint64(2))
+}
+
+func (s *matcherSuite) TestGetTxnSource(c *check.C) {
+	defer testleak.AfterTest(c)()
+	testCases := []struct {
+		unrecognized []byte
+		expected     uint64
+	}{
+		{nil, 0},
+		{[]byte{9 << 3, 0x81, 0x02}, 257},
+		// generate_old_value = true, txn_source = 3
+		{[]byte{8 << 3, 1, 9 << 3, 3}, 3},
+		// unknown fields of all the wire types precede the txn source
+		{[]byte{10<<3 | 1, 0, 0, 0, 0, 0, 0, 0, 0, 11<<3 | 2, 2, 'a', 'b', 12<<3 | 5, 0, 0, 0, 0, 9 << 3, 4}, 4},
+		// truncated
+		{[]byte{9 << 3}, 0},
+		{[]byte{11<<3 | 2, 5, 'a'}, 0},
+	}
+	for _, tc := range testCases {
+		row := &cdcpb.Event_Row{XXX_unrecognized: tc.unrecognized}
+		c.Assert(getTxnSource(row), check.Equals, tc.expected, check.Commentf("%v", tc.unrecognized))
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"encoding/binary"
+
+	"github.com/pingcap/kvproto/pkg/cdcpb"
+)
+
+// txnSourceFieldNumber is the field number of `txn_source` in `Event.Row`.
+// The kvproto vendored by TiCDC doesn't know the field yet, so it's kept in
+// the unrecognized bytes of the row.
+const txnSourceFieldNumber = 9
+
+// getTxnSource returns the transaction source of the row, 0 if the TiKV
+// doesn't send it.
+func getTxnSource(row *cdcpb.Event_Row) uint64 {
+	data := row.XXX_unrecognized
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0
+		}
+		data = data[n:]
+		fieldNumber, wireType := key>>3, key&0x7
+		var value uint64
+		switch wireType {
+		case 0: // varint
+			value, n = binary.Uvarint(data)
+			if n <= 0 {
+				return 0
+			}
+			if fieldNumber == txnSourceFieldNumber {
+				return value
+			}
+		case 1: // 64-bit
+			n = 8
+		case 2: // length-delimited
+			value, n = binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < value {
+				return 0
+			}
+			n += int(value)
+		case 5: // 32-bit
+			n = 4
+		default:
+			return 0
+		}
+		if len(data) < n {
+			return 0
+		}
+		data = data[n:]
+	}
+	return 0
+}
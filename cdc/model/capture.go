@@ -25,6 +25,9 @@ type CaptureInfo struct {
 	ID            CaptureID `json:"id"`
 	AdvertiseAddr string    `json:"address"`
 	Version       string    `json:"version"`
+	// Capacity is the relative processing capacity of the capture, which is
+	// the number of CPUs it can use. 0 means unknown.
+	Capacity int `json:"capacity,omitempty"`
 }
 
 // Marshal using json.Marshal.
@@ -128,6 +128,9 @@ type ChangeFeedInfo struct {
 	SyncPointEnabled  bool          `json:"sync-point-enabled"`
 	SyncPointInterval time.Duration `json:"sync-point-interval"`
 	CreatorVersion    string        `json:"creator-version"`
+
+	// PauseSchedule pauses and resumes the changefeed periodically.
+	PauseSchedule *PauseSchedule `json:"pause-schedule,omitempty"`
 }
 
 const changeFeedIDMaxLen = 128
@@ -124,6 +124,19 @@ type ChangefeedConfig struct {
 	IgnoreTxnStartTs      []uint64           `json:"ignore_txn_start_ts"`
 	MounterWorkerNum      int                `json:"mounter_worker_num" default:"16"`
 	SinkConfig            *config.SinkConfig `json:"sink_config"`
+	// the schedule to pause and resume the changefeed periodically
+	PauseSchedule *PauseScheduleConfig `json:"pause_schedule"`
+}
+
+// PauseScheduleConfig use to set the pause schedule of a changefeed, the
+// schedule is removed if both Pause and Resume are empty
+type PauseScheduleConfig struct {
+	// cron expression of the times to pause the changefeed, e.g. "0 2 * * *"
+	Pause string `json:"pause"`
+	// cron expression of the times to resume the changefeed, e.g. "0 4 * * *"
+	Resume string `json:"resume"`
+	// time zone of the cron expressions, the time zone of the owner is used if it's empty
+	TimeZone string `json:"time_zone"`
 }
 
 // ProcessorCommonInfo holds the common info of a processor
@@ -81,6 +81,9 @@ type RawKVEntry struct {
 
 	// Additonal debug info
 	RegionID uint64 `msg:"region_id"`
+	// TxnSource is the source of the transaction set by TiDB, TiCDC tags the
+	// transactions it writes with the replica ID in the cyclic replication.
+	TxnSource uint64 `msg:"txn_source"`
 }
 
 func (v *RawKVEntry) String() string {
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/regionspan"
 	"go.uber.org/zap"
 )
 
@@ -148,6 +149,9 @@ const (
 	// This field is necessary since we must persist enough information to
 	// restore complete table operation in case of processor or owner crashes.
 	OperFlagMoveTable uint64 = 1 << iota
+	// SplitTable means the operation is applied to a key range of the table,
+	// the other key ranges of the table are replicated by other captures.
+	OperFlagSplitTable
 )
 
 // All TableOperation status
@@ -167,6 +171,11 @@ type TableOperation struct {
 	Status     uint64 `json:"status,omitempty"`
 }
 
+// IsSplitTable returns whether the operation is applied to a key range of the table
+func (o *TableOperation) IsSplitTable() bool {
+	return o.Flag&OperFlagSplitTable != 0
+}
+
 // TableProcessed returns whether the table has been processed by processor
 func (o *TableOperation) TableProcessed() bool {
 	return o.Status == OperProcessed || o.Status == OperFinished
@@ -194,6 +203,10 @@ type TaskWorkload map[TableID]WorkloadInfo
 // WorkloadInfo records the workload info of a table
 type WorkloadInfo struct {
 	Workload uint64 `json:"workload"`
+	// EventRate and ByteRate are the number and the approximate size of the
+	// row changed events replicated by the table per second.
+	EventRate uint64 `json:"event-rate,omitempty"`
+	ByteRate  uint64 `json:"byte-rate,omitempty"`
 }
 
 // Unmarshal unmarshals into *TaskWorkload from json marshal byte slice
@@ -216,6 +229,9 @@ func (w *TaskWorkload) Marshal() (string, error) {
 type TableReplicaInfo struct {
 	StartTs     Ts      `json:"start-ts"`
 	MarkTableID TableID `json:"mark-table-id"`
+	// Span is the key range of the table replicated by the capture,
+	// nil means the whole table.
+	Span *regionspan.ComparableSpan `json:"span,omitempty"`
 }
 
 // Clone clones a TableReplicaInfo
@@ -224,6 +240,10 @@ func (i *TableReplicaInfo) Clone() *TableReplicaInfo {
 		return nil
 	}
 	clone := *i
+	if i.Span != nil {
+		span := i.Span.Clone()
+		clone.Span = &span
+	}
 	return &clone
 }
 
@@ -265,6 +285,9 @@ func (ts *TaskStatus) RemoveTable(id TableID, boundaryTs Ts, isMoveTable bool) (
 	if isMoveTable {
 		op.Flag |= OperFlagMoveTable
 	}
+	if table.Span != nil {
+		op.Flag |= OperFlagSplitTable
+	}
 	ts.Operation[id] = op
 	return table, true
 }
@@ -283,11 +306,15 @@ func (ts *TaskStatus) AddTable(id TableID, table *TableReplicaInfo, boundaryTs T
 	if ts.Operation == nil {
 		ts.Operation = make(map[TableID]*TableOperation)
 	}
-	ts.Operation[id] = &TableOperation{
+	op := &TableOperation{
 		Delete:     false,
 		BoundaryTs: boundaryTs,
 		Status:     OperDispatched,
 	}
+	if table.Span != nil {
+		op.Flag |= OperFlagSplitTable
+	}
+	ts.Operation[id] = op
 }
 
 // SomeOperationsUnapplied returns true if there are some operations not applied
@@ -328,6 +355,7 @@ func (ts *TaskStatus) Snapshot(cfID ChangeFeedID, captureID CaptureID, checkpoin
 		snap.Tables[tableID] = &TableReplicaInfo{
 			StartTs:     ts,
 			MarkTableID: table.MarkTableID,
+			Span:        table.Span,
 		}
 	}
 	return snap
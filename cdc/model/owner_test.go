@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/check"
+	"github.com/pingcap/ticdc/pkg/regionspan"
 	"github.com/stretchr/testify/require"
 )
 
@@ -316,6 +317,30 @@ func TestMoveTable(t *testing.T) {
 	}, info.Operation)
 }
 
+func TestSplitTable(t *testing.T) {
+	t.Parallel()
+
+	span := &regionspan.ComparableSpan{Start: []byte{1}, End: []byte{2}}
+	status := &TaskStatus{}
+	status.AddTable(1, &TableReplicaInfo{StartTs: 100, Span: span}, 100)
+	require.True(t, status.Operation[1].IsSplitTable())
+
+	// the span is deep copied
+	clone := status.Clone()
+	clone.Tables[1].Span.End[0] = 3
+	require.Equal(t, []byte{2}, status.Tables[1].Span.End)
+
+	status.Operation = nil
+	replicaInfo, found := status.RemoveTable(1, 200, false)
+	require.True(t, found)
+	require.Equal(t, span, replicaInfo.Span)
+	require.Equal(t, &TableOperation{
+		Delete:     true,
+		Flag:       OperFlagSplitTable,
+		BoundaryTs: 200,
+	}, status.Operation[1])
+}
+
 func TestShouldReturnRemovedTable(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,140 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/cron"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+const (
+	// maxPauseScheduleLookback bounds the scheduled events handled by the
+	// owner, the events missed longer ago are ignored.
+	maxPauseScheduleLookback = 31 * 24 * time.Hour
+	// pauseScheduleValidateWindows is the number of the pause windows checked
+	// in the validation.
+	pauseScheduleValidateWindows = 1000
+)
+
+// PauseSchedule pauses the changefeed at the times matched by the Pause cron
+// expression and resumes it at the times matched by the Resume one, e.g.
+// pausing at "0 2 * * *" and resuming at "0 4 * * *" pauses the changefeed
+// from 02:00 to 04:00 every day.
+type PauseSchedule struct {
+	Pause  string `json:"pause"`
+	Resume string `json:"resume"`
+	// TimeZone is the time zone of the cron expressions, such as "Asia/Shanghai",
+	// the local time zone of the owner is used if it's empty.
+	TimeZone string `json:"time-zone,omitempty"`
+	// CheckedTime is the time until which the scheduled events are handled by
+	// the owner, it's reset when the schedule is updated.
+	CheckedTime time.Time `json:"checked-time"`
+	// Paused is true if the changefeed is paused by the schedule, a changefeed
+	// paused manually is not resumed by the schedule.
+	Paused bool `json:"paused,omitempty"`
+}
+
+func (s *PauseSchedule) parse() (pause, resume *cron.Schedule, loc *time.Location, err error) {
+	pause, err = cron.Parse(s.Pause)
+	if err != nil {
+		return nil, nil, nil, cerror.WrapError(cerror.ErrInvalidPauseSchedule, err)
+	}
+	resume, err = cron.Parse(s.Resume)
+	if err != nil {
+		return nil, nil, nil, cerror.WrapError(cerror.ErrInvalidPauseSchedule, err)
+	}
+	loc = time.Local
+	if s.TimeZone != "" {
+		loc, err = time.LoadLocation(s.TimeZone)
+		if err != nil {
+			return nil, nil, nil, cerror.WrapError(cerror.ErrInvalidPauseSchedule, err)
+		}
+	}
+	return pause, resume, loc, nil
+}
+
+// Validate checks the cron expressions, and checks the changefeed is not paused
+// longer than the GC TTL, otherwise the data of the changefeed may be GCed
+// before it is resumed. The length of the pauses is not checked if gcTTL is 0.
+func (s *PauseSchedule) Validate(gcTTL time.Duration) error {
+	pause, resume, loc, err := s.parse()
+	if err != nil {
+		return err
+	}
+	t := time.Now().In(loc)
+	for i := 0; i < pauseScheduleValidateWindows; i++ {
+		pauseTime := pause.Next(t)
+		if pauseTime.IsZero() {
+			if i == 0 {
+				return cerror.ErrInvalidPauseSchedule.GenWithStackByArgs(
+					fmt.Sprintf("the changefeed is never paused by %q", s.Pause))
+			}
+			return nil
+		}
+		resumeTime := resume.Next(pauseTime)
+		if resumeTime.IsZero() {
+			return cerror.ErrInvalidPauseSchedule.GenWithStackByArgs(
+				fmt.Sprintf("the changefeed paused at %s is never resumed", pauseTime))
+		}
+		if gcTTL > 0 && resumeTime.Sub(pauseTime) >= gcTTL {
+			return cerror.ErrInvalidPauseSchedule.GenWithStackByArgs(
+				fmt.Sprintf("the changefeed paused at %s is resumed at %s, "+
+					"the pause should be shorter than the GC TTL %s", pauseTime, resumeTime, gcTTL))
+		}
+		t = pauseTime
+	}
+	return nil
+}
+
+// LastEvent returns the last scheduled event between CheckedTime and now, pause
+// is true if the event is a pause. ok is false if there is no event.
+func (s *PauseSchedule) LastEvent(now time.Time) (pause bool, ok bool, err error) {
+	pauseSchedule, resumeSchedule, loc, err := s.parse()
+	if err != nil {
+		return false, false, err
+	}
+	from := s.CheckedTime
+	if now.Sub(from) > maxPauseScheduleLookback {
+		from = now.Add(-maxPauseScheduleLookback)
+	}
+	from = from.In(loc)
+	nextPause, nextResume := pauseSchedule.Next(from), resumeSchedule.Next(from)
+	for {
+		pauseDue := !nextPause.IsZero() && !nextPause.After(now)
+		resumeDue := !nextResume.IsZero() && !nextResume.After(now)
+		switch {
+		// a resume at the same time as a pause is handled after the pause
+		case pauseDue && (!resumeDue || !nextPause.After(nextResume)):
+			pause, ok = true, true
+			nextPause = pauseSchedule.Next(nextPause)
+		case resumeDue:
+			pause, ok = false, true
+			nextResume = resumeSchedule.Next(nextResume)
+		default:
+			return pause, ok, nil
+		}
+	}
+}
+
+// NextResume returns the first scheduled resume after t.
+func (s *PauseSchedule) NextResume(t time.Time) (time.Time, error) {
+	_, resume, loc, err := s.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return resume.Next(t.In(loc)), nil
+}
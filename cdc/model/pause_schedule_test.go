@@ -0,0 +1,80 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseScheduleValidate(t *testing.T) {
+	t.Parallel()
+
+	gcTTL := 24 * time.Hour
+	s := &PauseSchedule{Pause: "0 2 * * *", Resume: "0 4 * * *", TimeZone: "Asia/Shanghai"}
+	require.Nil(t, s.Validate(gcTTL))
+	// paused on weekends
+	s = &PauseSchedule{Pause: "0 0 * * 6", Resume: "0 0 * * 1"}
+	require.Regexp(t, ".*should be shorter than the GC TTL.*", s.Validate(gcTTL))
+	require.Nil(t, s.Validate(72*time.Hour))
+	require.Nil(t, s.Validate(0))
+
+	s = &PauseSchedule{Pause: "0 2 * *", Resume: "0 4 * * *"}
+	require.Regexp(t, ".*ErrInvalidPauseSchedule.*", s.Validate(gcTTL))
+	require.Regexp(t, ".*ErrInvalidPauseSchedule.*", s.Validate(0))
+	s = &PauseSchedule{Pause: "0 2 * * *", Resume: "0 4 * * *", TimeZone: "Mars/Olympus"}
+	require.Regexp(t, ".*ErrInvalidPauseSchedule.*", s.Validate(gcTTL))
+	s = &PauseSchedule{Pause: "0 2 * * *", Resume: "0 0 30 2 *"}
+	require.Regexp(t, ".*is never resumed.*", s.Validate(gcTTL))
+	s = &PauseSchedule{Pause: "0 0 30 2 *", Resume: "0 4 * * *"}
+	require.Regexp(t, ".*is never paused.*", s.Validate(gcTTL))
+}
+
+func TestPauseScheduleLastEvent(t *testing.T) {
+	t.Parallel()
+
+	s := &PauseSchedule{Pause: "0 2 * * *", Resume: "0 4 * * *", TimeZone: "UTC"}
+	day := time.Date(2021, 11, 5, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		checked, now time.Time
+		pause, ok    bool
+	}{
+		{day, day.Add(time.Hour), false, false},
+		{day, day.Add(2 * time.Hour), true, true},
+		{day, day.Add(3 * time.Hour), true, true},
+		{day, day.Add(5 * time.Hour), false, true},
+		{day.Add(3 * time.Hour), day.Add(5 * time.Hour), false, true},
+		{day.Add(5 * time.Hour), day.Add(25 * time.Hour), false, false},
+		{day.Add(5 * time.Hour), day.Add(27 * time.Hour), true, true},
+		// the events long ago are ignored
+		{time.Time{}, day.Add(time.Hour), false, true},
+	}
+	for _, tc := range testCases {
+		s.CheckedTime = tc.checked
+		pause, ok, err := s.LastEvent(tc.now)
+		require.Nil(t, err)
+		require.Equal(t, tc.ok, ok, "%v", tc)
+		require.Equal(t, tc.pause, pause, "%v", tc)
+	}
+
+	// a resume at the same time as a pause is handled after the pause
+	s = &PauseSchedule{Pause: "0 2 * * *", Resume: "0 2 * * *", TimeZone: "UTC"}
+	s.CheckedTime = day
+	pause, ok, err := s.LastEvent(day.Add(3 * time.Hour))
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.False(t, pause)
+}
@@ -88,7 +88,7 @@ func newAsyncSink(ctx cdcContext.Context) (AsyncSink, error) {
 		cancel: cancel,
 	}
 	if changefeedInfo.SyncPointEnabled {
-		asyncSink.syncpointStore, err = sink.NewSyncpointStore(ctx, changefeedID, changefeedInfo.SinkURI)
+		asyncSink.syncpointStore, err = sink.NewSyncpointStore(ctx, changefeedID, changefeedInfo.SinkURI, s)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
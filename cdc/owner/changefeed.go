@@ -252,6 +252,14 @@ LOOP:
 	}
 	cancelCtx, cancel := cdcContext.WithCancel(ctx)
 	c.cancel = cancel
+	c.scheduler.splitter = nil
+	if cfg := c.state.Info.Config; cfg.Scheduler != nil && len(cfg.Scheduler.SplitTables) != 0 {
+		splitter, err := newRegionSplitter(cancelCtx, ctx.GlobalVars().PDClient, c.schema, cfg)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.scheduler.splitter = splitter
+	}
 	c.sink, err = c.newSink(cancelCtx)
 	if err != nil {
 		return errors.Trace(err)
@@ -19,8 +19,10 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
 	cerrors "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/orchestrator"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 )
 
@@ -47,6 +49,7 @@ func (m *feedStateManager) Tick(state *orchestrator.ChangefeedReactorState) {
 			m.cleanUpInfos()
 		}
 	}()
+	m.handleSchedule()
 	if m.handleAdminJob() {
 		// `handleAdminJob` returns true means that some admin jobs are pending
 		// skip to the next tick until all the admin jobs is handled
@@ -149,12 +152,18 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 		m.patchState(model.StateNormal)
 		// remove error history to make sure the changefeed can running in next tick
 		m.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+			changed := false
 			if info.Error != nil || len(info.ErrorHis) != 0 {
 				info.Error = nil
 				info.ErrorHis = nil
-				return info, true, nil
+				changed = true
 			}
-			return info, false, nil
+			// the changefeed is not paused by the schedule any more
+			if info.PauseSchedule != nil && info.PauseSchedule.Paused {
+				info.PauseSchedule.Paused = false
+				changed = true
+			}
+			return info, changed, nil
 		})
 	case model.AdminFinish:
 		switch m.state.Info.State {
@@ -173,6 +182,98 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 	return
 }
 
+// handleSchedule pushes the admin jobs triggered by the pause schedule of the
+// changefeed. A scheduled pause is skipped if the changefeed would be resumed
+// after the GC TTL, and a scheduled resume only resumes the changefeed paused
+// by the schedule.
+func (m *feedStateManager) handleSchedule() {
+	schedule := m.state.Info.PauseSchedule
+	if schedule == nil {
+		return
+	}
+	now := time.Now()
+	gcTTL := time.Duration(config.GetGlobalServerConfig().GcTTL) * time.Second
+	if schedule.CheckedTime.IsZero() {
+		// the schedule is new, it's checked against the GC TTL of the owner
+		// because the cli doesn't know it. The changefeed with an invalid
+		// schedule can't run until the schedule is updated.
+		if err := schedule.Validate(gcTTL); err != nil {
+			m.stopByInvalidSchedule(err)
+			return
+		}
+		m.patchScheduleCheckedTime(now, schedule.Paused)
+		return
+	}
+	pause, ok, err := schedule.LastEvent(now)
+	if err != nil {
+		log.Warn("invalid pause schedule of the changefeed", zap.String("changefeedID", m.state.ID),
+			zap.Any("schedule", schedule), zap.Error(err))
+		return
+	}
+	if !ok {
+		return
+	}
+	paused := schedule.Paused
+	switch {
+	case pause && m.state.Info.State == model.StateNormal:
+		resumeTime, err := schedule.NextResume(now)
+		if err != nil {
+			log.Warn("invalid pause schedule of the changefeed", zap.String("changefeedID", m.state.ID),
+				zap.Any("schedule", schedule), zap.Error(err))
+			return
+		}
+		checkpointTime := oracle.GetTimeFromTS(m.state.Info.GetCheckpointTs(m.state.Status))
+		if resumeTime.IsZero() || resumeTime.Sub(checkpointTime) >= gcTTL {
+			log.Warn("skip the scheduled pause, the changefeed would exceed the GC TTL before it is resumed",
+				zap.String("changefeedID", m.state.ID), zap.Time("checkpointTime", checkpointTime),
+				zap.Time("resumeTime", resumeTime), zap.Duration("gcTTL", gcTTL))
+			break
+		}
+		log.Info("pause the changefeed by the schedule", zap.String("changefeedID", m.state.ID),
+			zap.Time("resumeTime", resumeTime))
+		m.pushAdminJob(&model.AdminJob{CfID: m.state.ID, Type: model.AdminStop})
+		paused = true
+	case !pause && schedule.Paused:
+		log.Info("resume the changefeed by the schedule", zap.String("changefeedID", m.state.ID))
+		m.pushAdminJob(&model.AdminJob{CfID: m.state.ID, Type: model.AdminResume})
+		paused = false
+	}
+	m.patchScheduleCheckedTime(now, paused)
+}
+
+// stopByInvalidSchedule stops the running changefeed with the error of its
+// pause schedule.
+func (m *feedStateManager) stopByInvalidSchedule(err error) {
+	if m.state.Info.State != model.StateNormal {
+		return
+	}
+	log.Warn("stop the changefeed with an invalid pause schedule", zap.String("changefeedID", m.state.ID),
+		zap.Any("schedule", m.state.Info.PauseSchedule), zap.Error(err))
+	m.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		if info == nil {
+			return nil, false, nil
+		}
+		info.Error = &model.RunningError{
+			Code:    string(cerrors.ErrInvalidPauseSchedule.RFCCode()),
+			Message: err.Error(),
+		}
+		return info, true, nil
+	})
+	m.shouldBeRunning = false
+	m.patchState(model.StateStopped)
+}
+
+func (m *feedStateManager) patchScheduleCheckedTime(checkedTime time.Time, paused bool) {
+	m.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		if info == nil || info.PauseSchedule == nil {
+			return info, false, nil
+		}
+		info.PauseSchedule.CheckedTime = checkedTime
+		info.PauseSchedule.Paused = paused
+		return info, true, nil
+	})
+}
+
 func (m *feedStateManager) popAdminJob() *model.AdminJob {
 	if len(m.adminJobQueue) == 0 {
 		return nil
@@ -14,12 +14,16 @@
 package owner
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/pingcap/check"
 	"github.com/pingcap/ticdc/cdc/model"
 	"github.com/pingcap/ticdc/pkg/config"
 	cdcContext "github.com/pingcap/ticdc/pkg/context"
 	"github.com/pingcap/ticdc/pkg/orchestrator"
 	"github.com/pingcap/ticdc/pkg/util/testleak"
+	"github.com/tikv/client-go/v2/oracle"
 )
 
 var _ = check.Suite(&feedStateManagerSuite{})
@@ -253,3 +257,122 @@ func (s *feedStateManagerSuite) TestChangefeedStatusNotExist(c *check.C) {
 	c.Assert(state.Info, check.IsNil)
 	c.Assert(state.Exist(), check.IsFalse)
 }
+
+// cronAt returns a cron expression matching the minute of t every day.
+func cronAt(t time.Time) string {
+	t = t.UTC()
+	return fmt.Sprintf("%d %d * * *", t.Minute(), t.Hour())
+}
+
+func (s *feedStateManagerSuite) TestHandleSchedule(c *check.C) {
+	defer testleak.AfterTest(c)()
+	ctx := cdcContext.NewBackendContext4Test(true)
+	manager := new(feedStateManager)
+	state := orchestrator.NewChangefeedReactorState(ctx.ChangefeedVars().ID)
+	tester := orchestrator.NewReactorStateTester(c, state, nil)
+	now := time.Now()
+	state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		return &model.ChangeFeedInfo{SinkURI: "123", Config: &config.ReplicaConfig{},
+			PauseSchedule: &model.PauseSchedule{
+				Pause:    cronAt(now.Add(-2 * time.Minute)),
+				Resume:   cronAt(now.Add(time.Hour)),
+				TimeZone: "UTC",
+			}}, true, nil
+	})
+	state.PatchStatus(func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+		return &model.ChangeFeedStatus{CheckpointTs: oracle.GoTimeToTS(now)}, true, nil
+	})
+	tester.MustApplyPatches()
+	setSchedule := func(fn func(schedule *model.PauseSchedule)) {
+		state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+			fn(info.PauseSchedule)
+			return info, true, nil
+		})
+		tester.MustApplyPatches()
+	}
+
+	// the events before the schedule is set are ignored
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(manager.ShouldRunning(), check.IsTrue)
+	c.Assert(state.Info.PauseSchedule.CheckedTime.IsZero(), check.IsFalse)
+
+	// pause the changefeed by the schedule
+	setSchedule(func(schedule *model.PauseSchedule) {
+		schedule.CheckedTime = now.Add(-5 * time.Minute)
+	})
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(manager.ShouldRunning(), check.IsFalse)
+	c.Assert(state.Info.State, check.Equals, model.StateStopped)
+	c.Assert(state.Info.PauseSchedule.Paused, check.IsTrue)
+
+	// resume the changefeed by the schedule
+	setSchedule(func(schedule *model.PauseSchedule) {
+		schedule.Resume = cronAt(now.Add(-time.Minute))
+		schedule.CheckedTime = now.Add(-2 * time.Minute)
+	})
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(manager.ShouldRunning(), check.IsTrue)
+	c.Assert(state.Info.State, check.Equals, model.StateNormal)
+	c.Assert(state.Info.PauseSchedule.Paused, check.IsFalse)
+
+	// the changefeed paused manually is not resumed by the schedule
+	manager.PushAdminJob(&model.AdminJob{CfID: ctx.ChangefeedVars().ID, Type: model.AdminStop})
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(state.Info.State, check.Equals, model.StateStopped)
+	setSchedule(func(schedule *model.PauseSchedule) {
+		schedule.CheckedTime = now.Add(-2 * time.Minute)
+	})
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(manager.ShouldRunning(), check.IsFalse)
+	c.Assert(state.Info.State, check.Equals, model.StateStopped)
+	manager.PushAdminJob(&model.AdminJob{CfID: ctx.ChangefeedVars().ID, Type: model.AdminResume})
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(state.Info.State, check.Equals, model.StateNormal)
+
+	// the pause is skipped if the changefeed would exceed the GC TTL
+	state.PatchStatus(func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+		status.CheckpointTs = oracle.GoTimeToTS(now.Add(-24 * time.Hour))
+		return status, true, nil
+	})
+	setSchedule(func(schedule *model.PauseSchedule) {
+		schedule.Resume = cronAt(now.Add(time.Hour))
+		schedule.CheckedTime = now.Add(-5 * time.Minute)
+	})
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(manager.ShouldRunning(), check.IsTrue)
+	c.Assert(state.Info.State, check.Equals, model.StateNormal)
+	c.Assert(state.Info.PauseSchedule.Paused, check.IsFalse)
+
+	// the changefeed is stopped if the new schedule pauses it longer than the GC TTL
+	setSchedule(func(schedule *model.PauseSchedule) {
+		schedule.Pause = "0 0 * * 6"
+		schedule.Resume = "0 0 * * 1"
+		schedule.CheckedTime = time.Time{}
+	})
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(manager.ShouldRunning(), check.IsFalse)
+	c.Assert(state.Info.State, check.Equals, model.StateStopped)
+	c.Assert(state.Info.Error.Code, check.Equals, "CDC:ErrInvalidPauseSchedule")
+	c.Assert(state.Info.PauseSchedule.CheckedTime.IsZero(), check.IsTrue)
+
+	// and it can run after the schedule is updated
+	setSchedule(func(schedule *model.PauseSchedule) {
+		schedule.Pause = cronAt(now.Add(time.Hour))
+		schedule.Resume = cronAt(now.Add(2 * time.Hour))
+	})
+	manager.PushAdminJob(&model.AdminJob{CfID: ctx.ChangefeedVars().ID, Type: model.AdminResume})
+	manager.Tick(state)
+	tester.MustApplyPatches()
+	c.Assert(manager.ShouldRunning(), check.IsTrue)
+	c.Assert(state.Info.State, check.Equals, model.StateNormal)
+	c.Assert(state.Info.Error, check.IsNil)
+	c.Assert(state.Info.PauseSchedule.CheckedTime.IsZero(), check.IsFalse)
+}
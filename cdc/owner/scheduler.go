@@ -15,6 +15,7 @@ package owner
 
 import (
 	"math"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -22,6 +23,7 @@ import (
 	"github.com/pingcap/ticdc/cdc/model"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/orchestrator"
+	"github.com/pingcap/ticdc/pkg/regionspan"
 	"go.uber.org/zap"
 )
 
@@ -39,6 +41,8 @@ type schedulerJob struct {
 	// if the operation is an add operation, boundaryTs is start ts
 	BoundaryTs    uint64
 	TargetCapture model.CaptureID
+	// Span is the key range of the table to add, nil means the whole table
+	Span *regionspan.ComparableSpan
 }
 
 type moveTableJob struct {
@@ -55,11 +59,23 @@ type scheduler struct {
 	moveTableJobQueue     []*moveTableJob
 	needRebalanceNextTick bool
 	lastTickCaptureCount  int
+
+	// lastThroughputBalance is the last time the throughput is balanced,
+	// and tableMovedAt records when the tables are moved by it.
+	lastThroughputBalance time.Time
+	tableMovedAt          map[model.TableID]time.Time
+
+	// splitter splits the hot tables into key ranges, nil means no table is split.
+	// The key ranges of a table are replicated by different captures, their
+	// resolved ts are merged by the global resolved ts, which is the barrier
+	// of flushing the sink of every key range.
+	splitter tableSplitter
 }
 
 func newScheduler() *scheduler {
 	return &scheduler{
 		moveTableTargets: make(map[model.TableID]model.CaptureID),
+		tableMovedAt:     make(map[model.TableID]time.Time),
 	}
 }
 
@@ -146,13 +162,20 @@ func (s *scheduler) Rebalance() {
 func (s *scheduler) table2CaptureIndex() (map[model.TableID]model.CaptureID, error) {
 	table2CaptureIndex := make(map[model.TableID]model.CaptureID)
 	for captureID, taskStatus := range s.state.TaskStatuses {
-		for tableID := range taskStatus.Tables {
+		for tableID, replicaInfo := range taskStatus.Tables {
+			if replicaInfo.Span != nil {
+				// the key ranges of a split table are replicated by several captures
+				continue
+			}
 			if preCaptureID, exist := table2CaptureIndex[tableID]; exist && preCaptureID != captureID {
 				return nil, cerror.ErrTableListenReplicated.GenWithStackByArgs(tableID, preCaptureID, captureID)
 			}
 			table2CaptureIndex[tableID] = captureID
 		}
-		for tableID := range taskStatus.Operation {
+		for tableID, operation := range taskStatus.Operation {
+			if operation.IsSplitTable() {
+				continue
+			}
 			if preCaptureID, exist := table2CaptureIndex[tableID]; exist && preCaptureID != captureID {
 				return nil, cerror.ErrTableListenReplicated.GenWithStackByArgs(tableID, preCaptureID, captureID)
 			}
@@ -162,6 +185,47 @@ func (s *scheduler) table2CaptureIndex() (map[model.TableID]model.CaptureID, err
 	return table2CaptureIndex, nil
 }
 
+// splitTableReplicas records the key ranges of a split table replicated by the captures.
+type splitTableReplicas struct {
+	spans map[model.CaptureID]*regionspan.ComparableSpan
+	// operating is true if any key range is being added or removed
+	operating bool
+}
+
+// splitTable2Captures returns the replicas of the split tables.
+func (s *scheduler) splitTable2Captures() map[model.TableID]*splitTableReplicas {
+	splitTables := make(map[model.TableID]*splitTableReplicas)
+	getReplicas := func(tableID model.TableID) *splitTableReplicas {
+		replicas, exist := splitTables[tableID]
+		if !exist {
+			replicas = &splitTableReplicas{spans: make(map[model.CaptureID]*regionspan.ComparableSpan)}
+			splitTables[tableID] = replicas
+		}
+		return replicas
+	}
+	for captureID, taskStatus := range s.state.TaskStatuses {
+		for tableID, replicaInfo := range taskStatus.Tables {
+			if replicaInfo.Span != nil {
+				getReplicas(tableID).spans[captureID] = replicaInfo.Span
+			}
+		}
+		for tableID, operation := range taskStatus.Operation {
+			if operation.IsSplitTable() {
+				getReplicas(tableID).operating = true
+			}
+		}
+	}
+	return splitTables
+}
+
+// splitTable returns the key ranges of the table if it should be split.
+func (s *scheduler) splitTable(tableID model.TableID) []regionspan.ComparableSpan {
+	if s.splitter == nil {
+		return nil
+	}
+	return s.splitter.SplitTable(tableID, len(s.captures))
+}
+
 // dispatchToTargetCaptures sets the TargetCapture of scheduler jobs
 // If the TargetCapture of a job is not set, it chooses a capture with the minimum workload(minimum number of tables)
 // and sets the TargetCapture to the capture.
@@ -200,10 +264,13 @@ func (s *scheduler) dispatchToTargetCaptures(pendingJobs []*schedulerJob) {
 		}
 	}
 
-	getMinWorkloadCapture := func() model.CaptureID {
+	getMinWorkloadCapture := func(excluded map[model.CaptureID]bool) model.CaptureID {
 		minCapture := ""
 		minWorkLoad := uint64(math.MaxUint64)
 		for captureID, workload := range workloads {
+			if excluded[captureID] {
+				continue
+			}
 			if workload < minWorkLoad {
 				minCapture = captureID
 				minWorkLoad = workload
@@ -216,13 +283,26 @@ func (s *scheduler) dispatchToTargetCaptures(pendingJobs []*schedulerJob) {
 		return minCapture
 	}
 
+	// the key ranges of a split table are dispatched to different captures
+	splitTableTargets := make(map[model.TableID]map[model.CaptureID]bool)
 	for _, pendingJob := range pendingJobs {
 		if pendingJob.TargetCapture != "" {
 			continue
 		}
-		minCapture := getMinWorkloadCapture()
+		var excluded map[model.CaptureID]bool
+		if pendingJob.Span != nil {
+			excluded = splitTableTargets[pendingJob.TableID]
+			if excluded == nil {
+				excluded = make(map[model.CaptureID]bool)
+				splitTableTargets[pendingJob.TableID] = excluded
+			}
+		}
+		minCapture := getMinWorkloadCapture(excluded)
 		pendingJob.TargetCapture = minCapture
 		workloads[minCapture] += 1
+		if excluded != nil {
+			excluded[minCapture] = true
+		}
 	}
 }
 
@@ -234,12 +314,34 @@ func (s *scheduler) syncTablesWithCurrentTables() ([]*schedulerJob, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	splitTables := s.splitTable2Captures()
 	globalCheckpointTs := s.state.Status.CheckpointTs
 	for _, tableID := range s.currentTables {
 		if _, exist := allTableListeningNow[tableID]; exist {
 			delete(allTableListeningNow, tableID)
 			continue
 		}
+		if replicas, exist := splitTables[tableID]; exist {
+			delete(splitTables, tableID)
+			if !replicas.operating && !replicas.coverTable(tableID) {
+				// Some key ranges are lost, for example, the capture replicating
+				// them is down. Remove all the key ranges, and the table will be
+				// split again after they are removed.
+				pendingJob = append(pendingJob, s.removeSplitTableJobs(tableID, replicas)...)
+			}
+			continue
+		}
+		if spans := s.splitTable(tableID); len(spans) != 0 {
+			for i := range spans {
+				pendingJob = append(pendingJob, &schedulerJob{
+					Tp:         schedulerJobTypeAddTable,
+					TableID:    tableID,
+					BoundaryTs: globalCheckpointTs,
+					Span:       &spans[i],
+				})
+			}
+			continue
+		}
 		// For each table which should be listened but is not, add an adding-table job to the pending job list
 		pendingJob = append(pendingJob, &schedulerJob{
 			Tp:         schedulerJobTypeAddTable,
@@ -262,9 +364,40 @@ func (s *scheduler) syncTablesWithCurrentTables() ([]*schedulerJob, error) {
 			TargetCapture: captureID,
 		})
 	}
+	for tableID, replicas := range splitTables {
+		pendingJob = append(pendingJob, s.removeSplitTableJobs(tableID, replicas)...)
+	}
 	return pendingJob, nil
 }
 
+// coverTable returns true if the key ranges cover the whole table.
+func (r *splitTableReplicas) coverTable(tableID model.TableID) bool {
+	spans := make([]regionspan.ComparableSpan, 0, len(r.spans))
+	for _, span := range r.spans {
+		spans = append(spans, *span)
+	}
+	return spansCoverTable(tableID, spans)
+}
+
+// removeSplitTableJobs returns the jobs which remove all the key ranges of a split table.
+func (s *scheduler) removeSplitTableJobs(tableID model.TableID, replicas *splitTableReplicas) []*schedulerJob {
+	jobs := make([]*schedulerJob, 0, len(replicas.spans))
+	for captureID := range replicas.spans {
+		opts := s.state.TaskStatuses[captureID].Operation
+		if opts != nil && opts[tableID] != nil && opts[tableID].Delete {
+			// the key range is being removed, skip
+			continue
+		}
+		jobs = append(jobs, &schedulerJob{
+			Tp:            schedulerJobTypeRemoveTable,
+			TableID:       tableID,
+			BoundaryTs:    s.state.Status.CheckpointTs,
+			TargetCapture: captureID,
+		})
+	}
+	return jobs
+}
+
 func (s *scheduler) handleJobs(jobs []*schedulerJob) {
 	for _, job := range jobs {
 		job := job
@@ -279,6 +412,7 @@ func (s *scheduler) handleJobs(jobs []*schedulerJob) {
 				status.AddTable(job.TableID, &model.TableReplicaInfo{
 					StartTs:     job.BoundaryTs,
 					MarkTableID: 0, // mark table ID will be set in processors
+					Span:        job.Span,
 				}, job.BoundaryTs)
 			case schedulerJobTypeRemoveTable:
 				failpoint.Inject("OwnerRemoveTableError", func() {
@@ -315,11 +449,13 @@ func (s *scheduler) cleanUpFinishedOperations() {
 }
 
 func (s *scheduler) rebalance() (shouldUpdateState bool) {
+	if s.schedulerType() == schedulerTypeThroughput {
+		return s.rebalanceByThroughput(s.shouldRebalance())
+	}
 	if !s.shouldRebalance() {
 		// if no table is rebalanced, we can update the resolved ts and checkpoint ts
 		return true
 	}
-	// we only support rebalance by table number for now
 	return s.rebalanceByTableNum()
 }
 
@@ -359,11 +495,15 @@ func (s *scheduler) rebalanceByTableNum() (shouldUpdateState bool) {
 
 		// here we pick `tableNum2Remove` tables to delete,
 		// and then the removed tables will be dispatched by `syncTablesWithCurrentTables` function in the next tick
-		for tableID := range taskStatus.Tables {
+		for tableID, replicaInfo := range taskStatus.Tables {
 			tableID := tableID
 			if tableNum2Remove <= 0 {
 				break
 			}
+			if replicaInfo.Span != nil {
+				// the key ranges of a split table are not moved
+				continue
+			}
 			shouldUpdateState = false
 			s.state.PatchTaskStatus(captureID, func(status *model.TaskStatus) (*model.TaskStatus, bool, error) {
 				if status == nil {
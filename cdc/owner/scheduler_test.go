@@ -19,7 +19,9 @@ import (
 
 	"github.com/pingcap/check"
 	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/orchestrator"
+	"github.com/pingcap/ticdc/pkg/regionspan"
 	"github.com/pingcap/ticdc/pkg/util/testleak"
 )
 
@@ -338,3 +340,288 @@ func (s *schedulerSuite) TestScheduleRebalance(c *check.C) {
 	}
 	c.Assert(tableIDs, check.DeepEquals, map[model.TableID]struct{}{1: {}, 2: {}, 3: {}, 4: {}, 5: {}, 6: {}})
 }
+
+func (s *schedulerSuite) TestPlanThroughputMoves(c *check.C) {
+	defer testleak.AfterTest(c)()
+	newCapture := func(captureID model.CaptureID, capacity float64, tables map[model.TableID]float64) *captureThroughput {
+		ct := &captureThroughput{captureID: captureID, capacity: capacity, tables: tables}
+		for _, load := range tables {
+			ct.load += load
+		}
+		return ct
+	}
+	notFrozen := func(model.TableID) bool { return false }
+
+	// the hot capture is balanced in two moves
+	jobs := planThroughputMoves([]*captureThroughput{
+		newCapture("capture-1", 1, map[model.TableID]float64{1: 0.5, 2: 0.3, 3: 0.1}),
+		newCapture("capture-2", 1, map[model.TableID]float64{4: 0.1}),
+	}, 0.2, notFrozen)
+	c.Assert(jobs, check.DeepEquals, []*moveTableJob{
+		{tableID: 1, target: "capture-2"},
+		{tableID: 4, target: "capture-1"},
+	})
+
+	// the moved tables are not moved again
+	jobs = planThroughputMoves([]*captureThroughput{
+		newCapture("capture-1", 1, map[model.TableID]float64{1: 0.5, 2: 0.3, 3: 0.1}),
+		newCapture("capture-2", 1, map[model.TableID]float64{4: 0.1}),
+	}, 0.2, func(tableID model.TableID) bool { return tableID == 1 })
+	c.Assert(jobs, check.DeepEquals, []*moveTableJob{
+		{tableID: 2, target: "capture-2"},
+		{tableID: 3, target: "capture-2"},
+	})
+
+	// the skewness is within the tolerance
+	jobs = planThroughputMoves([]*captureThroughput{
+		newCapture("capture-1", 1, map[model.TableID]float64{1: 0.3, 2: 0.27}),
+		newCapture("capture-2", 1, map[model.TableID]float64{3: 0.43}),
+	}, 0.2, notFrozen)
+	c.Assert(jobs, check.HasLen, 0)
+
+	// moving the only hot table makes it worse
+	jobs = planThroughputMoves([]*captureThroughput{
+		newCapture("capture-1", 1, map[model.TableID]float64{1: 0.9}),
+		newCapture("capture-2", 1, map[model.TableID]float64{2: 0.1}),
+	}, 0.2, notFrozen)
+	c.Assert(jobs, check.HasLen, 0)
+
+	// the load is weighed against the capacity
+	jobs = planThroughputMoves([]*captureThroughput{
+		newCapture("capture-1", 3, map[model.TableID]float64{1: 0.25, 2: 0.25, 3: 0.25}),
+		newCapture("capture-2", 1, map[model.TableID]float64{4: 0.25}),
+	}, 0.2, notFrozen)
+	c.Assert(jobs, check.HasLen, 0)
+}
+
+func (s *schedulerSuite) TestScheduleRebalanceByThroughput(c *check.C) {
+	defer testleak.AfterTest(c)()
+	s.reset(c)
+	s.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		info = &model.ChangeFeedInfo{Config: config.GetDefaultReplicaConfig()}
+		info.Config.Scheduler.Tp = schedulerTypeThroughput
+		return info, true, nil
+	})
+	captureID1 := "test-capture-1"
+	captureID2 := "test-capture-2"
+	s.addCapture(captureID1)
+	s.state.PatchTaskStatus(captureID1, func(status *model.TaskStatus) (*model.TaskStatus, bool, error) {
+		status.Tables = make(map[model.TableID]*model.TableReplicaInfo)
+		for tableID := model.TableID(1); tableID <= 4; tableID++ {
+			status.Tables[tableID] = &model.TableReplicaInfo{StartTs: 1}
+		}
+		return status, true, nil
+	})
+	s.state.PatchTaskWorkload(captureID1, func(workload model.TaskWorkload) (model.TaskWorkload, bool, error) {
+		return model.TaskWorkload{
+			1: {Workload: 1, EventRate: 1000, ByteRate: 100000},
+			2: {Workload: 1, EventRate: 10, ByteRate: 1000},
+			3: {Workload: 1, EventRate: 10, ByteRate: 1000},
+			4: {Workload: 1, EventRate: 10, ByteRate: 1000},
+		}, true, nil
+	})
+	s.tester.MustApplyPatches()
+	tables := []model.TableID{1, 2, 3, 4}
+
+	// the only capture has all the load
+	shouldUpdateState, err := s.scheduler.Tick(s.state, tables, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsTrue)
+	s.tester.MustApplyPatches()
+	c.Assert(s.state.TaskStatuses[captureID1].Tables, check.HasLen, 4)
+
+	// a new capture is added, the hot table is moved to it
+	s.addCapture(captureID2)
+	shouldUpdateState, err = s.scheduler.Tick(s.state, tables, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsFalse)
+	s.tester.MustApplyPatches()
+	c.Assert(s.state.TaskStatuses[captureID1].Tables, check.HasLen, 3)
+	c.Assert(s.state.TaskStatuses[captureID1].Tables[1], check.IsNil)
+
+	// clean finished operation
+	s.finishTableOperation(captureID1, 1)
+	shouldUpdateState, err = s.scheduler.Tick(s.state, tables, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsTrue)
+	s.tester.MustApplyPatches()
+	c.Assert(s.state.TaskStatuses[captureID1].Operation, check.HasLen, 0)
+
+	// the hot table is added to the new capture
+	shouldUpdateState, err = s.scheduler.Tick(s.state, tables, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsFalse)
+	s.tester.MustApplyPatches()
+	c.Assert(s.state.TaskStatuses[captureID2].Tables, check.HasKey, model.TableID(1))
+
+	// the throughput is not checked again until the polling time elapses
+	s.finishTableOperation(captureID2, 1)
+	shouldUpdateState, err = s.scheduler.Tick(s.state, tables, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsTrue)
+	s.tester.MustApplyPatches()
+	c.Assert(s.scheduler.tableMovedAt, check.HasKey, model.TableID(1))
+	c.Assert(s.state.TaskStatuses[captureID1].Tables, check.HasLen, 3)
+	c.Assert(s.state.TaskStatuses[captureID2].Tables, check.HasLen, 1)
+}
+
+type mockTableSplitter struct {
+	boundaries map[model.TableID][][]byte
+}
+
+func (m *mockTableSplitter) SplitTable(tableID model.TableID, num int) []regionspan.ComparableSpan {
+	boundaries, ok := m.boundaries[tableID]
+	if !ok {
+		return nil
+	}
+	return splitSpanByBoundaries(regionspan.ToComparableSpan(regionspan.GetTableSpan(tableID)), boundaries, num)
+}
+
+func (s *schedulerSuite) splitTableSpans(tableID model.TableID) map[model.CaptureID]*regionspan.ComparableSpan {
+	spans := make(map[model.CaptureID]*regionspan.ComparableSpan)
+	for captureID, status := range s.state.TaskStatuses {
+		if replicaInfo, ok := status.Tables[tableID]; ok {
+			spans[captureID] = replicaInfo.Span
+		}
+	}
+	return spans
+}
+
+func (s *schedulerSuite) TestScheduleSplitTable(c *check.C) {
+	defer testleak.AfterTest(c)()
+	s.reset(c)
+	rawTableSpan := regionspan.GetTableSpan(1)
+	tableSpan := regionspan.ToComparableSpan(rawTableSpan)
+	boundaries := [][]byte{
+		regionspan.ToComparableKey(append(append([]byte{}, rawTableSpan.Start...), 1)),
+		regionspan.ToComparableKey(append(append([]byte{}, rawTableSpan.Start...), 2)),
+	}
+	s.scheduler.splitter = &mockTableSplitter{boundaries: map[model.TableID][][]byte{1: boundaries}}
+	captureIDs := []model.CaptureID{"test-capture-1", "test-capture-2", "test-capture-3"}
+	for _, captureID := range captureIDs {
+		s.addCapture(captureID)
+	}
+
+	// the key ranges are dispatched to different captures
+	shouldUpdateState, err := s.scheduler.Tick(s.state, []model.TableID{1}, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsFalse)
+	s.tester.MustApplyPatches()
+	spans := s.splitTableSpans(1)
+	c.Assert(spans, check.HasLen, 3)
+	var allSpans []regionspan.ComparableSpan
+	for captureID, span := range spans {
+		c.Assert(span, check.NotNil)
+		allSpans = append(allSpans, *span)
+		c.Assert(s.state.TaskStatuses[captureID].Operation[1], check.DeepEquals, &model.TableOperation{
+			Flag: model.OperFlagSplitTable, Status: model.OperDispatched,
+		})
+	}
+	c.Assert(spansCoverTable(1, allSpans), check.IsTrue)
+	c.Assert(allSpans[0], check.DeepEquals, regionspan.ComparableSpan{Start: tableSpan.Start, End: boundaries[0]})
+
+	for _, captureID := range captureIDs {
+		s.finishTableOperation(captureID, 1)
+	}
+	shouldUpdateState, err = s.scheduler.Tick(s.state, []model.TableID{1}, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsTrue)
+	s.tester.MustApplyPatches()
+
+	// a capture is down, the remaining key ranges are removed
+	delete(s.captures, captureIDs[2])
+	s.state.PatchTaskStatus(captureIDs[2], func(status *model.TaskStatus) (*model.TaskStatus, bool, error) {
+		return nil, true, nil
+	})
+	s.tester.MustApplyPatches()
+	shouldUpdateState, err = s.scheduler.Tick(s.state, []model.TableID{1}, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsFalse)
+	s.tester.MustApplyPatches()
+	c.Assert(s.splitTableSpans(1), check.HasLen, 0)
+	for _, captureID := range captureIDs[:2] {
+		c.Assert(s.state.TaskStatuses[captureID].Operation[1], check.DeepEquals, &model.TableOperation{
+			Delete: true, Flag: model.OperFlagSplitTable,
+		})
+		s.finishTableOperation(captureID, 1)
+	}
+
+	// clean finished operation
+	shouldUpdateState, err = s.scheduler.Tick(s.state, []model.TableID{1}, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsTrue)
+	s.tester.MustApplyPatches()
+
+	// the table is split into two key ranges again
+	shouldUpdateState, err = s.scheduler.Tick(s.state, []model.TableID{1}, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsFalse)
+	s.tester.MustApplyPatches()
+	spans = s.splitTableSpans(1)
+	c.Assert(spans, check.HasLen, 2)
+	allSpans = allSpans[:0]
+	for _, span := range spans {
+		allSpans = append(allSpans, *span)
+	}
+	c.Assert(spansCoverTable(1, allSpans), check.IsTrue)
+	for _, captureID := range captureIDs[:2] {
+		s.finishTableOperation(captureID, 1)
+	}
+	shouldUpdateState, err = s.scheduler.Tick(s.state, []model.TableID{1}, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsTrue)
+	s.tester.MustApplyPatches()
+
+	// the table is dropped, all the key ranges are removed
+	shouldUpdateState, err = s.scheduler.Tick(s.state, []model.TableID{}, s.captures)
+	c.Assert(err, check.IsNil)
+	c.Assert(shouldUpdateState, check.IsFalse)
+	s.tester.MustApplyPatches()
+	c.Assert(s.splitTableSpans(1), check.HasLen, 0)
+}
+
+func (s *schedulerSuite) TestSplitSpanByBoundaries(c *check.C) {
+	defer testleak.AfterTest(c)()
+	span := regionspan.ComparableSpan{Start: []byte{0}, End: []byte{10}}
+	boundaries := [][]byte{{1}, {2}, {3}, {4}}
+
+	c.Assert(splitSpanByBoundaries(span, boundaries, 1), check.IsNil)
+	c.Assert(splitSpanByBoundaries(span, nil, 3), check.IsNil)
+	c.Assert(splitSpanByBoundaries(span, boundaries, 2), check.DeepEquals, []regionspan.ComparableSpan{
+		{Start: []byte{0}, End: []byte{2}}, {Start: []byte{2}, End: []byte{10}},
+	})
+	c.Assert(splitSpanByBoundaries(span, boundaries, 3), check.DeepEquals, []regionspan.ComparableSpan{
+		{Start: []byte{0}, End: []byte{1}}, {Start: []byte{1}, End: []byte{3}}, {Start: []byte{3}, End: []byte{10}},
+	})
+	c.Assert(splitSpanByBoundaries(span, boundaries, 8), check.HasLen, 5)
+}
+
+func (s *schedulerSuite) TestNormalizeBoundaries(c *check.C) {
+	defer testleak.AfterTest(c)()
+	rawSpan := regionspan.GetTableSpan(1)
+	span := regionspan.ToComparableSpan(rawSpan)
+	rawKey := func(b byte) []byte {
+		return append(append([]byte{}, rawSpan.Start...), b)
+	}
+	key1 := regionspan.ToComparableKey(rawKey(1))
+	key2 := regionspan.ToComparableKey(rawKey(2))
+	key3 := regionspan.ToComparableKey(rawKey(3))
+
+	boundaries := normalizeBoundaries(span, [][]byte{
+		// the start key of the table is not a boundary
+		span.Start,
+		key1,
+		// the timestamp suffix is dropped
+		append(append([]byte{}, key2...), 0, 0, 0, 0, 0, 0, 0, 1),
+		key2,
+		// the key which is not encoded completely is skipped
+		key3[:len(key3)-1],
+		key3,
+		span.End,
+	})
+	c.Assert(boundaries, check.DeepEquals, [][]byte{key1, key2, key3})
+	for _, spans := range splitSpanByBoundaries(span, boundaries, 4) {
+		_, err := regionspan.FromComparableSpan(spans)
+		c.Assert(err, check.IsNil)
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/cdc/model"
+	"go.uber.org/zap"
+)
+
+const (
+	// schedulerTypeThroughput balances the tables by their throughput.
+	schedulerTypeThroughput = "throughput"
+
+	defaultThroughputPollingTime = 60 * time.Second
+	defaultThroughputTolerance   = 0.2
+	// maxThroughputMovesPerRound limits the tables moved in one round, since
+	// the replication of a table pauses while it is being moved.
+	maxThroughputMovesPerRound = 4
+	// tableMoveCooldownRounds is the number of rounds in which a moved table
+	// is not moved again, so a table doesn't flap between captures.
+	tableMoveCooldownRounds = 3
+)
+
+// captureThroughput is the throughput of the tables replicated by a capture,
+// the load of a table is its share of the throughput of the changefeed.
+type captureThroughput struct {
+	captureID model.CaptureID
+	capacity  float64
+	load      float64
+	tables    map[model.TableID]float64
+}
+
+func (s *scheduler) schedulerType() string {
+	if s.state == nil || s.state.Info == nil || s.state.Info.Config == nil || s.state.Info.Config.Scheduler == nil {
+		return ""
+	}
+	return s.state.Info.Config.Scheduler.Tp
+}
+
+func (s *scheduler) throughputConfig() (interval time.Duration, tolerance float64) {
+	interval, tolerance = defaultThroughputPollingTime, defaultThroughputTolerance
+	cfg := s.state.Info.Config.Scheduler
+	if cfg.PollingTime > 0 {
+		interval = time.Duration(cfg.PollingTime) * time.Second
+	}
+	if cfg.Tolerance > 0 {
+		tolerance = cfg.Tolerance
+	}
+	return
+}
+
+// hasRunningOperations returns true if any table is being added, removed or moved.
+func (s *scheduler) hasRunningOperations() bool {
+	if len(s.moveTableTargets) != 0 || len(s.moveTableJobQueue) != 0 {
+		return true
+	}
+	for _, status := range s.state.TaskStatuses {
+		if len(status.Operation) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// rebalanceByThroughput moves tables away from the captures whose throughput
+// exceeds their share by the tolerance, the tables are moved by MoveTable.
+// It runs every polling time, or immediately if force is true.
+func (s *scheduler) rebalanceByThroughput(force bool) (shouldUpdateState bool) {
+	interval, tolerance := s.throughputConfig()
+	now := time.Now()
+	if !force && now.Sub(s.lastThroughputBalance) < interval {
+		return true
+	}
+	if s.hasRunningOperations() {
+		// the workloads are not stable, retry in the next tick
+		if force {
+			s.needRebalanceNextTick = true
+		}
+		return true
+	}
+	s.lastThroughputBalance = now
+	for tableID, movedAt := range s.tableMovedAt {
+		if now.Sub(movedAt) >= interval*tableMoveCooldownRounds {
+			delete(s.tableMovedAt, tableID)
+		}
+	}
+
+	jobs := planThroughputMoves(s.captureThroughputs(), tolerance, func(tableID model.TableID) bool {
+		_, ok := s.tableMovedAt[tableID]
+		return ok
+	})
+	for _, job := range jobs {
+		log.Info("Rebalance by throughput: move table",
+			zap.String("changefeed", s.state.ID),
+			zap.Int64("table-id", job.tableID),
+			zap.String("target-capture", job.target))
+		s.MoveTable(job.tableID, job.target)
+		s.tableMovedAt[job.tableID] = now
+	}
+	return true
+}
+
+// captureThroughputs collects the throughput of the alive captures. The load
+// of a table is the average of its shares of the events and the bytes, if
+// there is no throughput at all, every table has the same load.
+func (s *scheduler) captureThroughputs() []*captureThroughput {
+	type tableRate struct {
+		captureID model.CaptureID
+		events    uint64
+		bytes     uint64
+	}
+	var totalEvents, totalBytes uint64
+	rates := make(map[model.TableID]tableRate)
+	for captureID, status := range s.state.TaskStatuses {
+		if _, ok := s.captures[captureID]; !ok {
+			continue
+		}
+		workloads := s.state.Workloads[captureID]
+		for tableID, replicaInfo := range status.Tables {
+			if replicaInfo.Span != nil {
+				// the key ranges of a split table are not moved
+				continue
+			}
+			w := workloads[tableID]
+			rates[tableID] = tableRate{captureID: captureID, events: w.EventRate, bytes: w.ByteRate}
+			totalEvents += w.EventRate
+			totalBytes += w.ByteRate
+		}
+	}
+
+	share := func(v, total uint64) float64 {
+		if total == 0 {
+			return 1 / float64(len(rates))
+		}
+		return float64(v) / float64(total)
+	}
+	captures := make(map[model.CaptureID]*captureThroughput, len(s.captures))
+	var knownCapacity, knownCount int
+	for captureID, info := range s.captures {
+		captures[captureID] = &captureThroughput{
+			captureID: captureID,
+			capacity:  float64(info.Capacity),
+			tables:    make(map[model.TableID]float64),
+		}
+		if info.Capacity > 0 {
+			knownCapacity += info.Capacity
+			knownCount++
+		}
+	}
+	for tableID, rate := range rates {
+		c := captures[rate.captureID]
+		load := (share(rate.events, totalEvents) + share(rate.bytes, totalBytes)) / 2
+		c.tables[tableID] = load
+		c.load += load
+	}
+
+	result := make([]*captureThroughput, 0, len(captures))
+	for _, c := range captures {
+		// the captures of old versions don't report the capacity
+		if c.capacity == 0 {
+			c.capacity = 1
+			if knownCount > 0 {
+				c.capacity = float64(knownCapacity) / float64(knownCount)
+			}
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+// planThroughputMoves moves the tables greedily from the capture with the
+// highest load relative to its capacity to the one with the lowest load. To
+// avoid flapping, a round starts only if a capture exceeds its share by the
+// tolerance, and stops once all captures are within half of the tolerance or
+// no move reduces the highest relative load.
+func planThroughputMoves(
+	captures []*captureThroughput, tolerance float64, frozen func(model.TableID) bool,
+) []*moveTableJob {
+	if len(captures) < 2 {
+		return nil
+	}
+	var totalLoad, totalCapacity float64
+	for _, c := range captures {
+		totalLoad += c.load
+		totalCapacity += c.capacity
+	}
+	if totalLoad == 0 {
+		return nil
+	}
+	// ratio is the load of a capture relative to its share, 1 means balanced.
+	ratio := func(c *captureThroughput, delta float64) float64 {
+		return ((c.load + delta) / totalLoad) / (c.capacity / totalCapacity)
+	}
+
+	var jobs []*moveTableJob
+	moved := make(map[model.TableID]bool)
+	for len(jobs) < maxThroughputMovesPerRound {
+		sort.Slice(captures, func(i, j int) bool {
+			ri, rj := ratio(captures[i], 0), ratio(captures[j], 0)
+			if ri != rj {
+				return ri < rj
+			}
+			return captures[i].captureID < captures[j].captureID
+		})
+		dst, src := captures[0], captures[len(captures)-1]
+		srcRatio := ratio(src, 0)
+		if (len(jobs) == 0 && srcRatio <= 1+tolerance) || srcRatio <= 1+tolerance/2 {
+			break
+		}
+
+		tableIDs := make([]model.TableID, 0, len(src.tables))
+		for tableID := range src.tables {
+			tableIDs = append(tableIDs, tableID)
+		}
+		sort.Slice(tableIDs, func(i, j int) bool { return tableIDs[i] < tableIDs[j] })
+		found := false
+		var target model.TableID
+		bestRatio := srcRatio
+		for _, tableID := range tableIDs {
+			if moved[tableID] || frozen(tableID) {
+				continue
+			}
+			load := src.tables[tableID]
+			newRatio := ratio(src, -load)
+			if r := ratio(dst, load); r > newRatio {
+				newRatio = r
+			}
+			if newRatio < bestRatio-1e-9 {
+				found, target, bestRatio = true, tableID, newRatio
+			}
+		}
+		if !found {
+			break
+		}
+		load := src.tables[target]
+		delete(src.tables, target)
+		src.load -= load
+		dst.tables[target] = load
+		dst.load += load
+		moved[target] = true
+		jobs = append(jobs, &moveTableJob{tableID: target, target: dst.captureID})
+	}
+	return jobs
+}
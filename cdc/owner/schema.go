@@ -85,6 +85,11 @@ func (s *schemaWrap4Owner) AllPhysicalTables() []model.TableID {
 	return s.allPhysicalTablesCache
 }
 
+// TableNameByID returns the name of a table or a partition of a table.
+func (s *schemaWrap4Owner) TableNameByID(tableID model.TableID) (model.TableName, bool) {
+	return s.schemaSnapshot.GetTableNameByID(tableID)
+}
+
 func (s *schemaWrap4Owner) HandleDDL(job *timodel.Job) error {
 	if job.BinlogInfo.FinishedTS <= s.ddlHandledTs {
 		return nil
@@ -0,0 +1,188 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sort"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/regionspan"
+	filterV2 "github.com/pingcap/tidb-tools/pkg/table-filter"
+	"github.com/pingcap/tidb/util/codec"
+	pd "github.com/tikv/pd/client"
+	"go.uber.org/zap"
+)
+
+// scanRegionsLimit is the max number of regions scanned from PD in one request.
+const scanRegionsLimit = 1024
+
+// tableSplitter splits a table into key ranges, the key ranges of a table are
+// replicated by different captures.
+type tableSplitter interface {
+	// SplitTable returns at most num key ranges of the table, it returns nil
+	// if the table is not split.
+	SplitTable(tableID model.TableID, num int) []regionspan.ComparableSpan
+}
+
+// regionSplitter splits the tables matched by the split-tables rules, each
+// key range of a table contains about the same number of regions.
+type regionSplitter struct {
+	ctx      context.Context
+	pdClient pd.Client
+	schema   *schemaWrap4Owner
+	filter   filterV2.Filter
+	spanNum  int
+}
+
+func newRegionSplitter(
+	ctx context.Context, pdClient pd.Client, schema *schemaWrap4Owner, cfg *config.ReplicaConfig,
+) (*regionSplitter, error) {
+	f, err := filterV2.Parse(cfg.Scheduler.SplitTables)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err)
+	}
+	if !cfg.CaseSensitive {
+		f = filterV2.CaseInsensitive(f)
+	}
+	return &regionSplitter{
+		ctx:      ctx,
+		pdClient: pdClient,
+		schema:   schema,
+		filter:   f,
+		spanNum:  cfg.Scheduler.SplitSpanNum,
+	}, nil
+}
+
+// SplitTable implements the tableSplitter interface. If the regions of the
+// table can't be loaded, the table is replicated as a whole.
+func (s *regionSplitter) SplitTable(tableID model.TableID, num int) []regionspan.ComparableSpan {
+	name, ok := s.schema.TableNameByID(tableID)
+	if !ok || !s.filter.MatchTable(name.Schema, name.Table) {
+		return nil
+	}
+	if s.spanNum > 0 && s.spanNum < num {
+		num = s.spanNum
+	}
+	if num < 2 {
+		return nil
+	}
+	tableSpan := regionspan.ToComparableSpan(regionspan.GetTableSpan(tableID))
+	boundaries, err := s.regionBoundaries(tableSpan)
+	if err != nil {
+		log.Warn("failed to load the regions of the table, the table is not split",
+			zap.Int64("tableID", tableID), zap.Stringer("table", name), zap.Error(err))
+		return nil
+	}
+	spans := splitSpanByBoundaries(tableSpan, boundaries, num)
+	if spans != nil {
+		log.Info("split the table into key ranges",
+			zap.Int64("tableID", tableID), zap.Stringer("table", name),
+			zap.Int("regionNum", len(boundaries)+1), zap.Any("spans", spans))
+	}
+	return spans
+}
+
+// regionBoundaries returns the start keys of the regions inside the span.
+func (s *regionSplitter) regionBoundaries(span regionspan.ComparableSpan) ([][]byte, error) {
+	var startKeys [][]byte
+	start := span.Start
+	for {
+		regions, err := s.pdClient.ScanRegions(s.ctx, start, span.End, scanRegionsLimit)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if len(regions) == 0 {
+			break
+		}
+		for _, region := range regions {
+			startKeys = append(startKeys, region.Meta.GetStartKey())
+		}
+		end := regions[len(regions)-1].Meta.GetEndKey()
+		if len(end) == 0 || bytes.Compare(end, span.End) >= 0 {
+			break
+		}
+		start = end
+	}
+	return normalizeBoundaries(span, startKeys), nil
+}
+
+// normalizeBoundaries returns the sorted boundaries inside the span, which
+// can be decoded to raw keys by the puller of a key range. The start keys of
+// the regions may have a suffix like a timestamp, which is dropped, or may be
+// not encoded completely, which are skipped.
+func normalizeBoundaries(span regionspan.ComparableSpan, startKeys [][]byte) [][]byte {
+	boundaries := make([][]byte, 0, len(startKeys))
+	for _, key := range startKeys {
+		_, rawKey, err := codec.DecodeBytes(key, nil)
+		if err != nil {
+			log.Debug("skip the region boundary which can't be decoded",
+				zap.String("key", hex.EncodeToString(key)), zap.Error(err))
+			continue
+		}
+		key = regionspan.ToComparableKey(rawKey)
+		if bytes.Compare(key, span.Start) <= 0 || bytes.Compare(key, span.End) >= 0 {
+			continue
+		}
+		if len(boundaries) > 0 && bytes.Compare(key, boundaries[len(boundaries)-1]) <= 0 {
+			continue
+		}
+		boundaries = append(boundaries, key)
+	}
+	return boundaries
+}
+
+// splitSpanByBoundaries splits the span at the sorted boundaries into at most
+// num key ranges, each key range has about the same number of boundaries.
+// It returns nil if the span can't be split into two key ranges at least.
+func splitSpanByBoundaries(span regionspan.ComparableSpan, boundaries [][]byte, num int) []regionspan.ComparableSpan {
+	pieceNum := len(boundaries) + 1
+	if num > pieceNum {
+		num = pieceNum
+	}
+	if num < 2 {
+		return nil
+	}
+	spans := make([]regionspan.ComparableSpan, 0, num)
+	start := span.Start
+	for i := 1; i < num; i++ {
+		end := boundaries[i*pieceNum/num-1]
+		spans = append(spans, regionspan.ComparableSpan{Start: start, End: end})
+		start = end
+	}
+	return append(spans, regionspan.ComparableSpan{Start: start, End: span.End})
+}
+
+// spansCoverTable returns true if the key ranges are contiguous and cover the
+// whole table.
+func spansCoverTable(tableID model.TableID, spans []regionspan.ComparableSpan) bool {
+	sort.Slice(spans, func(i, j int) bool {
+		return bytes.Compare(spans[i].Start, spans[j].Start) < 0
+	})
+	tableSpan := regionspan.ToComparableSpan(regionspan.GetTableSpan(tableID))
+	start := tableSpan.Start
+	for _, span := range spans {
+		if !bytes.Equal(span.Start, start) {
+			return false
+		}
+		start = span.End
+	}
+	return bytes.Equal(start, tableSpan.End)
+}
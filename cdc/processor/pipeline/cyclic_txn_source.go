@@ -0,0 +1,73 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/cyclic"
+	"github.com/pingcap/ticdc/pkg/pipeline"
+)
+
+// cyclicTxnSourceNode sets the ReplicaID of the row events by the transaction
+// sources, and filters the row events by the FilterReplicaID config item.
+// Unlike cyclicMarkNode, every row carries the source of its transaction, so
+// the rows needn't be cached to be matched with the mark rows.
+type cyclicTxnSourceNode struct {
+	localReplicaID  uint64
+	filterReplicaID map[uint64]struct{}
+}
+
+func newCyclicTxnSourceNode() pipeline.Node {
+	return &cyclicTxnSourceNode{}
+}
+
+func (n *cyclicTxnSourceNode) Init(ctx pipeline.NodeContext) error {
+	n.localReplicaID = ctx.ChangefeedVars().Info.Config.Cyclic.ReplicaID
+	filterReplicaID := ctx.ChangefeedVars().Info.Config.Cyclic.FilterReplicaID
+	n.filterReplicaID = make(map[uint64]struct{})
+	for _, rID := range filterReplicaID {
+		n.filterReplicaID[rID] = struct{}{}
+	}
+	return nil
+}
+
+// Receive receives the message from the previous node.
+func (n *cyclicTxnSourceNode) Receive(ctx pipeline.NodeContext) error {
+	msg := ctx.Message()
+	if msg.Tp != pipeline.MessageTypePolymorphicEvent {
+		ctx.SendToNextNode(msg)
+		return nil
+	}
+	event := msg.PolymorphicEvent
+	if event.RawKV.OpType == model.OpTypeResolved || event.Row == nil {
+		ctx.SendToNextNode(msg)
+		return nil
+	}
+	replicaID := cyclic.ExtractReplicaIDFromTxnSource(event.RawKV.TxnSource)
+	if replicaID == 0 {
+		// the transaction is written by the users of the upstream.
+		replicaID = n.localReplicaID
+	}
+	if _, shouldFilter := n.filterReplicaID[replicaID]; shouldFilter {
+		return nil
+	}
+	event.Row.ReplicaID = replicaID
+	ctx.SendToNextNode(msg)
+	return nil
+}
+
+func (n *cyclicTxnSourceNode) Destroy(ctx pipeline.NodeContext) error {
+	// do nothing
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pingcap/check"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	cdcContext "github.com/pingcap/ticdc/pkg/context"
+	"github.com/pingcap/ticdc/pkg/pipeline"
+	"github.com/pingcap/ticdc/pkg/util/testleak"
+)
+
+type txnSourceSuite struct{}
+
+var _ = check.Suite(&txnSourceSuite{})
+
+func (s *txnSourceSuite) TestCyclicTxnSourceNode(c *check.C) {
+	defer testleak.AfterTest(c)()
+	ctx := cdcContext.NewContext(context.Background(), &cdcContext.GlobalVars{})
+	ctx = cdcContext.WithChangefeedVars(ctx, &cdcContext.ChangefeedVars{
+		Info: &model.ChangeFeedInfo{
+			Config: &config.ReplicaConfig{
+				Cyclic: &config.CyclicConfig{
+					Enable:          true,
+					ReplicaID:       1,
+					FilterReplicaID: []uint64{2},
+					UseTxnSource:    true,
+				},
+			},
+		},
+	})
+	n := newCyclicTxnSourceNode()
+	err := n.Init(pipeline.MockNodeContext4Test(ctx, pipeline.Message{}, nil))
+	c.Assert(err, check.IsNil)
+
+	testCases := []struct {
+		txnSource uint64
+		// 0 means the row is filtered
+		expectedReplicaID uint64
+	}{
+		{txnSource: 0, expectedReplicaID: 1},
+		{txnSource: 2, expectedReplicaID: 0},
+		{txnSource: 3, expectedReplicaID: 3},
+		// the bits reserved by TiDB are ignored
+		{txnSource: 1<<8 | 2, expectedReplicaID: 0},
+		{txnSource: 1 << 8, expectedReplicaID: 1},
+	}
+	outputCh := make(chan pipeline.Message, 1)
+	for _, tc := range testCases {
+		event := model.NewPolymorphicEvent(&model.RawKVEntry{
+			OpType:    model.OpTypePut,
+			StartTs:   1,
+			CRTs:      2,
+			TxnSource: tc.txnSource,
+		})
+		event.Row = &model.RowChangedEvent{StartTs: 1, CommitTs: 2}
+		err := n.Receive(pipeline.MockNodeContext4Test(ctx, pipeline.PolymorphicEventMessage(event), outputCh))
+		c.Assert(err, check.IsNil)
+		if tc.expectedReplicaID == 0 {
+			c.Assert(outputCh, check.HasLen, 0, check.Commentf("%v", tc))
+			continue
+		}
+		msg := <-outputCh
+		c.Assert(msg.PolymorphicEvent.Row.ReplicaID, check.Equals, tc.expectedReplicaID, check.Commentf("%v", tc))
+	}
+
+	// resolved events are always sent
+	err = n.Receive(pipeline.MockNodeContext4Test(ctx, pipeline.PolymorphicEventMessage(model.NewResolvedPolymorphicEvent(0, 3)), outputCh))
+	c.Assert(err, check.IsNil)
+	msg := <-outputCh
+	c.Assert(msg.PolymorphicEvent.RawKV.OpType, check.Equals, model.OpTypeResolved)
+}
@@ -45,19 +45,32 @@ func newPullerNode(
 	}
 }
 
-func (n *pullerNode) tableSpan(ctx cdcContext.Context) []regionspan.Span {
+func (n *pullerNode) tableSpan(ctx cdcContext.Context) ([]regionspan.Span, error) {
 	// start table puller
 	config := ctx.ChangefeedVars().Info.Config
 	spans := make([]regionspan.Span, 0, 4)
-	spans = append(spans, regionspan.GetTableSpan(n.tableID))
+	if n.replicaInfo.Span != nil {
+		// only a key range of the table is replicated by this capture
+		span, err := regionspan.FromComparableSpan(*n.replicaInfo.Span)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		spans = append(spans, span)
+	} else {
+		spans = append(spans, regionspan.GetTableSpan(n.tableID))
+	}
 
 	if config.Cyclic.IsEnabled() && n.replicaInfo.MarkTableID != 0 {
 		spans = append(spans, regionspan.GetTableSpan(n.replicaInfo.MarkTableID))
 	}
-	return spans
+	return spans, nil
 }
 
 func (n *pullerNode) Init(ctx pipeline.NodeContext) error {
+	spans, err := n.tableSpan(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	metricTableResolvedTsGauge := tableResolvedTsGauge.WithLabelValues(ctx.ChangefeedVars().ID, ctx.GlobalVars().CaptureInfo.AdvertiseAddr, n.tableName)
 	ctxC, cancel := context.WithCancel(ctx)
 	ctxC = util.PutTableInfoInCtx(ctxC, n.tableID, n.tableName)
@@ -66,7 +79,7 @@ func (n *pullerNode) Init(ctx pipeline.NodeContext) error {
 	// NOTICE: always pull the old value internally
 	// See also: https://github.com/pingcap/ticdc/issues/2301.
 	plr := puller.NewPuller(ctxC, ctx.GlobalVars().PDClient, ctx.GlobalVars().GrpcPool, ctx.GlobalVars().KVStorage,
-		n.replicaInfo.StartTs, n.tableSpan(ctx), true)
+		n.replicaInfo.StartTs, spans, true)
 	n.wg.Go(func() error {
 		ctx.Throw(errors.Trace(plr.Run(ctxC)))
 		return nil
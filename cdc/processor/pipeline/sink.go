@@ -72,6 +72,11 @@ type sinkNode struct {
 	targetTs     model.Ts
 	barrierTs    model.Ts
 
+	// eventCount and eventBytes are the number and the approximate size of
+	// the row changed events emitted to the sink, used to calculate the workload.
+	eventCount uint64
+	eventBytes uint64
+
 	eventBuffer []*model.PolymorphicEvent
 	rowBuffer   []*model.RowChangedEvent
 
@@ -95,6 +100,12 @@ func (n *sinkNode) ResolvedTs() model.Ts   { return atomic.LoadUint64(&n.resolve
 func (n *sinkNode) CheckpointTs() model.Ts { return atomic.LoadUint64(&n.checkpointTs) }
 func (n *sinkNode) Status() TableStatus    { return n.status.Load() }
 
+// EmittedEvents returns the number and the approximate size of the row changed
+// events emitted to the sink.
+func (n *sinkNode) EmittedEvents() (count uint64, bytes uint64) {
+	return atomic.LoadUint64(&n.eventCount), atomic.LoadUint64(&n.eventBytes)
+}
+
 func (n *sinkNode) Init(ctx pipeline.NodeContext) error {
 	// do nothing
 	return nil
@@ -283,8 +294,10 @@ func (n *sinkNode) clearBuffers() {
 }
 
 func (n *sinkNode) emitRow2Sink(ctx pipeline.NodeContext) error {
+	var bytes int64
 	for _, ev := range n.eventBuffer {
 		n.rowBuffer = append(n.rowBuffer, ev.Row)
+		bytes += ev.Row.ApproximateSize
 	}
 	failpoint.Inject("ProcessorSyncResolvedPreEmit", func() {
 		log.Info("Prepare to panic for ProcessorSyncResolvedPreEmit")
@@ -295,6 +308,8 @@ func (n *sinkNode) emitRow2Sink(ctx pipeline.NodeContext) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	atomic.AddUint64(&n.eventCount, uint64(len(n.rowBuffer)))
+	atomic.AddUint64(&n.eventBytes, uint64(bytes))
 	n.clearBuffers()
 	return nil
 }
@@ -34,6 +34,13 @@ const (
 	// TODO determine a reasonable default value
 	// This is part of sink performance optimization
 	resolvedTsInterpolateInterval = 200 * time.Millisecond
+	// workloadUpdateInterval is the interval of recalculating the throughput
+	// of a table, the workload is written to etcd when it changes.
+	workloadUpdateInterval = 10 * time.Second
+	// workloadChangeTolerance is the relative change of the throughput which
+	// is published, the smaller changes don't matter to the throughput
+	// scheduler but cost writes to etcd.
+	workloadChangeTolerance = 0.1
 )
 
 // TablePipeline is a pipeline which capture the change log from tikv in a table
@@ -72,6 +79,11 @@ type tablePipelineImpl struct {
 	cancel     context.CancelFunc
 
 	replConfig *serverConfig.ReplicaConfig
+
+	workload           model.WorkloadInfo
+	lastWorkloadUpdate time.Time
+	lastEventCount     uint64
+	lastEventBytes     uint64
 }
 
 // TODO find a better name or avoid using an interface
@@ -126,13 +138,39 @@ func (t *tablePipelineImpl) AsyncStop(targetTs model.Ts) bool {
 	return true
 }
 
-var workload = model.WorkloadInfo{Workload: 1}
-
-// Workload returns the workload of this table
+// Workload returns the workload of this table. The Workload field is always 1,
+// the throughput is the average in the last workloadUpdateInterval.
 func (t *tablePipelineImpl) Workload() model.WorkloadInfo {
-	// TODO(leoppro) calculate the workload of this table
-	// We temporarily set the value to constant 1
-	return workload
+	now := time.Now()
+	if t.lastWorkloadUpdate.IsZero() {
+		t.workload = model.WorkloadInfo{Workload: 1}
+		t.lastWorkloadUpdate = now
+		t.lastEventCount, t.lastEventBytes = t.sinkNode.EmittedEvents()
+		return t.workload
+	}
+	elapsed := now.Sub(t.lastWorkloadUpdate)
+	if elapsed < workloadUpdateInterval {
+		return t.workload
+	}
+	count, bytes := t.sinkNode.EmittedEvents()
+	eventRate := uint64(float64(count-t.lastEventCount) / elapsed.Seconds())
+	byteRate := uint64(float64(bytes-t.lastEventBytes) / elapsed.Seconds())
+	if workloadRateChanged(t.workload.EventRate, eventRate) || workloadRateChanged(t.workload.ByteRate, byteRate) {
+		t.workload.EventRate, t.workload.ByteRate = eventRate, byteRate
+	}
+	t.lastWorkloadUpdate = now
+	t.lastEventCount, t.lastEventBytes = count, bytes
+	return t.workload
+}
+
+// workloadRateChanged returns true if the rate differs from the published one
+// by more than workloadChangeTolerance.
+func workloadRateChanged(published, rate uint64) bool {
+	diff := rate - published
+	if rate < published {
+		diff = published - rate
+	}
+	return float64(diff) > float64(published)*workloadChangeTolerance
 }
 
 // Status returns the status of this table pipeline
@@ -207,7 +245,9 @@ func NewTablePipeline(ctx cdcContext.Context,
 	p.AppendNode(ctx, "puller", newPullerNode(tableID, replicaInfo, tableName))
 	p.AppendNode(ctx, "sorter", sorterNode)
 	p.AppendNode(ctx, "mounter", newMounterNode())
-	if cyclicEnabled {
+	if config.Cyclic.IsTxnSourceUsed() {
+		p.AppendNode(ctx, "cyclic", newCyclicTxnSourceNode())
+	} else if cyclicEnabled {
 		p.AppendNode(ctx, "cyclic", newCyclicMarkNode(replicaInfo.MarkTableID))
 	}
 	p.AppendNode(ctx, "sink", sinkNode)
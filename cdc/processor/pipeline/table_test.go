@@ -0,0 +1,44 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"github.com/pingcap/check"
+	"github.com/pingcap/ticdc/pkg/util/testleak"
+)
+
+type tableSuite struct{}
+
+var _ = check.Suite(&tableSuite{})
+
+func (s *tableSuite) TestWorkloadRateChanged(c *check.C) {
+	defer testleak.AfterTest(c)()
+	testCases := []struct {
+		published uint64
+		rate      uint64
+		changed   bool
+	}{
+		{0, 0, false},
+		{0, 1, true},
+		{100, 0, true},
+		{100, 100, false},
+		{100, 110, false},
+		{100, 90, false},
+		{100, 111, true},
+		{100, 89, true},
+	}
+	for _, tc := range testCases {
+		c.Assert(workloadRateChanged(tc.published, tc.rate), check.Equals, tc.changed, check.Commentf("%v", tc))
+	}
+}
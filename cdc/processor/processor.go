@@ -185,6 +185,7 @@ func (p *processor) tick(ctx cdcContext.Context, state *orchestrator.ChangefeedR
 	p.handlePosition(oracle.GetPhysical(pdTime))
 	p.pushResolvedTs2Table()
 	p.handleWorkload()
+	p.handleRateLimit()
 	p.doGCSchemaStorage(ctx)
 	return p.changefeed, nil
 }
@@ -268,7 +269,8 @@ func (p *processor) lazyInitImpl(ctx cdcContext.Context) error {
 	stdCtx := util.PutChangefeedIDInCtx(ctx, p.changefeed.ID)
 	stdCtx = util.PutCaptureAddrInCtx(stdCtx, p.captureInfo.AdvertiseAddr)
 
-	p.mounter = entry.NewMounter(p.schemaStorage, p.changefeed.Info.Config.Mounter.WorkerNum, p.changefeed.Info.Config.EnableOldValue)
+	p.mounter = entry.NewMounter(p.schemaStorage, p.changefeed.Info.Config.Mounter.WorkerNum,
+		p.changefeed.Info.Config.EnableOldValue, p.filter)
 	p.wg.Add(1)
 	go func() {
 		defer p.wg.Done()
@@ -633,6 +635,16 @@ func (p *processor) handleWorkload() {
 	})
 }
 
+// handleRateLimit applies the rate limit of the changefeed to the sink, so the
+// limit can be updated without restarting the changefeed.
+func (p *processor) handleRateLimit() {
+	if p.sinkManager == nil {
+		return
+	}
+	sinkConfig := p.changefeed.Info.Config.Sink
+	p.sinkManager.UpdateRateLimit(sinkConfig.MaxRowsPerSecond, sinkConfig.MaxBytesPerSecond)
+}
+
 // pushResolvedTs2Table sends global resolved ts to all the table pipelines.
 func (p *processor) pushResolvedTs2Table() {
 	resolvedTs := p.changefeed.Status.ResolvedTs
@@ -687,7 +699,7 @@ func (p *processor) createTablePipelineImpl(ctx cdcContext.Context, tableID mode
 		}
 		return errors.Errorf("failed to get table name, fallback to use table id: %d", tableID)
 	}, retry.WithBackoffBaseDelay(backoffBaseDelayInMs), retry.WithMaxTries(maxTries), retry.WithIsRetryableErr(cerror.IsRetryableError))
-	if p.changefeed.Info.Config.Cyclic.IsEnabled() {
+	if p.changefeed.Info.Config.Cyclic.IsEnabled() && !p.changefeed.Info.Config.Cyclic.UseTxnSource {
 		// Retry to find mark table ID
 		var markTableID model.TableID
 		err := retry.Do(context.Background(), func() error {
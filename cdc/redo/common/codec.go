@@ -0,0 +1,377 @@
+//  Copyright 2021 PingCAP, Inc.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+const (
+	// CompressionNone means the redo log files are not compressed.
+	CompressionNone = "none"
+	// CompressionZstd means the records in redo log files are compressed by zstd.
+	CompressionZstd = "zstd"
+	// CompressionSnappy means the records in redo log files are compressed by snappy.
+	CompressionSnappy = "snappy"
+)
+
+const (
+	compressionNone byte = iota
+	compressionZstd
+	compressionSnappy
+)
+
+const (
+	encryptionNone byte = iota
+	encryptionAESGCM
+)
+
+const (
+	// fileHeaderSize is the size of the fixed part of the file header, which is
+	// the magic, the compression, the encryption, the size of the data key and
+	// the commit ts of the previous file at offset 16.
+	fileHeaderSize = 24
+	// dataKeySize is the size of the AES-256 key generated for each file.
+	dataKeySize = 32
+)
+
+// fileHeaderMagic starts the redo log files. The files written by the older
+// versions have no header and start with the frame size of the first record,
+// whose most significant byte is either 0 or has the padding flag 0x80 set, so
+// it never equals to the last byte of the magic.
+var fileHeaderMagic = []byte{'C', 'D', 'C', 'R', 'E', 'D', 'O', 0x01}
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil)
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil)
+	})
+	return zstdErr
+}
+
+// CodecConfig is the configuration of compressing and encrypting redo log files.
+type CodecConfig struct {
+	// Compression is the compression algorithm of the records, none, zstd or snappy.
+	Compression string
+	// MasterKey is the AES key which encrypts the data key of each file,
+	// nil means the files are not encrypted.
+	MasterKey []byte
+}
+
+// NewCodecConfig creates a CodecConfig. The master key is read from the key
+// file or the hex encoded masterKey, at most one of them can be specified.
+func NewCodecConfig(compression, keyFile, masterKey string) (*CodecConfig, error) {
+	cfg := &CodecConfig{Compression: strings.ToLower(compression)}
+	switch cfg.Compression {
+	case "":
+		cfg.Compression = CompressionNone
+	case CompressionNone, CompressionZstd, CompressionSnappy:
+	default:
+		return nil, cerror.ErrRedoConfigInvalid.GenWithStack("unsupported compression %s", compression)
+	}
+
+	if keyFile != "" && masterKey != "" {
+		return nil, cerror.ErrRedoConfigInvalid.GenWithStack(
+			"encryption-key-file and encryption-master-key can't be specified at the same time")
+	}
+	if keyFile != "" {
+		data, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoConfigInvalid, errors.Annotate(err, "can't read the encryption key file"))
+		}
+		masterKey = string(data)
+	}
+	if masterKey != "" {
+		key, err := hex.DecodeString(strings.TrimSpace(masterKey))
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoConfigInvalid, errors.Annotate(err, "the encryption key must be hex encoded"))
+		}
+		if _, err := aes.NewCipher(key); err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoConfigInvalid, err)
+		}
+		cfg.MasterKey = key
+	}
+	return cfg, nil
+}
+
+// Enabled returns true if the files are compressed or encrypted.
+func (c *CodecConfig) Enabled() bool {
+	return c != nil && ((c.Compression != "" && c.Compression != CompressionNone) || len(c.MasterKey) != 0)
+}
+
+// String returns the compression and the fingerprint of the master key.
+func (c *CodecConfig) String() string {
+	if c == nil {
+		return CompressionNone
+	}
+	if len(c.MasterKey) == 0 {
+		return c.Compression
+	}
+	sum := sha256.Sum256(c.MasterKey)
+	return fmt.Sprintf("%s:%x", c.Compression, sum[:4])
+}
+
+func (c *CodecConfig) compressionID() byte {
+	switch c.Compression {
+	case CompressionZstd:
+		return compressionZstd
+	case CompressionSnappy:
+		return compressionSnappy
+	default:
+		return compressionNone
+	}
+}
+
+// FileCodec compresses and encrypts the records of a redo log file, a nil
+// FileCodec keeps the records as they are.
+type FileCodec struct {
+	compression byte
+	aead        cipher.AEAD
+}
+
+// FileHeader is the header of a redo log file.
+type FileHeader struct {
+	// Codec decodes the records in the file, nil if the records are written
+	// as they are.
+	Codec *FileCodec
+	// PrevCommitTs is the commit ts in the name of the previous file written
+	// by the same writer, 0 if it is the first file or unknown.
+	PrevCommitTs uint64
+	// Size is the number of bytes of the header.
+	Size int64
+}
+
+// NewFileHeader creates the header of a new redo log file and the codec of the
+// records in it. prevCommitTs links the file to the previous one written by
+// the same writer, so the missing files can be found. If the records are
+// encrypted, a random data key is generated for each file, which is encrypted
+// by the master key and stored in the header.
+func NewFileHeader(cfg *CodecConfig, prevCommitTs uint64) ([]byte, *FileCodec, error) {
+	header := make([]byte, fileHeaderSize)
+	copy(header, fileHeaderMagic)
+	header[8] = compressionNone
+	header[9] = encryptionNone
+	binary.LittleEndian.PutUint64(header[16:24], prevCommitTs)
+	if !cfg.Enabled() {
+		return header, nil, nil
+	}
+
+	codec := &FileCodec{compression: cfg.compressionID()}
+	if codec.compression == compressionZstd {
+		if err := initZstd(); err != nil {
+			return nil, nil, cerror.WrapError(cerror.ErrRedoEncodeFailed, err)
+		}
+	}
+	header[8] = codec.compression
+	if len(cfg.MasterKey) != 0 {
+		dataKey := make([]byte, dataKeySize)
+		if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+			return nil, nil, cerror.WrapError(cerror.ErrRedoEncodeFailed, err)
+		}
+		masterAEAD, err := newAEAD(cfg.MasterKey)
+		if err != nil {
+			return nil, nil, cerror.WrapError(cerror.ErrRedoEncodeFailed, err)
+		}
+		wrappedKey, err := seal(masterAEAD, dataKey)
+		if err != nil {
+			return nil, nil, cerror.WrapError(cerror.ErrRedoEncodeFailed, err)
+		}
+		codec.aead, err = newAEAD(dataKey)
+		if err != nil {
+			return nil, nil, cerror.WrapError(cerror.ErrRedoEncodeFailed, err)
+		}
+		header[9] = encryptionAESGCM
+		binary.LittleEndian.PutUint16(header[10:12], uint16(len(wrappedKey)))
+		header = append(header, wrappedKey...)
+		// keep the records 8 bytes aligned
+		header = append(header, make([]byte, padding(len(wrappedKey)))...)
+	}
+	return header, codec, nil
+}
+
+// ReadFileHeader reads the header of a redo log file, an empty header is
+// returned if the file is written by an older version without a header.
+func ReadFileHeader(r *bufio.Reader, cfg *CodecConfig) (*FileHeader, error) {
+	magic, err := r.Peek(len(fileHeaderMagic))
+	if err != nil || !bytes.Equal(magic, fileHeaderMagic) {
+		// an empty file or a file written without a header
+		return &FileHeader{}, nil
+	}
+
+	header := make([]byte, fileHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, cerror.WrapError(cerror.ErrRedoDecodeFailed, errors.Annotate(err, "can't read the file header"))
+	}
+	fh := &FileHeader{
+		PrevCommitTs: binary.LittleEndian.Uint64(header[16:24]),
+		Size:         fileHeaderSize,
+	}
+	codec := &FileCodec{compression: header[8]}
+	switch codec.compression {
+	case compressionNone, compressionSnappy:
+	case compressionZstd:
+		if err := initZstd(); err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoDecodeFailed, err)
+		}
+	default:
+		return nil, cerror.ErrRedoDecodeFailed.GenWithStack("unknown compression %d", codec.compression)
+	}
+
+	switch header[9] {
+	case encryptionNone:
+		if codec.compression == compressionNone {
+			// the records are written as they are
+			return fh, nil
+		}
+	case encryptionAESGCM:
+		keyLen := int(binary.LittleEndian.Uint16(header[10:12]))
+		wrappedKey := make([]byte, keyLen+padding(keyLen))
+		if _, err := io.ReadFull(r, wrappedKey); err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoDecodeFailed, errors.Annotate(err, "can't read the data key"))
+		}
+		fh.Size += int64(len(wrappedKey))
+		if cfg == nil || len(cfg.MasterKey) == 0 {
+			return nil, cerror.ErrRedoDecodeFailed.GenWithStack("the file is encrypted, but no encryption key is specified")
+		}
+		masterAEAD, err := newAEAD(cfg.MasterKey)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoDecodeFailed, err)
+		}
+		dataKey, err := open(masterAEAD, wrappedKey[:keyLen])
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoDecodeFailed, errors.Annotate(err, "the encryption key doesn't match"))
+		}
+		codec.aead, err = newAEAD(dataKey)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoDecodeFailed, err)
+		}
+	default:
+		return nil, cerror.ErrRedoDecodeFailed.GenWithStack("unknown encryption %d", header[9])
+	}
+	fh.Codec = codec
+	return fh, nil
+}
+
+// Matches returns true if the records written by cfg can be appended to the
+// file of the codec.
+func (c *FileCodec) Matches(cfg *CodecConfig) bool {
+	if c == nil {
+		return !cfg.Enabled()
+	}
+	return cfg.Enabled() && c.compression == cfg.compressionID() && (c.aead != nil) == (len(cfg.MasterKey) != 0)
+}
+
+// Encode compresses and then encrypts a record.
+func (c *FileCodec) Encode(data []byte) ([]byte, error) {
+	if c == nil {
+		return data, nil
+	}
+	switch c.compression {
+	case compressionZstd:
+		data = zstdEncoder.EncodeAll(data, nil)
+	case compressionSnappy:
+		data = snappy.Encode(nil, data)
+	}
+	if c.aead != nil {
+		var err error
+		data, err = seal(c.aead, data)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoEncodeFailed, err)
+		}
+	}
+	return data, nil
+}
+
+// Decode decrypts and then decompresses a record.
+func (c *FileCodec) Decode(data []byte) ([]byte, error) {
+	if c == nil {
+		return data, nil
+	}
+	var err error
+	if c.aead != nil {
+		data, err = open(c.aead, data)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoDecodeFailed, err)
+		}
+	}
+	switch c.compression {
+	case compressionZstd:
+		data, err = zstdDecoder.DecodeAll(data, nil)
+	case compressionSnappy:
+		data, err = snappy.Decode(nil, data)
+	}
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrRedoDecodeFailed, err)
+	}
+	return data, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	return aead, errors.Trace(err)
+}
+
+// seal encrypts the data with a random nonce, which is stored before the
+// cipher text.
+func seal(aead cipher.AEAD, data []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+func open(aead cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("the cipher text is too short")
+	}
+	nonce, cipherText := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, cipherText, nil)
+	return plain, errors.Trace(err)
+}
+
+func padding(n int) int {
+	return (8 - n%8) % 8
+}
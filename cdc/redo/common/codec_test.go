@@ -0,0 +1,130 @@
+//  Copyright 2021 PingCAP, Inc.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCodecConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "redo-codec")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	key := strings.Repeat("ab", 32)
+	keyFile := filepath.Join(dir, "redo.key")
+	require.Nil(t, ioutil.WriteFile(keyFile, []byte(key+"\n"), 0o600))
+
+	cfg, err := NewCodecConfig("", "", "")
+	require.Nil(t, err)
+	require.Equal(t, CompressionNone, cfg.Compression)
+	require.False(t, cfg.Enabled())
+
+	cfg, err = NewCodecConfig("ZSTD", keyFile, "")
+	require.Nil(t, err)
+	require.Equal(t, CompressionZstd, cfg.Compression)
+	require.Equal(t, strings.Repeat("\xab", 32), string(cfg.MasterKey))
+	require.True(t, cfg.Enabled())
+	// the key is not printed
+	require.NotContains(t, cfg.String(), key)
+
+	cfg, err = NewCodecConfig("snappy", "", key)
+	require.Nil(t, err)
+	require.Len(t, cfg.MasterKey, 32)
+
+	_, err = NewCodecConfig("lz4", "", "")
+	require.Regexp(t, ".*unsupported compression lz4.*", err)
+	_, err = NewCodecConfig("", keyFile, key)
+	require.Regexp(t, ".*can't be specified at the same time.*", err)
+	_, err = NewCodecConfig("", filepath.Join(dir, "not-exist"), "")
+	require.Regexp(t, ".*can't read the encryption key file.*", err)
+	_, err = NewCodecConfig("", "", "not-hex")
+	require.Regexp(t, ".*must be hex encoded.*", err)
+	_, err = NewCodecConfig("", "", "abcd")
+	require.Regexp(t, ".*ErrRedoConfigInvalid.*", err)
+}
+
+func TestFileCodec(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, 16)
+	otherKey := bytes.Repeat([]byte{2}, 16)
+	data := bytes.Repeat([]byte("redo log data "), 100)
+
+	for _, cfg := range []*CodecConfig{
+		{Compression: CompressionZstd},
+		{Compression: CompressionSnappy},
+		{Compression: CompressionNone, MasterKey: key},
+		{Compression: CompressionZstd, MasterKey: key},
+		{Compression: CompressionSnappy, MasterKey: key},
+	} {
+		header, codec, err := NewFileHeader(cfg, 10)
+		require.Nil(t, err)
+		require.Zero(t, len(header)%8)
+		encoded, err := codec.Encode(data)
+		require.Nil(t, err)
+		require.NotEqual(t, data, encoded)
+		if cfg.Compression != CompressionNone {
+			require.Less(t, len(encoded), len(data))
+		}
+
+		fh, err := ReadFileHeader(bufio.NewReader(bytes.NewReader(header)), cfg)
+		require.Nil(t, err)
+		require.Equal(t, int64(len(header)), fh.Size)
+		require.Equal(t, uint64(10), fh.PrevCommitTs)
+		readCodec := fh.Codec
+		require.True(t, readCodec.Matches(cfg))
+		decoded, err := readCodec.Decode(encoded)
+		require.Nil(t, err)
+		require.Equal(t, data, decoded)
+
+		if len(cfg.MasterKey) != 0 {
+			_, err = ReadFileHeader(bufio.NewReader(bytes.NewReader(header)), nil)
+			require.Regexp(t, ".*no encryption key is specified.*", err)
+			_, err = ReadFileHeader(bufio.NewReader(bytes.NewReader(header)),
+				&CodecConfig{MasterKey: otherKey})
+			require.Regexp(t, ".*the encryption key doesn't match.*", err)
+			// the record is authenticated
+			encoded[len(encoded)-1] ^= 0xff
+			_, err = readCodec.Decode(encoded)
+			require.Regexp(t, ".*ErrRedoDecodeFailed.*", err)
+		}
+	}
+
+	// the records written without a codec are read as they are
+	header, codec, err := NewFileHeader(&CodecConfig{Compression: CompressionNone}, 10)
+	require.Nil(t, err)
+	require.Len(t, header, fileHeaderSize)
+	require.Nil(t, codec)
+	fh, err := ReadFileHeader(bufio.NewReader(bytes.NewReader(header)), nil)
+	require.Nil(t, err)
+	require.Equal(t, &FileHeader{PrevCommitTs: 10, Size: fileHeaderSize}, fh)
+	require.True(t, codec.Matches(nil))
+	require.False(t, codec.Matches(&CodecConfig{Compression: CompressionZstd}))
+	encoded, err := codec.Encode(data)
+	require.Nil(t, err)
+	require.Equal(t, data, encoded)
+	// the files written by the older versions have no header
+	for _, content := range [][]byte{nil, {1, 2, 3}, data} {
+		fh, err := ReadFileHeader(bufio.NewReader(bytes.NewReader(content)), nil)
+		require.Nil(t, err)
+		require.Equal(t, &FileHeader{}, fh)
+	}
+}
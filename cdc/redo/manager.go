@@ -24,6 +24,7 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/redo/common"
 	"github.com/pingcap/ticdc/cdc/redo/writer"
 	"github.com/pingcap/ticdc/pkg/config"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
@@ -169,6 +170,10 @@ func NewManager(ctx context.Context, cfg *config.ConsistentConfig, opts *Manager
 			redoDir = uri.Path
 		}
 
+		codecCfg, err := common.NewCodecConfig(cfg.Compression, cfg.EncryptionKeyFile, cfg.EncryptionMasterKey)
+		if err != nil {
+			return nil, err
+		}
 		writerCfg := &writer.LogWriterConfig{
 			Dir:               redoDir,
 			CaptureID:         util.CaptureAddrFromCtx(ctx),
@@ -177,6 +182,7 @@ func NewManager(ctx context.Context, cfg *config.ConsistentConfig, opts *Manager
 			MaxLogSize:        cfg.MaxLogSize,
 			FlushIntervalInMs: cfg.FlushIntervalInMs,
 			S3Storage:         m.storageType == consistentStorageS3,
+			Codec:             codecCfg,
 		}
 		if writerCfg.S3Storage {
 			writerCfg.S3URI = *uri
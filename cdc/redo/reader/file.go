@@ -64,6 +64,7 @@ type readerConfig struct {
 	s3Storage  bool
 	s3URI      url.URL
 	workerNums int
+	codec      *common.CodecConfig
 }
 
 type reader struct {
@@ -72,6 +73,10 @@ type reader struct {
 	br       *bufio.Reader
 	fileName string
 	closer   io.Closer
+	// codec decodes the records, nil if the file is written without a codec
+	codec *common.FileCodec
+	// prevCommitTs is the commit ts of the previous file in the file header
+	prevCommitTs uint64
 	// lastValidOff file offset following the last valid decoded record
 	lastValidOff int64
 }
@@ -96,25 +101,48 @@ func newReader(ctx context.Context, cfg *readerConfig) ([]fileReader, error) {
 		cfg.workerNums = defaultWorkerNum
 	}
 
-	rr, err := openSelectedFiles(ctx, cfg.dir, cfg.fileType, cfg.startTs, cfg.workerNums)
+	rr, err := openSelectedFiles(ctx, cfg.dir, cfg.fileType, cfg.startTs, cfg.workerNums, cfg.codec)
 	if err != nil {
 		return nil, err
 	}
 
 	readers := []fileReader{}
 	for i := range rr {
-		readers = append(readers,
-			&reader{
-				cfg:      cfg,
-				br:       bufio.NewReader(rr[i]),
-				fileName: rr[i].(*os.File).Name(),
-				closer:   rr[i],
-			})
+		r, err := newFileReader(rr[i].(*os.File), cfg.codec)
+		if err != nil {
+			for j := i; j < len(rr); j++ {
+				rr[j].Close() //nolint:errcheck
+			}
+			for _, r := range readers {
+				r.Close() //nolint:errcheck
+			}
+			return nil, err
+		}
+		r.cfg = cfg
+		readers = append(readers, r)
 	}
 
 	return readers, nil
 }
 
+// newFileReader creates a reader of the file, the header of the file is
+// consumed if there is one.
+func newFileReader(file *os.File, codecCfg *common.CodecConfig) (*reader, error) {
+	br := bufio.NewReader(file)
+	header, err := common.ReadFileHeader(br, codecCfg)
+	if err != nil {
+		return nil, errors.Annotatef(err, "can't read the header of redo logfile %s", file.Name())
+	}
+	return &reader{
+		br:           br,
+		fileName:     file.Name(),
+		closer:       file,
+		codec:        header.Codec,
+		prevCommitTs: header.PrevCommitTs,
+		lastValidOff: header.Size,
+	}, nil
+}
+
 func selectDownLoadFile(ctx context.Context, s3storage storage.ExternalStorage, fixedType string) ([]string, error) {
 	files := []string{}
 	err := s3storage.WalkDir(ctx, &storage.WalkOption{}, func(path string, size int64) error {
@@ -164,7 +192,7 @@ func downLoadToLocal(ctx context.Context, dir string, s3storage storage.External
 	return eg.Wait()
 }
 
-func openSelectedFiles(ctx context.Context, dir, fixedType string, startTs uint64, workerNum int) ([]io.ReadCloser, error) {
+func openSelectedFiles(ctx context.Context, dir, fixedType string, startTs uint64, workerNum int, codecCfg *common.CodecConfig) ([]io.ReadCloser, error) {
 	files, err := ioutil.ReadDir(dir)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrRedoFileOp, errors.Annotatef(err, "can't read log file directory: %s", dir))
@@ -212,7 +240,7 @@ func openSelectedFiles(ctx context.Context, dir, fixedType string, startTs uint6
 		}
 	}
 
-	sortFiles, err := createSortedFiles(ctx, dir, unSortedFile, workerNum)
+	sortFiles, err := createSortedFiles(ctx, dir, unSortedFile, workerNum, codecCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -224,11 +252,11 @@ func openReadFile(name string) (*os.File, error) {
 	return os.OpenFile(name, os.O_RDONLY, common.DefaultFileMode)
 }
 
-func readFile(file *os.File) (logHeap, error) {
-	r := &reader{
-		br:       bufio.NewReader(file),
-		fileName: file.Name(),
-		closer:   file,
+func readFile(file *os.File, codecCfg *common.CodecConfig) (logHeap, error) {
+	r, err := newFileReader(file, codecCfg)
+	if err != nil {
+		file.Close() //nolint:errcheck
+		return nil, err
 	}
 	defer r.Close()
 
@@ -248,11 +276,13 @@ func readFile(file *os.File) (logHeap, error) {
 	return h, nil
 }
 
-// writFile if not safely closed, the sorted file will end up with .sort.tmp as the file name suffix
-func writFile(ctx context.Context, dir, name string, h logHeap) error {
+// writFile if not safely closed, the sorted file will end up with .sort.tmp as the file name suffix,
+// the sorted file is written with the same codec as the log files.
+func writFile(ctx context.Context, dir, name string, h logHeap, codecCfg *common.CodecConfig) error {
 	cfg := &writer.FileWriterConfig{
 		Dir:        dir,
 		MaxLogSize: math.MaxInt32,
+		Codec:      codecCfg,
 	}
 	w, err := writer.NewWriter(ctx, cfg, writer.WithLogFileName(func() string { return name }))
 	if err != nil {
@@ -274,7 +304,7 @@ func writFile(ctx context.Context, dir, name string, h logHeap) error {
 	return w.Close()
 }
 
-func createSortedFiles(ctx context.Context, dir string, names []string, workerNum int) ([]io.ReadCloser, error) {
+func createSortedFiles(ctx context.Context, dir string, names []string, workerNum int, codecCfg *common.CodecConfig) ([]io.ReadCloser, error) {
 	logFiles := []io.ReadCloser{}
 	errCh := make(chan error)
 	retCh := make(chan io.ReadCloser)
@@ -293,7 +323,7 @@ func createSortedFiles(ctx context.Context, dir string, names []string, workerNu
 		}
 
 		for i := 0; i < len(nn); i++ {
-			go createSortedFile(ctx, dir, nn[i], errCh, retCh)
+			go createSortedFile(ctx, dir, nn[i], codecCfg, errCh, retCh)
 		}
 		for i := 0; i < len(nn); i++ {
 			select {
@@ -313,7 +343,7 @@ func createSortedFiles(ctx context.Context, dir string, names []string, workerNu
 	return logFiles, nil
 }
 
-func createSortedFile(ctx context.Context, dir string, name string, errCh chan error, retCh chan io.ReadCloser) {
+func createSortedFile(ctx context.Context, dir string, name string, codecCfg *common.CodecConfig, errCh chan error, retCh chan io.ReadCloser) {
 	path := filepath.Join(dir, name)
 	file, err := openReadFile(path)
 	if err != nil {
@@ -321,7 +351,7 @@ func createSortedFile(ctx context.Context, dir string, name string, errCh chan e
 		return
 	}
 
-	h, err := readFile(file)
+	h, err := readFile(file, codecCfg)
 	if err != nil {
 		errCh <- err
 		return
@@ -334,7 +364,7 @@ func createSortedFile(ctx context.Context, dir string, name string, errCh chan e
 	}
 
 	sortFileName := name + common.SortLogEXT
-	err = writFile(ctx, dir, sortFileName, h)
+	err = writFile(ctx, dir, sortFileName, h, codecCfg)
 	if err != nil {
 		errCh <- err
 		return
@@ -393,7 +423,15 @@ func (r *reader) Read(redoLog *model.RedoLog) error {
 		return cerror.WrapError(cerror.ErrRedoFileOp, err)
 	}
 
-	_, err = redoLog.UnmarshalMsg(data[:recBytes])
+	recData, err := r.codec.Decode(data[:recBytes])
+	if err != nil {
+		if r.isTornEntry(data) {
+			// just return io.EOF, since if torn write it is the last redoLog entry
+			return io.EOF
+		}
+		return err
+	}
+	_, err = redoLog.UnmarshalMsg(recData)
 	if err != nil {
 		if r.isTornEntry(data) {
 			// just return io.EOF, since if torn write it is the last redoLog entry
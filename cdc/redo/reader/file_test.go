@@ -14,7 +14,6 @@
 package reader
 
 import (
-	"bufio"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -28,11 +27,16 @@ import (
 	"github.com/pingcap/ticdc/cdc/redo/writer"
 	"github.com/pingcap/ticdc/pkg/leakutil"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
 	"golang.org/x/net/context"
 )
 
 func TestMain(m *testing.M) {
-	leakutil.SetUpLeakTest(m)
+	opts := []goleak.Option{
+		// the zstd decoder of redo logs is shared and never closed.
+		goleak.IgnoreTopFunction("github.com/klauspost/compress/zstd.(*blockDec).startDecoder"),
+	}
+	leakutil.SetUpLeakTest(m, opts...)
 }
 
 func TestReaderNewReader(t *testing.T) {
@@ -210,7 +214,7 @@ func TestReaderOpenSelectedFiles(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		ret, err := openSelectedFiles(ctx, tt.args.dir, tt.args.fixedName, tt.args.startTs, 100)
+		ret, err := openSelectedFiles(ctx, tt.args.dir, tt.args.fixedName, tt.args.startTs, 100, nil)
 		if tt.wantErr == "" {
 			require.Nil(t, err, tt.name)
 			require.Equal(t, len(tt.wantRet), len(ret), tt.name)
@@ -230,11 +234,8 @@ func TestReaderOpenSelectedFiles(t *testing.T) {
 			}
 			var preTs uint64 = 0
 			for _, r := range ret {
-				r := &reader{
-					br:       bufio.NewReader(r),
-					fileName: r.(*os.File).Name(),
-					closer:   r,
-				}
+				r, err := newFileReader(r.(*os.File), nil)
+				require.Nil(t, err, tt.name)
 				for {
 					rl := &model.RedoLog{}
 					err := r.Read(rl)
@@ -61,8 +61,11 @@ type LogReaderConfig struct {
 	// will load the file to memory first then write the sorted file to disk
 	// the memory used is WorkerNums * defaultMaxLogSize (64 * megabyte) total
 	WorkerNums int
-	startTs    uint64
-	endTs      uint64
+	// Codec decodes the log files written with a codec, only the master key
+	// is used because the compression is recorded in the files.
+	Codec   *common.CodecConfig
+	startTs uint64
+	endTs   uint64
 }
 
 // LogReader implement RedoLogReader interface
@@ -158,6 +161,7 @@ func (l *LogReader) setUpRowReader(ctx context.Context, startTs, endTs uint64) e
 		s3Storage:  l.cfg.S3Storage,
 		s3URI:      l.cfg.S3URI,
 		workerNums: l.cfg.WorkerNums,
+		codec:      l.cfg.Codec,
 	}
 	l.rowReader, err = newReader(ctx, rowCfg)
 	if err != nil {
@@ -187,6 +191,7 @@ func (l *LogReader) setUpDDLReader(ctx context.Context, startTs, endTs uint64) e
 		s3Storage:  l.cfg.S3Storage,
 		s3URI:      l.cfg.S3URI,
 		workerNums: l.cfg.WorkerNums,
+		codec:      l.cfg.Codec,
 	}
 	l.ddlReader, err = newReader(ctx, ddlCfg)
 	if err != nil {
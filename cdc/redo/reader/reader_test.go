@@ -14,6 +14,8 @@
 package reader
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -714,3 +716,49 @@ func TestLogReaderClose(t *testing.T) {
 		}
 	}
 }
+
+func TestLogReaderCodec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "redo-Codec")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	codecCfg := &common.CodecConfig{
+		Compression: common.CompressionZstd,
+		MasterKey:   []byte("0123456789abcdef"),
+	}
+	writeTestMetaFile(t, dir, 10, 20)
+	// the file written without a codec can be read with the encrypted files.
+	writeTestLogFile(t, dir, nil, common.DefaultRowLogFileType, 11, newTestRowLog(11))
+	encryptedFile := writeTestLogFile(t, dir, codecCfg, common.DefaultRowLogFileType, 13,
+		newTestRowLog(13), newTestRowLog(12))
+	data, err := ioutil.ReadFile(filepath.Join(dir, encryptedFile))
+	require.Nil(t, err)
+	require.NotContains(t, string(data), "RedoRow")
+
+	ctx := context.Background()
+	r, err := NewLogReader(ctx, &LogReaderConfig{Dir: dir, Codec: codecCfg})
+	require.Nil(t, err)
+	defer r.Close()
+	require.Nil(t, r.ResetReader(ctx, 10, 20))
+	rows, err := r.ReadNextLog(ctx, 10)
+	require.Nil(t, err)
+	commitTs := make([]uint64, 0, len(rows))
+	for _, row := range rows {
+		commitTs = append(commitTs, row.Row.CommitTs)
+	}
+	require.Equal(t, []uint64{11, 12, 13}, commitTs)
+
+	// the sorted file of the encrypted file is encrypted too.
+	data, err = ioutil.ReadFile(filepath.Join(dir, encryptedFile+common.SortLogEXT))
+	require.Nil(t, err)
+	header, err := common.ReadFileHeader(bufio.NewReader(bytes.NewReader(data)), codecCfg)
+	require.Nil(t, err)
+	require.True(t, header.Codec.Matches(codecCfg))
+
+	r, err = NewLogReader(ctx, &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	defer r.Close()
+	err = r.ResetReader(ctx, 10, 20)
+	require.Regexp(t, ".*no encryption key is specified.*", err)
+	time.Sleep(1001 * time.Millisecond)
+}
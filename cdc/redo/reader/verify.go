@@ -0,0 +1,307 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/redo/common"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+// VerifyIssue is a problem found in the redo logs.
+type VerifyIssue struct {
+	File    string `json:"file,omitempty"`
+	Message string `json:"message"`
+	// Fatal means the redo logs can't be applied correctly, otherwise the
+	// issue is only a warning.
+	Fatal bool `json:"fatal"`
+}
+
+// VerifyFile is the summary of a redo log file.
+type VerifyFile struct {
+	Name     string `json:"name"`
+	FileType string `json:"file-type"`
+	// CommitTs is the commit ts in the file name, which is the max commit ts
+	// of the events in the file.
+	CommitTs uint64 `json:"commit-ts"`
+	// PrevCommitTs is the commit ts of the previous file written by the same
+	// writer, which is recorded in the file header.
+	PrevCommitTs uint64 `json:"prev-commit-ts,omitempty"`
+	Events       int    `json:"events"`
+	MinCommitTs  uint64 `json:"min-commit-ts"`
+	MaxCommitTs  uint64 `json:"max-commit-ts"`
+}
+
+// VerifyReport is the result of verifying the redo logs.
+type VerifyReport struct {
+	CheckpointTs uint64        `json:"checkpoint-ts"`
+	ResolvedTs   uint64        `json:"resolved-ts"`
+	Files        []*VerifyFile `json:"files"`
+	// Rows and DDLs are the numbers of the events in (checkpoint-ts, resolved-ts].
+	Rows   int           `json:"rows"`
+	DDLs   int           `json:"ddls"`
+	Issues []VerifyIssue `json:"issues,omitempty"`
+}
+
+// HasFatal returns true if any issue is fatal.
+func (r *VerifyReport) HasFatal() bool {
+	for _, issue := range r.Issues {
+		if issue.Fatal {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *VerifyReport) addIssue(file string, fatal bool, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, VerifyIssue{File: file, Message: fmt.Sprintf(format, args...), Fatal: fatal})
+}
+
+// VerifyLogs checks the meta file and the log files of the redo logs. The
+// redo logs in s3 are downloaded to the local dir first. Only the errors
+// which stop the verification are returned, the problems found in the redo
+// logs are recorded in the report.
+func VerifyLogs(ctx context.Context, cfg *LogReaderConfig) (*VerifyReport, error) {
+	if cfg == nil {
+		return nil, cerror.WrapError(cerror.ErrRedoConfigInvalid, errors.New("LogReaderConfig can not be nil"))
+	}
+	if cfg.S3Storage {
+		s3storage, err := common.InitS3storage(ctx, cfg.S3URI)
+		if err != nil {
+			return nil, err
+		}
+		err = os.RemoveAll(cfg.Dir)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedoFileOp, err)
+		}
+		for _, fileType := range []string{
+			common.DefaultMetaFileType, common.DefaultRowLogFileType, common.DefaultDDLLogFileType,
+		} {
+			if err := downLoadToLocal(ctx, cfg.Dir, s3storage, fileType); err != nil {
+				return nil, cerror.WrapError(cerror.ErrRedoDownloadFailed, err)
+			}
+		}
+	}
+
+	files, err := ioutil.ReadDir(cfg.Dir)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrRedoFileOp, errors.Annotate(err, "can't read log file directory"))
+	}
+	report := &VerifyReport{}
+	verifyMeta(cfg.Dir, files, report)
+
+	for _, file := range files {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Trace(ctx.Err())
+		default:
+		}
+		name := file.Name()
+		// the sorted files are generated by the reader from the log files.
+		if file.IsDir() || filepath.Ext(name) == common.SortLogEXT || filepath.Ext(name) == common.MetaEXT {
+			continue
+		}
+		commitTs, fileType, err := common.ParseLogFileName(name)
+		if err != nil {
+			report.addIssue(name, true, "bad log file name: %s", err)
+			continue
+		}
+		if fileType == "" {
+			continue
+		}
+		if fileType != common.DefaultRowLogFileType && fileType != common.DefaultDDLLogFileType {
+			report.addIssue(name, true, "unknown log file type %s", fileType)
+			continue
+		}
+		if filepath.Ext(name) == common.TmpEXT {
+			report.addIssue(name, false, "the log file is not closed safely")
+		}
+		if err := verifyLogFile(cfg.Dir, name, fileType, commitTs, cfg.Codec, report); err != nil {
+			return nil, err
+		}
+	}
+	verifyCoverage(report)
+	sort.Slice(report.Files, func(i, j int) bool {
+		return report.Files[i].Name < report.Files[j].Name
+	})
+	return report, nil
+}
+
+// verifyCoverage checks that no log file with the events in (checkpoint-ts,
+// resolved-ts] is missing. The files of a writer are rotated one by one, and
+// the header of each file records the commit ts in the name of the previous
+// one, so the files are linked in a chain, which is broken if any file in the
+// middle is missing. The first file of a chain must be linked to a file at or
+// before checkpoint-ts, which could have been removed by GC. The files written
+// by the older versions have no header, they are not linked and not checked.
+func verifyCoverage(report *VerifyReport) {
+	chains := make(map[string][]*VerifyFile)
+	for _, f := range report.Files {
+		// the files of a writer have the same capture, changefeed, create
+		// time and file type in their names
+		key := f.Name[:strings.LastIndex(f.Name, "_")]
+		chains[key] = append(chains[key], f)
+	}
+
+	var rowLogEnd uint64
+	rowLogActive := false
+	for _, chain := range chains {
+		// the active file is named by its first commit ts, it's the last one
+		sort.Slice(chain, func(i, j int) bool {
+			if chain[i].CommitTs != chain[j].CommitTs {
+				return chain[i].CommitTs < chain[j].CommitTs
+			}
+			return filepath.Ext(chain[j].Name) == common.TmpEXT
+		})
+		for i, f := range chain {
+			if f.PrevCommitTs == 0 || f.PrevCommitTs <= report.CheckpointTs {
+				continue
+			}
+			if i == 0 || chain[i-1].CommitTs != f.PrevCommitTs {
+				report.addIssue(f.Name, true,
+					"the previous log file with commit-ts %d is missing, it has the events after checkpoint-ts %d",
+					f.PrevCommitTs, report.CheckpointTs)
+			}
+		}
+		if last := chain[len(chain)-1]; last.FileType == common.DefaultRowLogFileType {
+			if filepath.Ext(last.Name) == common.TmpEXT {
+				rowLogActive = true
+			} else if last.CommitTs > rowLogEnd {
+				rowLogEnd = last.CommitTs
+			}
+		}
+	}
+	// the DDL logs usually end long before resolved-ts, and the row logs end
+	// before it too if there are no changes, so it's only a warning.
+	if !rowLogActive && rowLogEnd != 0 && rowLogEnd < report.ResolvedTs {
+		report.addIssue("", false, "the row log files end at commit-ts %d before resolved-ts %d, "+
+			"the events after it are missing if there are any", rowLogEnd, report.ResolvedTs)
+	}
+}
+
+// verifyMeta reads the meta files, the one with the max checkpoint ts is used
+// as the LogReader does.
+func verifyMeta(dir string, files []os.FileInfo, report *VerifyReport) {
+	var meta *common.LogMeta
+	for _, file := range files {
+		if filepath.Ext(file.Name()) != common.MetaEXT {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			report.addIssue(file.Name(), true, "failed to read the meta file: %s", err)
+			continue
+		}
+		m := &common.LogMeta{}
+		if _, err := m.UnmarshalMsg(data); err != nil {
+			report.addIssue(file.Name(), true, "failed to decode the meta file: %s", err)
+			continue
+		}
+		if m.CheckPointTs > m.ResolvedTs {
+			report.addIssue(file.Name(), true,
+				"checkpoint-ts %d is greater than resolved-ts %d", m.CheckPointTs, m.ResolvedTs)
+		}
+		if meta == nil || m.CheckPointTs > meta.CheckPointTs {
+			meta = m
+		}
+	}
+	if meta == nil {
+		report.addIssue("", true, "no meta file found")
+		return
+	}
+	report.CheckpointTs = meta.CheckPointTs
+	report.ResolvedTs = meta.ResolvedTs
+}
+
+// verifyLogFile decodes all the events in the log file, and checks that the
+// commit ts of the events are not greater than the commit ts in the file name,
+// because the reader skips the files by the commit ts in their names.
+func verifyLogFile(dir, name, fileType string, commitTs uint64, codecCfg *common.CodecConfig, report *VerifyReport) error {
+	f, err := openReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrRedoFileOp, errors.Annotate(err, "can't open redo logfile"))
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close() //nolint:errcheck
+		return cerror.WrapError(cerror.ErrRedoFileOp, err)
+	}
+
+	vf := &VerifyFile{Name: name, FileType: fileType, CommitTs: commitTs}
+	report.Files = append(report.Files, vf)
+	r, err := newFileReader(f, codecCfg)
+	if err != nil {
+		f.Close() //nolint:errcheck
+		report.addIssue(name, true, "bad file header: %s", err)
+		return nil
+	}
+	defer r.Close()
+	vf.PrevCommitTs = r.prevCommitTs
+	for {
+		redoLog := &model.RedoLog{}
+		err := r.Read(redoLog)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			report.addIssue(name, true, "corrupted event at offset %d: %s", r.lastValidOff, err)
+			return nil
+		}
+
+		var ts uint64
+		switch {
+		case fileType == common.DefaultRowLogFileType && redoLog.RedoRow != nil && redoLog.RedoRow.Row != nil:
+			ts = redoLog.RedoRow.Row.CommitTs
+		case fileType == common.DefaultDDLLogFileType && redoLog.RedoDDL != nil && redoLog.RedoDDL.DDL != nil:
+			ts = redoLog.RedoDDL.DDL.CommitTs
+		default:
+			report.addIssue(name, true, "unexpected event at offset %d in %s log file", r.lastValidOff, fileType)
+			continue
+		}
+		vf.Events++
+		if vf.Events == 1 || ts < vf.MinCommitTs {
+			vf.MinCommitTs = ts
+		}
+		if ts > vf.MaxCommitTs {
+			vf.MaxCommitTs = ts
+		}
+		if ts > commitTs && filepath.Ext(name) != common.TmpEXT {
+			report.addIssue(name, true,
+				"event commit-ts %d is greater than the commit-ts %d in the file name", ts, commitTs)
+		}
+		if ts > report.CheckpointTs && ts <= report.ResolvedTs {
+			if fileType == common.DefaultRowLogFileType {
+				report.Rows++
+			} else {
+				report.DDLs++
+			}
+		}
+	}
+	// the reader stops at a torn write silently, the data after it is lost.
+	if r.lastValidOff < info.Size() {
+		report.addIssue(name, false, "%d bytes after offset %d are not readable, the last write may be torn",
+			info.Size()-r.lastValidOff, r.lastValidOff)
+	}
+	return nil
+}
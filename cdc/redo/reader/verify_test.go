@@ -0,0 +1,203 @@
+//  Copyright 2021 PingCAP, Inc.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/redo/common"
+	"github.com/pingcap/ticdc/cdc/redo/writer"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestLogFile(
+	t *testing.T, dir string, codecCfg *common.CodecConfig, fileType string, commitTs uint64, logs ...*model.RedoLog,
+) string {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cfg := &writer.FileWriterConfig{
+		MaxLogSize: 100000,
+		Dir:        dir,
+		Codec:      codecCfg,
+	}
+	fileName := fmt.Sprintf("%s_%s_%d_%s_%d%s", "cp", "test-cf", time.Now().Unix(), fileType, commitTs, common.LogEXT)
+	w, err := writer.NewWriter(ctx, cfg, writer.WithLogFileName(func() string {
+		return fileName
+	}))
+	require.Nil(t, err)
+	for _, log := range logs {
+		data, err := log.MarshalMsg(nil)
+		require.Nil(t, err)
+		_, err = w.Write(data)
+		require.Nil(t, err)
+	}
+	require.Nil(t, w.Close())
+	return fileName
+}
+
+func writeTestMetaFile(t *testing.T, dir string, checkpointTs, resolvedTs uint64) {
+	fileName := fmt.Sprintf("%s_%s_%d_%s%s", "cp", "test-cf", time.Now().Unix(), common.DefaultMetaFileType, common.MetaEXT)
+	meta := &common.LogMeta{
+		CheckPointTs: checkpointTs,
+		ResolvedTs:   resolvedTs,
+	}
+	data, err := meta.MarshalMsg(nil)
+	require.Nil(t, err)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, fileName), data, 0o644))
+}
+
+func newTestRowLog(commitTs uint64) *model.RedoLog {
+	return &model.RedoLog{
+		RedoRow: &model.RedoRowChangedEvent{Row: &model.RowChangedEvent{CommitTs: commitTs}},
+		Type:    model.RedoLogTypeRow,
+	}
+}
+
+func newTestDDLLog(commitTs uint64) *model.RedoLog {
+	return &model.RedoLog{
+		RedoDDL: &model.RedoDDLEvent{DDL: &model.DDLEvent{CommitTs: commitTs}},
+		Type:    model.RedoLogTypeDDL,
+	}
+}
+
+func TestVerifyLogs(t *testing.T) {
+	_, err := VerifyLogs(context.Background(), nil)
+	require.NotNil(t, err)
+
+	dir, err := ioutil.TempDir("", "redo-VerifyLogs")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// no meta file
+	report, err := VerifyLogs(context.Background(), &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	require.True(t, report.HasFatal())
+
+	writeTestMetaFile(t, dir, 10, 14)
+	rowFile := writeTestLogFile(t, dir, nil, common.DefaultRowLogFileType, 20, newTestRowLog(11), newTestRowLog(15))
+	writeTestLogFile(t, dir, nil, common.DefaultDDLLogFileType, 12, newTestDDLLog(12))
+	report, err = VerifyLogs(context.Background(), &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	require.False(t, report.HasFatal())
+	require.Empty(t, report.Issues)
+	require.Equal(t, uint64(10), report.CheckpointTs)
+	require.Equal(t, uint64(14), report.ResolvedTs)
+	require.Equal(t, 1, report.Rows)
+	require.Equal(t, 1, report.DDLs)
+	require.Len(t, report.Files, 2)
+
+	// the last write is torn, it's only a warning.
+	f, err := os.OpenFile(filepath.Join(dir, rowFile), os.O_APPEND|os.O_WRONLY, 0o644)
+	require.Nil(t, err)
+	require.Nil(t, binary.Write(f, binary.LittleEndian, int64(100)))
+	_, err = f.Write([]byte("torn"))
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+	report, err = VerifyLogs(context.Background(), &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	require.False(t, report.HasFatal())
+	require.Len(t, report.Issues, 1)
+	require.Equal(t, rowFile, report.Issues[0].File)
+
+	// the commit ts of the event is greater than the one in the file name.
+	badFile := writeTestLogFile(t, dir, nil, common.DefaultRowLogFileType, 13, newTestRowLog(16))
+	// the file name can't be parsed.
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "bad-name"+common.LogEXT), nil, 0o644))
+	report, err = VerifyLogs(context.Background(), &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	require.True(t, report.HasFatal())
+	fatalFiles := make(map[string]bool)
+	for _, issue := range report.Issues {
+		if issue.Fatal {
+			fatalFiles[issue.File] = true
+		}
+	}
+	require.Equal(t, map[string]bool{badFile: true, "bad-name" + common.LogEXT: true}, fatalFiles)
+}
+
+func TestVerifyLogsMissingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "redo-VerifyLogsMissingFile")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// each file holds two rows, so the rows are written to 3 files, which
+	// are named by the commit ts 12, 14 and 16.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w, err := writer.NewWriter(ctx, &writer.FileWriterConfig{
+		MaxLogSize:   1,
+		Dir:          dir,
+		ChangeFeedID: "test-cf",
+		CaptureID:    "cp",
+		FileType:     common.DefaultRowLogFileType,
+		CreateTime:   time.Now(),
+	})
+	require.Nil(t, err)
+	value := strings.Repeat("a", 400*1024)
+	for ts := uint64(11); ts <= 16; ts++ {
+		log := newTestRowLog(ts)
+		log.RedoRow.Columns = []*model.RedoColumn{{Column: &model.Column{Name: "a", Value: value}}}
+		data, err := log.MarshalMsg(nil)
+		require.Nil(t, err)
+		w.AdvanceTs(ts)
+		_, err = w.Write(data)
+		require.Nil(t, err)
+	}
+	require.Nil(t, w.Close())
+	writeTestMetaFile(t, dir, 10, 16)
+
+	report, err := VerifyLogs(context.Background(), &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	require.Empty(t, report.Issues)
+	require.Equal(t, 6, report.Rows)
+	require.Len(t, report.Files, 3)
+	for i, f := range report.Files {
+		require.Equal(t, uint64(12+2*i), f.CommitTs)
+		if i > 0 {
+			require.Equal(t, report.Files[i-1].CommitTs, f.PrevCommitTs)
+		}
+	}
+	first, middle, last := report.Files[0].Name, report.Files[1].Name, report.Files[2].Name
+
+	// the file in the middle is missing
+	require.Nil(t, os.Remove(filepath.Join(dir, middle)))
+	report, err = VerifyLogs(context.Background(), &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	require.True(t, report.HasFatal())
+	require.Len(t, report.Issues, 1)
+	require.Equal(t, last, report.Issues[0].File)
+
+	// the first file is removed by GC after checkpoint-ts is advanced
+	require.Nil(t, os.Remove(filepath.Join(dir, first)))
+	writeTestMetaFile(t, dir, 14, 16)
+	report, err = VerifyLogs(context.Background(), &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	require.Empty(t, report.Issues)
+
+	// the row logs end before resolved-ts, which is a warning
+	writeTestMetaFile(t, dir, 15, 20)
+	report, err = VerifyLogs(context.Background(), &LogReaderConfig{Dir: dir})
+	require.Nil(t, err)
+	require.False(t, report.HasFatal())
+	require.Len(t, report.Issues, 1)
+}
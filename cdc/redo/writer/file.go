@@ -15,6 +15,7 @@
 package writer
 
 import (
+	"bufio"
 	"context"
 	"encoding/binary"
 	"fmt"
@@ -84,6 +85,9 @@ type FileWriterConfig struct {
 	FlushIntervalInMs int64
 	S3Storage         bool
 	S3URI             url.URL
+	// Codec compresses and encrypts the records, the records are written as
+	// they are if it is nil.
+	Codec *common.CodecConfig
 }
 
 // Option define the writerOptions
@@ -119,6 +123,8 @@ type Writer struct {
 	bw            *pioutil.PageWriter
 	uint64buf     []byte
 	storage       storage.ExternalStorage
+	// codec encodes the records of the current file
+	codec *common.FileCodec
 	sync.RWMutex
 }
 
@@ -210,6 +216,10 @@ func (w *Writer) Write(rawData []byte) (int, error) {
 	if w.maxCommitTS.Load() < w.eventCommitTS.Load() {
 		w.maxCommitTS.Store(w.eventCommitTS.Load())
 	}
+	rawData, err := w.codec.Encode(rawData)
+	if err != nil {
+		return 0, err
+	}
 	// ref: https://github.com/etcd-io/etcd/pull/5250
 	lenField, padBytes := encodeFrameSize(len(rawData))
 	if err := w.writeUint64(lenField, w.uint64buf); err != nil {
@@ -336,6 +346,8 @@ func (w *Writer) openNew() error {
 		return cerror.WrapError(cerror.ErrRedoFileOp, errors.Annotatef(err, "can't make dir: %s for new redo logfile", w.cfg.Dir))
 	}
 
+	// the ts in the name of the last closed file links the new file to it
+	prevCommitTS := w.commitTS.Load()
 	// reset ts used in file name when new file
 	w.commitTS.Store(w.eventCommitTS.Load())
 	w.maxCommitTS.Store(w.eventCommitTS.Load())
@@ -350,6 +362,17 @@ func (w *Writer) openNew() error {
 	if err != nil {
 		return err
 	}
+
+	header, codec, err := common.NewFileHeader(w.cfg.Codec, prevCommitTS)
+	if err != nil {
+		return err
+	}
+	w.codec = codec
+	// like the frame sizes, the header is not counted in the size of the
+	// records for rotating
+	if _, err := w.bw.Write(header); err != nil {
+		return cerror.WrapError(cerror.ErrRedoFileOp, err)
+	}
 	return nil
 }
 
@@ -367,6 +390,14 @@ func (w *Writer) openOrNew(writeLen int) error {
 		return w.rotate()
 	}
 
+	// the records can only be appended to the file written by the same codec
+	codec, err := readFileCodec(path, w.cfg.Codec)
+	if err != nil || !codec.Matches(w.cfg.Codec) {
+		log.Info("the codec of the redo logfile doesn't match, rotate it",
+			zap.String("file", path), zap.Error(err))
+		return w.rotate()
+	}
+
 	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, common.DefaultFileMode)
 	if err != nil {
 		// return err let the caller decide next move
@@ -375,6 +406,7 @@ func (w *Writer) openOrNew(writeLen int) error {
 
 	w.file = file
 	w.size = info.Size()
+	w.codec = codec
 	err = w.newPageWriter()
 	if err != nil {
 		return err
@@ -382,6 +414,20 @@ func (w *Writer) openOrNew(writeLen int) error {
 	return nil
 }
 
+func readFileCodec(path string, cfg *common.CodecConfig) (*common.FileCodec, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrRedoFileOp, err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	header, err := common.ReadFileHeader(bufio.NewReader(file), cfg)
+	if err != nil {
+		return nil, err
+	}
+	return header.Codec, nil
+}
+
 func (w *Writer) newPageWriter() error {
 	offset, err := w.file.Seek(0, io.SeekCurrent)
 	if err != nil {
@@ -92,6 +92,8 @@ type LogWriterConfig struct {
 	S3Storage         bool
 	// S3URI should be like S3URI="s3://logbucket/test-changefeed?endpoint=http://$S3_ENDPOINT/"
 	S3URI url.URL
+	// Codec compresses and encrypts the records in the log files
+	Codec *common.CodecConfig
 }
 
 // LogWriter implement the RedoLogWriter interface
@@ -132,6 +134,7 @@ func NewLogWriter(ctx context.Context, cfg *LogWriterConfig) (*LogWriter, error)
 		FlushIntervalInMs: cfg.FlushIntervalInMs,
 		S3Storage:         cfg.S3Storage,
 		S3URI:             cfg.S3URI,
+		Codec:             cfg.Codec,
 	}
 	ddlCfg := &FileWriterConfig{
 		Dir:               cfg.Dir,
@@ -143,6 +146,7 @@ func NewLogWriter(ctx context.Context, cfg *LogWriterConfig) (*LogWriter, error)
 		FlushIntervalInMs: cfg.FlushIntervalInMs,
 		S3Storage:         cfg.S3Storage,
 		S3URI:             cfg.S3URI,
+		Codec:             cfg.Codec,
 	}
 	logWriter = &LogWriter{
 		cfg: cfg,
@@ -595,5 +599,5 @@ func (l *LogWriter) filePath() string {
 }
 
 func (cfg LogWriterConfig) String() string {
-	return fmt.Sprintf("%s:%s:%s:%d:%d:%s:%t", cfg.ChangeFeedID, cfg.CaptureID, cfg.Dir, cfg.MaxLogSize, cfg.FlushIntervalInMs, cfg.S3URI.String(), cfg.S3Storage)
+	return fmt.Sprintf("%s:%s:%s:%d:%d:%s:%t:%s", cfg.ChangeFeedID, cfg.CaptureID, cfg.Dir, cfg.MaxLogSize, cfg.FlushIntervalInMs, cfg.S3URI.String(), cfg.S3Storage, cfg.Codec)
 }
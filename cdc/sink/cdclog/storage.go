@@ -0,0 +1,326 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdclog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/sink/columnselector"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	parsemodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/types"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// storageMetadataFile is the index file which records the checkpoint ts.
+	// The data files whose resolved ts are not greater than the checkpoint ts
+	// are finished, and no more data files are written before it.
+	storageMetadataFile = "metadata"
+	// storageSchemaFile is the schema file in the directory of a table version.
+	storageSchemaFile = "schema.json"
+)
+
+// tableVersion identifies the directory of a table version, which is
+// `{schema}/{table}/{version}`.
+type tableVersion struct {
+	schema  string
+	table   string
+	version uint64
+}
+
+func (v tableVersion) dir() string {
+	return path.Join(v.schema, v.table, strconv.FormatUint(v.version, 10))
+}
+
+// tableSchema is the content of the schema file of a table version.
+type tableSchema struct {
+	Schema  string         `json:"schema"`
+	Table   string         `json:"table"`
+	Version uint64         `json:"version"`
+	Query   string         `json:"query,omitempty"`
+	Columns []schemaColumn `json:"columns"`
+}
+
+type schemaColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// storageMetadata is the content of the index file.
+type storageMetadata struct {
+	CheckpointTs uint64 `json:"checkpoint-ts"`
+}
+
+// makeStorageDataFileName returns the name of a data file, which holds the
+// rows of a physical table flushed at the resolved ts.
+func makeStorageDataFileName(resolvedTs uint64, tableID int64, ext string) string {
+	return fmt.Sprintf("CDC%020d_%d%s", resolvedTs, tableID, ext)
+}
+
+// storageSink writes the rows of each table version into a directory of an
+// external storage. Each flush writes a data file for every table which has
+// rows to flush, so the data files are closed on the resolved ts boundaries.
+type storageSink struct {
+	storage storage.ExternalStorage
+	// localRoot is the root directory of a local storage, the directories are
+	// created before writing files, because the local storage doesn't do it.
+	localRoot string
+	encoder   rowEncoder
+
+	filter          *filter.Filter
+	columnSelectors *columnselector.ColumnSelectors
+
+	rowsMu sync.Mutex
+	// rows buffers the rows of each physical table until they are flushed.
+	rows map[int64][]*model.RowChangedEvent
+
+	schemasMu sync.Mutex
+	// schemas records the table versions whose schema files exist.
+	schemas map[tableVersion]struct{}
+}
+
+// NewStorageSink creates a sink which writes rows in the given protocol to
+// per-table directories of a local or s3 storage.
+func NewStorageSink(
+	ctx context.Context, sinkURI *url.URL, filter *filter.Filter, replicaConfig *config.ReplicaConfig,
+) (*storageSink, error) {
+	params := sinkURI.Query()
+	encoder, err := newRowEncoder(
+		params.Get("protocol"), params, replicaConfig.EnableOldValue, util.TimezoneFromCtx(ctx))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	columnSelectors, err := columnselector.New(replicaConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	backend, err := storage.ParseBackend(sinkURI.String(), nil)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrStorageSinkInitialize, err)
+	}
+	extStorage, err := storage.New(ctx, backend, &storage.ExternalStorageOptions{
+		SendCredentials: false,
+		SkipCheckPath:   true,
+		HTTPClient:      nil,
+	})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrStorageSinkInitialize, err)
+	}
+	s := &storageSink{
+		storage:         extStorage,
+		encoder:         encoder,
+		filter:          filter,
+		columnSelectors: columnSelectors,
+		rows:            make(map[int64][]*model.RowChangedEvent),
+		schemas:         make(map[tableVersion]struct{}),
+	}
+	if local := backend.GetLocal(); local != nil {
+		s.localRoot = local.Path
+	}
+	log.Info("storage sink created", zap.String("uri", extStorage.URI()),
+		zap.String("protocol", params.Get("protocol")))
+	return s, nil
+}
+
+func (s *storageSink) Initialize(ctx context.Context, tableInfo []*model.SimpleTableInfo) error {
+	return nil
+}
+
+func (s *storageSink) EmitRowChangedEvents(ctx context.Context, rows ...*model.RowChangedEvent) error {
+	s.rowsMu.Lock()
+	defer s.rowsMu.Unlock()
+	for _, row := range rows {
+		if s.filter.ShouldIgnoreDMLEvent(row.StartTs, row.Table.Schema, row.Table.Table) {
+			log.Info("Row changed event ignored", zap.Uint64("start-ts", row.StartTs))
+			continue
+		}
+		row = s.columnSelectors.Apply(row)
+		tableID := row.Table.GetTableID()
+		s.rows[tableID] = append(s.rows[tableID], row)
+	}
+	return nil
+}
+
+func (s *storageSink) FlushRowChangedEvents(ctx context.Context, resolvedTs uint64) (uint64, error) {
+	s.rowsMu.Lock()
+	flushed := make(map[int64][]*model.RowChangedEvent)
+	for tableID, rows := range s.rows {
+		// the rows of a table are emitted in the order of commit ts.
+		i := sort.Search(len(rows), func(i int) bool {
+			return rows[i].CommitTs > resolvedTs
+		})
+		if i == 0 {
+			continue
+		}
+		flushed[tableID] = rows[:i]
+		if i == len(rows) {
+			delete(s.rows, tableID)
+		} else {
+			s.rows[tableID] = rows[i:]
+		}
+	}
+	s.rowsMu.Unlock()
+
+	eg, ectx := errgroup.WithContext(ctx)
+	for tableID, rows := range flushed {
+		tableID, rows := tableID, rows
+		eg.Go(func() error {
+			return s.flushTable(ectx, tableID, rows, resolvedTs)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return 0, errors.Trace(err)
+	}
+	return resolvedTs, nil
+}
+
+// flushTable writes the rows of a physical table into the data files of the
+// table versions.
+func (s *storageSink) flushTable(ctx context.Context, tableID int64, rows []*model.RowChangedEvent, resolvedTs uint64) error {
+	for len(rows) > 0 {
+		v := tableVersion{schema: rows[0].Table.Schema, table: rows[0].Table.Table, version: rows[0].TableInfoVersion}
+		n := 1
+		for n < len(rows) && rows[n].TableInfoVersion == v.version {
+			n++
+		}
+		if err := s.ensureSchemaFile(ctx, v, rows[0]); err != nil {
+			return errors.Trace(err)
+		}
+		data, err := s.encoder.encode(rows[:n])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		name := path.Join(v.dir(), makeStorageDataFileName(resolvedTs, tableID, s.encoder.fileExt()))
+		if err := s.writeFile(ctx, name, data); err != nil {
+			return errors.Trace(err)
+		}
+		log.Debug("storage sink writes a data file", zap.String("name", name), zap.Int("rows", n))
+		rows = rows[n:]
+	}
+	return nil
+}
+
+// ensureSchemaFile writes the schema file of the table version, if it isn't
+// written by a DDL, the schema is generated from the columns of the row.
+func (s *storageSink) ensureSchemaFile(ctx context.Context, v tableVersion, row *model.RowChangedEvent) error {
+	s.schemasMu.Lock()
+	_, ok := s.schemas[v]
+	s.schemasMu.Unlock()
+	if ok {
+		return nil
+	}
+	name := path.Join(v.dir(), storageSchemaFile)
+	exists, err := s.storage.FileExists(ctx, name)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrStorageSinkWriteFile, err)
+	}
+	if !exists {
+		cols := row.Columns
+		if len(cols) == 0 {
+			cols = row.PreColumns
+		}
+		schema := &tableSchema{Schema: v.schema, Table: v.table, Version: v.version}
+		for _, col := range cols {
+			if col != nil {
+				schema.Columns = append(schema.Columns, schemaColumn{Name: col.Name, Type: types.TypeStr(col.Type)})
+			}
+		}
+		if err := s.writeJSON(ctx, name, schema); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	s.schemasMu.Lock()
+	s.schemas[v] = struct{}{}
+	s.schemasMu.Unlock()
+	return nil
+}
+
+func (s *storageSink) EmitCheckpointTs(ctx context.Context, ts uint64) error {
+	return s.writeJSON(ctx, storageMetadataFile, &storageMetadata{CheckpointTs: ts})
+}
+
+// EmitDDLEvent writes the schema file of the table version created by the DDL.
+func (s *storageSink) EmitDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
+	if s.filter.ShouldIgnoreDDLEvent(ddl.StartTs, ddl.Type, ddl.TableInfo.Schema, ddl.TableInfo.Table) {
+		log.Info(
+			"DDL event ignored",
+			zap.String("query", ddl.Query),
+			zap.Uint64("startTs", ddl.StartTs),
+			zap.Uint64("commitTs", ddl.CommitTs),
+		)
+		return cerror.ErrDDLEventIgnored.GenWithStackByArgs()
+	}
+	if ddl.TableInfo.Table == "" || ddl.Type == parsemodel.ActionDropTable {
+		return nil
+	}
+	v := tableVersion{schema: ddl.TableInfo.Schema, table: ddl.TableInfo.Table, version: ddl.CommitTs}
+	schema := &tableSchema{Schema: v.schema, Table: v.table, Version: v.version, Query: ddl.Query}
+	for _, col := range ddl.TableInfo.ColumnInfo {
+		schema.Columns = append(schema.Columns, schemaColumn{Name: col.Name, Type: types.TypeStr(col.Type)})
+	}
+	if err := s.writeJSON(ctx, path.Join(v.dir(), storageSchemaFile), schema); err != nil {
+		return errors.Trace(err)
+	}
+	s.schemasMu.Lock()
+	s.schemas[v] = struct{}{}
+	s.schemasMu.Unlock()
+	return nil
+}
+
+func (s *storageSink) writeJSON(ctx context.Context, name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMarshalFailed, err)
+	}
+	return s.writeFile(ctx, name, data)
+}
+
+func (s *storageSink) writeFile(ctx context.Context, name string, data []byte) error {
+	if s.localRoot != "" {
+		dir := filepath.Join(s.localRoot, filepath.FromSlash(path.Dir(name)))
+		if err := os.MkdirAll(dir, defaultDirMode); err != nil {
+			return cerror.WrapError(cerror.ErrFileSinkCreateDir, err)
+		}
+	}
+	return cerror.WrapError(cerror.ErrStorageSinkWriteFile, s.storage.WriteFile(ctx, name, data))
+}
+
+func (s *storageSink) Close(ctx context.Context) error {
+	return nil
+}
+
+func (s *storageSink) Barrier(ctx context.Context) error {
+	// Barrier does nothing because FlushRowChangedEvents in storage sink has
+	// written all the buffered rows.
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdclog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/sink/codec"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+// Protocols supported by the storage sink.
+const (
+	StorageProtocolCSV       = "csv"
+	StorageProtocolCanalJSON = "canal-json"
+	StorageProtocolParquet   = "parquet"
+)
+
+// rowEncoder encodes the rows of a table into the content of a data file.
+type rowEncoder interface {
+	// encode encodes the rows, which are of the same table version and
+	// sorted by the commit ts.
+	encode(rows []*model.RowChangedEvent) ([]byte, error)
+	// fileExt returns the extension of the data files.
+	fileExt() string
+}
+
+func newRowEncoder(
+	protocol string, params url.Values, enableOldValue bool, tz *time.Location,
+) (rowEncoder, error) {
+	switch strings.ToLower(protocol) {
+	case StorageProtocolCSV:
+		return newCSVEncoder(params)
+	case StorageProtocolCanalJSON:
+		if !enableOldValue {
+			return nil, cerror.ErrSinkInvalidConfig.GenWithStack("canal-json requires old value to be enabled")
+		}
+		return newCanalJSONEncoder(params)
+	case StorageProtocolParquet:
+		return newParquetEncoder(params, tz)
+	}
+	return nil, cerror.ErrSinkInvalidConfig.GenWithStack("the storage sink protocol (%s) is not supported", protocol)
+}
+
+// csvEncoder encodes a row as a line of
+// `op,table,schema,commit-ts,column values...`, where op is I, U or D.
+type csvEncoder struct {
+	delimiter string
+	quote     string
+	null      string
+}
+
+func newCSVEncoder(params url.Values) (*csvEncoder, error) {
+	e := &csvEncoder{delimiter: ",", quote: `"`, null: `\N`}
+	if s := params.Get("csv-delimiter"); s != "" {
+		e.delimiter = s
+	}
+	if params["csv-quote"] != nil {
+		e.quote = params.Get("csv-quote")
+	}
+	if params["csv-null"] != nil {
+		e.null = params.Get("csv-null")
+	}
+	if utf8.RuneCountInString(e.quote) > 1 {
+		return nil, cerror.ErrSinkInvalidConfig.GenWithStack("csv-quote %s contains more than one character", e.quote)
+	}
+	if e.quote != "" && strings.Contains(e.delimiter, e.quote) {
+		return nil, cerror.ErrSinkInvalidConfig.GenWithStack("csv-delimiter %s contains csv-quote %s", e.delimiter, e.quote)
+	}
+	return e, nil
+}
+
+func (e *csvEncoder) fileExt() string {
+	return ".csv"
+}
+
+func (e *csvEncoder) encode(rows []*model.RowChangedEvent) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	for _, row := range rows {
+		op, cols := "I", row.Columns
+		if row.IsDelete() {
+			op, cols = "D", row.PreColumns
+		} else if len(row.PreColumns) != 0 {
+			op = "U"
+		}
+		e.writeString(buf, op)
+		buf.WriteString(e.delimiter)
+		e.writeString(buf, row.Table.Table)
+		buf.WriteString(e.delimiter)
+		e.writeString(buf, row.Table.Schema)
+		buf.WriteString(e.delimiter)
+		buf.WriteString(strconv.FormatUint(row.CommitTs, 10))
+		for _, col := range cols {
+			if col == nil {
+				continue
+			}
+			buf.WriteString(e.delimiter)
+			if err := e.writeColumn(buf, col); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func (e *csvEncoder) writeColumn(buf *bytes.Buffer, col *model.Column) error {
+	switch v := col.Value.(type) {
+	case nil:
+		buf.WriteString(e.null)
+	case bool:
+		if v {
+			buf.WriteByte('1')
+		} else {
+			buf.WriteByte('0')
+		}
+	case int64:
+		buf.WriteString(strconv.FormatInt(v, 10))
+	case uint64:
+		buf.WriteString(strconv.FormatUint(v, 10))
+	case float32:
+		buf.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			e.writeString(buf, strconv.FormatFloat(v, 'g', -1, 64))
+		} else {
+			buf.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+		}
+	case string:
+		e.writeString(buf, v)
+	case []byte:
+		// the binary values are base64 encoded, because they may not be valid
+		// UTF-8 strings.
+		if col.Flag.IsBinary() {
+			e.writeString(buf, base64.StdEncoding.EncodeToString(v))
+		} else {
+			e.writeString(buf, string(v))
+		}
+	default:
+		return cerror.ErrCSVEncodeFailed.GenWithStack(
+			"unsupported value type %T of column %s", col.Value, col.Name)
+	}
+	return nil
+}
+
+// writeString writes the quoted string, the quote character in the string is
+// escaped by doubling it.
+func (e *csvEncoder) writeString(buf *bytes.Buffer, s string) {
+	if e.quote == "" {
+		buf.WriteString(s)
+		return
+	}
+	buf.WriteString(e.quote)
+	buf.WriteString(strings.ReplaceAll(s, e.quote, e.quote+e.quote))
+	buf.WriteString(e.quote)
+}
+
+// canalJSONEncoder encodes a row as a line of canal-json message.
+type canalJSONEncoder struct {
+	params map[string]string
+}
+
+func newCanalJSONEncoder(params url.Values) (*canalJSONEncoder, error) {
+	e := &canalJSONEncoder{params: make(map[string]string)}
+	if s := params.Get("enable-tidb-extension"); s != "" {
+		e.params["enable-tidb-extension"] = s
+	}
+	// pre-flight verification of encoder parameters
+	if _, err := e.newEncoder(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return e, nil
+}
+
+func (e *canalJSONEncoder) newEncoder() (codec.EventBatchEncoder, error) {
+	encoder := codec.NewCanalFlatEventBatchEncoder()
+	if err := encoder.SetParams(e.params); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return encoder, nil
+}
+
+func (e *canalJSONEncoder) fileExt() string {
+	return ".json"
+}
+
+func (e *canalJSONEncoder) encode(rows []*model.RowChangedEvent) ([]byte, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	// the tables are flushed concurrently, so every call uses its own encoder.
+	encoder, err := e.newEncoder()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, row := range rows {
+		if _, err := encoder.AppendRowChangedEvent(row); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if _, err := encoder.AppendResolvedEvent(rows[len(rows)-1].CommitTs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	buf := &bytes.Buffer{}
+	for _, msg := range encoder.Build() {
+		buf.Write(msg.Value)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,401 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdclog
+
+import (
+	"bytes"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/model"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/xitongsys/parquet-go/marshal"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	// parquetCommitTsColumn and parquetOpColumn are the metadata columns
+	// appended to every row, the op is I, U or D.
+	parquetCommitTsColumn = "_commit_ts"
+	parquetOpColumn       = "_op"
+
+	defaultParquetDecimalPrecision = 38
+	defaultParquetDecimalScale     = 10
+	maxParquetDecimalPrecision     = 65
+)
+
+var (
+	parquetZeroTimeStr = types.NewTime(types.ZeroCoreTime, mysql.TypeTimestamp, 0).String()
+	parquetZeroDateStr = types.NewTime(types.ZeroCoreTime, mysql.TypeDate, 0).String()
+	parquetUnixEpoch   = time.Unix(0, 0).UTC()
+)
+
+// parquetEncoder encodes the rows as a parquet file, all the rows flushed at
+// a resolved ts are written in one row group. The values of decimal columns
+// are stored with the precision and scale specified in the sink uri, because
+// the rows don't carry the definitions of the columns.
+type parquetEncoder struct {
+	compression parquet.CompressionCodec
+	precision   int
+	scale       int
+	tz          *time.Location
+}
+
+func newParquetEncoder(params url.Values, tz *time.Location) (*parquetEncoder, error) {
+	e := &parquetEncoder{
+		compression: parquet.CompressionCodec_SNAPPY,
+		precision:   defaultParquetDecimalPrecision,
+		scale:       defaultParquetDecimalScale,
+		tz:          tz,
+	}
+	if e.tz == nil {
+		e.tz = time.UTC
+	}
+	switch s := strings.ToLower(params.Get("parquet-compression")); s {
+	case "", "snappy":
+	case "gzip":
+		e.compression = parquet.CompressionCodec_GZIP
+	case "zstd":
+		e.compression = parquet.CompressionCodec_ZSTD
+	case "none":
+		e.compression = parquet.CompressionCodec_UNCOMPRESSED
+	default:
+		return nil, cerror.ErrSinkInvalidConfig.GenWithStack("parquet-compression %s is not supported", s)
+	}
+	if s := params.Get("parquet-decimal-precision"); s != "" {
+		a, err := strconv.Atoi(s)
+		if err != nil || a <= 0 || a > maxParquetDecimalPrecision {
+			return nil, cerror.ErrSinkInvalidConfig.GenWithStack("invalid parquet-decimal-precision %s", s)
+		}
+		e.precision = a
+	}
+	if s := params.Get("parquet-decimal-scale"); s != "" {
+		a, err := strconv.Atoi(s)
+		if err != nil || a < 0 {
+			return nil, cerror.ErrSinkInvalidConfig.GenWithStack("invalid parquet-decimal-scale %s", s)
+		}
+		e.scale = a
+	}
+	if e.scale > e.precision {
+		return nil, cerror.ErrSinkInvalidConfig.GenWithStack(
+			"parquet-decimal-scale %d is greater than parquet-decimal-precision %d", e.scale, e.precision)
+	}
+	return e, nil
+}
+
+func (e *parquetEncoder) fileExt() string {
+	return ".parquet"
+}
+
+func (e *parquetEncoder) encode(rows []*model.RowChangedEvent) ([]byte, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	// the schema is built from the columns of the first row, all the rows are
+	// of the same table version.
+	schemas := []*parquet.SchemaElement{newParquetRootSchema()}
+	cols := rowColumns(rows[0])
+	names := make(map[string]int, len(cols))
+	for _, col := range cols {
+		if col == nil {
+			continue
+		}
+		schema, err := e.columnSchema(col)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		names[col.Name] = len(schemas) - 1
+		schemas = append(schemas, schema)
+	}
+	commitTsSchema := newParquetSchema(parquetCommitTsColumn, parquet.Type_INT64)
+	commitTsSchema.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UINT_64)
+	opSchema := newParquetSchema(parquetOpColumn, parquet.Type_BYTE_ARRAY)
+	opSchema.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+	schemas = append(schemas, commitTsSchema, opSchema)
+	numChildren := int32(len(schemas) - 1)
+	schemas[0].NumChildren = &numChildren
+
+	buf := &bytes.Buffer{}
+	w, err := writer.NewParquetWriterFromWriter(buf, schemas, 1)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+	}
+	// the values are written as flat records, the same as the csv writer.
+	w.MarshalFunc = marshal.MarshalCSV
+	w.CompressionType = e.compression
+
+	for _, row := range rows {
+		op := "I"
+		if row.IsDelete() {
+			op = "D"
+		} else if len(row.PreColumns) != 0 {
+			op = "U"
+		}
+		record := make([]interface{}, len(schemas)-1)
+		for _, col := range rowColumns(row) {
+			if col == nil {
+				continue
+			}
+			i, ok := names[col.Name]
+			if !ok {
+				return nil, cerror.ErrParquetEncodeFailed.GenWithStack(
+					"column %s is not in the schema of table %s", col.Name, row.Table)
+			}
+			v, err := e.columnValue(col, schemas[i+1])
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			record[i] = v
+		}
+		record[len(record)-2] = int64(row.CommitTs)
+		record[len(record)-1] = op
+		if err := w.Write(record); err != nil {
+			return nil, cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+		}
+	}
+	if err := w.WriteStop(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// rowColumns returns the columns written for the row, which are the old
+// values of a deleted row.
+func rowColumns(row *model.RowChangedEvent) []*model.Column {
+	if row.IsDelete() {
+		return row.PreColumns
+	}
+	return row.Columns
+}
+
+func newParquetRootSchema() *parquet.SchemaElement {
+	root := parquet.NewSchemaElement()
+	root.Name = "parquet_go_root"
+	root.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	return root
+}
+
+// newParquetSchema returns the schema of an optional column, all the columns
+// are optional because the values are marshaled as flat records.
+func newParquetSchema(name string, tp parquet.Type) *parquet.SchemaElement {
+	schema := parquet.NewSchemaElement()
+	schema.Name = name
+	schema.Type = parquet.TypePtr(tp)
+	schema.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL)
+	numChildren := int32(0)
+	schema.NumChildren = &numChildren
+	return schema
+}
+
+// columnSchema maps the type of the column to the parquet type.
+func (e *parquetEncoder) columnSchema(col *model.Column) (*parquet.SchemaElement, error) {
+	var schema *parquet.SchemaElement
+	switch col.Type {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeYear:
+		schema = newParquetSchema(col.Name, parquet.Type_INT32)
+	case mysql.TypeLong:
+		if col.Flag.IsUnsigned() {
+			schema = newParquetSchema(col.Name, parquet.Type_INT64)
+		} else {
+			schema = newParquetSchema(col.Name, parquet.Type_INT32)
+		}
+	case mysql.TypeLonglong, mysql.TypeBit, mysql.TypeEnum, mysql.TypeSet:
+		schema = newParquetSchema(col.Name, parquet.Type_INT64)
+		if col.Type != mysql.TypeLonglong || col.Flag.IsUnsigned() {
+			schema.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UINT_64)
+		}
+	case mysql.TypeFloat:
+		schema = newParquetSchema(col.Name, parquet.Type_FLOAT)
+	case mysql.TypeDouble:
+		schema = newParquetSchema(col.Name, parquet.Type_DOUBLE)
+	case mysql.TypeNewDecimal:
+		schema = newParquetSchema(col.Name, parquet.Type_BYTE_ARRAY)
+		schema.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_DECIMAL)
+		precision, scale := int32(e.precision), int32(e.scale)
+		schema.Precision, schema.Scale = &precision, &scale
+	case mysql.TypeDate, mysql.TypeNewDate:
+		schema = newParquetSchema(col.Name, parquet.Type_INT32)
+		schema.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_DATE)
+	case mysql.TypeDatetime, mysql.TypeTimestamp:
+		schema = newParquetSchema(col.Name, parquet.Type_INT64)
+		schema.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_TIMESTAMP_MICROS)
+	case mysql.TypeDuration, mysql.TypeJSON:
+		schema = newParquetSchema(col.Name, parquet.Type_BYTE_ARRAY)
+		schema.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+	case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		schema = newParquetSchema(col.Name, parquet.Type_BYTE_ARRAY)
+		if !col.Flag.IsBinary() {
+			schema.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+		}
+	default:
+		return nil, cerror.ErrParquetEncodeFailed.GenWithStack(
+			"unsupported type %s of column %s", types.TypeStr(col.Type), col.Name)
+	}
+	return schema, nil
+}
+
+// columnValue converts the value of the column to the go type which parquet
+// writer expects for the schema.
+func (e *parquetEncoder) columnValue(col *model.Column, schema *parquet.SchemaElement) (interface{}, error) {
+	if col.Value == nil {
+		return nil, nil
+	}
+	switch schema.GetType() {
+	case parquet.Type_INT32:
+		if schema.GetConvertedType() == parquet.ConvertedType_DATE {
+			t, ok, err := e.parseTime(col)
+			if err != nil || !ok {
+				return nil, errors.Trace(err)
+			}
+			return int32(t.Sub(parquetUnixEpoch) / (24 * time.Hour)), nil
+		}
+		switch v := col.Value.(type) {
+		case int64:
+			return int32(v), nil
+		case uint64:
+			return int32(v), nil
+		}
+	case parquet.Type_INT64:
+		if schema.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MICROS {
+			t, ok, err := e.parseTime(col)
+			if err != nil || !ok {
+				return nil, errors.Trace(err)
+			}
+			return t.UnixNano() / int64(time.Microsecond), nil
+		}
+		switch v := col.Value.(type) {
+		case int64:
+			return v, nil
+		case uint64:
+			// the unsigned values are stored in the bits of int64.
+			return int64(v), nil
+		}
+	case parquet.Type_FLOAT:
+		if v, ok := col.Value.(float64); ok {
+			return float32(v), nil
+		}
+	case parquet.Type_DOUBLE:
+		if v, ok := col.Value.(float64); ok {
+			return v, nil
+		}
+	case parquet.Type_BYTE_ARRAY:
+		var s string
+		switch v := col.Value.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		default:
+			return nil, cerror.ErrParquetEncodeFailed.GenWithStack(
+				"unsupported value type %T of column %s", col.Value, col.Name)
+		}
+		if schema.GetConvertedType() == parquet.ConvertedType_DECIMAL {
+			return e.decimalBytes(col, s)
+		}
+		return s, nil
+	}
+	return nil, cerror.ErrParquetEncodeFailed.GenWithStack(
+		"unsupported value type %T of column %s", col.Value, col.Name)
+}
+
+// parseTime parses the value of a date or time column, it returns false if
+// the value is a zero date, which is stored as null. The timestamp values
+// are in the time zone of the changefeed, the others are regarded as UTC.
+func (e *parquetEncoder) parseTime(col *model.Column) (time.Time, bool, error) {
+	s, ok := col.Value.(string)
+	if !ok {
+		return time.Time{}, false, cerror.ErrParquetEncodeFailed.GenWithStack(
+			"unsupported value type %T of column %s", col.Value, col.Name)
+	}
+	if (col.Type == mysql.TypeDate && s == parquetZeroDateStr) ||
+		(col.Type != mysql.TypeDate && s == parquetZeroTimeStr) {
+		return time.Time{}, false, nil
+	}
+	tz := time.UTC
+	if col.Type == mysql.TypeTimestamp {
+		tz = e.tz
+	}
+	for _, layout := range []string{types.DateFormat, types.TimeFormat, types.TimeFSPFormat} {
+		if t, err := time.ParseInLocation(layout, s, tz); err == nil {
+			return t, true, nil
+		}
+	}
+	return time.Time{}, false, cerror.ErrParquetEncodeFailed.GenWithStack(
+		"invalid time value %s of column %s", s, col.Name)
+}
+
+// decimalBytes returns the big-endian two's complement bytes of the unscaled
+// value of the decimal, it fails rather than rounds the value if the value
+// doesn't fit the precision and scale.
+func (e *parquetEncoder) decimalBytes(col *model.Column, s string) (string, error) {
+	invalid := func() (string, error) {
+		return "", cerror.ErrParquetEncodeFailed.GenWithStack(
+			"decimal value %s of column %s doesn't fit precision %d and scale %d", s, col.Name, e.precision, e.scale)
+	}
+	digits := strings.TrimSpace(s)
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimLeft(digits, "+-")
+	intPart, fracPart := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		intPart, fracPart = digits[:i], digits[i+1:]
+	}
+	if len(fracPart) > e.scale {
+		if strings.Trim(fracPart[e.scale:], "0") != "" {
+			return invalid()
+		}
+		fracPart = fracPart[:e.scale]
+	}
+	fracPart += strings.Repeat("0", e.scale-len(fracPart))
+	intPart = strings.TrimLeft(intPart, "0")
+	if len(intPart)+e.scale > e.precision {
+		return invalid()
+	}
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		if intPart+fracPart != "" {
+			return "", cerror.ErrParquetEncodeFailed.GenWithStack(
+				"invalid decimal value %s of column %s", s, col.Name)
+		}
+		unscaled = new(big.Int)
+	}
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	return string(twosComplementBytes(unscaled)), nil
+}
+
+// twosComplementBytes returns the minimal big-endian two's complement bytes
+// of the integer.
+func twosComplementBytes(x *big.Int) []byte {
+	if x.Sign() >= 0 {
+		b := x.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// the length is enough to hold the bits of |x|-1 and the sign bit.
+	abs := new(big.Int).Neg(x)
+	n := (new(big.Int).Sub(abs, big.NewInt(1)).BitLen() + 8) / 8
+	v := new(big.Int).Lsh(big.NewInt(1), uint(n*8))
+	return v.Add(v, x).Bytes()
+}
@@ -0,0 +1,177 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdclog
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// readParquetColumns reads all the columns of the parquet file, and returns
+// the names, the schema and the values of the columns.
+func readParquetColumns(t *testing.T, data []byte) ([]string, []*parquet.SchemaElement, [][]interface{}) {
+	f, err := buffer.NewBufferFile(data)
+	require.Nil(t, err)
+	r, err := reader.NewParquetColumnReader(f, 1)
+	require.Nil(t, err)
+	defer r.ReadStop()
+	require.Len(t, r.Footer.RowGroups, 1)
+
+	// the reader renames the schema, so the names in the file are taken from
+	// the schema handler.
+	schemas := r.Footer.Schema[1:]
+	names := make([]string, 0, len(schemas))
+	columns := make([][]interface{}, len(schemas))
+	for i := range schemas {
+		names = append(names, r.SchemaHandler.Infos[i+1].ExName)
+		values, _, _, err := r.ReadColumnByIndex(int64(i), r.GetNumRows())
+		require.Nil(t, err)
+		columns[i] = values
+	}
+	return names, schemas, columns
+}
+
+func TestParquetEncoder(t *testing.T) {
+	t.Parallel()
+
+	tz, err := time.LoadLocation("Asia/Shanghai")
+	require.Nil(t, err)
+	e, err := newParquetEncoder(url.Values{"parquet-decimal-scale": []string{"2"}}, tz)
+	require.Nil(t, err)
+
+	rows := []*model.RowChangedEvent{{
+		CommitTs: 100,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: int64(1)},
+			{Name: "big", Type: mysql.TypeLonglong, Flag: model.UnsignedFlag, Value: uint64(1<<63 + 1)},
+			{Name: "price", Type: mysql.TypeNewDecimal, Value: "-123.4"},
+			{Name: "d", Type: mysql.TypeDate, Value: "1970-01-02"},
+			{Name: "dt", Type: mysql.TypeDatetime, Value: "1970-01-01 00:00:01.5"},
+			{Name: "ts", Type: mysql.TypeTimestamp, Value: "1970-01-01 08:00:01"},
+			{Name: "j", Type: mysql.TypeJSON, Value: `{"a": 1}`},
+			{Name: "s", Type: mysql.TypeVarchar, Value: []byte("abc")},
+			{Name: "b", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0xff, 0}},
+			{Name: "f", Type: mysql.TypeDouble, Value: 1.5},
+			{Name: "e", Type: mysql.TypeEnum, Value: uint64(2)},
+		},
+	}, {
+		CommitTs: 101,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: int64(2)},
+			{Name: "ts", Type: mysql.TypeTimestamp, Value: "0000-00-00 00:00:00"},
+		},
+	}}
+	data, err := e.encode(rows)
+	require.Nil(t, err)
+
+	names, schemas, columns := readParquetColumns(t, data)
+	require.Equal(t, []string{"id", "big", "price", "d", "dt", "ts", "j", "s", "b", "f", "e", "_commit_ts", "_op"}, names)
+	require.Equal(t, parquet.ConvertedType_DECIMAL, schemas[2].GetConvertedType())
+	require.Equal(t, int32(38), schemas[2].GetPrecision())
+	require.Equal(t, int32(2), schemas[2].GetScale())
+	require.Equal(t, parquet.ConvertedType_DATE, schemas[3].GetConvertedType())
+	require.Equal(t, parquet.ConvertedType_TIMESTAMP_MICROS, schemas[5].GetConvertedType())
+	require.Equal(t, parquet.ConvertedType_UTF8, schemas[6].GetConvertedType())
+	require.Nil(t, schemas[8].ConvertedType)
+
+	require.Equal(t, []interface{}{int32(1), int32(2)}, columns[0])
+	require.Equal(t, []interface{}{int64(-1<<63 + 1), nil}, columns[1])
+	// -12340 in two's complement.
+	require.Equal(t, []interface{}{string([]byte{0xcf, 0xcc}), nil}, columns[2])
+	require.Equal(t, []interface{}{int32(1), nil}, columns[3])
+	require.Equal(t, []interface{}{int64(1500000), nil}, columns[4])
+	// the zero timestamp is null.
+	require.Equal(t, []interface{}{int64(1000000), nil}, columns[5])
+	require.Equal(t, []interface{}{`{"a": 1}`, nil}, columns[6])
+	require.Equal(t, []interface{}{"abc", nil}, columns[7])
+	require.Equal(t, []interface{}{string([]byte{0xff, 0}), nil}, columns[8])
+	require.Equal(t, []interface{}{1.5, nil}, columns[9])
+	require.Equal(t, []interface{}{int64(2), nil}, columns[10])
+	require.Equal(t, []interface{}{int64(100), int64(101)}, columns[11])
+	require.Equal(t, []interface{}{"I", "D"}, columns[12])
+}
+
+func TestParquetEncoderDecimal(t *testing.T) {
+	t.Parallel()
+
+	e, err := newParquetEncoder(url.Values{
+		"parquet-decimal-precision": []string{"5"},
+		"parquet-decimal-scale":     []string{"2"},
+	}, nil)
+	require.Nil(t, err)
+	col := &model.Column{Name: "d", Type: mysql.TypeNewDecimal}
+	for _, tc := range []struct {
+		value    string
+		expected int64
+	}{
+		{"0", 0},
+		{"-0.00", 0},
+		{"1.5", 150},
+		{"999.99", 99999},
+		{"-999.99", -99999},
+		{"1.230", 123},
+		{"-1.28", -128},
+		{"1.28", 128},
+	} {
+		b, err := e.decimalBytes(col, tc.value)
+		require.Nil(t, err, tc.value)
+		// decode the two's complement bytes.
+		v := new(big.Int).SetBytes([]byte(b))
+		if b[0]&0x80 != 0 {
+			v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+		}
+		require.Equal(t, tc.expected, v.Int64(), tc.value)
+	}
+	for _, value := range []string{"1000", "1.234", "abc"} {
+		_, err := e.decimalBytes(col, value)
+		require.Regexp(t, ".*ErrParquetEncodeFailed.*", err, value)
+	}
+}
+
+func TestStorageSinkParquet(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	s := newTestStorageSink(t, dir, "protocol=parquet&parquet-compression=zstd", config.GetDefaultReplicaConfig())
+	defer s.Close(ctx)
+
+	require.Nil(t, s.EmitRowChangedEvents(ctx,
+		newTestRow("t1", 1, 100, 110, 1), newTestRow("t1", 1, 100, 120, 2), newTestRow("t1", 1, 100, 130, 3)))
+	_, err := s.FlushRowChangedEvents(ctx, 120)
+	require.Nil(t, err)
+
+	// the rows flushed at the resolved ts are in one file.
+	_, schemas, columns := readParquetColumns(t, []byte(readFile(t, dir, "test/t1/100/CDC00000000000000000120_1.parquet")))
+	require.Len(t, schemas, 4)
+	require.Equal(t, []interface{}{int64(1), int64(2)}, columns[0])
+	require.Equal(t, []interface{}{"a\"b", "a\"b"}, columns[1])
+	require.Equal(t, []interface{}{int64(110), int64(120)}, columns[2])
+
+	_, err = newRowEncoder(StorageProtocolParquet, url.Values{"parquet-compression": []string{"lzo"}}, true, nil)
+	require.Regexp(t, ".*ErrSinkInvalidConfig.*", err)
+}
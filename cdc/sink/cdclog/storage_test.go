@@ -0,0 +1,250 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdclog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/filter"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStorageSink(t *testing.T, dir string, query string, replicaConfig *config.ReplicaConfig) *storageSink {
+	sinkURI, err := url.Parse(fmt.Sprintf("local://%s?%s", dir, query))
+	require.Nil(t, err)
+	f, err := filter.NewFilter(replicaConfig)
+	require.Nil(t, err)
+	s, err := NewStorageSink(context.Background(), sinkURI, f, replicaConfig)
+	require.Nil(t, err)
+	return s
+}
+
+func newTestRow(table string, tableID int64, version, commitTs uint64, id int64) *model.RowChangedEvent {
+	return &model.RowChangedEvent{
+		StartTs:          commitTs - 1,
+		CommitTs:         commitTs,
+		Table:            &model.TableName{Schema: "test", Table: table, TableID: tableID},
+		TableInfoVersion: version,
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: id},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("a\"b")},
+		},
+	}
+}
+
+func readFile(t *testing.T, dir string, name string) string {
+	data, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	require.Nil(t, err)
+	return string(data)
+}
+
+func TestStorageSinkCSV(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	s := newTestStorageSink(t, dir, "protocol=csv", config.GetDefaultReplicaConfig())
+	defer s.Close(ctx)
+
+	ddl := &model.DDLEvent{
+		StartTs:  90,
+		CommitTs: 100,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "test", Table: "t1", TableID: 1,
+			ColumnInfo: []*model.ColumnInfo{{Name: "id", Type: mysql.TypeLonglong}, {Name: "name", Type: mysql.TypeVarchar}},
+		},
+		Query: "create table test.t1(id bigint primary key, name varchar(10))",
+		Type:  timodel.ActionCreateTable,
+	}
+	require.Nil(t, s.EmitDDLEvent(ctx, ddl))
+	require.Equal(t,
+		`{"schema":"test","table":"t1","version":100,"query":"create table test.t1(id bigint primary key, name varchar(10))",`+
+			`"columns":[{"name":"id","type":"bigint"},{"name":"name","type":"varchar"}]}`,
+		readFile(t, dir, "test/t1/100/schema.json"))
+
+	require.Nil(t, s.EmitRowChangedEvents(ctx,
+		newTestRow("t1", 1, 100, 110, 1),
+		newTestRow("t1", 1, 100, 120, 2),
+		newTestRow("t2", 2, 50, 120, 3),
+		newTestRow("t1", 1, 100, 140, 4),
+	))
+	checkpointTs, err := s.FlushRowChangedEvents(ctx, 130)
+	require.Nil(t, err)
+	require.Equal(t, uint64(130), checkpointTs)
+	require.Equal(t,
+		"\"I\",\"t1\",\"test\",110,1,\"a\"\"b\"\n\"I\",\"t1\",\"test\",120,2,\"a\"\"b\"\n",
+		readFile(t, dir, "test/t1/100/CDC00000000000000000130_1.csv"))
+	require.Equal(t,
+		"\"I\",\"t2\",\"test\",120,3,\"a\"\"b\"\n",
+		readFile(t, dir, "test/t2/50/CDC00000000000000000130_2.csv"))
+	// the schema file of a table version without DDL is generated from the rows.
+	require.Equal(t,
+		`{"schema":"test","table":"t2","version":50,"columns":[{"name":"id","type":"bigint"},{"name":"name","type":"varchar"}]}`,
+		readFile(t, dir, "test/t2/50/schema.json"))
+
+	// only the tables with rows to flush write data files.
+	_, err = s.FlushRowChangedEvents(ctx, 150)
+	require.Nil(t, err)
+	require.Equal(t,
+		"\"I\",\"t1\",\"test\",140,4,\"a\"\"b\"\n",
+		readFile(t, dir, "test/t1/100/CDC00000000000000000150_1.csv"))
+	files, err := ioutil.ReadDir(filepath.Join(dir, "test", "t2", "50"))
+	require.Nil(t, err)
+	require.Len(t, files, 2)
+
+	require.Nil(t, s.EmitCheckpointTs(ctx, 150))
+	require.Equal(t, `{"checkpoint-ts":150}`, readFile(t, dir, "metadata"))
+
+	// the schema level DDLs and dropped tables don't have schema files.
+	require.Nil(t, s.EmitDDLEvent(ctx, &model.DDLEvent{
+		CommitTs:  160,
+		TableInfo: &model.SimpleTableInfo{Schema: "test", Table: "t2"},
+		Query:     "drop table test.t2",
+		Type:      timodel.ActionDropTable,
+	}))
+	files, err = ioutil.ReadDir(filepath.Join(dir, "test", "t2"))
+	require.Nil(t, err)
+	require.Len(t, files, 1)
+}
+
+func TestStorageSinkCanalJSON(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.EnableOldValue = true
+	s := newTestStorageSink(t, dir, "protocol=canal-json&enable-tidb-extension=true", replicaConfig)
+	defer s.Close(ctx)
+
+	require.Nil(t, s.EmitRowChangedEvents(ctx, newTestRow("t1", 1, 100, 110, 1), newTestRow("t1", 1, 100, 120, 2)))
+	_, err := s.FlushRowChangedEvents(ctx, 130)
+	require.Nil(t, err)
+
+	scanner := bufio.NewScanner(bytes.NewBufferString(readFile(t, dir, "test/t1/100/CDC00000000000000000130_1.json")))
+	var commitTs []uint64
+	for scanner.Scan() {
+		msg := &struct {
+			Type   string `json:"type"`
+			Table  string `json:"table"`
+			TiDBTs struct {
+				CommitTs uint64 `json:"commit-ts"`
+			} `json:"_tidb"`
+		}{}
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), msg))
+		require.Equal(t, "INSERT", msg.Type)
+		require.Equal(t, "t1", msg.Table)
+		commitTs = append(commitTs, msg.TiDBTs.CommitTs)
+	}
+	require.Equal(t, []uint64{110, 120}, commitTs)
+}
+
+func TestStorageSinkFilter(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Filter = &config.FilterConfig{Rules: []string{"test.t1"}}
+	replicaConfig.Sink.ColumnSelectors = []*config.ColumnSelector{{Matcher: []string{"test.*"}, Columns: []string{"id"}}}
+	s := newTestStorageSink(t, dir, "protocol=csv&csv-quote=&csv-delimiter=|", replicaConfig)
+	defer s.Close(ctx)
+
+	require.Nil(t, s.EmitRowChangedEvents(ctx, newTestRow("t1", 1, 100, 110, 1), newTestRow("t2", 2, 100, 110, 2)))
+	_, err := s.FlushRowChangedEvents(ctx, 130)
+	require.Nil(t, err)
+	require.Equal(t, "I|t1|test|110|1\n", readFile(t, dir, "test/t1/100/CDC00000000000000000130_1.csv"))
+	files, err := ioutil.ReadDir(filepath.Join(dir, "test"))
+	require.Nil(t, err)
+	require.Len(t, files, 1)
+}
+
+func TestNewStorageSinkInvalid(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, query := range []string{
+		"protocol=avro",
+		"protocol=canal-json",
+		"protocol=csv&csv-quote=ab",
+		"protocol=csv&csv-delimiter=a%22b",
+	} {
+		sinkURI, err := url.Parse(fmt.Sprintf("local://%s?%s", dir, query))
+		require.Nil(t, err)
+		replicaConfig := config.GetDefaultReplicaConfig()
+		replicaConfig.EnableOldValue = false
+		f, err := filter.NewFilter(replicaConfig)
+		require.Nil(t, err)
+		_, err = NewStorageSink(context.Background(), sinkURI, f, replicaConfig)
+		require.Regexp(t, ".*ErrSinkInvalidConfig.*", err, query)
+	}
+}
+
+func TestCSVEncoder(t *testing.T) {
+	t.Parallel()
+
+	e, err := newCSVEncoder(url.Values{"csv-null": []string{"NULL"}})
+	require.Nil(t, err)
+	require.Equal(t, ".csv", e.fileExt())
+	table := &model.TableName{Schema: "test", Table: "t"}
+	data, err := e.encode([]*model.RowChangedEvent{
+		{
+			CommitTs: 1,
+			Table:    table,
+			Columns: []*model.Column{
+				{Name: "a", Type: mysql.TypeLonglong, Value: int64(-1)},
+				{Name: "b", Type: mysql.TypeLonglong, Flag: model.UnsignedFlag, Value: uint64(1)},
+				{Name: "c", Type: mysql.TypeDouble, Value: 1.5},
+				{Name: "d", Type: mysql.TypeFloat, Value: float32(2.5)},
+				{Name: "e", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0, 1, 2}},
+				{Name: "f", Type: mysql.TypeNewDecimal, Value: "1.23"},
+				{Name: "g", Type: mysql.TypeVarchar, Value: nil},
+				nil,
+			},
+		},
+		{
+			CommitTs:   2,
+			Table:      table,
+			PreColumns: []*model.Column{{Name: "a", Type: mysql.TypeLonglong, Value: int64(1)}},
+			Columns:    []*model.Column{{Name: "a", Type: mysql.TypeLonglong, Value: int64(2)}},
+		},
+		{
+			CommitTs:   3,
+			Table:      table,
+			PreColumns: []*model.Column{{Name: "a", Type: mysql.TypeLonglong, Value: int64(2)}},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, `"I","t","test",1,-1,1,1.5,2.5,"AAEC","1.23",NULL`+"\n"+
+		`"U","t","test",2,2`+"\n"+
+		`"D","t","test",3,2`+"\n", string(data))
+
+	_, err = e.encode([]*model.RowChangedEvent{{
+		Table:   table,
+		Columns: []*model.Column{{Name: "a", Type: mysql.TypeLonglong, Value: int32(1)}},
+	}})
+	require.Regexp(t, ".*unsupported value type int32.*", err)
+}
@@ -138,6 +138,11 @@ func (a *AvroEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage, er
 	return nil, nil
 }
 
+// EncodeSyncpointEvent is no-op for now
+func (a *AvroEventBatchEncoder) EncodeSyncpointEvent(ts uint64) (*MQMessage, error) {
+	return nil, nil
+}
+
 // EncodeDDLEvent is no-op now
 func (a *AvroEventBatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*MQMessage, error) {
 	return nil, nil
@@ -329,6 +329,12 @@ func (d *CanalEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage, e
 	return nil, nil
 }
 
+// EncodeSyncpointEvent implements the EventBatchEncoder interface
+func (d *CanalEventBatchEncoder) EncodeSyncpointEvent(ts uint64) (*MQMessage, error) {
+	// Canal has no corresponding message type for the syncpoint event either.
+	return nil, nil
+}
+
 // AppendRowChangedEvent implements the EventBatchEncoder interface
 func (d *CanalEventBatchEncoder) AppendRowChangedEvent(e *model.RowChangedEvent) (EncoderResult, error) {
 	entry, err := d.entryBuilder.FromRowEvent(e)
@@ -36,11 +36,14 @@ type CanalFlatEventBatchEncoder struct {
 	unresolvedBuf []canalFlatMessageInterface
 	resolvedBuf   []canalFlatMessageInterface
 	// When it is true, canal-json would generate TiDB extension information
-	// which, at the moment, only includes `tidbWaterMarkType` and `_tidb` fields.
+	// which, at the moment, only includes `tidbWaterMarkType`, `tidbSyncpointType` and `_tidb` fields.
 	enableTiDBExtension bool
 }
 
-const tidbWaterMarkType = "TIDB_WATERMARK"
+const (
+	tidbWaterMarkType = "TIDB_WATERMARK"
+	tidbSyncpointType = "TIDB_SYNCPOINT"
+)
 
 // NewCanalFlatEventBatchEncoder creates a new CanalFlatEventBatchEncoder
 func NewCanalFlatEventBatchEncoder() EventBatchEncoder {
@@ -145,6 +148,7 @@ func (c *canalFlatMessage) getMySQLType() map[string]string {
 type tidbExtension struct {
 	CommitTs    uint64 `json:"commit-ts"`
 	WatermarkTs uint64 `json:"watermark-ts"`
+	SyncpointTs uint64 `json:"syncpoint-ts,omitempty"`
 }
 
 type canalFlatMessageWithTiDBExtension struct {
@@ -316,6 +320,19 @@ func (c *CanalFlatEventBatchEncoder) newFlatMessage4CheckpointEvent(ts uint64) *
 	}
 }
 
+func (c *CanalFlatEventBatchEncoder) newFlatMessage4SyncpointEvent(ts uint64) *canalFlatMessageWithTiDBExtension {
+	return &canalFlatMessageWithTiDBExtension{
+		canalFlatMessage: &canalFlatMessage{
+			ID:            0,
+			IsDDL:         false,
+			EventType:     tidbSyncpointType,
+			ExecutionTime: convertToCanalTs(ts),
+			BuildTime:     time.Now().UnixNano() / int64(time.Millisecond), // converts to milliseconds
+		},
+		Extensions: &tidbExtension{SyncpointTs: ts},
+	}
+}
+
 // EncodeCheckpointEvent implements the EventBatchEncoder interface
 func (c *CanalFlatEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage, error) {
 	if !c.enableTiDBExtension {
@@ -330,6 +347,20 @@ func (c *CanalFlatEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessag
 	return newResolvedMQMessage(ProtocolCanalJSON, nil, value, ts), nil
 }
 
+// EncodeSyncpointEvent implements the EventBatchEncoder interface
+func (c *CanalFlatEventBatchEncoder) EncodeSyncpointEvent(ts uint64) (*MQMessage, error) {
+	if !c.enableTiDBExtension {
+		return nil, nil
+	}
+
+	msg := c.newFlatMessage4SyncpointEvent(ts)
+	value, err := json.Marshal(msg)
+	if err != nil {
+		return nil, cerrors.WrapError(cerrors.ErrCanalEncodeFailed, err)
+	}
+	return newSyncpointMQMessage(ProtocolCanalJSON, nil, value, ts), nil
+}
+
 // AppendRowChangedEvent implements the interface EventBatchEncoder
 func (c *CanalFlatEventBatchEncoder) AppendRowChangedEvent(e *model.RowChangedEvent) (EncoderResult, error) {
 	message, err := c.newFlatMessageForDML(e)
@@ -499,6 +530,23 @@ func (b *CanalFlatEventBatchDecoder) NextResolvedEvent() (uint64, error) {
 	return message.Extensions.WatermarkTs, nil
 }
 
+// NextSyncpointEvent implements the EventBatchDecoder interface
+// `HasNext` should be called before this.
+func (b *CanalFlatEventBatchDecoder) NextSyncpointEvent() (uint64, error) {
+	if b.msg == nil || b.msg.Type != model.MqMessageTypeSyncpoint {
+		return 0, cerrors.ErrCanalDecodeFailed.GenWithStack("not found syncpoint event message")
+	}
+
+	message := &canalFlatMessageWithTiDBExtension{
+		canalFlatMessage: &canalFlatMessage{},
+	}
+	if err := json.Unmarshal(b.msg.Value, message); err != nil {
+		return 0, errors.Trace(err)
+	}
+	b.msg = nil
+	return message.Extensions.SyncpointTs, nil
+}
+
 func canalFlatMessage2RowChangedEvent(flatMessage canalFlatMessageInterface) (*model.RowChangedEvent, error) {
 	result := new(model.RowChangedEvent)
 	result.CommitTs = flatMessage.getCommitTs()
@@ -337,6 +337,34 @@ func (s *canalFlatSuite) TestEncodeCheckpointEvent(c *check.C) {
 	}
 }
 
+func (s *canalFlatSuite) TestEncodeSyncpointEvent(c *check.C) {
+	defer testleak.AfterTest(c)()
+	var syncpoint uint64 = 2333
+	for _, enable := range []bool{false, true} {
+		encoder := &CanalFlatEventBatchEncoder{builder: NewCanalEntryBuilder(), enableTiDBExtension: enable}
+		msg, err := encoder.EncodeSyncpointEvent(syncpoint)
+		c.Assert(err, check.IsNil)
+		if !enable {
+			c.Assert(msg, check.IsNil)
+			continue
+		}
+		c.Assert(msg, check.NotNil)
+
+		rawBytes, err := json.Marshal(msg)
+		c.Assert(err, check.IsNil)
+		decoder := NewCanalFlatEventBatchDecoder(rawBytes, enable)
+		ty, hasNext, err := decoder.HasNext()
+		c.Assert(err, check.IsNil)
+		c.Assert(hasNext, check.IsTrue)
+		c.Assert(ty, check.Equals, model.MqMessageTypeSyncpoint)
+		_, err = decoder.NextResolvedEvent()
+		c.Assert(err, check.ErrorMatches, ".*not found resolved event message.*")
+		consumed, err := decoder.NextSyncpointEvent()
+		c.Assert(err, check.IsNil)
+		c.Assert(consumed, check.Equals, syncpoint)
+	}
+}
+
 var testCaseUpdate = &model.RowChangedEvent{
 	CommitTs: 417318403368288260,
 	Table: &model.TableName{
@@ -41,6 +41,11 @@ func (e *CraftEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage, e
 	return newResolvedMQMessage(ProtocolCraft, nil, craft.NewResolvedEventEncoder(e.allocator, ts).Encode(), ts), nil
 }
 
+// EncodeSyncpointEvent implements the EventBatchEncoder interface
+func (e *CraftEventBatchEncoder) EncodeSyncpointEvent(ts uint64) (*MQMessage, error) {
+	return newSyncpointMQMessage(ProtocolCraft, nil, craft.NewSyncpointEventEncoder(e.allocator, ts).Encode(), ts), nil
+}
+
 func (e *CraftEventBatchEncoder) flush() {
 	headers := e.rowChangedBuffer.GetHeaders()
 	ts := headers.GetTs(0)
@@ -189,6 +194,20 @@ func (b *CraftEventBatchDecoder) NextResolvedEvent() (uint64, error) {
 	return ts, nil
 }
 
+// NextSyncpointEvent implements the EventBatchDecoder interface
+func (b *CraftEventBatchDecoder) NextSyncpointEvent() (uint64, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MqMessageTypeSyncpoint {
+		return 0, cerror.ErrCraftCodecInvalidData.GenWithStack("not found syncpoint event message")
+	}
+	ts := b.headers.GetTs(b.index)
+	b.index++
+	return ts, nil
+}
+
 // NextRowChangedEvent implements the EventBatchDecoder interface
 func (b *CraftEventBatchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 	ty, hasNext, err := b.HasNext()
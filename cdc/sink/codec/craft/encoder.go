@@ -298,9 +298,18 @@ func (e *MessageEncoder) encodeRowChangeEvents(events []rowChangedEvent) *Messag
 
 // NewResolvedEventEncoder creates a new encoder with given allocator and timestamp
 func NewResolvedEventEncoder(allocator *SliceAllocator, ts uint64) *MessageEncoder {
+	return newTsEventEncoder(allocator, ts, model.MqMessageTypeResolved)
+}
+
+// NewSyncpointEventEncoder creates a new encoder with given allocator and timestamp
+func NewSyncpointEventEncoder(allocator *SliceAllocator, ts uint64) *MessageEncoder {
+	return newTsEventEncoder(allocator, ts, model.MqMessageTypeSyncpoint)
+}
+
+func newTsEventEncoder(allocator *SliceAllocator, ts uint64, ty model.MqMessageType) *MessageEncoder {
 	return NewMessageEncoder(allocator).encodeHeaders(&Headers{
 		ts:        allocator.oneUint64Slice(ts),
-		ty:        allocator.oneUint64Slice(uint64(model.MqMessageTypeResolved)),
+		ty:        allocator.oneUint64Slice(uint64(ty)),
 		partition: oneNullInt64Slice,
 		schema:    oneNullStringSlice,
 		table:     oneNullStringSlice,
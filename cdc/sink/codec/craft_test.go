@@ -133,6 +133,28 @@ func (s *craftBatchSuite) testBatchCodec(c *check.C, newEncoder func() EventBatc
 			checkTSDecoder(decoder, cs[i:i+1])
 		}
 	}
+
+	for _, cs := range s.resolvedTsCases {
+		encoder := newEncoder()
+		for _, ts := range cs {
+			msg, err := encoder.EncodeSyncpointEvent(ts)
+			c.Assert(err, check.IsNil)
+			c.Assert(msg, check.NotNil)
+			c.Assert(msg.Type, check.Equals, model.MqMessageTypeSyncpoint)
+			decoder, err := newDecoder(msg.Value)
+			c.Assert(err, check.IsNil)
+			tp, hasNext, err := decoder.HasNext()
+			c.Assert(err, check.IsNil)
+			c.Assert(hasNext, check.IsTrue)
+			c.Assert(tp, check.Equals, model.MqMessageTypeSyncpoint)
+			syncpointTs, err := decoder.NextSyncpointEvent()
+			c.Assert(err, check.IsNil)
+			c.Assert(syncpointTs, check.Equals, ts)
+			_, hasNext, err = decoder.HasNext()
+			c.Assert(err, check.IsNil)
+			c.Assert(hasNext, check.IsFalse)
+		}
+	}
 }
 
 func (s *craftBatchSuite) TestParamsEdgeCases(c *check.C) {
@@ -0,0 +1,894 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/model"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/ticdc/pkg/version"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	parser_types "github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/types"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+const (
+	debeziumConnector = "tidb"
+	// debeziumSourceTypeParam is the schema parameter that carries the MySQL
+	// type of a column, the same as Debezium's `column.propagate.source.type`.
+	debeziumSourceTypeParam = "__debezium.source.column.type"
+
+	debeziumOpCreate = "c"
+	debeziumOpUpdate = "u"
+	debeziumOpDelete = "d"
+)
+
+// Debezium logical types, see https://debezium.io/documentation/reference/connectors/mysql.html#mysql-data-types
+const (
+	debeziumDate           = "io.debezium.time.Date"
+	debeziumMicroTimestamp = "io.debezium.time.MicroTimestamp"
+	debeziumZonedTimestamp = "io.debezium.time.ZonedTimestamp"
+	debeziumMicroTime      = "io.debezium.time.MicroTime"
+	debeziumYear           = "io.debezium.time.Year"
+	debeziumJSON           = "io.debezium.data.Json"
+	debeziumBits           = "io.debezium.data.Bits"
+)
+
+type debeziumEventBatchEncoderBuilder struct {
+	opts map[string]string
+}
+
+// Build a `DebeziumEventBatchEncoder`
+func (b *debeziumEventBatchEncoderBuilder) Build(ctx context.Context) (EventBatchEncoder, error) {
+	encoder := newDebeziumEventBatchEncoder()
+	if err := encoder.SetParams(b.opts); err != nil {
+		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
+	}
+	if tz := util.TimezoneFromCtx(ctx); tz != nil {
+		encoder.tz = tz
+	}
+	return encoder, nil
+}
+
+func newDebeziumEventBatchEncoderBuilder(opts map[string]string) EncoderBuilder {
+	return &debeziumEventBatchEncoderBuilder{opts: opts}
+}
+
+// DebeziumEventBatchEncoder encodes the events into the Debezium envelope, one
+// event per message. Debezium has no resolved event, so checkpoint events are
+// ignored, and DDL events are encoded as Debezium schema change events.
+type DebeziumEventBatchEncoder struct {
+	messages []*MQMessage
+	size     int
+	// When it is true, every message carries a `schema` block describing
+	// the payload, the same as Kafka Connect's JsonConverter with
+	// `schemas.enable=true`.
+	enableSchema bool
+	// tz is used to convert TIMESTAMP columns to UTC.
+	tz *time.Location
+}
+
+// NewDebeziumEventBatchEncoder creates a new DebeziumEventBatchEncoder.
+func NewDebeziumEventBatchEncoder() EventBatchEncoder {
+	return newDebeziumEventBatchEncoder()
+}
+
+func newDebeziumEventBatchEncoder() *DebeziumEventBatchEncoder {
+	return &DebeziumEventBatchEncoder{
+		enableSchema: true,
+		tz:           time.UTC,
+	}
+}
+
+// debeziumSchema is the Kafka Connect schema of a field or a message.
+type debeziumSchema struct {
+	Type       string            `json:"type"`
+	Optional   bool              `json:"optional"`
+	Name       string            `json:"name,omitempty"`
+	Version    int               `json:"version,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+	Field      string            `json:"field,omitempty"`
+	Fields     []*debeziumSchema `json:"fields,omitempty"`
+}
+
+// debeziumMessage is a message with an optional schema block, when the schema
+// is disabled only the payload is encoded.
+type debeziumMessage struct {
+	Schema  *debeziumSchema `json:"schema"`
+	Payload interface{}     `json:"payload"`
+}
+
+type debeziumSource struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	DB        string `json:"db"`
+	Table     string `json:"table,omitempty"`
+	CommitTs  uint64 `json:"commit_ts"`
+}
+
+type debeziumRowPayload struct {
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+	Source *debeziumSource        `json:"source"`
+	Op     string                 `json:"op"`
+	TsMs   int64                  `json:"ts_ms"`
+}
+
+type debeziumTableChange struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type debeziumSchemaChangeKey struct {
+	DatabaseName string `json:"databaseName"`
+}
+
+type debeziumSchemaChangePayload struct {
+	Source       *debeziumSource        `json:"source"`
+	DatabaseName string                 `json:"databaseName"`
+	DDL          string                 `json:"ddl"`
+	TableChanges []*debeziumTableChange `json:"tableChanges"`
+}
+
+var debeziumSourceSchema = &debeziumSchema{
+	Type:  "struct",
+	Name:  "io.debezium.connector.tidb.Source",
+	Field: "source",
+	Fields: []*debeziumSchema{
+		{Type: "string", Field: "version"},
+		{Type: "string", Field: "connector"},
+		{Type: "int64", Field: "ts_ms"},
+		{Type: "string", Optional: true, Field: "snapshot"},
+		{Type: "string", Field: "db"},
+		{Type: "string", Optional: true, Field: "table"},
+		{Type: "int64", Field: "commit_ts"},
+	},
+}
+
+func (d *DebeziumEventBatchEncoder) newSource(commitTs uint64, schema, table string) *debeziumSource {
+	return &debeziumSource{
+		Version:   version.ReleaseVersion,
+		Connector: debeziumConnector,
+		TsMs:      oracle.ExtractPhysical(commitTs),
+		Snapshot:  "false",
+		DB:        schema,
+		Table:     table,
+		CommitTs:  commitTs,
+	}
+}
+
+func (d *DebeziumEventBatchEncoder) encode(schema *debeziumSchema, payload interface{}) ([]byte, error) {
+	var data []byte
+	var err error
+	if d.enableSchema {
+		data, err = json.Marshal(&debeziumMessage{Schema: schema, Payload: payload})
+	} else {
+		data, err = json.Marshal(payload)
+	}
+	return data, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+}
+
+// columnsToDebeziumSchema returns the schema of the `before` or `after` struct.
+func columnsToDebeziumSchema(name, field string, cols []*model.Column) (*debeziumSchema, error) {
+	fields := make([]*debeziumSchema, 0, len(cols))
+	for _, col := range cols {
+		if col == nil {
+			continue
+		}
+		s, err := columnToDebeziumSchema(col)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		fields = append(fields, s)
+	}
+	return &debeziumSchema{
+		Type:     "struct",
+		Optional: true,
+		Name:     name,
+		Field:    field,
+		Fields:   fields,
+	}, nil
+}
+
+// columnToDebeziumSchema maps the MySQL type of the column to the Kafka Connect
+// type that Debezium's MySQL connector uses with the default settings, except
+// that decimals are encoded as strings (`decimal.handling.mode=string`).
+func columnToDebeziumSchema(col *model.Column) (*debeziumSchema, error) {
+	sourceType := strings.ToUpper(parser_types.TypeStr(col.Type))
+	if col.Flag.IsUnsigned() {
+		sourceType += " UNSIGNED"
+	}
+	s := &debeziumSchema{
+		Optional:   !col.Flag.IsHandleKey(),
+		Parameters: map[string]string{debeziumSourceTypeParam: sourceType},
+		Field:      col.Name,
+	}
+	switch col.Type {
+	case mysql.TypeTiny, mysql.TypeShort:
+		s.Type = "int16"
+	case mysql.TypeInt24:
+		s.Type = "int32"
+	case mysql.TypeLong:
+		s.Type = "int32"
+		if col.Flag.IsUnsigned() {
+			s.Type = "int64"
+		}
+	case mysql.TypeLonglong, mysql.TypeEnum, mysql.TypeSet:
+		// Enum and set are encoded as their numeric values, as the
+		// elements are not available in the row changed event.
+		s.Type = "int64"
+	case mysql.TypeYear:
+		s.Type, s.Name, s.Version = "int32", debeziumYear, 1
+	case mysql.TypeFloat:
+		s.Type = "float32"
+	case mysql.TypeDouble:
+		s.Type = "float64"
+	case mysql.TypeNewDecimal, mysql.TypeNull:
+		s.Type = "string"
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		s.Type = "string"
+		if col.Flag.IsBinary() {
+			s.Type = "bytes"
+		}
+	case mysql.TypeDate:
+		s.Type, s.Name, s.Version = "int32", debeziumDate, 1
+	case mysql.TypeDatetime:
+		s.Type, s.Name, s.Version = "int64", debeziumMicroTimestamp, 1
+	case mysql.TypeTimestamp:
+		s.Type, s.Name, s.Version = "string", debeziumZonedTimestamp, 1
+	case mysql.TypeDuration:
+		s.Type, s.Name, s.Version = "int64", debeziumMicroTime, 1
+	case mysql.TypeJSON:
+		s.Type, s.Name, s.Version = "string", debeziumJSON, 1
+	case mysql.TypeBit:
+		s.Type, s.Name, s.Version = "bytes", debeziumBits, 1
+	default:
+		return nil, cerror.ErrDebeziumEncodeFailed.GenWithStack(
+			"unsupported type %d of column %s", col.Type, col.Name)
+	}
+	return s, nil
+}
+
+func (d *DebeziumEventBatchEncoder) columnsToDebeziumData(cols []*model.Column) (map[string]interface{}, error) {
+	if len(cols) == 0 {
+		return nil, nil
+	}
+	data := make(map[string]interface{}, len(cols))
+	for _, col := range cols {
+		if col == nil {
+			continue
+		}
+		value, err := d.columnToDebeziumValue(col)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		data[col.Name] = value
+	}
+	return data, nil
+}
+
+func (d *DebeziumEventBatchEncoder) columnToDebeziumValue(col *model.Column) (interface{}, error) {
+	if col.Value == nil {
+		return nil, nil
+	}
+	switch col.Type {
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		var b []byte
+		switch v := col.Value.(type) {
+		case []byte:
+			b = v
+		case string:
+			b = []byte(v)
+		default:
+			b = []byte(model.ColumnValueString(v))
+		}
+		if col.Flag.IsBinary() {
+			// encoded in base64 by json.Marshal
+			return b, nil
+		}
+		return string(b), nil
+	case mysql.TypeNewDecimal, mysql.TypeJSON:
+		return model.ColumnValueString(col.Value), nil
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		str := model.ColumnValueString(col.Value)
+		// Zero dates are not representable, Debezium emits null for them.
+		if str == zeroDateStr || str == zeroTimeStr {
+			return nil, nil
+		}
+		loc := time.UTC
+		if col.Type == mysql.TypeTimestamp {
+			loc = d.tz
+		}
+		t, err := time.ParseInLocation(types.TimeFormat, str, loc)
+		if err != nil {
+			t, err = time.ParseInLocation(types.DateFormat, str, loc)
+		}
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+		}
+		switch col.Type {
+		case mysql.TypeDate:
+			return t.Unix() / (24 * 60 * 60), nil
+		case mysql.TypeDatetime:
+			return t.UnixNano() / int64(time.Microsecond), nil
+		default:
+			return t.UTC().Format(time.RFC3339Nano), nil
+		}
+	case mysql.TypeDuration:
+		dur, err := types.ParseDuration(nil, model.ColumnValueString(col.Value), types.MaxFsp)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+		}
+		return dur.Duration.Microseconds(), nil
+	case mysql.TypeBit:
+		var v uint64
+		switch val := col.Value.(type) {
+		case uint64:
+			v = val
+		case int64:
+			v = uint64(val)
+		default:
+			return nil, cerror.ErrDebeziumEncodeFailed.GenWithStack(
+				"unexpected value %v of bit column %s", col.Value, col.Name)
+		}
+		// io.debezium.data.Bits is a little-endian byte array
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, v)
+		return b, nil
+	default:
+		return col.Value, nil
+	}
+}
+
+func (d *DebeziumEventBatchEncoder) newRowMessage(e *model.RowChangedEvent) (key, value []byte, err error) {
+	payload := &debeziumRowPayload{
+		Source: d.newSource(e.CommitTs, e.Table.Schema, e.Table.Table),
+		TsMs:   time.Now().UnixNano() / int64(time.Millisecond),
+	}
+	switch {
+	case e.IsDelete():
+		payload.Op = debeziumOpDelete
+	case len(e.PreColumns) == 0:
+		payload.Op = debeziumOpCreate
+	default:
+		payload.Op = debeziumOpUpdate
+	}
+	if payload.Before, err = d.columnsToDebeziumData(e.PreColumns); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if payload.After, err = d.columnsToDebeziumData(e.Columns); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	cols := e.Columns
+	if len(cols) == 0 {
+		cols = e.PreColumns
+	}
+	name := e.Table.Schema + "." + e.Table.Table
+	var valueSchema, keySchema *debeziumSchema
+	if d.enableSchema {
+		rowSchema, err := columnsToDebeziumSchema(name+".Value", "", cols)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		before, after := *rowSchema, *rowSchema
+		before.Field, after.Field = "before", "after"
+		valueSchema = &debeziumSchema{
+			Type: "struct",
+			Name: name + ".Envelope",
+			Fields: []*debeziumSchema{
+				&before, &after, debeziumSourceSchema,
+				{Type: "string", Field: "op"},
+				{Type: "int64", Optional: true, Field: "ts_ms"},
+			},
+		}
+	}
+	if value, err = d.encode(valueSchema, payload); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	// The key is the handle key of the row, as Debezium uses the primary key.
+	var handleCols []*model.Column
+	for _, col := range cols {
+		if col != nil && col.Flag.IsHandleKey() {
+			handleCols = append(handleCols, col)
+		}
+	}
+	if len(handleCols) == 0 {
+		return nil, value, nil
+	}
+	keyPayload, err := d.columnsToDebeziumData(handleCols)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if d.enableSchema {
+		keySchema, err = columnsToDebeziumSchema(name+".Key", "", handleCols)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		keySchema.Optional = false
+	}
+	if key, err = d.encode(keySchema, keyPayload); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return key, value, nil
+}
+
+// EncodeCheckpointEvent implements the EventBatchEncoder interface
+func (d *DebeziumEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage, error) {
+	// Debezium has no corresponding message type for the checkpoint event,
+	// so the event is ignored.
+	return nil, nil
+}
+
+// EncodeSyncpointEvent implements the EventBatchEncoder interface
+func (d *DebeziumEventBatchEncoder) EncodeSyncpointEvent(ts uint64) (*MQMessage, error) {
+	// Debezium has no corresponding message type for the syncpoint event either.
+	return nil, nil
+}
+
+// AppendRowChangedEvent implements the EventBatchEncoder interface
+func (d *DebeziumEventBatchEncoder) AppendRowChangedEvent(e *model.RowChangedEvent) (EncoderResult, error) {
+	key, value, err := d.newRowMessage(e)
+	if err != nil {
+		return EncoderNoOperation, errors.Trace(err)
+	}
+	msg := NewMQMessage(ProtocolDebezium, key, value, e.CommitTs, model.MqMessageTypeRow, &e.Table.Schema, &e.Table.Table)
+	d.messages = append(d.messages, msg)
+	d.size += len(key) + len(value)
+	return EncoderNoOperation, nil
+}
+
+// AppendResolvedEvent implements the EventBatchEncoder interface
+func (d *DebeziumEventBatchEncoder) AppendResolvedEvent(ts uint64) (EncoderResult, error) {
+	if len(d.messages) == 0 {
+		return EncoderNoOperation, nil
+	}
+	return EncoderNeedAsyncWrite, nil
+}
+
+// EncodeDDLEvent implements the EventBatchEncoder interface.
+// The DDL is encoded as a Debezium schema change event, which is sent to the
+// schema change topic by the MQ sink.
+func (d *DebeziumEventBatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*MQMessage, error) {
+	payload := &debeziumSchemaChangePayload{
+		Source:       d.newSource(e.CommitTs, e.TableInfo.Schema, e.TableInfo.Table),
+		DatabaseName: e.TableInfo.Schema,
+		DDL:          e.Query,
+		TableChanges: make([]*debeziumTableChange, 0, 1),
+	}
+	if e.TableInfo.Table != "" {
+		payload.TableChanges = append(payload.TableChanges, &debeziumTableChange{
+			Type: ddlToDebeziumTableChangeType(e.Type),
+			ID:   quoteDebeziumTableID(e.TableInfo.Schema, e.TableInfo.Table),
+		})
+	}
+
+	var keySchema, valueSchema *debeziumSchema
+	if d.enableSchema {
+		keySchema = &debeziumSchema{
+			Type:   "struct",
+			Name:   "io.debezium.connector.tidb.SchemaChangeKey",
+			Fields: []*debeziumSchema{{Type: "string", Field: "databaseName"}},
+		}
+		valueSchema = &debeziumSchema{
+			Type: "struct",
+			Name: "io.debezium.connector.tidb.SchemaChangeValue",
+			Fields: []*debeziumSchema{
+				debeziumSourceSchema,
+				{Type: "string", Field: "databaseName"},
+				{Type: "string", Field: "ddl"},
+				{Type: "array", Field: "tableChanges"},
+			},
+		}
+	}
+	key, err := d.encode(keySchema, &debeziumSchemaChangeKey{DatabaseName: e.TableInfo.Schema})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	value, err := d.encode(valueSchema, payload)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newDDLMQMessage(ProtocolDebezium, key, value, e), nil
+}
+
+func ddlToDebeziumTableChangeType(tp timodel.ActionType) string {
+	switch tp {
+	case timodel.ActionCreateTable, timodel.ActionCreateView:
+		return "CREATE"
+	case timodel.ActionDropTable, timodel.ActionDropView:
+		return "DROP"
+	default:
+		return "ALTER"
+	}
+}
+
+func quoteDebeziumTableID(schema, table string) string {
+	return strconv.Quote(schema) + "." + strconv.Quote(table)
+}
+
+// Build implements the EventBatchEncoder interface
+func (d *DebeziumEventBatchEncoder) Build() []*MQMessage {
+	if len(d.messages) == 0 {
+		return nil
+	}
+	ret := d.messages
+	d.Reset()
+	return ret
+}
+
+// MixedBuild is not used here
+func (d *DebeziumEventBatchEncoder) MixedBuild(withVersion bool) []byte {
+	panic("MixedBuild not supported by DebeziumEventBatchEncoder")
+}
+
+// Size implements the EventBatchEncoder interface
+func (d *DebeziumEventBatchEncoder) Size() int {
+	return d.size
+}
+
+// Reset implements the EventBatchEncoder interface
+func (d *DebeziumEventBatchEncoder) Reset() {
+	d.messages = nil
+	d.size = 0
+}
+
+// SetParams reads the `enable-debezium-schema` param, which is true by default.
+func (d *DebeziumEventBatchEncoder) SetParams(params map[string]string) error {
+	if s, ok := params["enable-debezium-schema"]; ok {
+		a, err := strconv.ParseBool(s)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+		}
+		d.enableSchema = a
+	}
+	return nil
+}
+
+// DebeziumEventBatchDecoder decodes a Debezium message into the original event.
+// This decoder is only used for testing now.
+type DebeziumEventBatchDecoder struct {
+	key   []byte
+	value []byte
+	tp    model.MqMessageType
+}
+
+// NewDebeziumEventBatchDecoder creates a new DebeziumEventBatchDecoder.
+func NewDebeziumEventBatchDecoder(key []byte, value []byte) EventBatchDecoder {
+	return &DebeziumEventBatchDecoder{key: key, value: value}
+}
+
+// debeziumRawMessage is the message with an optional schema block, the payload
+// is the whole message if there is no schema block.
+type debeziumRawMessage struct {
+	Schema  *debeziumSchema `json:"schema"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+func decodeDebeziumMessage(data []byte) (*debeziumSchema, json.RawMessage, error) {
+	msg := &debeziumRawMessage{}
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	if msg.Schema == nil && msg.Payload == nil {
+		return nil, data, nil
+	}
+	return msg.Schema, msg.Payload, nil
+}
+
+// HasNext implements the EventBatchDecoder interface
+func (b *DebeziumEventBatchDecoder) HasNext() (model.MqMessageType, bool, error) {
+	if b.value == nil {
+		return model.MqMessageTypeUnknown, false, nil
+	}
+	if b.tp != model.MqMessageTypeUnknown {
+		return b.tp, true, nil
+	}
+	_, payload, err := decodeDebeziumMessage(b.value)
+	if err != nil {
+		return model.MqMessageTypeUnknown, false, errors.Trace(err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return model.MqMessageTypeUnknown, false, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	switch {
+	case fields["ddl"] != nil:
+		b.tp = model.MqMessageTypeDDL
+	case fields["op"] != nil:
+		b.tp = model.MqMessageTypeRow
+	default:
+		return model.MqMessageTypeUnknown, false, cerror.ErrDebeziumDecodeFailed.GenWithStack(
+			"unknown debezium message %s", payload)
+	}
+	return b.tp, true, nil
+}
+
+// NextResolvedEvent implements the EventBatchDecoder interface
+func (b *DebeziumEventBatchDecoder) NextResolvedEvent() (uint64, error) {
+	return 0, cerror.ErrDebeziumDecodeFailed.GenWithStack("debezium has no resolved event")
+}
+
+// NextSyncpointEvent implements the EventBatchDecoder interface
+func (b *DebeziumEventBatchDecoder) NextSyncpointEvent() (uint64, error) {
+	return 0, cerror.ErrDebeziumDecodeFailed.GenWithStack("debezium has no syncpoint event")
+}
+
+// NextRowChangedEvent implements the EventBatchDecoder interface
+// `HasNext` should be called before this.
+func (b *DebeziumEventBatchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	if b.tp != model.MqMessageTypeRow {
+		return nil, cerror.ErrDebeziumDecodeFailed.GenWithStack("not found row changed event message")
+	}
+	schema, payload, err := decodeDebeziumMessage(b.value)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	row := &debeziumRawRowPayload{}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(row); err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	if row.Source == nil {
+		return nil, cerror.ErrDebeziumDecodeFailed.GenWithStack("source is missing")
+	}
+
+	var fields []*debeziumSchema
+	if schema != nil {
+		for _, f := range schema.Fields {
+			if f.Field == "after" || f.Field == "before" {
+				fields = f.Fields
+				break
+			}
+		}
+	}
+	handleKeys, err := decodeDebeziumKey(b.key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	e := &model.RowChangedEvent{
+		StartTs:  row.Source.CommitTs,
+		CommitTs: row.Source.CommitTs,
+		Table:    &model.TableName{Schema: row.Source.DB, Table: row.Source.Table},
+	}
+	if e.Columns, err = debeziumDataToColumns(row.After, fields, handleKeys); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if e.PreColumns, err = debeziumDataToColumns(row.Before, fields, handleKeys); err != nil {
+		return nil, errors.Trace(err)
+	}
+	b.value, b.tp = nil, model.MqMessageTypeUnknown
+	return e, nil
+}
+
+// NextDDLEvent implements the EventBatchDecoder interface
+// `HasNext` should be called before this.
+func (b *DebeziumEventBatchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
+	if b.tp != model.MqMessageTypeDDL {
+		return nil, cerror.ErrDebeziumDecodeFailed.GenWithStack("not found ddl event message")
+	}
+	_, payload, err := decodeDebeziumMessage(b.value)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	change := &debeziumSchemaChangePayload{}
+	if err := json.Unmarshal(payload, change); err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	if change.Source == nil {
+		return nil, cerror.ErrDebeziumDecodeFailed.GenWithStack("source is missing")
+	}
+	b.value, b.tp = nil, model.MqMessageTypeUnknown
+	return &model.DDLEvent{
+		StartTs:  change.Source.CommitTs,
+		CommitTs: change.Source.CommitTs,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: change.Source.DB,
+			Table:  change.Source.Table,
+		},
+		Query: change.DDL,
+	}, nil
+}
+
+type debeziumRawRowPayload struct {
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+	Source *debeziumSource        `json:"source"`
+	Op     string                 `json:"op"`
+}
+
+// decodeDebeziumKey returns the names of the handle key columns.
+func decodeDebeziumKey(key []byte) (map[string]struct{}, error) {
+	if len(key) == 0 {
+		return nil, nil
+	}
+	_, payload, err := decodeDebeziumMessage(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	handleKeys := make(map[string]struct{}, len(data))
+	for name := range data {
+		handleKeys[name] = struct{}{}
+	}
+	return handleKeys, nil
+}
+
+// debeziumDataToColumns converts the `before` or `after` struct to columns.
+// The column types are restored from the schema block, columns are ordered
+// by the schema if there is one, otherwise by their names.
+func debeziumDataToColumns(
+	data map[string]interface{}, fields []*debeziumSchema, handleKeys map[string]struct{},
+) ([]*model.Column, error) {
+	if data == nil {
+		return nil, nil
+	}
+	if fields == nil {
+		names := make([]string, 0, len(data))
+		for name := range data {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fields = make([]*debeziumSchema, 0, len(names))
+		for _, name := range names {
+			fields = append(fields, &debeziumSchema{Field: name})
+		}
+	}
+	cols := make([]*model.Column, 0, len(fields))
+	for _, field := range fields {
+		value, ok := data[field.Field]
+		if !ok {
+			continue
+		}
+		col, err := debeziumValueToColumn(field, value)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if _, ok := handleKeys[col.Name]; ok {
+			col.Flag.SetIsHandleKey()
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+func debeziumValueToColumn(field *debeziumSchema, value interface{}) (*model.Column, error) {
+	col := &model.Column{Name: field.Field}
+	sourceType := strings.ToLower(field.Parameters[debeziumSourceTypeParam])
+	if strings.HasSuffix(sourceType, " unsigned") {
+		sourceType = strings.TrimSuffix(sourceType, " unsigned")
+		col.Flag.SetIsUnsigned()
+	}
+	if sourceType == "" {
+		// Without the schema, the type is guessed from the JSON value.
+		switch v := value.(type) {
+		case json.Number:
+			if _, err := v.Int64(); err == nil {
+				col.Type = mysql.TypeLonglong
+			} else {
+				col.Type = mysql.TypeDouble
+			}
+		case string:
+			col.Type = mysql.TypeVarchar
+		default:
+			col.Type = mysql.TypeNull
+		}
+	} else {
+		col.Type = parser_types.StrToType(sourceType)
+	}
+	if field.Type == "bytes" && col.Type != mysql.TypeBit {
+		col.Flag.SetIsBinary()
+	}
+	if value == nil {
+		return col, nil
+	}
+
+	var err error
+	col.Value, err = debeziumValueToColumnValue(col, value)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	return col, nil
+}
+
+func debeziumValueToColumnValue(col *model.Column, value interface{}) (interface{}, error) {
+	str := fmt.Sprint(value)
+	switch col.Type {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong,
+		mysql.TypeYear, mysql.TypeEnum, mysql.TypeSet:
+		if col.Flag.IsUnsigned() || col.Type == mysql.TypeEnum || col.Type == mysql.TypeSet {
+			return strconv.ParseUint(str, 10, 64)
+		}
+		return strconv.ParseInt(str, 10, 64)
+	case mysql.TypeFloat, mysql.TypeDouble:
+		return strconv.ParseFloat(str, 64)
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if col.Flag.IsBinary() {
+			return base64.StdEncoding.DecodeString(str)
+		}
+		return []byte(str), nil
+	case mysql.TypeDate:
+		days, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return time.Unix(days*24*60*60, 0).UTC().Format(types.DateFormat), nil
+	case mysql.TypeDatetime:
+		micros, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return formatDebeziumTime(time.Unix(0, micros*int64(time.Microsecond)).UTC()), nil
+	case mysql.TypeTimestamp:
+		t, err := time.Parse(time.RFC3339Nano, str)
+		if err != nil {
+			return nil, err
+		}
+		return formatDebeziumTime(t.UTC()), nil
+	case mysql.TypeDuration:
+		micros, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		dur := types.Duration{Duration: time.Duration(micros) * time.Microsecond}
+		if micros%int64(time.Second/time.Microsecond) != 0 {
+			dur.Fsp = types.MaxFsp
+		}
+		return dur.String(), nil
+	case mysql.TypeBit:
+		b, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, err
+		}
+		var buf [8]byte
+		copy(buf[:], b)
+		return binary.LittleEndian.Uint64(buf[:]), nil
+	default:
+		return str, nil
+	}
+}
+
+// formatDebeziumTime formats the time in the same way as the mounter does,
+// the fractional part is only kept when it's not zero.
+func formatDebeziumTime(t time.Time) string {
+	if t.Nanosecond() == 0 {
+		return t.Format(types.TimeFormat)
+	}
+	return t.Format(types.TimeFSPFormat)
+}
@@ -0,0 +1,248 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/check"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/ticdc/pkg/util/testleak"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+)
+
+type debeziumSuite struct{}
+
+var _ = check.Suite(&debeziumSuite{})
+
+func newDebeziumTestColumns(id int64, name string) []*model.Column {
+	return []*model.Column{
+		{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: id},
+		{Name: "tiny", Type: mysql.TypeTiny, Flag: model.UnsignedFlag, Value: uint64(255)},
+		{Name: "big", Type: mysql.TypeLonglong, Value: int64(-1)},
+		{Name: "float", Type: mysql.TypeFloat, Value: float64(1.5)},
+		{Name: "double", Type: mysql.TypeDouble, Value: float64(2.25)},
+		{Name: "decimal", Type: mysql.TypeNewDecimal, Value: "12.3400"},
+		{Name: "name", Type: mysql.TypeVarchar, Value: []byte(name)},
+		{Name: "blob", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0, 1, 2, 0xff}},
+		{Name: "date", Type: mysql.TypeDate, Value: "2021-01-02"},
+		{Name: "datetime", Type: mysql.TypeDatetime, Value: "2021-01-02 03:04:05.123456"},
+		{Name: "timestamp", Type: mysql.TypeTimestamp, Value: "2021-01-02 03:04:05"},
+		{Name: "time", Type: mysql.TypeDuration, Value: "-838:59:59"},
+		{Name: "year", Type: mysql.TypeYear, Value: int64(2021)},
+		{Name: "enum", Type: mysql.TypeEnum, Value: uint64(2)},
+		{Name: "set", Type: mysql.TypeSet, Value: uint64(3)},
+		{Name: "bit", Type: mysql.TypeBit, Value: uint64(5)},
+		{Name: "json", Type: mysql.TypeJSON, Value: `{"a": 1}`},
+		{Name: "null", Type: mysql.TypeVarchar, Value: nil},
+	}
+}
+
+func (s *debeziumSuite) TestRowRoundTrip(c *check.C) {
+	defer testleak.AfterTest(c)()
+	table := &model.TableName{Schema: "test", Table: "t1"}
+	events := []*model.RowChangedEvent{
+		{CommitTs: 424316552636792833, Table: table, Columns: newDebeziumTestColumns(1, "Alice")},
+		{
+			CommitTs:   424316552636792834,
+			Table:      table,
+			PreColumns: newDebeziumTestColumns(1, "Alice"),
+			Columns:    newDebeziumTestColumns(1, "Bob"),
+		},
+		{CommitTs: 424316552636792835, Table: table, PreColumns: newDebeziumTestColumns(1, "Bob")},
+	}
+	expectedOps := []string{debeziumOpCreate, debeziumOpUpdate, debeziumOpDelete}
+
+	encoder := NewDebeziumEventBatchEncoder()
+	for _, e := range events {
+		op, err := encoder.AppendRowChangedEvent(e)
+		c.Assert(err, check.IsNil)
+		c.Assert(op, check.Equals, EncoderNoOperation)
+	}
+	c.Assert(encoder.Size(), check.Greater, 0)
+	op, err := encoder.AppendResolvedEvent(424316552636792835)
+	c.Assert(err, check.IsNil)
+	c.Assert(op, check.Equals, EncoderNeedAsyncWrite)
+
+	messages := encoder.Build()
+	c.Assert(messages, check.HasLen, len(events))
+	c.Assert(encoder.Size(), check.Equals, 0)
+	c.Assert(encoder.Build(), check.IsNil)
+
+	for i, msg := range messages {
+		c.Assert(msg.Ts, check.Equals, events[i].CommitTs)
+		c.Assert(msg.Protocol, check.Equals, ProtocolDebezium)
+
+		var value struct {
+			Schema  *debeziumSchema     `json:"schema"`
+			Payload *debeziumRowPayload `json:"payload"`
+		}
+		c.Assert(json.Unmarshal(msg.Value, &value), check.IsNil)
+		c.Assert(value.Schema, check.NotNil)
+		c.Assert(value.Payload.Op, check.Equals, expectedOps[i])
+		c.Assert(value.Payload.Source.DB, check.Equals, "test")
+		c.Assert(value.Payload.Source.Table, check.Equals, "t1")
+		c.Assert(value.Payload.Source.CommitTs, check.Equals, events[i].CommitTs)
+
+		decoder := NewDebeziumEventBatchDecoder(msg.Key, msg.Value)
+		tp, hasNext, err := decoder.HasNext()
+		c.Assert(err, check.IsNil)
+		c.Assert(hasNext, check.IsTrue)
+		c.Assert(tp, check.Equals, model.MqMessageTypeRow)
+		row, err := decoder.NextRowChangedEvent()
+		c.Assert(err, check.IsNil)
+		c.Assert(row.CommitTs, check.Equals, events[i].CommitTs)
+		c.Assert(row.Table, check.DeepEquals, table)
+		c.Assert(row.Columns, check.DeepEquals, events[i].Columns)
+		c.Assert(row.PreColumns, check.DeepEquals, events[i].PreColumns)
+
+		_, hasNext, err = decoder.HasNext()
+		c.Assert(err, check.IsNil)
+		c.Assert(hasNext, check.IsFalse)
+	}
+}
+
+func (s *debeziumSuite) TestColumnSchema(c *check.C) {
+	defer testleak.AfterTest(c)()
+	expected := map[string][2]string{
+		"id":        {"int32", ""},
+		"tiny":      {"int16", ""},
+		"big":       {"int64", ""},
+		"float":     {"float32", ""},
+		"double":    {"float64", ""},
+		"decimal":   {"string", ""},
+		"name":      {"string", ""},
+		"blob":      {"bytes", ""},
+		"date":      {"int32", debeziumDate},
+		"datetime":  {"int64", debeziumMicroTimestamp},
+		"timestamp": {"string", debeziumZonedTimestamp},
+		"time":      {"int64", debeziumMicroTime},
+		"year":      {"int32", debeziumYear},
+		"enum":      {"int64", ""},
+		"set":       {"int64", ""},
+		"bit":       {"bytes", debeziumBits},
+		"json":      {"string", debeziumJSON},
+		"null":      {"string", ""},
+	}
+	schema, err := columnsToDebeziumSchema("test.t1.Value", "after", newDebeziumTestColumns(1, "Alice"))
+	c.Assert(err, check.IsNil)
+	c.Assert(schema.Fields, check.HasLen, len(expected))
+	for _, field := range schema.Fields {
+		c.Assert([2]string{field.Type, field.Name}, check.Equals, expected[field.Field], check.Commentf("%s", field.Field))
+		c.Assert(field.Optional, check.Equals, field.Field != "id")
+	}
+	c.Assert(schema.Fields[1].Parameters[debeziumSourceTypeParam], check.Equals, "TINYINT UNSIGNED")
+
+	_, err = columnToDebeziumSchema(&model.Column{Name: "geo", Type: mysql.TypeGeometry})
+	c.Assert(err, check.ErrorMatches, ".*unsupported type.*")
+}
+
+func (s *debeziumSuite) TestWithoutSchema(c *check.C) {
+	defer testleak.AfterTest(c)()
+	builder := newDebeziumEventBatchEncoderBuilder(map[string]string{"enable-debezium-schema": "false"})
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	c.Assert(err, check.IsNil)
+	encoder, err := builder.Build(util.PutTimezoneInCtx(context.Background(), shanghai))
+	c.Assert(err, check.IsNil)
+
+	event := &model.RowChangedEvent{
+		CommitTs: 424316552636792833,
+		Table:    &model.TableName{Schema: "test", Table: "t1"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: int64(1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("Alice")},
+			{Name: "timestamp", Type: mysql.TypeTimestamp, Value: "2021-01-02 03:04:05"},
+		},
+	}
+	_, err = encoder.AppendRowChangedEvent(event)
+	c.Assert(err, check.IsNil)
+	messages := encoder.Build()
+	c.Assert(messages, check.HasLen, 1)
+	c.Assert(string(messages[0].Key), check.Equals, `{"id":1}`)
+
+	var payload map[string]interface{}
+	c.Assert(json.Unmarshal(messages[0].Value, &payload), check.IsNil)
+	c.Assert(payload["schema"], check.IsNil)
+	c.Assert(payload["op"], check.Equals, debeziumOpCreate)
+	c.Assert(payload["after"], check.DeepEquals, map[string]interface{}{
+		"id":        float64(1),
+		"name":      "Alice",
+		"timestamp": "2021-01-01T19:04:05Z",
+	})
+
+	// Without the schema, the column types are guessed from the values.
+	decoder := NewDebeziumEventBatchDecoder(messages[0].Key, messages[0].Value)
+	tp, hasNext, err := decoder.HasNext()
+	c.Assert(err, check.IsNil)
+	c.Assert(hasNext, check.IsTrue)
+	c.Assert(tp, check.Equals, model.MqMessageTypeRow)
+	row, err := decoder.NextRowChangedEvent()
+	c.Assert(err, check.IsNil)
+	c.Assert(row.PreColumns, check.IsNil)
+	c.Assert(row.Columns, check.DeepEquals, []*model.Column{
+		{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag, Value: int64(1)},
+		{Name: "name", Type: mysql.TypeVarchar, Value: []byte("Alice")},
+		{Name: "timestamp", Type: mysql.TypeVarchar, Value: []byte("2021-01-01T19:04:05Z")},
+	})
+}
+
+func (s *debeziumSuite) TestDDLAndCheckpoint(c *check.C) {
+	defer testleak.AfterTest(c)()
+	for _, enableSchema := range []bool{true, false} {
+		encoder := newDebeziumEventBatchEncoder()
+		encoder.enableSchema = enableSchema
+
+		msg, err := encoder.EncodeCheckpointEvent(424316552636792833)
+		c.Assert(err, check.IsNil)
+		c.Assert(msg, check.IsNil)
+		op, err := encoder.AppendResolvedEvent(424316552636792833)
+		c.Assert(err, check.IsNil)
+		c.Assert(op, check.Equals, EncoderNoOperation)
+
+		ddl := &model.DDLEvent{
+			StartTs:   424316552636792832,
+			CommitTs:  424316552636792833,
+			TableInfo: &model.SimpleTableInfo{Schema: "test", Table: "t1"},
+			Query:     "create table t1(id int primary key)",
+			Type:      timodel.ActionCreateTable,
+		}
+		msg, err = encoder.EncodeDDLEvent(ddl)
+		c.Assert(err, check.IsNil)
+		c.Assert(msg.Type, check.Equals, model.MqMessageTypeDDL)
+
+		_, payload, err := decodeDebeziumMessage(msg.Value)
+		c.Assert(err, check.IsNil)
+		change := &debeziumSchemaChangePayload{}
+		c.Assert(json.Unmarshal(payload, change), check.IsNil)
+		c.Assert(change.DatabaseName, check.Equals, "test")
+		c.Assert(change.TableChanges, check.DeepEquals, []*debeziumTableChange{{Type: "CREATE", ID: `"test"."t1"`}})
+
+		decoder := NewDebeziumEventBatchDecoder(msg.Key, msg.Value)
+		tp, hasNext, err := decoder.HasNext()
+		c.Assert(err, check.IsNil)
+		c.Assert(hasNext, check.IsTrue)
+		c.Assert(tp, check.Equals, model.MqMessageTypeDDL)
+		_, err = decoder.NextRowChangedEvent()
+		c.Assert(err, check.NotNil)
+		decoded, err := decoder.NextDDLEvent()
+		c.Assert(err, check.IsNil)
+		c.Assert(decoded.CommitTs, check.Equals, ddl.CommitTs)
+		c.Assert(decoded.TableInfo, check.DeepEquals, ddl.TableInfo)
+		c.Assert(decoded.Query, check.Equals, ddl.Query)
+	}
+}
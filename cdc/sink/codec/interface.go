@@ -31,6 +31,12 @@ type EventBatchEncoder interface {
 	// EncodeCheckpointEvent appends a checkpoint event into the batch.
 	// This event will be broadcast to all partitions to signal a global checkpoint.
 	EncodeCheckpointEvent(ts uint64) (*MQMessage, error)
+	// EncodeSyncpointEvent appends a syncpoint event into the batch.
+	// This event will be broadcast to all partitions after all the rows committed
+	// before ts are sent, so the consumers can build a consistent snapshot at ts
+	// once they receive it from all partitions. It returns nil if the protocol
+	// has no such a message type.
+	EncodeSyncpointEvent(ts uint64) (*MQMessage, error)
 	// AppendRowChangedEvent appends a row changed event into the batch
 	AppendRowChangedEvent(e *model.RowChangedEvent) (EncoderResult, error)
 	// AppendResolvedEvent appends a resolved event into the batch.
@@ -91,6 +97,10 @@ func newResolvedMQMessage(proto Protocol, key, value []byte, ts uint64) *MQMessa
 	return NewMQMessage(proto, key, value, ts, model.MqMessageTypeResolved, nil, nil)
 }
 
+func newSyncpointMQMessage(proto Protocol, key, value []byte, ts uint64) *MQMessage {
+	return NewMQMessage(proto, key, value, ts, model.MqMessageTypeSyncpoint, nil, nil)
+}
+
 // NewMQMessage should be used when creating a MQMessage struct.
 // It copies the input byte slices to avoid any surprises in asynchronous MQ writes.
 func NewMQMessage(proto Protocol, key []byte, value []byte, ts uint64, ty model.MqMessageType, schema, table *string) *MQMessage {
@@ -127,6 +137,8 @@ type EventBatchDecoder interface {
 	HasNext() (model.MqMessageType, bool, error)
 	// NextResolvedEvent returns the next resolved event if exists
 	NextResolvedEvent() (uint64, error)
+	// NextSyncpointEvent returns the ts of the next syncpoint event if exists
+	NextSyncpointEvent() (uint64, error)
 	// NextRowChangedEvent returns the next row changed event if exists
 	NextRowChangedEvent() (*model.RowChangedEvent, error)
 	// NextDDLEvent returns the next DDL event if exists
@@ -154,6 +166,8 @@ const (
 	ProtocolMaxwell
 	ProtocolCanalJSON
 	ProtocolCraft
+	ProtocolDebezium
+	ProtocolProtobuf
 )
 
 // FromString converts the protocol from string to Protocol enum type
@@ -171,6 +185,10 @@ func (p *Protocol) FromString(protocol string) {
 		*p = ProtocolCanalJSON
 	case "craft":
 		*p = ProtocolCraft
+	case "debezium":
+		*p = ProtocolDebezium
+	case "protobuf":
+		*p = ProtocolProtobuf
 	default:
 		*p = ProtocolDefault
 		log.Warn("can't support codec protocol, using default protocol", zap.String("protocol", protocol))
@@ -196,6 +214,10 @@ func NewEventBatchEncoderBuilder(p Protocol, credential *security.Credential, op
 		return newCanalFlatEventBatchEncoderBuilder(opts), nil
 	case ProtocolCraft:
 		return newCraftEventBatchEncoderBuilder(opts), nil
+	case ProtocolDebezium:
+		return newDebeziumEventBatchEncoderBuilder(opts), nil
+	case ProtocolProtobuf:
+		return newProtobufEventBatchEncoderBuilder(opts), nil
 	default:
 		log.Warn("unknown codec protocol value of EventBatchEncoder, use open-protocol as the default", zap.Int("protocol_value", int(p)))
 		return newJSONEventBatchEncoderBuilder(opts), nil
@@ -236,6 +236,13 @@ func newResolvedMessage(ts uint64) *mqMessageKey {
 	}
 }
 
+func newSyncpointMessage(ts uint64) *mqMessageKey {
+	return &mqMessageKey{
+		Ts:   ts,
+		Type: model.MqMessageTypeSyncpoint,
+	}
+}
+
 func rowEventToMqMessage(e *model.RowChangedEvent) (*mqMessageKey, *mqMessageRow) {
 	var partition *int64
 	if e.Table.IsPartition {
@@ -377,7 +384,18 @@ func (d *JSONEventBatchEncoder) AppendResolvedEvent(ts uint64) (EncoderResult, e
 
 // EncodeCheckpointEvent implements the EventBatchEncoder interface
 func (d *JSONEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage, error) {
-	keyMsg := newResolvedMessage(ts)
+	return d.encodeTsEvent(newResolvedMessage(ts), newResolvedMQMessage)
+}
+
+// EncodeSyncpointEvent implements the EventBatchEncoder interface
+func (d *JSONEventBatchEncoder) EncodeSyncpointEvent(ts uint64) (*MQMessage, error) {
+	return d.encodeTsEvent(newSyncpointMessage(ts), newSyncpointMQMessage)
+}
+
+func (d *JSONEventBatchEncoder) encodeTsEvent(
+	keyMsg *mqMessageKey,
+	newMQMessage func(proto Protocol, key, value []byte, ts uint64) *MQMessage,
+) (*MQMessage, error) {
 	key, err := keyMsg.Encode()
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -405,7 +423,7 @@ func (d *JSONEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage, er
 	valueBuf := new(bytes.Buffer)
 	valueBuf.Write(valueLenByte[:])
 
-	ret := newResolvedMQMessage(ProtocolDefault, keyBuf.Bytes(), valueBuf.Bytes(), ts)
+	ret := newMQMessage(ProtocolDefault, keyBuf.Bytes(), valueBuf.Bytes(), keyMsg.Ts)
 	return ret, nil
 }
 
@@ -675,6 +693,24 @@ func (b *JSONEventBatchMixedDecoder) NextResolvedEvent() (uint64, error) {
 	return resolvedTs, nil
 }
 
+// NextSyncpointEvent implements the EventBatchDecoder interface
+func (b *JSONEventBatchMixedDecoder) NextSyncpointEvent() (uint64, error) {
+	if b.nextKey == nil {
+		if err := b.decodeNextKey(); err != nil {
+			return 0, err
+		}
+	}
+	b.mixedBytes = b.mixedBytes[b.nextKeyLen+8:]
+	if b.nextKey.Type != model.MqMessageTypeSyncpoint {
+		return 0, cerror.ErrJSONCodecInvalidData.GenWithStack("not found syncpoint event message")
+	}
+	valueLen := binary.BigEndian.Uint64(b.mixedBytes[:8])
+	b.mixedBytes = b.mixedBytes[valueLen+8:]
+	syncpointTs := b.nextKey.Ts
+	b.nextKey = nil
+	return syncpointTs, nil
+}
+
 // NextRowChangedEvent implements the EventBatchDecoder interface
 func (b *JSONEventBatchMixedDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 	if b.nextKey == nil {
@@ -776,6 +812,24 @@ func (b *JSONEventBatchDecoder) NextResolvedEvent() (uint64, error) {
 	return resolvedTs, nil
 }
 
+// NextSyncpointEvent implements the EventBatchDecoder interface
+func (b *JSONEventBatchDecoder) NextSyncpointEvent() (uint64, error) {
+	if b.nextKey == nil {
+		if err := b.decodeNextKey(); err != nil {
+			return 0, err
+		}
+	}
+	b.keyBytes = b.keyBytes[b.nextKeyLen+8:]
+	if b.nextKey.Type != model.MqMessageTypeSyncpoint {
+		return 0, cerror.ErrJSONCodecInvalidData.GenWithStack("not found syncpoint event message")
+	}
+	valueLen := binary.BigEndian.Uint64(b.valueBytes[:8])
+	b.valueBytes = b.valueBytes[valueLen+8:]
+	syncpointTs := b.nextKey.Ts
+	b.nextKey = nil
+	return syncpointTs, nil
+}
+
 // NextRowChangedEvent implements the EventBatchDecoder interface
 func (b *JSONEventBatchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 	if b.nextKey == nil {
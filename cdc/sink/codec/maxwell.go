@@ -84,6 +84,12 @@ func (d *MaxwellEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage,
 	return nil, nil
 }
 
+// EncodeSyncpointEvent implements the EventBatchEncoder interface
+func (d *MaxwellEventBatchEncoder) EncodeSyncpointEvent(ts uint64) (*MQMessage, error) {
+	// Maxwell has no corresponding message type for the syncpoint event either.
+	return nil, nil
+}
+
 // AppendResolvedEvent implements the EventBatchEncoder interface
 func (d *MaxwellEventBatchEncoder) AppendResolvedEvent(ts uint64) (EncoderResult, error) {
 	return EncoderNoOperation, nil
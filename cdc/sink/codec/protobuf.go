@@ -0,0 +1,417 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/cdc/model"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/proto/event"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+)
+
+// ProtobufBatchVersion1 represents the version of the EventBatch encoded by
+// the protobuf protocol.
+const ProtobufBatchVersion1 uint32 = 1
+
+// ProtobufEventBatchEncoder encodes the events into protobuf messages defined
+// by proto/CDCEvent.proto, so that they can be decoded by stock tooling.
+type ProtobufEventBatchEncoder struct {
+	messageBuf   []*MQMessage
+	curBatchSize int
+
+	// configs
+	maxMessageSize int
+	maxBatchSize   int
+}
+
+// NewProtobufEventBatchEncoder creates a new ProtobufEventBatchEncoder.
+func NewProtobufEventBatchEncoder() EventBatchEncoder {
+	return &ProtobufEventBatchEncoder{
+		messageBuf:     make([]*MQMessage, 0, 2),
+		maxMessageSize: DefaultMaxMessageBytes,
+		maxBatchSize:   DefaultMaxBatchSize,
+	}
+}
+
+type protobufEventBatchEncoderBuilder struct {
+	opts map[string]string
+}
+
+// Build a ProtobufEventBatchEncoder
+func (b *protobufEventBatchEncoderBuilder) Build(ctx context.Context) (EventBatchEncoder, error) {
+	encoder := NewProtobufEventBatchEncoder()
+	if err := encoder.SetParams(b.opts); err != nil {
+		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
+	}
+
+	return encoder, nil
+}
+
+func newProtobufEventBatchEncoderBuilder(opts map[string]string) EncoderBuilder {
+	return &protobufEventBatchEncoderBuilder{opts: opts}
+}
+
+func (e *ProtobufEventBatchEncoder) encodeSingleEvent(ev *event.Event) ([]byte, error) {
+	batch := &event.EventBatch{
+		Version: ProtobufBatchVersion1,
+		Events:  []*event.Event{ev},
+	}
+	value, err := batch.Marshal()
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrProtobufCodecInvalidData, err)
+	}
+	return value, nil
+}
+
+// EncodeCheckpointEvent implements the EventBatchEncoder interface
+func (e *ProtobufEventBatchEncoder) EncodeCheckpointEvent(ts uint64) (*MQMessage, error) {
+	value, err := e.encodeSingleEvent(&event.Event{Type: event.EventType_RESOLVED, Ts: ts})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newResolvedMQMessage(ProtocolProtobuf, nil, value, ts), nil
+}
+
+// EncodeSyncpointEvent implements the EventBatchEncoder interface
+func (e *ProtobufEventBatchEncoder) EncodeSyncpointEvent(ts uint64) (*MQMessage, error) {
+	value, err := e.encodeSingleEvent(&event.Event{Type: event.EventType_SYNCPOINT, Ts: ts})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newSyncpointMQMessage(ProtocolProtobuf, nil, value, ts), nil
+}
+
+// AppendRowChangedEvent implements the EventBatchEncoder interface
+func (e *ProtobufEventBatchEncoder) AppendRowChangedEvent(ev *model.RowChangedEvent) (EncoderResult, error) {
+	row, err := rowChangedToProtobuf(ev)
+	if err != nil {
+		return EncoderNoOperation, errors.Trace(err)
+	}
+	// the concatenation of the encoded batches is decoded as a single batch, the
+	// events are appended to the message one by one.
+	versionHead, err := (&event.EventBatch{Version: ProtobufBatchVersion1}).Marshal()
+	if err != nil {
+		return EncoderNoOperation, cerror.WrapError(cerror.ErrProtobufCodecInvalidData, err)
+	}
+	value, err := (&event.EventBatch{
+		Events: []*event.Event{{Type: event.EventType_ROW, Ts: ev.CommitTs, Row: row}},
+	}).Marshal()
+	if err != nil {
+		return EncoderNoOperation, cerror.WrapError(cerror.ErrProtobufCodecInvalidData, err)
+	}
+
+	// for single message that longer than max-message-size, do not send it.
+	length := len(versionHead) + len(value) + maximumRecordOverhead
+	if length > e.maxMessageSize {
+		log.Warn("Single message too large",
+			zap.Int("max-message-size", e.maxMessageSize), zap.Int("length", length), zap.Any("table", ev.Table))
+		return EncoderNoOperation, cerror.ErrProtobufCodecRowTooLarge.GenWithStackByArgs()
+	}
+
+	if len(e.messageBuf) == 0 ||
+		e.curBatchSize >= e.maxBatchSize ||
+		e.messageBuf[len(e.messageBuf)-1].Length()+len(value) > e.maxMessageSize {
+		e.messageBuf = append(e.messageBuf, NewMQMessage(ProtocolProtobuf, nil, versionHead, ev.CommitTs,
+			model.MqMessageTypeRow, &ev.Table.Schema, &ev.Table.Table))
+		e.curBatchSize = 0
+	}
+	message := e.messageBuf[len(e.messageBuf)-1]
+	message.Value = append(message.Value, value...)
+	e.curBatchSize++
+	return EncoderNoOperation, nil
+}
+
+// AppendResolvedEvent is no-op
+func (e *ProtobufEventBatchEncoder) AppendResolvedEvent(ts uint64) (EncoderResult, error) {
+	return EncoderNoOperation, nil
+}
+
+// EncodeDDLEvent implements the EventBatchEncoder interface
+func (e *ProtobufEventBatchEncoder) EncodeDDLEvent(ev *model.DDLEvent) (*MQMessage, error) {
+	value, err := e.encodeSingleEvent(&event.Event{
+		Type: event.EventType_DDL,
+		Ts:   ev.CommitTs,
+		Ddl: &event.DDLChanged{
+			Schema: ev.TableInfo.Schema,
+			Table:  ev.TableInfo.Table,
+			Type:   uint32(ev.Type),
+			Query:  ev.Query,
+		},
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newDDLMQMessage(ProtocolProtobuf, nil, value, ev), nil
+}
+
+// Build implements the EventBatchEncoder interface
+func (e *ProtobufEventBatchEncoder) Build() []*MQMessage {
+	ret := e.messageBuf
+	e.messageBuf = make([]*MQMessage, 0, 2)
+	return ret
+}
+
+// MixedBuild implements the EventBatchEncoder interface
+func (e *ProtobufEventBatchEncoder) MixedBuild(withVersion bool) []byte {
+	panic("Only JsonEncoder supports mixed build")
+}
+
+// Size implements the EventBatchEncoder interface
+func (e *ProtobufEventBatchEncoder) Size() int {
+	size := 0
+	for _, message := range e.messageBuf {
+		size += len(message.Value)
+	}
+	return size
+}
+
+// Reset implements the EventBatchEncoder interface
+func (e *ProtobufEventBatchEncoder) Reset() {
+	e.messageBuf = make([]*MQMessage, 0, 2)
+}
+
+// SetParams reads relevant parameters for protobuf protocol
+func (e *ProtobufEventBatchEncoder) SetParams(params map[string]string) error {
+	var err error
+
+	e.maxMessageSize = DefaultMaxMessageBytes
+	if maxMessageBytes, ok := params["max-message-bytes"]; ok {
+		e.maxMessageSize, err = strconv.Atoi(maxMessageBytes)
+		if err != nil {
+			return cerror.ErrSinkInvalidConfig.Wrap(err)
+		}
+	}
+	if e.maxMessageSize <= 0 || e.maxMessageSize > math.MaxInt32 {
+		return cerror.ErrSinkInvalidConfig.Wrap(errors.Errorf("invalid max-message-bytes %d", e.maxMessageSize))
+	}
+
+	e.maxBatchSize = DefaultMaxBatchSize
+	if maxBatchSize, ok := params["max-batch-size"]; ok {
+		e.maxBatchSize, err = strconv.Atoi(maxBatchSize)
+		if err != nil {
+			return cerror.ErrSinkInvalidConfig.Wrap(err)
+		}
+	}
+	if e.maxBatchSize <= 0 || e.maxBatchSize > math.MaxUint16 {
+		return cerror.ErrSinkInvalidConfig.Wrap(errors.Errorf("invalid max-batch-size %d", e.maxBatchSize))
+	}
+
+	return nil
+}
+
+func rowChangedToProtobuf(ev *model.RowChangedEvent) (*event.RowChanged, error) {
+	row := &event.RowChanged{
+		Schema:      ev.Table.Schema,
+		Table:       ev.Table.Table,
+		TableId:     ev.Table.TableID,
+		IsPartition: ev.Table.IsPartition,
+	}
+	var err error
+	if row.PreColumns, err = columnsToProtobuf(ev.PreColumns); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if row.Columns, err = columnsToProtobuf(ev.Columns); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return row, nil
+}
+
+func columnsToProtobuf(cols []*model.Column) ([]*event.Column, error) {
+	if len(cols) == 0 {
+		return nil, nil
+	}
+	converted := make([]*event.Column, 0, len(cols))
+	for _, col := range cols {
+		if col == nil {
+			// keep the positions of the other columns
+			converted = append(converted, &event.Column{Value: &event.Column_NullValue{NullValue: true}})
+			continue
+		}
+		pbCol := &event.Column{
+			Name: col.Name,
+			Type: uint32(col.Type),
+			Flag: uint32(col.Flag),
+		}
+		switch v := col.Value.(type) {
+		case nil:
+			pbCol.Value = &event.Column_NullValue{NullValue: true}
+		case int64:
+			pbCol.Value = &event.Column_Int64Value{Int64Value: v}
+		case uint64:
+			pbCol.Value = &event.Column_Uint64Value{Uint64Value: v}
+		case float64:
+			pbCol.Value = &event.Column_DoubleValue{DoubleValue: v}
+		case string:
+			pbCol.Value = &event.Column_StringValue{StringValue: v}
+		case []byte:
+			pbCol.Value = &event.Column_BytesValue{BytesValue: v}
+		default:
+			return nil, cerror.ErrProtobufCodecInvalidData.GenWithStack(
+				"unsupported value type %T of column %s", col.Value, col.Name)
+		}
+		converted = append(converted, pbCol)
+	}
+	return converted, nil
+}
+
+func columnsFromProtobuf(cols []*event.Column) []*model.Column {
+	if len(cols) == 0 {
+		return nil
+	}
+	converted := make([]*model.Column, len(cols))
+	for i, pbCol := range cols {
+		if pbCol.Name == "" {
+			continue
+		}
+		col := &model.Column{
+			Name: pbCol.Name,
+			Type: byte(pbCol.Type),
+			Flag: model.ColumnFlagType(pbCol.Flag),
+		}
+		switch v := pbCol.Value.(type) {
+		case *event.Column_Int64Value:
+			col.Value = v.Int64Value
+		case *event.Column_Uint64Value:
+			col.Value = v.Uint64Value
+		case *event.Column_DoubleValue:
+			col.Value = v.DoubleValue
+		case *event.Column_StringValue:
+			col.Value = v.StringValue
+		case *event.Column_BytesValue:
+			col.Value = v.BytesValue
+		}
+		converted[i] = col
+	}
+	return converted
+}
+
+// ProtobufEventBatchDecoder decodes the byte of a batch into the original messages.
+type ProtobufEventBatchDecoder struct {
+	batch *event.EventBatch
+	index int
+}
+
+// NewProtobufEventBatchDecoder creates a new ProtobufEventBatchDecoder.
+func NewProtobufEventBatchDecoder(value []byte) (EventBatchDecoder, error) {
+	batch := &event.EventBatch{}
+	if err := batch.Unmarshal(value); err != nil {
+		return nil, cerror.WrapError(cerror.ErrProtobufCodecInvalidData, err)
+	}
+	if batch.Version != ProtobufBatchVersion1 {
+		return nil, cerror.ErrProtobufCodecInvalidData.GenWithStack("unexpected batch version %d", batch.Version)
+	}
+	return &ProtobufEventBatchDecoder{batch: batch}, nil
+}
+
+// HasNext implements the EventBatchDecoder interface
+func (b *ProtobufEventBatchDecoder) HasNext() (model.MqMessageType, bool, error) {
+	if b.index >= len(b.batch.Events) {
+		return model.MqMessageTypeUnknown, false, nil
+	}
+	switch b.batch.Events[b.index].Type {
+	case event.EventType_ROW:
+		return model.MqMessageTypeRow, true, nil
+	case event.EventType_DDL:
+		return model.MqMessageTypeDDL, true, nil
+	case event.EventType_RESOLVED:
+		return model.MqMessageTypeResolved, true, nil
+	case event.EventType_SYNCPOINT:
+		return model.MqMessageTypeSyncpoint, true, nil
+	}
+	return model.MqMessageTypeUnknown, false, cerror.ErrProtobufCodecInvalidData.GenWithStack(
+		"unknown event type %d", b.batch.Events[b.index].Type)
+}
+
+// NextResolvedEvent implements the EventBatchDecoder interface
+func (b *ProtobufEventBatchDecoder) NextResolvedEvent() (uint64, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MqMessageTypeResolved {
+		return 0, cerror.ErrProtobufCodecInvalidData.GenWithStack("not found resolved event message")
+	}
+	ts := b.batch.Events[b.index].Ts
+	b.index++
+	return ts, nil
+}
+
+// NextSyncpointEvent implements the EventBatchDecoder interface
+func (b *ProtobufEventBatchDecoder) NextSyncpointEvent() (uint64, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MqMessageTypeSyncpoint {
+		return 0, cerror.ErrProtobufCodecInvalidData.GenWithStack("not found syncpoint event message")
+	}
+	ts := b.batch.Events[b.index].Ts
+	b.index++
+	return ts, nil
+}
+
+// NextRowChangedEvent implements the EventBatchDecoder interface
+func (b *ProtobufEventBatchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MqMessageTypeRow || b.batch.Events[b.index].Row == nil {
+		return nil, cerror.ErrProtobufCodecInvalidData.GenWithStack("not found row changed event message")
+	}
+	pbEvent := b.batch.Events[b.index]
+	ev := &model.RowChangedEvent{
+		CommitTs: pbEvent.Ts,
+		Table: &model.TableName{
+			Schema:      pbEvent.Row.Schema,
+			Table:       pbEvent.Row.Table,
+			TableID:     pbEvent.Row.TableId,
+			IsPartition: pbEvent.Row.IsPartition,
+		},
+		PreColumns: columnsFromProtobuf(pbEvent.Row.PreColumns),
+		Columns:    columnsFromProtobuf(pbEvent.Row.Columns),
+	}
+	b.index++
+	return ev, nil
+}
+
+// NextDDLEvent implements the EventBatchDecoder interface
+func (b *ProtobufEventBatchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MqMessageTypeDDL || b.batch.Events[b.index].Ddl == nil {
+		return nil, cerror.ErrProtobufCodecInvalidData.GenWithStack("not found ddl event message")
+	}
+	pbEvent := b.batch.Events[b.index]
+	ev := &model.DDLEvent{
+		CommitTs: pbEvent.Ts,
+		Query:    pbEvent.Ddl.Query,
+		Type:     timodel.ActionType(pbEvent.Ddl.Type),
+		TableInfo: &model.SimpleTableInfo{
+			Schema: pbEvent.Ddl.Schema,
+			Table:  pbEvent.Ddl.Table,
+		},
+	}
+	b.index++
+	return ev, nil
+}
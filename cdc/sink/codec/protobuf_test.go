@@ -0,0 +1,197 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"github.com/pingcap/check"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/util/testleak"
+	"github.com/pingcap/ticdc/proto/event"
+	"github.com/pingcap/tidb/parser/mysql"
+)
+
+type protobufBatchSuite struct{}
+
+var _ = check.Suite(&protobufBatchSuite{})
+
+func (s *protobufBatchSuite) TestEventBatchCodec(c *check.C) {
+	defer testleak.AfterTest(c)()
+	cases := &craftBatchSuite{
+		rowCases:        codecRowCases,
+		ddlCases:        codecDDLCases,
+		resolvedTsCases: codecResolvedTSCases,
+	}
+	cases.testBatchCodec(c, NewProtobufEventBatchEncoder, NewProtobufEventBatchDecoder)
+}
+
+func (s *protobufBatchSuite) TestColumnValues(c *check.C) {
+	defer testleak.AfterTest(c)()
+	ev := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: int64(-1)},
+			{Name: "unsigned", Type: mysql.TypeLonglong, Flag: model.UnsignedFlag, Value: uint64(1 << 63)},
+			{Name: "bit", Type: mysql.TypeBit, Value: uint64(5)},
+			{Name: "double", Type: mysql.TypeDouble, Value: float64(1.5)},
+			{Name: "decimal", Type: mysql.TypeNewDecimal, Value: "1.23"},
+			{Name: "blob", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0, 1, 2}},
+			{Name: "empty", Type: mysql.TypeVarchar, Value: []byte{}},
+			{Name: "null", Type: mysql.TypeVarchar, Flag: model.NullableFlag, Value: nil},
+			nil,
+			{Name: "last", Type: mysql.TypeLonglong, Value: int64(1)},
+		},
+	}
+	encoder := NewProtobufEventBatchEncoder()
+	_, err := encoder.AppendRowChangedEvent(ev)
+	c.Assert(err, check.IsNil)
+	messages := encoder.Build()
+	c.Assert(messages, check.HasLen, 1)
+	c.Assert(messages[0].Key, check.IsNil)
+	c.Assert(*messages[0].Schema, check.Equals, "a")
+	c.Assert(*messages[0].Table, check.Equals, "b")
+
+	// the value can be decoded with the generated code directly.
+	batch := &event.EventBatch{}
+	c.Assert(batch.Unmarshal(messages[0].Value), check.IsNil)
+	c.Assert(batch.Version, check.Equals, ProtobufBatchVersion1)
+	c.Assert(batch.Events, check.HasLen, 1)
+	c.Assert(batch.Events[0].Type, check.Equals, event.EventType_ROW)
+	c.Assert(batch.Events[0].Row.Columns[0].GetInt64Value(), check.Equals, int64(-1))
+	c.Assert(batch.Events[0].Row.Columns[7].GetNullValue(), check.IsTrue)
+	// the column which is not replicated keeps the positions of the other columns
+	c.Assert(batch.Events[0].Row.Columns, check.HasLen, 10)
+	c.Assert(batch.Events[0].Row.Columns[8].Name, check.Equals, "")
+	c.Assert(batch.Events[0].Row.Columns[9].Name, check.Equals, "last")
+
+	decoder, err := NewProtobufEventBatchDecoder(messages[0].Value)
+	c.Assert(err, check.IsNil)
+	tp, hasNext, err := decoder.HasNext()
+	c.Assert(err, check.IsNil)
+	c.Assert(hasNext, check.IsTrue)
+	c.Assert(tp, check.Equals, model.MqMessageTypeRow)
+	row, err := decoder.NextRowChangedEvent()
+	c.Assert(err, check.IsNil)
+	c.Assert(row, check.DeepEquals, ev)
+	_, hasNext, err = decoder.HasNext()
+	c.Assert(err, check.IsNil)
+	c.Assert(hasNext, check.IsFalse)
+
+	_, err = encoder.AppendRowChangedEvent(&model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "float", Type: mysql.TypeFloat, Value: float32(1)}},
+	})
+	c.Assert(err, check.ErrorMatches, ".*unsupported value type float32.*")
+}
+
+func (s *protobufBatchSuite) TestBatchLimits(c *check.C) {
+	defer testleak.AfterTest(c)()
+	testEvent := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "col1", Type: mysql.TypeVarchar, Value: []byte("aa")}},
+	}
+
+	encoder := NewProtobufEventBatchEncoder()
+	err := encoder.SetParams(map[string]string{"max-message-bytes": "256"})
+	c.Assert(err, check.IsNil)
+	for i := 0; i < 10000; i++ {
+		r, err := encoder.AppendRowChangedEvent(testEvent)
+		c.Assert(r, check.Equals, EncoderNoOperation)
+		c.Assert(err, check.IsNil)
+	}
+	messages := encoder.Build()
+	c.Assert(len(messages), check.Greater, 1)
+	for _, msg := range messages {
+		c.Assert(len(msg.Value), check.LessEqual, 256)
+	}
+
+	err = encoder.SetParams(map[string]string{"max-batch-size": "3"})
+	c.Assert(err, check.IsNil)
+	for i := 0; i < 10; i++ {
+		_, err := encoder.AppendRowChangedEvent(testEvent)
+		c.Assert(err, check.IsNil)
+	}
+	messages = encoder.Build()
+	c.Assert(messages, check.HasLen, 4)
+	rows := 0
+	for _, msg := range messages {
+		decoder, err := NewProtobufEventBatchDecoder(msg.Value)
+		c.Assert(err, check.IsNil)
+		for {
+			_, hasNext, err := decoder.HasNext()
+			c.Assert(err, check.IsNil)
+			if !hasNext {
+				break
+			}
+			_, err = decoder.NextRowChangedEvent()
+			c.Assert(err, check.IsNil)
+			rows++
+		}
+	}
+	c.Assert(rows, check.Equals, 10)
+
+	// a single row larger than max-message-bytes is rejected
+	err = encoder.SetParams(map[string]string{"max-message-bytes": "64"})
+	c.Assert(err, check.IsNil)
+	_, err = encoder.AppendRowChangedEvent(&model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "col1", Type: mysql.TypeVarchar, Value: make([]byte, 64)}},
+	})
+	c.Assert(err, check.ErrorMatches, ".*ErrProtobufCodecRowTooLarge.*")
+	c.Assert(encoder.Build(), check.HasLen, 0)
+
+	err = encoder.SetParams(map[string]string{"max-batch-size": "0"})
+	c.Assert(err, check.ErrorMatches, ".*invalid.*")
+	err = encoder.SetParams(map[string]string{"max-message-bytes": "-1"})
+	c.Assert(err, check.ErrorMatches, ".*invalid.*")
+}
+
+func (s *protobufBatchSuite) TestDecodeInvalidData(c *check.C) {
+	defer testleak.AfterTest(c)()
+	value, err := (&event.EventBatch{Version: 2}).Marshal()
+	c.Assert(err, check.IsNil)
+	_, err = NewProtobufEventBatchDecoder(value)
+	c.Assert(err, check.ErrorMatches, ".*unexpected batch version 2.*")
+
+	_, err = NewProtobufEventBatchDecoder([]byte{0xff})
+	c.Assert(err, check.ErrorMatches, ".*ErrProtobufCodecInvalidData.*")
+
+	encoder := NewProtobufEventBatchEncoder()
+	msg, err := encoder.EncodeCheckpointEvent(1)
+	c.Assert(err, check.IsNil)
+	decoder, err := NewProtobufEventBatchDecoder(msg.Value)
+	c.Assert(err, check.IsNil)
+	_, err = decoder.NextRowChangedEvent()
+	c.Assert(err, check.ErrorMatches, ".*not found row changed event message.*")
+	_, err = decoder.NextDDLEvent()
+	c.Assert(err, check.ErrorMatches, ".*not found ddl event message.*")
+	ts, err := decoder.NextResolvedEvent()
+	c.Assert(err, check.IsNil)
+	c.Assert(ts, check.Equals, uint64(1))
+	_, err = decoder.NextResolvedEvent()
+	c.Assert(err, check.ErrorMatches, ".*not found resolved event message.*")
+
+	value, err = (&event.EventBatch{
+		Version: ProtobufBatchVersion1,
+		Events:  []*event.Event{{Type: event.EventType(100), Ts: 1}},
+	}).Marshal()
+	c.Assert(err, check.IsNil)
+	decoder, err = NewProtobufEventBatchDecoder(value)
+	c.Assert(err, check.IsNil)
+	_, _, err = decoder.HasNext()
+	c.Assert(err, check.ErrorMatches, ".*unknown event type 100.*")
+}
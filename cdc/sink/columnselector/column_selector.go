@@ -0,0 +1,162 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package columnselector
+
+import (
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	filter "github.com/pingcap/tidb-tools/pkg/table-filter"
+)
+
+type selector struct {
+	tableF  filter.Filter
+	columnM filter.ColumnFilter
+}
+
+func (s *selector) match(column string) bool {
+	return s.columnM.MatchColumn(column)
+}
+
+// ColumnSelectors trims the columns of row changed events according to
+// the `sink.column-selectors` config. The first selector whose matcher
+// covers the table is used, tables that match no selector are left untouched.
+type ColumnSelectors struct {
+	selectors []*selector
+}
+
+// New creates a ColumnSelectors from the replica config.
+func New(cfg *config.ReplicaConfig) (*ColumnSelectors, error) {
+	selectors := make([]*selector, 0, len(cfg.Sink.ColumnSelectors))
+	for _, selectorCfg := range cfg.Sink.ColumnSelectors {
+		f, err := filter.Parse(selectorCfg.Matcher)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err)
+		}
+		if !cfg.CaseSensitive {
+			f = filter.CaseInsensitive(f)
+		}
+		if len(selectorCfg.Columns) == 0 {
+			return nil, cerror.ErrColumnSelectorInvalid.GenWithStack(
+				"column selector with matcher %v selects no column", selectorCfg.Matcher)
+		}
+		// Column names are case-insensitive, so is the parsed column filter.
+		m, err := filter.ParseColumnFilter(selectorCfg.Columns)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrColumnSelectorInvalid, err)
+		}
+		selectors = append(selectors, &selector{tableF: f, columnM: m})
+	}
+	return &ColumnSelectors{selectors: selectors}, nil
+}
+
+func (c *ColumnSelectors) matchSelector(schema, table string) *selector {
+	for _, s := range c.selectors {
+		if s.tableF.MatchTable(schema, table) {
+			return s
+		}
+	}
+	return nil
+}
+
+// Apply returns the row changed event with the Columns and PreColumns trimmed
+// to the selected columns. IndexColumns are remapped to the new column offsets,
+// and indexes that contain an unselected column are removed. The row may be
+// shared with others, so a copy is returned if any column is removed.
+func (c *ColumnSelectors) Apply(row *model.RowChangedEvent) *model.RowChangedEvent {
+	if len(c.selectors) == 0 {
+		return row
+	}
+	s := c.matchSelector(row.Table.Schema, row.Table.Table)
+	if s == nil {
+		return row
+	}
+
+	// Columns and PreColumns share the same layout, so the offsets of the
+	// selected columns can be computed from either of them.
+	cols := row.Columns
+	if len(cols) == 0 {
+		cols = row.PreColumns
+	}
+	// newOffsets maps the offset of a column to its offset after trimming,
+	// unselected columns are mapped to -1.
+	newOffsets := make([]int, len(cols))
+	selected := 0
+	for i, col := range cols {
+		if col == nil || !s.match(col.Name) {
+			newOffsets[i] = -1
+			continue
+		}
+		newOffsets[i] = selected
+		selected++
+	}
+	if selected == len(cols) {
+		return row
+	}
+
+	trimmed := *row
+	trimmed.Columns = trimColumns(row.Columns, newOffsets, selected)
+	trimmed.PreColumns = trimColumns(row.PreColumns, newOffsets, selected)
+
+	indexColumns := make([][]int, 0, len(row.IndexColumns))
+outer:
+	for _, index := range row.IndexColumns {
+		newIndex := make([]int, 0, len(index))
+		for _, offset := range index {
+			if offset >= len(newOffsets) || newOffsets[offset] < 0 {
+				continue outer
+			}
+			newIndex = append(newIndex, newOffsets[offset])
+		}
+		indexColumns = append(indexColumns, newIndex)
+	}
+	trimmed.IndexColumns = indexColumns
+	return &trimmed
+}
+
+func trimColumns(cols []*model.Column, newOffsets []int, selected int) []*model.Column {
+	if len(cols) == 0 {
+		return cols
+	}
+	trimmed := make([]*model.Column, 0, selected)
+	for i, col := range cols {
+		if i < len(newOffsets) && newOffsets[i] >= 0 {
+			trimmed = append(trimmed, col)
+		}
+	}
+	return trimmed
+}
+
+// VerifyTable checks that the column selector matching the table keeps all the
+// handle key columns, which are required to identify rows in the MySQL sink and
+// to dispatch rows by the index-value dispatcher in the MQ sink.
+func (c *ColumnSelectors) VerifyTable(info *model.TableInfo) error {
+	s := c.matchSelector(info.TableName.Schema, info.TableName.Table)
+	if s == nil {
+		return nil
+	}
+	for _, col := range info.Columns {
+		if !model.IsColCDCVisible(col) {
+			continue
+		}
+		flag := info.ColumnsFlag[col.ID]
+		if flag.IsHandleKey() && !s.match(col.Name.O) {
+			return cerror.ErrColumnSelectorInvalid.GenWithStack(
+				"column selector drops the handle key column %s of table %s, "+
+					"which is required to identify rows and by the index-value dispatcher",
+				col.Name.O, info.TableName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package columnselector
+
+import (
+	"testing"
+
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	parser_types "github.com/pingcap/tidb/parser/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newReplicaConfig(selectors ...*config.ColumnSelector) *config.ReplicaConfig {
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Sink.ColumnSelectors = selectors
+	return cfg
+}
+
+func TestNewColumnSelectors(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(newReplicaConfig(&config.ColumnSelector{
+		Matcher: []string{"test.*"},
+		Columns: []string{"a"},
+	}))
+	require.Nil(t, err)
+
+	_, err = New(newReplicaConfig(&config.ColumnSelector{
+		Matcher: []string{"test.*"},
+	}))
+	require.True(t, cerror.ErrColumnSelectorInvalid.Equal(err))
+
+	_, err = New(newReplicaConfig(&config.ColumnSelector{
+		Matcher: []string{"test.*"},
+		Columns: []string{"a", "!"},
+	}))
+	require.Regexp(t, ".*CDC:ErrColumnSelectorInvalid.*", err)
+
+	_, err = New(newReplicaConfig(&config.ColumnSelector{
+		Matcher: []string{"rtest1"},
+		Columns: []string{"a"},
+	}))
+	require.Regexp(t, ".*CDC:ErrFilterRuleInvalid.*", err)
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	selectors, err := New(newReplicaConfig(
+		&config.ColumnSelector{
+			Matcher: []string{"test.t1"},
+			Columns: []string{"ID", "c"},
+		},
+		&config.ColumnSelector{
+			Matcher: []string{"test.*"},
+			Columns: []string{"*", "!c"},
+		},
+	))
+	require.Nil(t, err)
+
+	newColumns := func() []*model.Column {
+		return []*model.Column{
+			{Name: "id", Value: 1, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+			{Name: "b", Value: 2, Flag: model.UniqueKeyFlag},
+			{Name: "c", Value: 3},
+		}
+	}
+
+	// the first matching selector is used
+	row := &model.RowChangedEvent{
+		Table:        &model.TableName{Schema: "test", Table: "t1"},
+		Columns:      newColumns(),
+		PreColumns:   newColumns(),
+		IndexColumns: [][]int{{0}, {1}, {0, 2}},
+	}
+	selected := selectors.Apply(row)
+	require.Equal(t, []string{"id", "c"}, columnNames(selected.Columns))
+	require.Equal(t, []string{"id", "c"}, columnNames(selected.PreColumns))
+	require.Equal(t, [][]int{{0}, {0, 1}}, selected.IndexColumns)
+	// the original row is not modified
+	require.Equal(t, []string{"id", "b", "c"}, columnNames(row.Columns))
+	require.Equal(t, []string{"id", "b", "c"}, columnNames(row.PreColumns))
+	require.Equal(t, [][]int{{0}, {1}, {0, 2}}, row.IndexColumns)
+
+	// delete events only carry PreColumns
+	row = &model.RowChangedEvent{
+		Table:        &model.TableName{Schema: "test", Table: "t2"},
+		PreColumns:   newColumns(),
+		IndexColumns: [][]int{{0}, {1}, {0, 2}},
+	}
+	selected = selectors.Apply(row)
+	require.Len(t, selected.Columns, 0)
+	require.Equal(t, []string{"id", "b"}, columnNames(selected.PreColumns))
+	require.Equal(t, [][]int{{0}, {1}}, selected.IndexColumns)
+
+	// tables matching no selector are untouched
+	row = &model.RowChangedEvent{
+		Table:        &model.TableName{Schema: "other", Table: "t1"},
+		Columns:      newColumns(),
+		IndexColumns: [][]int{{0}, {1}, {0, 2}},
+	}
+	require.Same(t, row, selectors.Apply(row))
+	require.Equal(t, []string{"id", "b", "c"}, columnNames(row.Columns))
+	require.Equal(t, [][]int{{0}, {1}, {0, 2}}, row.IndexColumns)
+}
+
+func columnNames(cols []*model.Column) []string {
+	names := make([]string, 0, len(cols))
+	for _, col := range cols {
+		names = append(names, col.Name)
+	}
+	return names
+}
+
+func TestVerifyTable(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.WrapTableInfo(1, "test", 1, &timodel.TableInfo{
+		Name:       timodel.NewCIStr("t1"),
+		PKIsHandle: true,
+		Columns: []*timodel.ColumnInfo{
+			{
+				ID:        1,
+				Name:      timodel.NewCIStr("id"),
+				FieldType: parser_types.FieldType{Flag: mysql.PriKeyFlag | mysql.NotNullFlag},
+				State:     timodel.StatePublic,
+			},
+			{
+				ID:    2,
+				Name:  timodel.NewCIStr("name"),
+				State: timodel.StatePublic,
+			},
+		},
+	})
+
+	selectors, err := New(newReplicaConfig(&config.ColumnSelector{
+		Matcher: []string{"test.t1"},
+		Columns: []string{"id"},
+	}))
+	require.Nil(t, err)
+	require.Nil(t, selectors.VerifyTable(tableInfo))
+
+	selectors, err = New(newReplicaConfig(&config.ColumnSelector{
+		Matcher: []string{"test.t2"},
+		Columns: []string{"name"},
+	}))
+	require.Nil(t, err)
+	require.Nil(t, selectors.VerifyTable(tableInfo))
+
+	selectors, err = New(newReplicaConfig(&config.ColumnSelector{
+		Matcher: []string{"test.*"},
+		Columns: []string{"name"},
+	}))
+	require.Nil(t, err)
+	err = selectors.VerifyTable(tableInfo)
+	require.True(t, cerror.ErrColumnSelectorInvalid.Equal(err))
+	require.Regexp(t, "handle key column id of table test.t1", err.Error())
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatcher
+
+import (
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/hash"
+	filter "github.com/pingcap/tidb-tools/pkg/table-filter"
+	"go.uber.org/zap"
+)
+
+// columnsDispatcher dispatches rows by the values of the user specified
+// columns, so that all the rows of a business key go to the same partition
+// even if the columns are not the primary key.
+type columnsDispatcher struct {
+	partitionNum int32
+	hasher       *hash.PositionInertia
+	columns      []string
+	// missingWarned records the tables which are warned about the missing
+	// columns, so the warning is logged once for every table.
+	missingWarned map[model.TableName]struct{}
+}
+
+func newColumnsDispatcher(partitionNum int32, columns []string) *columnsDispatcher {
+	return &columnsDispatcher{
+		partitionNum:  partitionNum,
+		hasher:        hash.NewPositionInertia(),
+		columns:       columns,
+		missingWarned: make(map[model.TableName]struct{}),
+	}
+}
+
+func (r *columnsDispatcher) Dispatch(row *model.RowChangedEvent) int32 {
+	r.hasher.Reset()
+	r.hasher.Write([]byte(row.Table.Schema), []byte(row.Table.Table))
+
+	dispatchCols := row.Columns
+	if len(row.Columns) == 0 {
+		dispatchCols = row.PreColumns
+	}
+	// Columns are hashed in the order of the config, so that the partition
+	// does not depend on the column order of the table.
+	for _, name := range r.columns {
+		found := false
+		for _, col := range dispatchCols {
+			if col == nil || !strings.EqualFold(col.Name, name) {
+				continue
+			}
+			r.hasher.Write([]byte(col.Name), []byte(model.ColumnValueString(col.Value)))
+			found = true
+			break
+		}
+		if !found {
+			r.warnMissingColumn(row.Table, name)
+		}
+	}
+	return int32(r.hasher.Sum32() % uint32(r.partitionNum))
+}
+
+// warnMissingColumn warns that a column of the dispatcher is not in the row,
+// the rows of the table are dispatched without it, all of them may go to the
+// same partition. It happens if the column is dropped after the check of the
+// changefeed config.
+func (r *columnsDispatcher) warnMissingColumn(table *model.TableName, name string) {
+	if _, ok := r.missingWarned[*table]; ok {
+		return
+	}
+	r.missingWarned[*table] = struct{}{}
+	log.Warn("the column of the columns dispatcher does not exist in the row, dispatch the rows without it",
+		zap.String("schema", table.Schema), zap.String("table", table.Table), zap.String("column", name))
+}
+
+// VerifyTable checks that all the columns of the columns dispatcher matching
+// the table exist in the table schema.
+func VerifyTable(cfg *config.ReplicaConfig, info *model.TableInfo) error {
+	for _, ruleConfig := range cfg.Sink.DispatchRules {
+		f, err := filter.Parse(ruleConfig.Matcher)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrFilterRuleInvalid, err)
+		}
+		if !cfg.CaseSensitive {
+			f = filter.CaseInsensitive(f)
+		}
+		if !f.MatchTable(info.TableName.Schema, info.TableName.Table) {
+			continue
+		}
+		if !strings.EqualFold(ruleConfig.Dispatcher, "columns") {
+			return nil
+		}
+		for _, name := range ruleConfig.Columns {
+			if !hasColumn(info, name) {
+				return cerror.ErrDispatcherColumnsInvalid.GenWithStack(
+					"column %s of the columns dispatcher does not exist in table %s",
+					name, info.TableName)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func hasColumn(info *model.TableInfo, name string) bool {
+	for _, col := range info.Columns {
+		if model.IsColCDCVisible(col) && strings.EqualFold(col.Name.O, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatcher
+
+import (
+	"github.com/pingcap/check"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/util/testleak"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	parser_types "github.com/pingcap/tidb/parser/types"
+)
+
+type ColumnsDispatcherSuite struct{}
+
+var _ = check.Suite(&ColumnsDispatcherSuite{})
+
+func (s ColumnsDispatcherSuite) TestColumnsDispatcher(c *check.C) {
+	defer testleak.AfterTest(c)()
+	newRow := func(id, tenantID int, preColumns bool) *model.RowChangedEvent {
+		cols := []*model.Column{
+			{Name: "id", Value: id, Flag: model.HandleKeyFlag},
+			{Name: "tenant_id", Value: tenantID},
+		}
+		row := &model.RowChangedEvent{
+			Table: &model.TableName{Schema: "test", Table: "t1"},
+		}
+		if preColumns {
+			row.PreColumns = cols
+		} else {
+			row.Columns = cols
+		}
+		return row
+	}
+
+	p := newColumnsDispatcher(16, []string{"TENANT_ID"})
+	expected := p.Dispatch(newRow(1, 100, false))
+	// Rows of the same tenant go to the same partition whatever the handle is.
+	for id := 2; id < 100; id++ {
+		c.Assert(p.Dispatch(newRow(id, 100, false)), check.Equals, expected)
+		c.Assert(p.Dispatch(newRow(id, 100, true)), check.Equals, expected)
+	}
+	partitions := make(map[int32]struct{})
+	for tenantID := 0; tenantID < 100; tenantID++ {
+		partitions[p.Dispatch(newRow(1, tenantID, false))] = struct{}{}
+	}
+	c.Assert(len(partitions), check.Greater, 1)
+
+	// the rows without the column are dispatched by the other columns, and
+	// the missing column is warned once for the table.
+	missing := newColumnsDispatcher(16, []string{"tenant_id", "region"})
+	c.Assert(missing.Dispatch(newRow(1, 100, false)), check.Equals, missing.Dispatch(newRow(2, 100, false)))
+	c.Assert(missing.missingWarned, check.HasLen, 1)
+	c.Assert(p.missingWarned, check.HasLen, 0)
+
+	d, err := NewDispatcher(&config.ReplicaConfig{
+		Sink: &config.SinkConfig{
+			DispatchRules: []*config.DispatchRule{
+				{Matcher: []string{"test.*"}, Dispatcher: "columns", Columns: []string{"tenant_id"}},
+			},
+		},
+	}, 16)
+	c.Assert(err, check.IsNil)
+	c.Assert(d.(*dispatcherSwitcher).matchDispatcher(newRow(1, 100, false)), check.FitsTypeOf, &columnsDispatcher{})
+	c.Assert(d.Dispatch(newRow(1, 100, false)), check.Equals, expected)
+
+	_, err = NewDispatcher(&config.ReplicaConfig{
+		Sink: &config.SinkConfig{
+			DispatchRules: []*config.DispatchRule{
+				{Matcher: []string{"test.*"}, Dispatcher: "columns"},
+			},
+		},
+	}, 16)
+	c.Assert(err, check.ErrorMatches, ".*CDC:ErrDispatcherColumnsInvalid.*")
+}
+
+func (s ColumnsDispatcherSuite) TestVerifyTable(c *check.C) {
+	defer testleak.AfterTest(c)()
+	tableInfo := model.WrapTableInfo(1, "test", 1, &timodel.TableInfo{
+		Name:       timodel.NewCIStr("t1"),
+		PKIsHandle: true,
+		Columns: []*timodel.ColumnInfo{
+			{
+				ID:        1,
+				Name:      timodel.NewCIStr("id"),
+				FieldType: parser_types.FieldType{Flag: mysql.PriKeyFlag | mysql.NotNullFlag},
+				State:     timodel.StatePublic,
+			},
+			{
+				ID:    2,
+				Name:  timodel.NewCIStr("tenant_id"),
+				State: timodel.StatePublic,
+			},
+		},
+	})
+	newConfig := func(rules ...*config.DispatchRule) *config.ReplicaConfig {
+		cfg := config.GetDefaultReplicaConfig()
+		cfg.Sink.DispatchRules = rules
+		return cfg
+	}
+
+	c.Assert(VerifyTable(config.GetDefaultReplicaConfig(), tableInfo), check.IsNil)
+	c.Assert(VerifyTable(newConfig(
+		&config.DispatchRule{Matcher: []string{"test.*"}, Dispatcher: "columns", Columns: []string{"Tenant_ID", "id"}},
+	), tableInfo), check.IsNil)
+	c.Assert(VerifyTable(newConfig(
+		&config.DispatchRule{Matcher: []string{"test.*"}, Dispatcher: "columns", Columns: []string{"region"}},
+	), tableInfo), check.ErrorMatches, ".*column region of the columns dispatcher does not exist.*")
+	// Only the first matched rule takes effect.
+	c.Assert(VerifyTable(newConfig(
+		&config.DispatchRule{Matcher: []string{"test.t1"}, Dispatcher: "ts"},
+		&config.DispatchRule{Matcher: []string{"test.*"}, Dispatcher: "columns", Columns: []string{"region"}},
+	), tableInfo), check.IsNil)
+	c.Assert(VerifyTable(newConfig(
+		&config.DispatchRule{Matcher: []string{"other.*"}, Dispatcher: "columns", Columns: []string{"region"}},
+	), tableInfo), check.IsNil)
+}
@@ -38,6 +38,7 @@ const (
 	dispatchRuleTS
 	dispatchRuleTable
 	dispatchRuleIndexValue
+	dispatchRuleColumns
 )
 
 func (r *dispatchRule) fromString(rule string) {
@@ -52,6 +53,8 @@ func (r *dispatchRule) fromString(rule string) {
 		*r = dispatchRuleTable
 	case "index-value":
 		*r = dispatchRuleIndexValue
+	case "columns":
+		*r = dispatchRuleColumns
 	default:
 		*r = dispatchRuleDefault
 		log.Warn("can't support dispatch rule, using default rule", zap.String("rule", rule))
@@ -110,6 +113,17 @@ func NewDispatcher(cfg *config.ReplicaConfig, partitionNum int32) (Dispatcher, e
 					"switching on the old value, so please use caution!")
 			}
 			d = newIndexValueDispatcher(partitionNum)
+		case dispatchRuleColumns:
+			if len(ruleConfig.Columns) == 0 {
+				return nil, cerror.ErrDispatcherColumnsInvalid.GenWithStack(
+					"columns dispatcher with matcher %v has no column", ruleConfig.Matcher)
+			}
+			if cfg.EnableOldValue {
+				log.Warn("This columns distribution mode " +
+					"does not guarantee row-level orderliness when " +
+					"switching on the old value, so please use caution!")
+			}
+			d = newColumnsDispatcher(partitionNum, ruleConfig.Columns)
 		case dispatchRuleTS:
 			d = newTsDispatcher(partitionNum)
 		case dispatchRuleTable:
@@ -0,0 +1,126 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatcher
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	filter "github.com/pingcap/tidb-tools/pkg/table-filter"
+)
+
+// TopicDispatcher is an abstraction for dispatching tables into different topics
+type TopicDispatcher interface {
+	// DispatchTopic returns the topic that the events of the table are sent to.
+	// An empty table means a schema level event, which goes to the default topic.
+	DispatchTopic(schema, table string) string
+}
+
+const (
+	schemaPlaceholder = "{schema}"
+	tablePlaceholder  = "{table}"
+	// maxTopicNameLength is the longest topic name Kafka accepts.
+	maxTopicNameLength = 249
+)
+
+var (
+	// topicExprRE restricts a topic expression to at most one {schema} and one
+	// {table} placeholder surrounded by characters that are legal in a topic name.
+	topicExprRE = regexp.MustCompile(
+		`^[A-Za-z0-9._\-]*(\{schema\})?[A-Za-z0-9._\-]*(\{table\})?[A-Za-z0-9._\-]*$`)
+	// invalidTopicCharRE matches characters that are illegal in a topic name.
+	invalidTopicCharRE = regexp.MustCompile(`[^A-Za-z0-9._\-]`)
+)
+
+// topicExpression is a template like "cdc_{schema}_{table}" that
+// produces the topic name of a table.
+type topicExpression string
+
+func (e topicExpression) validate() error {
+	if !topicExprRE.MatchString(string(e)) {
+		return cerror.ErrDispatcherTopicInvalid.GenWithStack(
+			"invalid topic expression %q, only {schema} and {table} placeholders "+
+				"and the characters [A-Za-z0-9._-] are allowed", e)
+	}
+	return nil
+}
+
+// substitute replaces the placeholders with the schema and table names,
+// characters that are illegal in a topic name are replaced with '_'.
+func (e topicExpression) substitute(schema, table string) string {
+	topic := strings.Replace(string(e), schemaPlaceholder, schema, 1)
+	topic = strings.Replace(topic, tablePlaceholder, table, 1)
+	topic = invalidTopicCharRE.ReplaceAllString(topic, "_")
+	if len(topic) > maxTopicNameLength {
+		topic = topic[:maxTopicNameLength]
+	}
+	return topic
+}
+
+type topicSwitcher struct {
+	defaultTopic string
+	rules        []struct {
+		topicExpression
+		filter.Filter
+	}
+}
+
+func (s *topicSwitcher) DispatchTopic(schema, table string) string {
+	if table == "" {
+		return s.defaultTopic
+	}
+	for _, rule := range s.rules {
+		if !rule.MatchTable(schema, table) {
+			continue
+		}
+		if rule.topicExpression == "" {
+			return s.defaultTopic
+		}
+		return rule.substitute(schema, table)
+	}
+	return s.defaultTopic
+}
+
+// NewTopicDispatcher creates a new topic dispatcher, tables that are not
+// covered by any topic rule are dispatched to the default topic.
+func NewTopicDispatcher(cfg *config.ReplicaConfig, defaultTopic string) (TopicDispatcher, error) {
+	rules := make([]struct {
+		topicExpression
+		filter.Filter
+	}, 0, len(cfg.Sink.DispatchRules))
+
+	for _, ruleConfig := range cfg.Sink.DispatchRules {
+		f, err := filter.Parse(ruleConfig.Matcher)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err)
+		}
+		if !cfg.CaseSensitive {
+			f = filter.CaseInsensitive(f)
+		}
+		expr := topicExpression(ruleConfig.Topic)
+		if err := expr.validate(); err != nil {
+			return nil, err
+		}
+		rules = append(rules, struct {
+			topicExpression
+			filter.Filter
+		}{topicExpression: expr, Filter: f})
+	}
+	return &topicSwitcher{
+		defaultTopic: defaultTopic,
+		rules:        rules,
+	}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatcher
+
+import (
+	"strings"
+
+	"github.com/pingcap/check"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/util/testleak"
+)
+
+type TopicSuite struct{}
+
+var _ = check.Suite(&TopicSuite{})
+
+func (s TopicSuite) TestTopicExpression(c *check.C) {
+	defer testleak.AfterTest(c)()
+	for _, expr := range []string{
+		"", "cdc", "cdc_{schema}", "cdc_{table}", "{schema}_{table}", "cdc-{schema}.{table}_v1",
+	} {
+		c.Assert(topicExpression(expr).validate(), check.IsNil)
+	}
+	for _, expr := range []string{
+		"{table}_{schema}", "cdc_{schema}_{schema}", "cdc {schema}", "cdc_{db}", "cdc_{schema",
+	} {
+		c.Assert(topicExpression(expr).validate(), check.ErrorMatches, ".*invalid topic expression.*")
+	}
+
+	c.Assert(topicExpression("cdc_{schema}_{table}").substitute("test", "t1"), check.Equals, "cdc_test_t1")
+	c.Assert(topicExpression("cdc_{schema}").substitute("test", "t1"), check.Equals, "cdc_test")
+	c.Assert(topicExpression("{schema}.{table}").substitute("测试", "t 1"), check.Equals, "__.t_1")
+	topic := topicExpression("{schema}_{table}").substitute(strings.Repeat("a", 200), strings.Repeat("b", 200))
+	c.Assert(topic, check.HasLen, maxTopicNameLength)
+}
+
+func (s TopicSuite) TestTopicDispatcher(c *check.C) {
+	defer testleak.AfterTest(c)()
+	d, err := NewTopicDispatcher(config.GetDefaultReplicaConfig(), "default")
+	c.Assert(err, check.IsNil)
+	c.Assert(d.DispatchTopic("test", "t1"), check.Equals, "default")
+
+	d, err = NewTopicDispatcher(&config.ReplicaConfig{
+		Sink: &config.SinkConfig{
+			DispatchRules: []*config.DispatchRule{
+				{Matcher: []string{"test_default.*"}, Dispatcher: "ts"},
+				{Matcher: []string{"test_schema.*"}, Topic: "schema_{schema}"},
+				{Matcher: []string{"test.*", "!test.t2"}, Topic: "cdc_{schema}_{table}"},
+			},
+		},
+	}, "default")
+	c.Assert(err, check.IsNil)
+	c.Assert(d.DispatchTopic("test_default", "t1"), check.Equals, "default")
+	c.Assert(d.DispatchTopic("test_schema", "t1"), check.Equals, "schema_test_schema")
+	c.Assert(d.DispatchTopic("test_schema", "t2"), check.Equals, "schema_test_schema")
+	c.Assert(d.DispatchTopic("test", "t1"), check.Equals, "cdc_test_t1")
+	c.Assert(d.DispatchTopic("TEST", "T1"), check.Equals, "cdc_TEST_T1")
+	c.Assert(d.DispatchTopic("test", "t2"), check.Equals, "default")
+	c.Assert(d.DispatchTopic("test", ""), check.Equals, "default")
+	c.Assert(d.DispatchTopic("other", "t1"), check.Equals, "default")
+
+	_, err = NewTopicDispatcher(&config.ReplicaConfig{
+		Sink: &config.SinkConfig{
+			DispatchRules: []*config.DispatchRule{
+				{Matcher: []string{"test.*"}, Topic: "cdc_{db}"},
+			},
+		},
+	}, "default")
+	c.Assert(err, check.ErrorMatches, ".*CDC:ErrDispatcherTopicInvalid.*")
+}
@@ -47,6 +47,9 @@ type Manager struct {
 	captureAddr               string
 	changefeedID              model.ChangeFeedID
 	metricsTableSinkTotalRows prometheus.Counter
+
+	// limiter throttles the rows emitted by all the table sinks.
+	limiter *rateLimiter
 }
 
 // NewManager creates a new Sink manager
@@ -63,6 +66,18 @@ func NewManager(
 		captureAddr:               captureAddr,
 		changefeedID:              changefeedID,
 		metricsTableSinkTotalRows: tableSinkTotalRowsCountCounter.WithLabelValues(captureAddr, changefeedID),
+		limiter:                   newRateLimiter(throttledDurationCounter.WithLabelValues(captureAddr, changefeedID)),
+	}
+}
+
+// UpdateRateLimit updates the max rows and bytes per second emitted by all the
+// table sinks, zero means unlimited. It takes effect without recreating the sinks.
+func (m *Manager) UpdateRateLimit(maxRowsPerSecond, maxBytesPerSecond uint64) {
+	if m.limiter.setLimit(maxRowsPerSecond, maxBytesPerSecond) {
+		log.Info("sink rate limit is updated",
+			zap.String("changefeed", m.changefeedID),
+			zap.Uint64("maxRowsPerSecond", maxRowsPerSecond),
+			zap.Uint64("maxBytesPerSecond", maxBytesPerSecond))
 	}
 }
 
@@ -87,6 +102,7 @@ func (m *Manager) CreateTableSink(tableID model.TableID, checkpointTs model.Ts,
 // Close closes the Sink manager and backend Sink, this method can be reentrantly called
 func (m *Manager) Close(ctx context.Context) error {
 	tableSinkTotalRowsCountCounter.DeleteLabelValues(m.captureAddr, m.changefeedID)
+	throttledDurationCounter.DeleteLabelValues(m.captureAddr, m.changefeedID)
 	return m.backendSink.Close(ctx)
 }
 
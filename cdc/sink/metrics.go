@@ -109,6 +109,14 @@ var (
 			Name:      "buffer_sink_total_rows_count",
 			Help:      "The total count of rows that are processed by buffer sink",
 		}, []string{"capture", "changefeed"})
+
+	throttledDurationCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "sink",
+			Name:      "throttled_duration_seconds",
+			Help:      "The total time (s) that rows are throttled by the rate limit of changefeed",
+		}, []string{"capture", "changefeed"})
 )
 
 // InitMetrics registers all metrics in this file
@@ -125,4 +133,5 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(bufferChanSizeGauge)
 	registry.MustRegister(tableSinkTotalRowsCountCounter)
 	registry.MustRegister(bufferSinkTotalRowsCountCounter)
+	registry.MustRegister(throttledDurationCounter)
 }
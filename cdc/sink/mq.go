@@ -17,6 +17,7 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -24,10 +25,12 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/cdc/model"
 	"github.com/pingcap/ticdc/cdc/sink/codec"
+	"github.com/pingcap/ticdc/cdc/sink/columnselector"
 	"github.com/pingcap/ticdc/cdc/sink/dispatcher"
 	"github.com/pingcap/ticdc/cdc/sink/producer"
 	"github.com/pingcap/ticdc/cdc/sink/producer/kafka"
 	"github.com/pingcap/ticdc/cdc/sink/producer/pulsar"
+	"github.com/pingcap/ticdc/cdc/sink/producer/webhook"
 	"github.com/pingcap/ticdc/pkg/config"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/filter"
@@ -35,9 +38,11 @@ import (
 	"github.com/pingcap/ticdc/pkg/security"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
 )
 
 type mqEvent struct {
+	topic      string
 	row        *model.RowChangedEvent
 	resolvedTs uint64
 }
@@ -46,19 +51,39 @@ const (
 	defaultPartitionInputChSize = 12800
 	// -1 means broadcast to all partitions, it's the default for the default open protocol.
 	defaultDDLDispatchPartition = -1
+	// defaultSchemaChangeTopicSuffix is appended to the sink-uri topic to name
+	// the schema change topic of the Debezium protocol.
+	defaultSchemaChangeTopicSuffix = "-schema-changes"
 )
 
 type mqSink struct {
-	mqProducer     producer.Producer
-	dispatcher     dispatcher.Dispatcher
-	encoderBuilder codec.EncoderBuilder
-	filter         *filter.Filter
-	protocol       codec.Protocol
+	mqProducer      producer.Producer
+	dispatcher      dispatcher.Dispatcher
+	topicDispatcher dispatcher.TopicDispatcher
+	encoderBuilder  codec.EncoderBuilder
+	filter          *filter.Filter
+	columnSelectors *columnselector.ColumnSelectors
+	protocol        codec.Protocol
+	// txnProducer is the mqProducer if it's transactional, the rows of every
+	// table are encoded and sent separately, and they're only committed by
+	// FlushRowChangedEvents.
+	txnProducer producer.TransactionalProducer
 
 	partitionNum        int32
 	partitionInput      []chan mqEvent
 	partitionResolvedTs []uint64
 
+	// topicsMu protects topics, which records all the topics used by the sink.
+	// Resolved and checkpoint events are broadcast to all of them.
+	topicsMu sync.Mutex
+	topics   map[string]struct{}
+	// topicCreation creates a topic only once for the concurrent callers
+	// without holding topicsMu.
+	topicCreation singleflight.Group
+	// schemaChangeTopic is the topic DDL events are sent to instead of the
+	// topics of the tables, only used by the Debezium protocol.
+	schemaChangeTopic string
+
 	checkpointTs     uint64
 	resolvedNotifier *notify.Notifier
 	resolvedReceiver *notify.Receiver
@@ -67,7 +92,7 @@ type mqSink struct {
 }
 
 func newMqSink(
-	ctx context.Context, credential *security.Credential, mqProducer producer.Producer,
+	ctx context.Context, credential *security.Credential, mqProducer producer.Producer, defaultTopic string,
 	filter *filter.Filter, config *config.ReplicaConfig, opts map[string]string, errCh chan error,
 ) (*mqSink, error) {
 	var protocol codec.Protocol
@@ -92,6 +117,27 @@ func newMqSink(
 		return nil, errors.Trace(err)
 	}
 
+	topicDispatcher, err := dispatcher.NewTopicDispatcher(config, defaultTopic)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	columnSelectors, err := columnselector.New(config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var schemaChangeTopic string
+	if protocol == codec.ProtocolDebezium {
+		schemaChangeTopic = opts["schema-change-topic"]
+		if schemaChangeTopic == "" {
+			schemaChangeTopic = defaultTopic + defaultSchemaChangeTopicSuffix
+		}
+		if err := mqProducer.CreateTopic(schemaChangeTopic); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
 	partitionInput := make([]chan mqEvent, partitionNum)
 	for i := 0; i < int(partitionNum); i++ {
 		partitionInput[i] = make(chan mqEvent, defaultPartitionInputChSize)
@@ -103,16 +149,22 @@ func newMqSink(
 		return nil, errors.Trace(err)
 	}
 
+	txnProducer, _ := mqProducer.(producer.TransactionalProducer)
 	s := &mqSink{
-		mqProducer:     mqProducer,
-		dispatcher:     d,
-		encoderBuilder: encoderBuilder,
-		filter:         filter,
-		protocol:       protocol,
+		mqProducer:      mqProducer,
+		dispatcher:      d,
+		topicDispatcher: topicDispatcher,
+		encoderBuilder:  encoderBuilder,
+		filter:          filter,
+		columnSelectors: columnSelectors,
+		protocol:        protocol,
+		txnProducer:     txnProducer,
 
 		partitionNum:        partitionNum,
 		partitionInput:      partitionInput,
 		partitionResolvedTs: make([]uint64, partitionNum),
+		topics:              map[string]struct{}{defaultTopic: {}},
+		schemaChangeTopic:   schemaChangeTopic,
 		resolvedNotifier:    notifier,
 		resolvedReceiver:    resolvedReceiver,
 
@@ -140,14 +192,18 @@ func (k *mqSink) EmitRowChangedEvents(ctx context.Context, rows ...*model.RowCha
 			log.Info("Row changed event ignored", zap.Uint64("start-ts", row.StartTs))
 			continue
 		}
+		// dispatch the row before the columns are removed by the column
+		// selectors, the dispatchers may hash the removed columns.
 		partition := k.dispatcher.Dispatch(row)
+		row = k.columnSelectors.Apply(row)
+		topic, err := k.getTopic(row.Table.Schema, row.Table.Table)
+		if err != nil {
+			return errors.Trace(err)
+		}
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case k.partitionInput[partition] <- struct {
-			row        *model.RowChangedEvent
-			resolvedTs uint64
-		}{row: row}:
+		case k.partitionInput[partition] <- mqEvent{topic: topic, row: row}:
 		}
 		rowsCount++
 	}
@@ -164,10 +220,7 @@ func (k *mqSink) FlushRowChangedEvents(ctx context.Context, resolvedTs uint64) (
 		select {
 		case <-ctx.Done():
 			return 0, ctx.Err()
-		case k.partitionInput[i] <- struct {
-			row        *model.RowChangedEvent
-			resolvedTs uint64
-		}{resolvedTs: resolvedTs}:
+		case k.partitionInput[i] <- mqEvent{resolvedTs: resolvedTs}:
 		}
 	}
 
@@ -207,8 +260,79 @@ func (k *mqSink) EmitCheckpointTs(ctx context.Context, ts uint64) error {
 	if msg == nil {
 		return nil
 	}
-	err = k.writeToProducer(ctx, msg, codec.EncoderNeedSyncWrite, -1)
-	return errors.Trace(err)
+	for _, topic := range k.allTopics() {
+		err = k.writeToProducer(ctx, topic, msg, codec.EncoderNeedSyncWrite, -1)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// getTopic returns the topic of the table, and makes sure the topic
+// exists before it's used for the first time.
+func (k *mqSink) getTopic(schema, table string) (string, error) {
+	topic := k.topicDispatcher.DispatchTopic(schema, table)
+	k.topicsMu.Lock()
+	_, ok := k.topics[topic]
+	k.topicsMu.Unlock()
+	if ok {
+		return topic, nil
+	}
+	// creating a topic may take a while, the rows of the existing topics
+	// should not wait for it.
+	_, err, _ := k.topicCreation.Do(topic, func() (interface{}, error) {
+		if err := k.mqProducer.CreateTopic(topic); err != nil {
+			return nil, errors.Trace(err)
+		}
+		k.topicsMu.Lock()
+		k.topics[topic] = struct{}{}
+		k.topicsMu.Unlock()
+		return nil, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return topic, nil
+}
+
+// allTopics returns all the topics that have been used by the sink.
+func (k *mqSink) allTopics() []string {
+	k.topicsMu.Lock()
+	defer k.topicsMu.Unlock()
+	topics := make([]string, 0, len(k.topics))
+	for topic := range k.topics {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+// ddlTopics returns the topics that a DDL event should be sent to. A DDL of
+// a table goes to the topics of the table before and after the DDL, and a
+// schema level DDL is broadcast to all topics. If there is a schema change
+// topic, all DDLs go to it only.
+func (k *mqSink) ddlTopics(ddl *model.DDLEvent) ([]string, error) {
+	if k.schemaChangeTopic != "" {
+		return []string{k.schemaChangeTopic}, nil
+	}
+	if ddl.TableInfo == nil || ddl.TableInfo.Table == "" {
+		return k.allTopics(), nil
+	}
+	topic, err := k.getTopic(ddl.TableInfo.Schema, ddl.TableInfo.Table)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	topics := []string{topic}
+	if ddl.PreTableInfo != nil && ddl.PreTableInfo.Table != "" {
+		preTopic, err := k.getTopic(ddl.PreTableInfo.Schema, ddl.PreTableInfo.Table)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if preTopic != topic {
+			topics = append(topics, preTopic)
+		}
+	}
+	return topics, nil
 }
 
 func (k *mqSink) EmitDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
@@ -242,16 +366,36 @@ func (k *mqSink) EmitDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
 	if _, ok := encoder.(*codec.CanalEventBatchEncoder); ok {
 		partition = 0
 	}
+	// for Debezium, send to partition 0 of the schema change topic.
+	if _, ok := encoder.(*codec.DebeziumEventBatchEncoder); ok {
+		partition = 0
+	}
+
+	topics, err := k.ddlTopics(ddl)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	k.statistics.AddDDLCount()
-	log.Debug("emit ddl event", zap.String("query", ddl.Query), zap.Uint64("commit-ts", ddl.CommitTs), zap.Int32("partition", partition))
-	err = k.writeToProducer(ctx, msg, codec.EncoderNeedSyncWrite, partition)
-	return errors.Trace(err)
+	for _, topic := range topics {
+		log.Debug("emit ddl event", zap.String("query", ddl.Query), zap.Uint64("commit-ts", ddl.CommitTs),
+			zap.String("topic", topic), zap.Int32("partition", partition))
+		err = k.writeToProducer(ctx, topic, msg, codec.EncoderNeedSyncWrite, partition)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
 }
 
-// Initialize registers Avro schemas for all tables
+// Initialize makes sure the topics of all tables exist, so that the
+// checkpoint events can be broadcast to all of them.
 func (k *mqSink) Initialize(ctx context.Context, tableInfo []*model.SimpleTableInfo) error {
-	// No longer need it for now
+	for _, table := range tableInfo {
+		if _, err := k.getTopic(table.Schema, table.Table); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return nil
 }
 
@@ -280,16 +424,32 @@ func (k *mqSink) run(ctx context.Context) error {
 
 const batchSizeLimit = 4 * 1024 * 1024 // 4MB
 
+// encoderKey identifies an encoder of a worker. The encoders are per topic,
+// and also per table for a transactional producer.
+type encoderKey struct {
+	topic   string
+	tableID model.TableID
+}
+
 func (k *mqSink) runWorker(ctx context.Context, partition int32) error {
 	input := k.partitionInput[partition]
-	encoder, err := k.encoderBuilder.Build(ctx)
-	if err != nil {
-		return errors.Trace(err)
+	// encoders holds an encoder for each topic written by this worker.
+	encoders := make(map[encoderKey]codec.EventBatchEncoder)
+	getEncoder := func(key encoderKey) (codec.EventBatchEncoder, error) {
+		if encoder, ok := encoders[key]; ok {
+			return encoder, nil
+		}
+		encoder, err := k.encoderBuilder.Build(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		encoders[key] = encoder
+		return encoder, nil
 	}
 	tick := time.NewTicker(500 * time.Millisecond)
 	defer tick.Stop()
 
-	flushToProducer := func(op codec.EncoderResult) error {
+	flushToProducer := func(key encoderKey, encoder codec.EventBatchEncoder, op codec.EncoderResult) error {
 		return k.statistics.RecordBatchExecution(func() (int, error) {
 			messages := encoder.Build()
 			thisBatchSize := len(messages)
@@ -298,19 +458,25 @@ func (k *mqSink) runWorker(ctx context.Context, partition int32) error {
 			}
 
 			for _, msg := range messages {
-				err := k.writeToProducer(ctx, msg, codec.EncoderNeedAsyncWrite, partition)
+				var err error
+				if k.txnProducer != nil {
+					err = k.txnProducer.AsyncSendTableMessage(ctx, key.tableID, key.topic, msg, partition)
+				} else {
+					err = k.writeToProducer(ctx, key.topic, msg, codec.EncoderNeedAsyncWrite, partition)
+				}
 				if err != nil {
 					return 0, err
 				}
 			}
 
-			if op == codec.EncoderNeedSyncWrite {
+			// the transactions are only committed by FlushRowChangedEvents.
+			if op == codec.EncoderNeedSyncWrite && k.txnProducer == nil {
 				err := k.mqProducer.Flush(ctx)
 				if err != nil {
 					return 0, err
 				}
 			}
-			log.Debug("MQSink flushed", zap.Int("thisBatchSize", thisBatchSize))
+			log.Debug("MQSink flushed", zap.String("topic", key.topic), zap.Int("thisBatchSize", thisBatchSize))
 			return thisBatchSize, nil
 		})
 	}
@@ -320,21 +486,43 @@ func (k *mqSink) runWorker(ctx context.Context, partition int32) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-tick.C:
-			if err := flushToProducer(codec.EncoderNeedAsyncWrite); err != nil {
-				return errors.Trace(err)
+			for key, encoder := range encoders {
+				if err := flushToProducer(key, encoder, codec.EncoderNeedAsyncWrite); err != nil {
+					return errors.Trace(err)
+				}
 			}
 			continue
 		case e = <-input:
 		}
 		if e.row == nil {
 			if e.resolvedTs != 0 {
-				op, err := encoder.AppendResolvedEvent(e.resolvedTs)
-				if err != nil {
-					return errors.Trace(err)
+				// The resolved event is sent to this partition of every topic,
+				// including the topics not written by this worker yet. The
+				// encoders of a transactional producer only encode rows, so
+				// only the existing ones are flushed.
+				var keys []encoderKey
+				if k.txnProducer == nil {
+					for _, topic := range k.allTopics() {
+						keys = append(keys, encoderKey{topic: topic})
+					}
+				} else {
+					for key := range encoders {
+						keys = append(keys, key)
+					}
 				}
-
-				if err := flushToProducer(op); err != nil {
-					return errors.Trace(err)
+				for _, key := range keys {
+					encoder, err := getEncoder(key)
+					if err != nil {
+						return errors.Trace(err)
+					}
+					op, err := encoder.AppendResolvedEvent(e.resolvedTs)
+					if err != nil {
+						return errors.Trace(err)
+					}
+
+					if err := flushToProducer(key, encoder, op); err != nil {
+						return errors.Trace(err)
+					}
 				}
 
 				atomic.StoreUint64(&k.partitionResolvedTs[partition], e.resolvedTs)
@@ -342,6 +530,14 @@ func (k *mqSink) runWorker(ctx context.Context, partition int32) error {
 			}
 			continue
 		}
+		key := encoderKey{topic: e.topic}
+		if k.txnProducer != nil {
+			key.tableID = e.row.Table.TableID
+		}
+		encoder, err := getEncoder(key)
+		if err != nil {
+			return errors.Trace(err)
+		}
 		op, err := encoder.AppendRowChangedEvent(e.row)
 		if err != nil {
 			return errors.Trace(err)
@@ -352,32 +548,35 @@ func (k *mqSink) runWorker(ctx context.Context, partition int32) error {
 		}
 
 		if encoder.Size() >= batchSizeLimit || op != codec.EncoderNoOperation {
-			if err := flushToProducer(op); err != nil {
+			if err := flushToProducer(key, encoder, op); err != nil {
 				return errors.Trace(err)
 			}
 		}
 	}
 }
 
-func (k *mqSink) writeToProducer(ctx context.Context, message *codec.MQMessage, op codec.EncoderResult, partition int32) error {
+func (k *mqSink) writeToProducer(
+	ctx context.Context, topic string, message *codec.MQMessage, op codec.EncoderResult, partition int32,
+) error {
 	switch op {
 	case codec.EncoderNeedAsyncWrite:
 		if partition >= 0 {
-			return k.mqProducer.AsyncSendMessage(ctx, message, partition)
+			return k.mqProducer.AsyncSendMessage(ctx, topic, message, partition)
 		}
 		return cerror.ErrAsyncBroadcastNotSupport.GenWithStackByArgs()
 	case codec.EncoderNeedSyncWrite:
 		if partition >= 0 {
-			err := k.mqProducer.AsyncSendMessage(ctx, message, partition)
+			err := k.mqProducer.AsyncSendMessage(ctx, topic, message, partition)
 			if err != nil {
 				return err
 			}
 			return k.mqProducer.Flush(ctx)
 		}
-		return k.mqProducer.SyncBroadcastMessage(ctx, message)
+		return k.mqProducer.SyncBroadcastMessage(ctx, topic, message)
 	}
 
 	log.Warn("writeToProducer called with no-op",
+		zap.String("topic", topic),
 		zap.ByteString("key", message.Key),
 		zap.ByteString("value", message.Value),
 		zap.Int32("partition", partition))
@@ -399,11 +598,17 @@ func newKafkaSaramaSink(ctx context.Context, sinkURI *url.URL, filter *filter.Fi
 
 	var protocol codec.Protocol
 	protocol.FromString(replicaConfig.Sink.Protocol)
-	producer, err := kafka.NewKafkaSaramaProducer(ctx, topic, protocol, config, errCh)
+	var mqProducer producer.Producer
+	var err error
+	if config.TransactionalID != "" {
+		mqProducer, err = kafka.NewKafkaTransactionalProducer(ctx, topic, protocol, config)
+	} else {
+		mqProducer, err = kafka.NewKafkaSaramaProducer(ctx, topic, protocol, config, errCh)
+	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	sink, err := newMqSink(ctx, config.Credential, producer, filter, replicaConfig, opts, errCh)
+	sink, err := newMqSink(ctx, config.Credential, mqProducer, topic, filter, replicaConfig, opts, errCh)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -419,7 +624,7 @@ func newPulsarSink(ctx context.Context, sinkURI *url.URL, filter *filter.Filter,
 	if s != "" {
 		replicaConfig.Sink.Protocol = s
 	}
-	// These two options are not used by Pulsar producer itself, but the encoders
+	// These options are not used by Pulsar producer itself, but the encoders
 	s = sinkURI.Query().Get("max-message-bytes")
 	if s != "" {
 		opts["max-message-bytes"] = s
@@ -429,10 +634,38 @@ func newPulsarSink(ctx context.Context, sinkURI *url.URL, filter *filter.Filter,
 	if s != "" {
 		opts["max-batch-size"] = s
 	}
+
+	s = sinkURI.Query().Get("enable-debezium-schema")
+	if s != "" {
+		opts["enable-debezium-schema"] = s
+	}
+
+	s = sinkURI.Query().Get("schema-change-topic")
+	if s != "" {
+		opts["schema-change-topic"] = s
+	}
 	// For now, it's a placeholder. Avro format have to make connection to Schema Registry,
 	// and it may need credential.
 	credential := &security.Credential{}
-	sink, err := newMqSink(ctx, credential, producer, filter, replicaConfig, opts, errCh)
+	sink, err := newMqSink(ctx, credential, producer, producer.DefaultTopic(), filter, replicaConfig, opts, errCh)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sink, nil
+}
+
+func newWebhookSink(ctx context.Context, sinkURI *url.URL, filter *filter.Filter, replicaConfig *config.ReplicaConfig, opts map[string]string, errCh chan error) (*mqSink, error) {
+	config := webhook.NewConfig()
+	if err := config.Initialize(sinkURI, replicaConfig, opts); err != nil {
+		return nil, errors.Trace(err)
+	}
+	producer, err := webhook.NewProducer(config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// the topic is only a field of the messages posted to the endpoint.
+	topic := strings.Trim(sinkURI.Path, "/")
+	sink, err := newMqSink(ctx, config.Credential, producer, topic, filter, replicaConfig, opts, errCh)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -0,0 +1,79 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/cdc/sink/codec"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// mqSyncpointStore broadcasts the syncpoints to all the partitions of all the
+// topics used by the MQ sink. A syncpoint is only sent after the checkpoint
+// reaches it, so all the rows committed before the syncpoint precede it in
+// every partition, and the consumers can materialize consistent cuts by it.
+type mqSyncpointStore struct {
+	sink *mqSink
+}
+
+func newMQSyncpointStore(ctx context.Context, s *mqSink) (SyncpointStore, error) {
+	encoder, err := s.encoderBuilder.Build(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	msg, err := encoder.EncodeSyncpointEvent(0)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if msg == nil {
+		return nil, cerror.ErrSinkInvalidConfig.GenWithStack(
+			"the syncpoint is not supported by the protocol of the sink, " +
+				"please use open-protocol, craft, protobuf or canal-json with enable-tidb-extension")
+	}
+	return &mqSyncpointStore{sink: s}, nil
+}
+
+// CreateSynctable is no-op, the syncpoints are sent as messages.
+func (s *mqSyncpointStore) CreateSynctable(ctx context.Context) error {
+	return nil
+}
+
+// SinkSyncpoint implements the SyncpointStore interface
+func (s *mqSyncpointStore) SinkSyncpoint(ctx context.Context, id string, checkpointTs uint64) error {
+	encoder, err := s.sink.encoderBuilder.Build(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	msg, err := encoder.EncodeSyncpointEvent(checkpointTs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, topic := range s.sink.allTopics() {
+		err = s.sink.writeToProducer(ctx, topic, msg, codec.EncoderNeedSyncWrite, -1)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	log.Info("syncpoint broadcast", zap.String("changefeed", id), zap.Uint64("ts", checkpointTs))
+	return nil
+}
+
+// Close is no-op, the producer is closed by the sink.
+func (s *mqSyncpointStore) Close() error {
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/sink/codec"
+	"github.com/stretchr/testify/require"
+)
+
+type broadcastRecorder struct {
+	broadcast map[string][]*codec.MQMessage
+}
+
+func (p *broadcastRecorder) AsyncSendMessage(ctx context.Context, topic string, message *codec.MQMessage, partition int32) error {
+	return nil
+}
+
+func (p *broadcastRecorder) SyncBroadcastMessage(ctx context.Context, topic string, message *codec.MQMessage) error {
+	p.broadcast[topic] = append(p.broadcast[topic], message)
+	return nil
+}
+
+func (p *broadcastRecorder) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (p *broadcastRecorder) GetPartitionNum() int32 {
+	return 1
+}
+
+func (p *broadcastRecorder) CreateTopic(topic string) error {
+	return nil
+}
+
+func (p *broadcastRecorder) Close() error {
+	return nil
+}
+
+func TestMQSyncpointStore(t *testing.T) {
+	ctx := context.Background()
+	newSink := func(protocol codec.Protocol) (*mqSink, *broadcastRecorder) {
+		builder, err := codec.NewEventBatchEncoderBuilder(protocol, nil, map[string]string{})
+		require.Nil(t, err)
+		p := &broadcastRecorder{broadcast: make(map[string][]*codec.MQMessage)}
+		return &mqSink{
+			mqProducer:     p,
+			encoderBuilder: builder,
+			protocol:       protocol,
+			topics:         map[string]struct{}{"t1": {}, "t2": {}},
+		}, p
+	}
+
+	s, _ := newSink(codec.ProtocolMaxwell)
+	_, err := newMQSyncpointStore(ctx, s)
+	require.Regexp(t, ".*the syncpoint is not supported by the protocol.*", err)
+
+	s, p := newSink(codec.ProtocolDefault)
+	store, err := newMQSyncpointStore(ctx, s)
+	require.Nil(t, err)
+	require.Nil(t, store.CreateSynctable(ctx))
+	require.Nil(t, store.SinkSyncpoint(ctx, "test", 417318403368288260))
+	require.Len(t, p.broadcast, 2)
+	for _, topic := range []string{"t1", "t2"} {
+		require.Len(t, p.broadcast[topic], 1)
+		msg := p.broadcast[topic][0]
+		require.Equal(t, model.MqMessageTypeSyncpoint, msg.Type)
+		decoder, err := codec.NewJSONEventBatchDecoder(msg.Key, msg.Value)
+		require.Nil(t, err)
+		ty, hasNext, err := decoder.HasNext()
+		require.Nil(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MqMessageTypeSyncpoint, ty)
+		ts, err := decoder.NextSyncpointEvent()
+		require.Nil(t, err)
+		require.Equal(t, uint64(417318403368288260), ts)
+	}
+	require.Nil(t, store.Close())
+}
@@ -15,11 +15,17 @@ package sink
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"sync"
+	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/ticdc/cdc/sink/codec"
+	"github.com/pingcap/ticdc/cdc/sink/producer/webhook"
 
 	"github.com/Shopify/sarama"
 	"github.com/pingcap/check"
@@ -233,3 +239,274 @@ func (s mqSinkSuite) TestPulsarSinkEncoderConfig(c *check.C) {
 	c.Assert(encoder.(*codec.JSONEventBatchEncoder).GetMaxBatchSize(), check.Equals, 1)
 	c.Assert(encoder.(*codec.JSONEventBatchEncoder).GetMaxMessageSize(), check.Equals, 4194304)
 }
+
+func (s mqSinkSuite) TestWebhookSink(c *check.C) {
+	defer testleak.AfterTest(c)()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var messages []*webhook.Message
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		batch := &webhook.Batch{}
+		c.Assert(json.NewDecoder(r.Body).Decode(batch), check.IsNil)
+		messages = append(messages, batch.Messages...)
+	}))
+	defer server.Close()
+	setFailing := func(f bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		failing = f
+	}
+	popMessages := func() []*webhook.Message {
+		mu.Lock()
+		defer mu.Unlock()
+		ret := messages
+		messages = nil
+		return ret
+	}
+	decodeRow := func(msg *webhook.Message) *model.RowChangedEvent {
+		c.Assert(msg.Type, check.Equals, model.MqMessageTypeRow)
+		decoder, err := codec.NewJSONEventBatchDecoder(msg.Key, msg.Value)
+		c.Assert(err, check.IsNil)
+		_, hasNext, err := decoder.HasNext()
+		c.Assert(err, check.IsNil)
+		c.Assert(hasNext, check.IsTrue)
+		row, err := decoder.NextRowChangedEvent()
+		c.Assert(err, check.IsNil)
+		return row
+	}
+
+	sinkURI, err := url.Parse(server.URL + "/events?protocol=default&max-retry=0")
+	c.Assert(err, check.IsNil)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	fr, err := filter.NewFilter(replicaConfig)
+	c.Assert(err, check.IsNil)
+	errCh := make(chan error, 1)
+	sink, err := newWebhookSink(ctx, sinkURI, fr, replicaConfig, map[string]string{}, errCh)
+	c.Assert(err, check.IsNil)
+
+	newRow := func(commitTs uint64) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			Table:    &model.TableName{Schema: "test", Table: "t1"},
+			StartTs:  commitTs - 10,
+			CommitTs: commitTs,
+			Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+		}
+	}
+	c.Assert(sink.EmitRowChangedEvents(ctx, newRow(120)), check.IsNil)
+	checkpointTs, err := sink.FlushRowChangedEvents(ctx, 120)
+	c.Assert(err, check.IsNil)
+	c.Assert(checkpointTs, check.Equals, uint64(120))
+	posted := popMessages()
+	c.Assert(posted, check.HasLen, 1)
+	c.Assert(posted[0].Topic, check.Equals, "events")
+	c.Assert(decodeRow(posted[0]).CommitTs, check.Equals, uint64(120))
+
+	// the checkpoint doesn't advance until the endpoint accepts the rows.
+	setFailing(true)
+	c.Assert(sink.EmitRowChangedEvents(ctx, newRow(130)), check.IsNil)
+	_, err = sink.FlushRowChangedEvents(ctx, 130)
+	c.Assert(err, check.ErrorMatches, ".*ErrWebhookSendMessage.*")
+	c.Assert(sink.checkpointTs, check.Equals, uint64(120))
+
+	setFailing(false)
+	checkpointTs, err = sink.FlushRowChangedEvents(ctx, 130)
+	c.Assert(err, check.IsNil)
+	c.Assert(checkpointTs, check.Equals, uint64(130))
+	posted = popMessages()
+	c.Assert(posted, check.HasLen, 1)
+	c.Assert(decodeRow(posted[0]).CommitTs, check.Equals, uint64(130))
+
+	ddl := &model.DDLEvent{
+		StartTs:  140,
+		CommitTs: 150,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "test", Table: "t2",
+		},
+		Query: "create table test.t2",
+		Type:  1,
+	}
+	c.Assert(sink.EmitDDLEvent(ctx, ddl), check.IsNil)
+	posted = popMessages()
+	c.Assert(posted, check.HasLen, 1)
+	c.Assert(posted[0].Type, check.Equals, model.MqMessageTypeDDL)
+	c.Assert(posted[0].CommitTs, check.Equals, uint64(150))
+
+	c.Assert(sink.Close(ctx), check.IsNil)
+}
+
+type tableTopicDispatcher struct{}
+
+func (tableTopicDispatcher) DispatchTopic(schema, table string) string {
+	return table
+}
+
+// slowTopicProducer blocks the creation of topic "slow" until released.
+type slowTopicProducer struct {
+	broadcastRecorder
+	mu      sync.Mutex
+	created map[string]int
+	release chan struct{}
+}
+
+func (p *slowTopicProducer) CreateTopic(topic string) error {
+	p.mu.Lock()
+	p.created[topic]++
+	p.mu.Unlock()
+	if topic == "slow" {
+		<-p.release
+	}
+	return nil
+}
+
+func (p *slowTopicProducer) createdCount(topic string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.created[topic]
+}
+
+func (s mqSinkSuite) TestGetTopicConcurrently(c *check.C) {
+	defer testleak.AfterTest(c)()
+	p := &slowTopicProducer{created: make(map[string]int), release: make(chan struct{})}
+	sink := &mqSink{
+		mqProducer:      p,
+		topicDispatcher: tableTopicDispatcher{},
+		topics:          map[string]struct{}{"fast": {}},
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			topic, err := sink.getTopic("test", "slow")
+			if err == nil && topic != "slow" {
+				err = errors.Errorf("unexpected topic %s", topic)
+			}
+			errCh <- err
+		}()
+	}
+	for p.createdCount("slow") == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// the existing topic doesn't wait for the creation of the other topic
+	topic, err := sink.getTopic("test", "fast")
+	c.Assert(err, check.IsNil)
+	c.Assert(topic, check.Equals, "fast")
+	c.Assert(p.createdCount("fast"), check.Equals, 0)
+
+	time.Sleep(100 * time.Millisecond)
+	close(p.release)
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		c.Assert(err, check.IsNil)
+	}
+	// the topic is created only once
+	c.Assert(p.createdCount("slow"), check.Equals, 1)
+	c.Assert(sink.allTopics(), check.HasLen, 2)
+}
+
+// txnRecorder records the messages of the tables sent to a transactional
+// producer, and how many times it's flushed.
+type txnRecorder struct {
+	broadcastRecorder
+	mu      sync.Mutex
+	tables  map[model.TableID][]*codec.MQMessage
+	flushes int
+}
+
+func (p *txnRecorder) AsyncSendTableMessage(
+	ctx context.Context, tableID model.TableID, topic string, message *codec.MQMessage, partition int32,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.tables[tableID] = append(p.tables[tableID], message)
+	return nil
+}
+
+func (p *txnRecorder) Flush(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.flushes++
+	return nil
+}
+
+func (p *txnRecorder) flushCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.flushes
+}
+
+func (s mqSinkSuite) TestTransactionalProducer(c *check.C) {
+	defer testleak.AfterTest(c)()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	fr, err := filter.NewFilter(replicaConfig)
+	c.Assert(err, check.IsNil)
+	p := &txnRecorder{tables: make(map[model.TableID][]*codec.MQMessage)}
+	errCh := make(chan error, 1)
+	sink, err := newMqSink(ctx, nil, p, "test", fr, replicaConfig, map[string]string{}, errCh)
+	c.Assert(err, check.IsNil)
+
+	newRow := func(tableID model.TableID, table string, commitTs uint64) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			Table:    &model.TableName{Schema: "test", Table: table, TableID: tableID},
+			StartTs:  commitTs - 10,
+			CommitTs: commitTs,
+			Columns:  []*model.Column{{Name: "id", Type: 3, Value: 1}},
+		}
+	}
+	c.Assert(sink.EmitRowChangedEvents(ctx,
+		newRow(1, "t1", 100), newRow(2, "t2", 100), newRow(1, "t1", 110)), check.IsNil)
+	// the rows are sent by the tick, but they're not committed until the
+	// changefeed is flushed.
+	time.Sleep(time.Second)
+	c.Assert(p.flushCount(), check.Equals, 0)
+
+	checkpointTs, err := sink.FlushRowChangedEvents(ctx, 110)
+	c.Assert(err, check.IsNil)
+	c.Assert(checkpointTs, check.Equals, uint64(110))
+	c.Assert(p.flushCount(), check.Equals, 1)
+
+	// the rows of different tables are never batched in the same message.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	c.Assert(p.tables, check.HasLen, 2)
+	for tableID, messages := range p.tables {
+		var rows []*model.RowChangedEvent
+		for _, msg := range messages {
+			decoder, err := codec.NewJSONEventBatchDecoder(msg.Key, msg.Value)
+			c.Assert(err, check.IsNil)
+			for {
+				_, hasNext, err := decoder.HasNext()
+				c.Assert(err, check.IsNil)
+				if !hasNext {
+					break
+				}
+				row, err := decoder.NextRowChangedEvent()
+				c.Assert(err, check.IsNil)
+				rows = append(rows, row)
+			}
+		}
+		for _, row := range rows {
+			c.Assert(row.Table.Table, check.Equals, fmt.Sprintf("t%d", tableID))
+		}
+		if tableID == 1 {
+			c.Assert(rows, check.HasLen, 2)
+		} else {
+			c.Assert(rows, check.HasLen, 1)
+		}
+	}
+}
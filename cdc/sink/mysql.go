@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/sink/columnselector"
 	"github.com/pingcap/ticdc/cdc/sink/common"
 	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/cyclic"
@@ -74,6 +75,8 @@ type mysqlSink struct {
 	metricConflictDetectDurationHis prometheus.Observer
 	metricBucketSizeCounters        []prometheus.Counter
 
+	columnSelectors *columnselector.ColumnSelectors
+
 	forceReplicate bool
 	cancel         func()
 }
@@ -97,6 +100,23 @@ func newMySQLSink(
 
 	params.enableOldValue = replicaConfig.EnableOldValue
 
+	var cyclicConfig *config.CyclicConfig
+	if val, ok := opts[mark.OptCyclicConfig]; ok {
+		cyclicConfig = new(config.CyclicConfig)
+		err := cyclicConfig.Unmarshal([]byte(val))
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrMySQLInvalidConfig, err)
+		}
+		if cyclicConfig.IsTxnSourceUsed() {
+			params.txnSource = cyclicConfig.ReplicaID
+		}
+	}
+
+	columnSelectors, err := columnselector.New(replicaConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	// dsn format of the driver:
 	// [username[:password]@][protocol[(address)]]/dbname[?param1=value1&...&paramN=valueN]
 	username := sinkURI.User.Username()
@@ -163,17 +183,13 @@ func newMySQLSink(
 		metricConflictDetectDurationHis: metricConflictDetectDurationHis,
 		metricBucketSizeCounters:        metricBucketSizeCounters,
 		errCh:                           make(chan error, 1),
+		columnSelectors:                 columnSelectors,
 		forceReplicate:                  replicaConfig.ForceReplicate,
 		cancel:                          cancel,
 	}
 
-	if val, ok := opts[mark.OptCyclicConfig]; ok {
-		cfg := new(config.CyclicConfig)
-		err := cfg.Unmarshal([]byte(val))
-		if err != nil {
-			return nil, cerror.WrapError(cerror.ErrMySQLInvalidConfig, err)
-		}
-		sink.cyclic = cyclic.NewCyclic(cfg)
+	if cyclicConfig != nil {
+		sink.cyclic = cyclic.NewCyclic(cyclicConfig)
 
 		err = sink.adjustSQLMode(ctx)
 		if err != nil {
@@ -200,7 +216,11 @@ func newMySQLSink(
 }
 
 func (s *mysqlSink) EmitRowChangedEvents(ctx context.Context, rows ...*model.RowChangedEvent) error {
-	count := s.txnCache.Append(s.filter, rows...)
+	selectedRows := make([]*model.RowChangedEvent, 0, len(rows))
+	for _, row := range rows {
+		selectedRows = append(selectedRows, s.columnSelectors.Apply(row))
+	}
+	count := s.txnCache.Append(s.filter, selectedRows...)
 	s.statistics.AddRowsCount(count)
 	return nil
 }
@@ -256,7 +276,7 @@ func (s *mysqlSink) flushRowChangedEvents(ctx context.Context, receiver *notify.
 			continue
 		}
 
-		if s.cyclic != nil {
+		if s.cyclic != nil && !s.cyclic.UseTxnSource() {
 			// Filter rows if it is origin from downstream.
 			skippedRowCount := cyclic.FilterAndReduceTxns(
 				resolvedTxnsMap, s.cyclic.FilterReplicaID(), s.cyclic.ReplicaID())
@@ -696,7 +716,7 @@ func (s *mysqlSink) prepareDMLs(rows []*model.RowChangedEvent, replicaID uint64,
 		sqls:   sqls,
 		values: values,
 	}
-	if s.cyclic != nil && len(rows) > 0 {
+	if s.cyclic != nil && !s.cyclic.UseTxnSource() && len(rows) > 0 {
 		// Write mark table with the current replica ID.
 		row := rows[0]
 		updateMark := s.cyclic.UdpateSourceTableCyclicMark(
@@ -25,6 +25,7 @@ import (
 	dmysql "github.com/go-sql-driver/mysql"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/cyclic"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/security"
 	"github.com/pingcap/ticdc/pkg/util"
@@ -82,6 +83,9 @@ type sinkParams struct {
 	safeMode            bool
 	timezone            string
 	tls                 string
+	// txnSource tags the transactions written by the sink if it's not 0, see
+	// cyclic.TxnSourceSessionVar.
+	txnSource uint64
 }
 
 func (s *sinkParams) Clone() *sinkParams {
@@ -244,6 +248,19 @@ func generateDSNByParams(
 		dsnCfg.Params["tidb_txn_mode"] = txnMode
 	}
 
+	if params.txnSource != 0 {
+		txnSource, err := checkTiDBVariable(ctx, testDB, cyclic.TxnSourceSessionVar, strconv.FormatUint(params.txnSource, 10))
+		if err != nil {
+			return "", err
+		}
+		if txnSource == "" {
+			return "", cerror.ErrMySQLInvalidConfig.GenWithStack(
+				"the downstream doesn't support the session variable %s, "+
+					"which is required by the cyclic replication using the transaction source", cyclic.TxnSourceSessionVar)
+		}
+		dsnCfg.Params[cyclic.TxnSourceSessionVar] = txnSource
+	}
+
 	dsnClone := dsnCfg.Clone()
 	dsnClone.Passwd = "******"
 	log.Info("sink uri is configured", zap.String("format dsn", dsnClone.FormatDSN()))
@@ -119,9 +119,41 @@ func (s MySQLSinkSuite) TestGenerateDSNByParams(c *check.C) {
 		}
 	}
 
+	testTxnSourceParam := func(supported bool) {
+		db, mock, err := sqlmock.New()
+		c.Assert(err, check.IsNil)
+		defer db.Close()
+		columns := []string{"Variable_name", "Value"}
+		mock.ExpectQuery("show session variables like 'allow_auto_random_explicit_insert';").WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("allow_auto_random_explicit_insert", "0"),
+		)
+		mock.ExpectQuery("show session variables like 'tidb_txn_mode';").WillReturnRows(
+			sqlmock.NewRows(columns).AddRow("tidb_txn_mode", "pessimistic"),
+		)
+		rows := sqlmock.NewRows(columns)
+		if supported {
+			rows.AddRow("tidb_cdc_write_source", "0")
+		}
+		mock.ExpectQuery("show session variables like 'tidb_cdc_write_source';").WillReturnRows(rows)
+
+		dsn, err := dmysql.ParseDSN("root:123456@tcp(127.0.0.1:4000)/")
+		c.Assert(err, check.IsNil)
+		params := defaultParams.Clone()
+		params.txnSource = 2
+		dsnStr, err := generateDSNByParams(context.TODO(), dsn, params, db)
+		if !supported {
+			c.Assert(err, check.ErrorMatches, ".*doesn't support the session variable tidb_cdc_write_source.*")
+			return
+		}
+		c.Assert(err, check.IsNil)
+		c.Assert(strings.Contains(dsnStr, "tidb_cdc_write_source=2"), check.IsTrue)
+	}
+
 	testDefaultParams()
 	testTimezoneParam()
 	testTimeoutParams()
+	testTxnSourceParam(true)
+	testTxnSourceParam(false)
 }
 
 func (s MySQLSinkSuite) TestParseSinkURIToParams(c *check.C) {
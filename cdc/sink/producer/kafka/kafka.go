@@ -55,6 +55,8 @@ type Config struct {
 	SaslScram       *security.SaslScram
 	// control whether to create topic
 	AutoCreate bool
+	// TransactionalID enables the transactional producer if it's not empty.
+	TransactionalID string
 }
 
 // NewConfig returns a default Kafka configuration
@@ -179,6 +181,28 @@ func (c *Config) Initialize(sinkURI *url.URL, replicaConfig *config.ReplicaConfi
 		opts["enable-tidb-extension"] = s
 	}
 
+	c.TransactionalID = params.Get("transactional-id")
+
+	s = params.Get("enable-debezium-schema")
+	if s != "" {
+		_, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		if replicaConfig.Sink.Protocol != "debezium" {
+			return cerror.WrapError(cerror.ErrKafkaInvalidConfig, errors.New("enable-debezium-schema only support debezium"))
+		}
+		opts["enable-debezium-schema"] = s
+	}
+
+	s = params.Get("schema-change-topic")
+	if s != "" {
+		if replicaConfig.Sink.Protocol != "debezium" {
+			return cerror.WrapError(cerror.ErrKafkaInvalidConfig, errors.New("schema-change-topic only support debezium"))
+		}
+		opts["schema-change-topic"] = s
+	}
+
 	return nil
 }
 
@@ -191,13 +215,17 @@ type kafkaSaramaProducer struct {
 	syncClient  sarama.SyncProducer
 	// producersReleased records whether asyncClient and syncClient have been closed properly
 	producersReleased bool
-	topic             string
 	partitionNum      int32
 
-	partitionOffset []struct {
-		flushed uint64
-		sent    uint64
-	}
+	// topicsLock protects topicOffsets.
+	topicsLock sync.RWMutex
+	// topicOffsets records the offsets of each partition of the topics
+	// which have been created or verified by topicPreProcess.
+	topicOffsets map[string]partitionOffsets
+	protocol     codec.Protocol
+	config       *Config
+	saramaConfig *sarama.Config
+
 	flushedNotifier *notify.Notifier
 	flushedReceiver *notify.Receiver
 
@@ -208,6 +236,11 @@ type kafkaSaramaProducer struct {
 	closing kafkaProducerClosingFlag
 }
 
+type partitionOffsets []struct {
+	flushed uint64
+	sent    uint64
+}
+
 type kafkaProducerClosingFlag = int32
 
 const (
@@ -215,7 +248,42 @@ const (
 	kafkaProducerClosing = 1
 )
 
-func (k *kafkaSaramaProducer) AsyncSendMessage(ctx context.Context, message *codec.MQMessage, partition int32) error {
+// CreateTopic makes sure the topic exists and its partition number is not less
+// than that of the producer, the topic is created if `auto-create-topic` is true.
+func (k *kafkaSaramaProducer) CreateTopic(topic string) error {
+	_, err := k.getTopicOffsets(topic)
+	return err
+}
+
+func (k *kafkaSaramaProducer) getTopicOffsets(topic string) (partitionOffsets, error) {
+	k.topicsLock.RLock()
+	offsets, ok := k.topicOffsets[topic]
+	k.topicsLock.RUnlock()
+	if ok {
+		return offsets, nil
+	}
+
+	// topicPreProcess talks to the brokers, so it must not be called with
+	// the lock held. Creating an existing topic is a no-op, so it's fine if
+	// several goroutines check the same topic concurrently.
+	if err := topicPreProcess(topic, k.protocol, k.config, k.saramaConfig); err != nil {
+		return nil, cerror.WrapError(cerror.ErrKafkaNewSaramaProducer, err)
+	}
+
+	k.topicsLock.Lock()
+	defer k.topicsLock.Unlock()
+	if offsets, ok := k.topicOffsets[topic]; ok {
+		return offsets, nil
+	}
+	offsets = make(partitionOffsets, k.partitionNum)
+	k.topicOffsets[topic] = offsets
+	log.Info("kafka producer starts to write a new topic", zap.String("topic", topic))
+	return offsets, nil
+}
+
+func (k *kafkaSaramaProducer) AsyncSendMessage(
+	ctx context.Context, topic string, message *codec.MQMessage, partition int32,
+) error {
 	k.clientLock.RLock()
 	defer k.clientLock.RUnlock()
 
@@ -225,13 +293,18 @@ func (k *kafkaSaramaProducer) AsyncSendMessage(ctx context.Context, message *cod
 		return nil
 	}
 
+	offsets, err := k.getTopicOffsets(topic)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	msg := &sarama.ProducerMessage{
-		Topic:     k.topic,
+		Topic:     topic,
 		Key:       sarama.ByteEncoder(message.Key),
 		Value:     sarama.ByteEncoder(message.Value),
 		Partition: partition,
 	}
-	msg.Metadata = atomic.AddUint64(&k.partitionOffset[partition].sent, 1)
+	msg.Metadata = atomic.AddUint64(&offsets[partition].sent, 1)
 
 	failpoint.Inject("KafkaSinkAsyncSendError", func() {
 		// simulate sending message to input channel successfully but flushing
@@ -256,13 +329,18 @@ func (k *kafkaSaramaProducer) AsyncSendMessage(ctx context.Context, message *cod
 	return nil
 }
 
-func (k *kafkaSaramaProducer) SyncBroadcastMessage(ctx context.Context, message *codec.MQMessage) error {
+func (k *kafkaSaramaProducer) SyncBroadcastMessage(
+	ctx context.Context, topic string, message *codec.MQMessage,
+) error {
 	k.clientLock.RLock()
 	defer k.clientLock.RUnlock()
+	if _, err := k.getTopicOffsets(topic); err != nil {
+		return errors.Trace(err)
+	}
 	msgs := make([]*sarama.ProducerMessage, k.partitionNum)
 	for i := 0; i < int(k.partitionNum); i++ {
 		msgs[i] = &sarama.ProducerMessage{
-			Topic:     k.topic,
+			Topic:     topic,
 			Key:       sarama.ByteEncoder(message.Key),
 			Value:     sarama.ByteEncoder(message.Value),
 			Partition: int32(i),
@@ -280,33 +358,38 @@ func (k *kafkaSaramaProducer) SyncBroadcastMessage(ctx context.Context, message
 }
 
 func (k *kafkaSaramaProducer) Flush(ctx context.Context) error {
-	targetOffsets := make([]uint64, k.partitionNum)
-	for i := 0; i < len(k.partitionOffset); i++ {
-		targetOffsets[i] = atomic.LoadUint64(&k.partitionOffset[i].sent)
-	}
-
-	noEventsToFLush := true
-	for i, target := range targetOffsets {
-		if target > atomic.LoadUint64(&k.partitionOffset[i].flushed) {
-			noEventsToFLush = false
-			break
+	type flushTarget struct {
+		offsets partitionOffsets
+		targets []uint64
+	}
+	k.topicsLock.RLock()
+	flushTargets := make([]flushTarget, 0, len(k.topicOffsets))
+	for _, offsets := range k.topicOffsets {
+		targets := make([]uint64, len(offsets))
+		for i := 0; i < len(offsets); i++ {
+			targets[i] = atomic.LoadUint64(&offsets[i].sent)
 		}
+		flushTargets = append(flushTargets, flushTarget{offsets: offsets, targets: targets})
 	}
-	if noEventsToFLush {
-		// no events to flush
-		return nil
-	}
+	k.topicsLock.RUnlock()
 
-	// checkAllPartitionFlushed checks whether data in each partition is flushed
+	// checkAllPartitionFlushed checks whether data in each partition of each topic is flushed
 	checkAllPartitionFlushed := func() bool {
-		for i, target := range targetOffsets {
-			if target > atomic.LoadUint64(&k.partitionOffset[i].flushed) {
-				return false
+		for _, t := range flushTargets {
+			for i, target := range t.targets {
+				if target > atomic.LoadUint64(&t.offsets[i].flushed) {
+					return false
+				}
 			}
 		}
 		return true
 	}
 
+	if checkAllPartitionFlushed() {
+		// no events to flush
+		return nil
+	}
+
 flushLoop:
 	for {
 		select {
@@ -385,7 +468,10 @@ func (k *kafkaSaramaProducer) run(ctx context.Context) error {
 				continue
 			}
 			flushedOffset := msg.Metadata.(uint64)
-			atomic.StoreUint64(&k.partitionOffset[msg.Partition].flushed, flushedOffset)
+			k.topicsLock.RLock()
+			offsets := k.topicOffsets[msg.Topic]
+			k.topicsLock.RUnlock()
+			atomic.StoreUint64(&offsets[msg.Partition].flushed, flushedOffset)
 			k.flushedNotifier.Notify()
 		case err := <-k.asyncClient.Errors():
 			// We should not wrap a nil pointer if the pointer is of a subtype of `error`
@@ -424,8 +510,8 @@ func topicPreProcess(topic string, protocol codec.Protocol, config *Config, sara
 	if created {
 		// make sure that topic's `max.message.bytes` is not less than given `max-message-bytes`
 		// else the producer will send message that too large to make topic reject, then changefeed would error.
-		// only the default `open protocol` and `craft protocol` use `max-message-bytes`, so check this for them.
-		if protocol == codec.ProtocolDefault || protocol == codec.ProtocolCraft {
+		// only the default `open protocol`, `craft protocol` and `protobuf protocol` use `max-message-bytes`, so check this for them.
+		if protocol == codec.ProtocolDefault || protocol == codec.ProtocolCraft || protocol == codec.ProtocolProtobuf {
 			topicMaxMessageBytes, err := getTopicMaxMessageBytes(admin, info)
 			if err != nil {
 				return cerror.WrapError(cerror.ErrKafkaNewSaramaProducer, err)
@@ -457,8 +543,8 @@ func topicPreProcess(topic string, protocol codec.Protocol, config *Config, sara
 
 	// when try to create the topic, we don't know how to set the `max.message.bytes` for the topic.
 	// Kafka would create the topic with broker's `message.max.bytes`,
-	// we have to make sure it's not greater than `max-message-bytes` for the default open protocol & craft protocol.
-	if protocol == codec.ProtocolDefault || protocol == codec.ProtocolCraft {
+	// we have to make sure it's not greater than `max-message-bytes` for the default open protocol, craft protocol & protobuf protocol.
+	if protocol == codec.ProtocolDefault || protocol == codec.ProtocolCraft || protocol == codec.ProtocolProtobuf {
 		brokerMessageMaxBytes, err := getBrokerMessageMaxBytes(admin)
 		if err != nil {
 			log.Warn("TiCDC cannot find `message.max.bytes` from broker's configuration")
@@ -490,6 +576,7 @@ func topicPreProcess(topic string, protocol codec.Protocol, config *Config, sara
 	}
 
 	log.Info("TiCDC create the topic",
+		zap.String("topic", topic),
 		zap.Int32("partition-num", config.PartitionNum),
 		zap.Int16("replication-factor", config.ReplicationFactor))
 
@@ -498,7 +585,8 @@ func topicPreProcess(topic string, protocol codec.Protocol, config *Config, sara
 
 var newSaramaConfigImpl = newSaramaConfig
 
-// NewKafkaSaramaProducer creates a kafka sarama producer
+// NewKafkaSaramaProducer creates a kafka sarama producer, topic is the default
+// topic which is created or verified before the producer starts.
 func NewKafkaSaramaProducer(ctx context.Context, topic string, protocol codec.Protocol, config *Config, errCh chan error) (*kafkaSaramaProducer, error) {
 	log.Info("Starting kafka sarama producer ...", zap.Reflect("config", config))
 	cfg, err := newSaramaConfigImpl(ctx, config)
@@ -527,12 +615,13 @@ func NewKafkaSaramaProducer(ctx context.Context, topic string, protocol codec.Pr
 	k := &kafkaSaramaProducer{
 		asyncClient:  asyncClient,
 		syncClient:   syncClient,
-		topic:        topic,
 		partitionNum: config.PartitionNum,
-		partitionOffset: make([]struct {
-			flushed uint64
-			sent    uint64
-		}, config.PartitionNum),
+		topicOffsets: map[string]partitionOffsets{
+			topic: make(partitionOffsets, config.PartitionNum),
+		},
+		protocol:        protocol,
+		config:          config,
+		saramaConfig:    cfg,
 		flushedNotifier: notifier,
 		flushedReceiver: flushedReceiver,
 		closeCh:         make(chan struct{}),
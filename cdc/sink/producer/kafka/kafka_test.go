@@ -104,6 +104,21 @@ func (s *kafkaSuite) TestInitializeConfig(c *check.C) {
 	c.Assert(err, check.IsNil)
 	err = cfg.Initialize(sinkURI, replicaConfig, opts)
 	c.Assert(errors.Cause(err), check.ErrorMatches, ".*invalid partition num.*")
+
+	uri = "kafka://127.0.0.1:9092/abc?protocol=debezium&enable-debezium-schema=false&schema-change-topic=ddl"
+	sinkURI, err = url.Parse(uri)
+	c.Assert(err, check.IsNil)
+	opts = make(map[string]string)
+	err = NewConfig().Initialize(sinkURI, replicaConfig, opts)
+	c.Assert(err, check.IsNil)
+	c.Assert(opts["enable-debezium-schema"], check.Equals, "false")
+	c.Assert(opts["schema-change-topic"], check.Equals, "ddl")
+
+	uri = "kafka://127.0.0.1:9092/abc?protocol=default&schema-change-topic=ddl"
+	sinkURI, err = url.Parse(uri)
+	c.Assert(err, check.IsNil)
+	err = NewConfig().Initialize(sinkURI, replicaConfig, opts)
+	c.Assert(err, check.ErrorMatches, ".*schema-change-topic only support debezium.*")
 }
 
 func (s *kafkaSuite) TestSaramaProducer(c *check.C) {
@@ -156,12 +171,12 @@ func (s *kafkaSuite) TestSaramaProducer(c *check.C) {
 	c.Assert(err, check.IsNil)
 	c.Assert(producer.GetPartitionNum(), check.Equals, int32(2))
 	for i := 0; i < 100; i++ {
-		err = producer.AsyncSendMessage(ctx, &codec.MQMessage{
+		err = producer.AsyncSendMessage(ctx, topic, &codec.MQMessage{
 			Key:   []byte("test-key-1"),
 			Value: []byte("test-value"),
 		}, int32(0))
 		c.Assert(err, check.IsNil)
-		err = producer.AsyncSendMessage(ctx, &codec.MQMessage{
+		err = producer.AsyncSendMessage(ctx, topic, &codec.MQMessage{
 			Key:   []byte("test-key-1"),
 			Value: []byte("test-value"),
 		}, int32(1))
@@ -186,14 +201,11 @@ func (s *kafkaSuite) TestSaramaProducer(c *check.C) {
 
 	err = producer.Flush(ctx)
 	c.Assert(err, check.IsNil)
-	expected := []struct {
-		flushed uint64
-		sent    uint64
-	}{
+	expected := partitionOffsets{
 		{100, 100},
 		{100, 100},
 	}
-	c.Assert(producer.partitionOffset, check.DeepEquals, expected)
+	c.Assert(producer.topicOffsets[topic], check.DeepEquals, expected)
 	select {
 	case err := <-errCh:
 		c.Fatalf("unexpected err: %s", err)
@@ -203,7 +215,7 @@ func (s *kafkaSuite) TestSaramaProducer(c *check.C) {
 	err = producer.Flush(ctx)
 	c.Assert(err, check.IsNil)
 
-	err = producer.SyncBroadcastMessage(ctx, &codec.MQMessage{
+	err = producer.SyncBroadcastMessage(ctx, topic, &codec.MQMessage{
 		Key:   []byte("test-broadcast"),
 		Value: nil,
 	})
@@ -218,14 +230,14 @@ func (s *kafkaSuite) TestSaramaProducer(c *check.C) {
 	wg.Wait()
 
 	// check send messages when context is canceled or producer closed
-	err = producer.AsyncSendMessage(ctx, &codec.MQMessage{
+	err = producer.AsyncSendMessage(ctx, topic, &codec.MQMessage{
 		Key:   []byte("cancel"),
 		Value: nil,
 	}, int32(0))
 	if err != nil {
 		c.Assert(err, check.Equals, context.Canceled)
 	}
-	err = producer.SyncBroadcastMessage(ctx, &codec.MQMessage{
+	err = producer.SyncBroadcastMessage(ctx, topic, &codec.MQMessage{
 		Key:   []byte("cancel"),
 		Value: nil,
 	})
@@ -280,7 +292,7 @@ func (s *kafkaSuite) TestTopicPreProcess(c *check.C) {
 	cfg.Metadata.Retry.Max = 1
 
 	err = topicPreProcess(topic, codec.ProtocolDefault, config, cfg)
-	c.Assert(errors.Cause(err), check.Equals, sarama.ErrOutOfBrokers)
+	c.Assert(errors.Cause(err), check.ErrorMatches, sarama.ErrOutOfBrokers.Error()+".*")
 }
 
 func (s *kafkaSuite) TestNewSaramaConfig(c *check.C) {
@@ -409,7 +421,7 @@ func (s *kafkaSuite) TestProducerSendMessageFailed(c *check.C) {
 	go func() {
 		defer wg.Done()
 		for i := 0; i < 20; i++ {
-			err = producer.AsyncSendMessage(ctx, &codec.MQMessage{
+			err = producer.AsyncSendMessage(ctx, topic, &codec.MQMessage{
 				Key:   []byte("test-key-1"),
 				Value: []byte("test-value"),
 			}, int32(0))
@@ -0,0 +1,416 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/sink/codec"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// transactionTimeout is the `transaction.timeout.ms` of the producers, a
+	// transaction only lasts for a flush, so it doesn't need to be long.
+	transactionTimeout = time.Minute
+	// transactionProducerIdleTimeout is how long a transaction producer is
+	// kept after its last transaction, the producers of the tables moved to
+	// other captures are closed after it.
+	transactionProducerIdleTimeout = 5 * time.Minute
+)
+
+// kafkaTransactionalProducer writes the messages to Kafka in transactions by
+// the transactional producers of sarama.
+//
+// The messages of a table are sent by the producer of the table, whose
+// transactional id is made of the changefeed and the table. Once the table is
+// moved to another capture, the producer created there fences the producer of
+// the previous capture, so that the previous capture can't write the table
+// anymore and its ongoing transaction is aborted. The messages which don't
+// belong to any table, i.e. the DDL and checkpoint events of the owner, are
+// sent by the producer of the changefeed.
+//
+// A transaction is started by the first message after a flush, and it's only
+// committed by Flush, so the consumers with the isolation level
+// `read_committed` never see the messages of a failed flush. Note that Flush
+// commits the transactions of the tables one by one, and the rows after the
+// checkpoint are sent again after the changefeed restarts, the consumers
+// recognize these rows by their commit ts.
+type kafkaTransactionalProducer struct {
+	// transactionalID is the prefix of the transactional ids of the producers.
+	transactionalID string
+	partitionNum    int32
+	protocol        codec.Protocol
+	config          *Config
+	saramaConfig    *sarama.Config
+
+	// topicsLock protects topics.
+	topicsLock sync.RWMutex
+	topics     map[string]struct{}
+
+	// producersLock protects producers, which are indexed by the
+	// transactional ids.
+	producersLock sync.Mutex
+	producers     map[string]*transactionProducer
+
+	// newAsyncProducer creates the sarama producer, it's replaced in the tests.
+	newAsyncProducer func(cfg *sarama.Config) (sarama.AsyncProducer, error)
+
+	// atomic flag indicating whether the producer is closing
+	closing kafkaProducerClosingFlag
+}
+
+// transactionProducer is a transactional producer of sarama with its own
+// transactional id.
+type transactionProducer struct {
+	id string
+	// lock serializes the messages and the end of the transactions, a message
+	// must be sent after the transaction begins and before it ends.
+	lock     sync.Mutex
+	producer sarama.AsyncProducer
+	inTxn    bool
+	lastUsed time.Time
+	// closed is set once the producer is closed for being idle.
+	closed  bool
+	errorWg sync.WaitGroup
+}
+
+// NewKafkaTransactionalProducer creates a kafka producer which writes the
+// messages in transactions, topic is the default topic which is created or
+// verified before the producer starts.
+func NewKafkaTransactionalProducer(
+	ctx context.Context, topic string, protocol codec.Protocol, config *Config,
+) (*kafkaTransactionalProducer, error) {
+	log.Info("Starting kafka transactional producer ...", zap.Reflect("config", config))
+	cfg, err := newSaramaConfigImpl(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.Version.IsAtLeast(sarama.V0_11_0_0) {
+		return nil, cerror.ErrKafkaInvalidConfig.GenWithStack(
+			"transactional-id requires kafka-version 0.11.0 or later, but got %s", cfg.Version)
+	}
+	cfg.Producer.Idempotent = true
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Producer.Transaction.Timeout = transactionTimeout
+	// the errors of the messages fail the transaction, and the producer has
+	// to wait for all of them before the transaction ends anyway.
+	cfg.Producer.Return.Successes = false
+	cfg.Net.MaxOpenRequests = 1
+
+	if err := topicPreProcess(topic, protocol, config, cfg); err != nil {
+		return nil, cerror.WrapError(cerror.ErrKafkaNewSaramaProducer, err)
+	}
+	transactionalID := fmt.Sprintf("%s_%s", config.TransactionalID, util.ChangefeedIDFromCtx(ctx))
+	return newKafkaTransactionalProducer(transactionalID, topic, protocol, config, cfg,
+		func(cfg *sarama.Config) (sarama.AsyncProducer, error) {
+			return sarama.NewAsyncProducer(config.BrokerEndpoints, cfg)
+		}), nil
+}
+
+func newKafkaTransactionalProducer(
+	transactionalID string, topic string, protocol codec.Protocol, config *Config, saramaConfig *sarama.Config,
+	newAsyncProducer func(cfg *sarama.Config) (sarama.AsyncProducer, error),
+) *kafkaTransactionalProducer {
+	return &kafkaTransactionalProducer{
+		transactionalID:  transactionalID,
+		partitionNum:     config.PartitionNum,
+		protocol:         protocol,
+		config:           config,
+		saramaConfig:     saramaConfig,
+		topics:           map[string]struct{}{topic: {}},
+		producers:        make(map[string]*transactionProducer),
+		newAsyncProducer: newAsyncProducer,
+		closing:          kafkaProducerRunning,
+	}
+}
+
+// tableTransactionalID returns the transactional id of the producer of the
+// table. The id stays the same wherever the table is replicated, so that the
+// producer of a capture fences the producers of the table on the other
+// captures.
+func (k *kafkaTransactionalProducer) tableTransactionalID(tableID model.TableID) string {
+	return fmt.Sprintf("%s_%d", k.transactionalID, tableID)
+}
+
+// CreateTopic makes sure the topic exists and its partition number is not less
+// than that of the producer, the topic is created if `auto-create-topic` is true.
+func (k *kafkaTransactionalProducer) CreateTopic(topic string) error {
+	k.topicsLock.RLock()
+	_, ok := k.topics[topic]
+	k.topicsLock.RUnlock()
+	if ok {
+		return nil
+	}
+
+	// creating or checking a topic makes requests to Kafka, which must not
+	// block the other topics.
+	if err := topicPreProcess(topic, k.protocol, k.config, k.saramaConfig); err != nil {
+		return cerror.WrapError(cerror.ErrKafkaNewSaramaProducer, err)
+	}
+	k.topicsLock.Lock()
+	defer k.topicsLock.Unlock()
+	if _, ok := k.topics[topic]; !ok {
+		k.topics[topic] = struct{}{}
+		log.Info("kafka producer starts to write a new topic", zap.String("topic", topic))
+	}
+	return nil
+}
+
+// AsyncSendMessage sends the message in the ongoing transaction of the
+// changefeed, it's committed by the next Flush.
+func (k *kafkaTransactionalProducer) AsyncSendMessage(
+	ctx context.Context, topic string, message *codec.MQMessage, partition int32,
+) error {
+	return k.send(ctx, k.transactionalID, topic, message, partition)
+}
+
+// AsyncSendTableMessage sends the message in the ongoing transaction of the
+// table, it's committed by the next Flush.
+func (k *kafkaTransactionalProducer) AsyncSendTableMessage(
+	ctx context.Context, tableID model.TableID, topic string, message *codec.MQMessage, partition int32,
+) error {
+	return k.send(ctx, k.tableTransactionalID(tableID), topic, message, partition)
+}
+
+// SyncBroadcastMessage sends the message to all partitions of the topic in
+// the ongoing transaction of the changefeed, and commits the transactions.
+func (k *kafkaTransactionalProducer) SyncBroadcastMessage(
+	ctx context.Context, topic string, message *codec.MQMessage,
+) error {
+	for i := int32(0); i < k.partitionNum; i++ {
+		if err := k.send(ctx, k.transactionalID, topic, message, i); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return k.Flush(ctx)
+}
+
+func (k *kafkaTransactionalProducer) send(
+	ctx context.Context, transactionalID string, topic string, message *codec.MQMessage, partition int32,
+) error {
+	if atomic.LoadInt32(&k.closing) == kafkaProducerClosing {
+		return nil
+	}
+	if err := k.CreateTopic(topic); err != nil {
+		return errors.Trace(err)
+	}
+	msg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Key:       sarama.ByteEncoder(message.Key),
+		Value:     sarama.ByteEncoder(message.Value),
+		Partition: partition,
+	}
+	for {
+		p, err := k.getProducer(transactionalID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if p == nil {
+			return nil
+		}
+		sent, err := p.send(ctx, msg)
+		if err != nil || sent {
+			return err
+		}
+		// the producer has been closed for being idle, send the message by a
+		// new one.
+	}
+}
+
+// send sends the message in the ongoing transaction, it returns false if
+// the producer has been closed.
+func (p *transactionProducer) send(ctx context.Context, msg *sarama.ProducerMessage) (bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.closed {
+		return false, nil
+	}
+	if !p.inTxn {
+		if err := p.producer.BeginTxn(); err != nil {
+			return false, cerror.WrapError(cerror.ErrKafkaTransaction, err)
+		}
+		p.inTxn = true
+	}
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case p.producer.Input() <- msg:
+	}
+	return true, nil
+}
+
+// getProducer returns the producer of the transactional id, the producer is
+// created if it doesn't exist. It returns nil if k is closing.
+func (k *kafkaTransactionalProducer) getProducer(transactionalID string) (*transactionProducer, error) {
+	k.producersLock.Lock()
+	defer k.producersLock.Unlock()
+	if atomic.LoadInt32(&k.closing) == kafkaProducerClosing {
+		return nil, nil
+	}
+	if p, ok := k.producers[transactionalID]; ok {
+		return p, nil
+	}
+
+	cfg := *k.saramaConfig
+	cfg.Producer.Transaction.ID = transactionalID
+	producer, err := k.newAsyncProducer(&cfg)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrKafkaNewSaramaProducer, err)
+	}
+	p := &transactionProducer{
+		id:       transactionalID,
+		producer: producer,
+		lastUsed: time.Now(),
+	}
+	p.errorWg.Add(1)
+	go func() {
+		defer p.errorWg.Done()
+		for err := range producer.Errors() {
+			// the failed message fails the transaction, which is aborted
+			// when it's committed.
+			log.Warn("kafka transactional producer failed to send message",
+				zap.String("transactional-id", transactionalID), zap.Error(err))
+		}
+	}()
+	k.producers[transactionalID] = p
+	log.Info("kafka transactional producer started", zap.String("transactional-id", transactionalID))
+	return p, nil
+}
+
+// Flush commits the ongoing transactions, and closes the producers which have
+// been idle for a while.
+func (k *kafkaTransactionalProducer) Flush(ctx context.Context) error {
+	if atomic.LoadInt32(&k.closing) == kafkaProducerClosing {
+		return nil
+	}
+	k.producersLock.Lock()
+	producers := make([]*transactionProducer, 0, len(k.producers))
+	for _, p := range k.producers {
+		producers = append(producers, p)
+	}
+	k.producersLock.Unlock()
+
+	var idle []*transactionProducer
+	var idleLock sync.Mutex
+	g, _ := errgroup.WithContext(ctx)
+	for _, p := range producers {
+		p := p
+		g.Go(func() error {
+			p.lock.Lock()
+			defer p.lock.Unlock()
+			if !p.inTxn {
+				if time.Since(p.lastUsed) >= transactionProducerIdleTimeout {
+					idleLock.Lock()
+					idle = append(idle, p)
+					idleLock.Unlock()
+				}
+				return nil
+			}
+			return p.commit()
+		})
+	}
+	err := g.Wait()
+	for _, p := range idle {
+		k.closeProducer(p)
+	}
+	return err
+}
+
+// commit commits the ongoing transaction, or aborts it if any message of
+// the transaction failed. The caller must hold p.lock.
+func (p *transactionProducer) commit() error {
+	err := p.producer.CommitTxn()
+	p.inTxn = false
+	p.lastUsed = time.Now()
+	if err == nil {
+		return nil
+	}
+	log.Warn("kafka transaction failed, abort it",
+		zap.String("transactional-id", p.id), zap.Error(err))
+	if abortErr := p.producer.AbortTxn(); abortErr != nil {
+		log.Warn("abort kafka transaction failed",
+			zap.String("transactional-id", p.id), zap.Error(abortErr))
+	}
+	return cerror.WrapError(cerror.ErrKafkaTransaction, err)
+}
+
+// closeProducer closes an idle producer.
+func (k *kafkaTransactionalProducer) closeProducer(p *transactionProducer) {
+	k.producersLock.Lock()
+	p.lock.Lock()
+	// the producer may be used again since it's found idle.
+	if p.inTxn {
+		p.lock.Unlock()
+		k.producersLock.Unlock()
+		return
+	}
+	delete(k.producers, p.id)
+	p.closed = true
+	p.lock.Unlock()
+	k.producersLock.Unlock()
+
+	if err := p.producer.Close(); err != nil {
+		log.Warn("close kafka transactional producer failed",
+			zap.String("transactional-id", p.id), zap.Error(err))
+	}
+	p.errorWg.Wait()
+	log.Info("kafka transactional producer closed", zap.String("transactional-id", p.id))
+}
+
+// GetPartitionNum implements the Producer interface.
+func (k *kafkaTransactionalProducer) GetPartitionNum() int32 {
+	return k.partitionNum
+}
+
+// Close aborts the ongoing transactions and closes the producers.
+func (k *kafkaTransactionalProducer) Close() error {
+	if !atomic.CompareAndSwapInt32(&k.closing, kafkaProducerRunning, kafkaProducerClosing) {
+		return nil
+	}
+	k.producersLock.Lock()
+	producers := k.producers
+	k.producers = make(map[string]*transactionProducer)
+	k.producersLock.Unlock()
+
+	for _, p := range producers {
+		p.lock.Lock()
+		if p.inTxn {
+			if err := p.producer.AbortTxn(); err != nil {
+				log.Warn("abort kafka transaction failed",
+					zap.String("transactional-id", p.id), zap.Error(err))
+			}
+			p.inTxn = false
+		}
+		p.closed = true
+		p.lock.Unlock()
+		if err := p.producer.Close(); err != nil {
+			log.Warn("close kafka transactional producer failed",
+				zap.String("transactional-id", p.id), zap.Error(err))
+		}
+		p.errorWg.Wait()
+	}
+	return nil
+}
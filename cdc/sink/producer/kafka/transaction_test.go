@@ -0,0 +1,227 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pingcap/check"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/sink/codec"
+	"github.com/pingcap/ticdc/pkg/util/testleak"
+)
+
+const (
+	txnTestTopic           = "txn-test"
+	txnTestTransactionalID = "txn-test_test-cf"
+)
+
+// mockTxnProducer is a transactional sarama producer which keeps the
+// messages of the transactions in memory.
+type mockTxnProducer struct {
+	sarama.AsyncProducer
+	id        string
+	input     chan *sarama.ProducerMessage
+	errors    chan *sarama.ProducerError
+	commitErr error
+
+	inTxn     bool
+	committed [][]*sarama.ProducerMessage
+	aborted   int
+	closed    bool
+}
+
+func (m *mockTxnProducer) Input() chan<- *sarama.ProducerMessage { return m.input }
+
+func (m *mockTxnProducer) Errors() <-chan *sarama.ProducerError { return m.errors }
+
+func (m *mockTxnProducer) BeginTxn() error {
+	m.inTxn = true
+	return nil
+}
+
+// drain returns the messages of the ongoing transaction.
+func (m *mockTxnProducer) drain() []*sarama.ProducerMessage {
+	var msgs []*sarama.ProducerMessage
+	for len(m.input) > 0 {
+		msgs = append(msgs, <-m.input)
+	}
+	return msgs
+}
+
+func (m *mockTxnProducer) CommitTxn() error {
+	if m.commitErr != nil {
+		return m.commitErr
+	}
+	m.committed = append(m.committed, m.drain())
+	m.inTxn = false
+	return nil
+}
+
+func (m *mockTxnProducer) AbortTxn() error {
+	m.drain()
+	m.aborted++
+	m.inTxn = false
+	return nil
+}
+
+func (m *mockTxnProducer) Close() error {
+	m.closed = true
+	close(m.errors)
+	return nil
+}
+
+// mockTxnProducers creates the mock producers for a kafkaTransactionalProducer.
+type mockTxnProducers struct {
+	mu        sync.Mutex
+	producers []*mockTxnProducer
+	commitErr error
+}
+
+func (m *mockTxnProducers) newAsyncProducer(cfg *sarama.Config) (sarama.AsyncProducer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p := &mockTxnProducer{
+		id:        cfg.Producer.Transaction.ID,
+		input:     make(chan *sarama.ProducerMessage, 16),
+		errors:    make(chan *sarama.ProducerError),
+		commitErr: m.commitErr,
+	}
+	m.producers = append(m.producers, p)
+	return p, nil
+}
+
+// get returns the mock producers of the transactional id.
+func (m *mockTxnProducers) get(id string) []*mockTxnProducer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var producers []*mockTxnProducer
+	for _, p := range m.producers {
+		if p.id == id {
+			producers = append(producers, p)
+		}
+	}
+	return producers
+}
+
+func newTxnTestProducer(mocks *mockTxnProducers) *kafkaTransactionalProducer {
+	config := NewConfig()
+	config.PartitionNum = 2
+	return newKafkaTransactionalProducer(
+		txnTestTransactionalID, txnTestTopic, codec.ProtocolDefault, config, sarama.NewConfig(), mocks.newAsyncProducer)
+}
+
+func (s *kafkaSuite) TestTransactionalProducer(c *check.C) {
+	defer testleak.AfterTest(c)()
+	ctx := context.Background()
+	mocks := &mockTxnProducers{}
+	k := newTxnTestProducer(mocks)
+
+	msg := &codec.MQMessage{Key: []byte("key"), Value: []byte("value")}
+	c.Assert(k.AsyncSendTableMessage(ctx, 1, txnTestTopic, msg, 0), check.IsNil)
+	c.Assert(k.AsyncSendTableMessage(ctx, 1, txnTestTopic, msg, 1), check.IsNil)
+	c.Assert(k.AsyncSendTableMessage(ctx, 2, txnTestTopic, msg, 0), check.IsNil)
+	c.Assert(k.AsyncSendMessage(ctx, txnTestTopic, msg, 1), check.IsNil)
+
+	// every table has its own producer, and the transactional ids don't
+	// depend on the capture.
+	table1 := mocks.get("txn-test_test-cf_1")
+	table2 := mocks.get("txn-test_test-cf_2")
+	changefeed := mocks.get(txnTestTransactionalID)
+	c.Assert(table1, check.HasLen, 1)
+	c.Assert(table2, check.HasLen, 1)
+	c.Assert(changefeed, check.HasLen, 1)
+	// nothing is committed before the flush.
+	for _, p := range []*mockTxnProducer{table1[0], table2[0], changefeed[0]} {
+		c.Assert(p.inTxn, check.IsTrue)
+		c.Assert(p.committed, check.HasLen, 0)
+	}
+
+	c.Assert(k.Flush(ctx), check.IsNil)
+	c.Assert(table1[0].committed, check.HasLen, 1)
+	c.Assert(table1[0].committed[0], check.HasLen, 2)
+	c.Assert(table1[0].committed[0][1].Partition, check.Equals, int32(1))
+	c.Assert(table2[0].committed, check.HasLen, 1)
+	c.Assert(table2[0].committed[0], check.HasLen, 1)
+	c.Assert(changefeed[0].committed, check.HasLen, 1)
+	c.Assert(changefeed[0].committed[0], check.HasLen, 1)
+
+	// an empty flush doesn't commit anything.
+	c.Assert(k.Flush(ctx), check.IsNil)
+	c.Assert(table1[0].committed, check.HasLen, 1)
+
+	// a broadcast message is sent to all partitions and committed at once.
+	c.Assert(k.SyncBroadcastMessage(ctx, txnTestTopic, msg), check.IsNil)
+	c.Assert(changefeed[0].committed, check.HasLen, 2)
+	c.Assert(changefeed[0].committed[1], check.HasLen, 2)
+	c.Assert(table1[0].committed, check.HasLen, 1)
+
+	// the ongoing transactions are aborted when the producer is closed.
+	c.Assert(k.AsyncSendTableMessage(ctx, 1, txnTestTopic, msg, 0), check.IsNil)
+	c.Assert(k.Close(), check.IsNil)
+	c.Assert(k.Close(), check.IsNil)
+	c.Assert(table1[0].aborted, check.Equals, 1)
+	c.Assert(table1[0].closed, check.IsTrue)
+	c.Assert(table2[0].closed, check.IsTrue)
+	c.Assert(changefeed[0].closed, check.IsTrue)
+	// the producer ignores the messages after it's closed.
+	c.Assert(k.AsyncSendTableMessage(ctx, 3, txnTestTopic, msg, 0), check.IsNil)
+	c.Assert(k.Flush(ctx), check.IsNil)
+	c.Assert(mocks.get("txn-test_test-cf_3"), check.HasLen, 0)
+}
+
+func (s *kafkaSuite) TestTransactionalProducerAbort(c *check.C) {
+	defer testleak.AfterTest(c)()
+	ctx := context.Background()
+	mocks := &mockTxnProducers{commitErr: errors.New("fenced")}
+	k := newTxnTestProducer(mocks)
+	defer k.Close()
+
+	c.Assert(k.AsyncSendTableMessage(ctx, 1, txnTestTopic, &codec.MQMessage{Value: []byte("value")}, 0), check.IsNil)
+	err := k.Flush(ctx)
+	c.Assert(err, check.ErrorMatches, ".*ErrKafkaTransaction.*fenced.*")
+	p := mocks.get("txn-test_test-cf_1")[0]
+	c.Assert(p.committed, check.HasLen, 0)
+	c.Assert(p.aborted, check.Equals, 1)
+}
+
+func (s *kafkaSuite) TestTransactionalProducerCloseIdle(c *check.C) {
+	defer testleak.AfterTest(c)()
+	ctx := context.Background()
+	mocks := &mockTxnProducers{}
+	k := newTxnTestProducer(mocks)
+	defer k.Close()
+
+	msg := &codec.MQMessage{Value: []byte("value")}
+	c.Assert(k.AsyncSendTableMessage(ctx, 1, txnTestTopic, msg, 0), check.IsNil)
+	c.Assert(k.AsyncSendTableMessage(ctx, 2, txnTestTopic, msg, 0), check.IsNil)
+	c.Assert(k.Flush(ctx), check.IsNil)
+
+	// the producer of table 1 is closed once it has been idle for a while.
+	k.producers["txn-test_test-cf_1"].lastUsed = time.Now().Add(-transactionProducerIdleTimeout)
+	c.Assert(k.Flush(ctx), check.IsNil)
+	c.Assert(mocks.get("txn-test_test-cf_1")[0].closed, check.IsTrue)
+	c.Assert(mocks.get("txn-test_test-cf_2")[0].closed, check.IsFalse)
+	c.Assert(k.producers, check.HasLen, 1)
+
+	// and a new one is created for the next messages of the table.
+	c.Assert(k.AsyncSendTableMessage(ctx, 1, txnTestTopic, msg, 0), check.IsNil)
+	c.Assert(k.Flush(ctx), check.IsNil)
+	producers := mocks.get("txn-test_test-cf_1")
+	c.Assert(producers, check.HasLen, 2)
+	c.Assert(producers[1].committed, check.HasLen, 1)
+}
@@ -16,20 +16,38 @@ package producer
 import (
 	"context"
 
+	"github.com/pingcap/ticdc/cdc/model"
 	"github.com/pingcap/ticdc/cdc/sink/codec"
 )
 
 // Producer is an interface of mq producer
 type Producer interface {
-	// AsyncSendMessage sends a message asynchronously.
-	AsyncSendMessage(ctx context.Context, message *codec.MQMessage, partition int32) error
-	// SyncBroadcastMessage broadcasts a message synchronously.
-	SyncBroadcastMessage(ctx context.Context, message *codec.MQMessage) error
+	// AsyncSendMessage sends a message to the partition of the topic asynchronously.
+	AsyncSendMessage(ctx context.Context, topic string, message *codec.MQMessage, partition int32) error
+	// SyncBroadcastMessage broadcasts a message to all partitions of the topic synchronously.
+	SyncBroadcastMessage(ctx context.Context, topic string, message *codec.MQMessage) error
 	// Flush all the messages buffered in the client and wait until all messages have been successfully
 	// persisted.
 	Flush(ctx context.Context) error
 	// GetPartitionNum gets partition number of topic.
+	// All the topics used by a producer share the same partition number.
 	GetPartitionNum() int32
+	// CreateTopic makes sure the topic exists and is usable by the producer,
+	// it creates the topic if it's allowed by the configuration.
+	CreateTopic(topic string) error
 	// Close closes the producer and client(s).
 	Close() error
 }
+
+// TransactionalProducer is a Producer which sends the messages in
+// transactions, the messages sent since the last Flush are committed by the
+// next Flush. The messages of a table are sent in the transactions of the
+// table, so they must not be batched with the messages of other tables.
+type TransactionalProducer interface {
+	Producer
+	// AsyncSendTableMessage sends a message of the table to the partition of
+	// the topic asynchronously.
+	AsyncSendTableMessage(
+		ctx context.Context, tableID model.TableID, topic string, message *codec.MQMessage, partition int32,
+	) error
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pingcap/failpoint"
@@ -57,7 +58,10 @@ func NewProducer(u *url.URL, errCh chan error) (*Producer, error) {
 		errCh:        errCh,
 		opt:          *opt,
 		client:       client,
-		producer:     producer,
+		defaultTopic: opt.producerOptions.Topic,
+		producers: map[string]pulsar.Producer{
+			opt.producerOptions.Topic: producer,
+		},
 		partitionNum: len(partitions),
 	}, nil
 }
@@ -66,9 +70,57 @@ func NewProducer(u *url.URL, errCh chan error) (*Producer, error) {
 type Producer struct {
 	opt          Option
 	client       pulsar.Client
-	producer     pulsar.Producer
-	errCh        chan error
-	partitionNum int
+	defaultTopic string
+	// producersLock protects producers, which holds one pulsar producer per topic.
+	producersLock sync.RWMutex
+	producers     map[string]pulsar.Producer
+	errCh         chan error
+	partitionNum  int
+}
+
+// DefaultTopic returns the topic specified in the sink uri.
+func (p *Producer) DefaultTopic() string {
+	return p.defaultTopic
+}
+
+// CreateTopic creates a pulsar producer for the topic, the topic is created by
+// the pulsar broker if `allowAutoTopicCreation` is enabled.
+func (p *Producer) CreateTopic(topic string) error {
+	_, err := p.getProducer(topic)
+	return err
+}
+
+func (p *Producer) getProducer(topic string) (pulsar.Producer, error) {
+	p.producersLock.RLock()
+	producer, ok := p.producers[topic]
+	p.producersLock.RUnlock()
+	if ok {
+		return producer, nil
+	}
+
+	p.producersLock.Lock()
+	defer p.producersLock.Unlock()
+	if producer, ok := p.producers[topic]; ok {
+		return producer, nil
+	}
+	partitions, err := p.client.TopicPartitions(topic)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	if len(partitions) < p.partitionNum {
+		return nil, cerror.ErrPulsarNewProducer.GenWithStack(
+			"the number of partition (%d) of topic %s is less than that of the default topic (%d)",
+			len(partitions), topic, p.partitionNum)
+	}
+	opt := *p.opt.producerOptions
+	opt.Topic = topic
+	producer, err = p.client.CreateProducer(opt)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	p.producers[topic] = producer
+	log.Info("pulsar producer starts to write a new topic", zap.String("topic", topic))
+	return producer, nil
 }
 
 func createProperties(message *codec.MQMessage, partition int32) map[string]string {
@@ -85,9 +137,15 @@ func createProperties(message *codec.MQMessage, partition int32) map[string]stri
 	return properties
 }
 
-// SendMessage send key-value msg to target partition.
-func (p *Producer) AsyncSendMessage(ctx context.Context, message *codec.MQMessage, partition int32) error {
-	p.producer.SendAsync(ctx, &pulsar.ProducerMessage{
+// AsyncSendMessage send key-value msg to target partition of the topic.
+func (p *Producer) AsyncSendMessage(
+	ctx context.Context, topic string, message *codec.MQMessage, partition int32,
+) error {
+	producer, err := p.getProducer(topic)
+	if err != nil {
+		return err
+	}
+	producer.SendAsync(ctx, &pulsar.ProducerMessage{
 		Payload:    message.Value,
 		Key:        string(message.Key),
 		Properties: createProperties(message, partition),
@@ -106,25 +164,36 @@ func (p *Producer) errors(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err err
 	}
 }
 
-// SyncBroadcastMessage send key-value msg to all partition.
-func (p *Producer) SyncBroadcastMessage(ctx context.Context, message *codec.MQMessage) error {
+// SyncBroadcastMessage send key-value msg to all partition of the topic.
+func (p *Producer) SyncBroadcastMessage(ctx context.Context, topic string, message *codec.MQMessage) error {
+	producer, err := p.getProducer(topic)
+	if err != nil {
+		return err
+	}
 	for partition := 0; partition < p.partitionNum; partition++ {
-		_, err := p.producer.Send(ctx, &pulsar.ProducerMessage{
+		_, err := producer.Send(ctx, &pulsar.ProducerMessage{
 			Payload:    message.Value,
 			Key:        string(message.Key),
 			Properties: createProperties(message, int32(partition)),
 			EventTime:  message.PhysicalTime(),
 		})
 		if err != nil {
-			return cerror.WrapError(cerror.ErrPulsarSendMessage, p.producer.Flush())
+			return cerror.WrapError(cerror.ErrPulsarSendMessage, producer.Flush())
 		}
 	}
 	return nil
 }
 
-// Flush flushes all in memory msgs to server.
+// Flush flushes all in memory msgs of all topics to server.
 func (p *Producer) Flush(_ context.Context) error {
-	return cerror.WrapError(cerror.ErrPulsarSendMessage, p.producer.Flush())
+	p.producersLock.RLock()
+	defer p.producersLock.RUnlock()
+	for _, producer := range p.producers {
+		if err := producer.Flush(); err != nil {
+			return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+		}
+	}
+	return nil
 }
 
 // GetPartitionNum got current topic's partition size.
@@ -132,13 +201,17 @@ func (p *Producer) GetPartitionNum() int32 {
 	return int32(p.partitionNum)
 }
 
-// Close closes the producer and client.
+// Close closes the producers and client.
 func (p *Producer) Close() error {
-	err := p.producer.Flush()
+	err := p.Flush(context.Background())
 	if err != nil {
-		return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+		return err
+	}
+	p.producersLock.Lock()
+	defer p.producersLock.Unlock()
+	for _, producer := range p.producers {
+		producer.Close()
 	}
-	p.producer.Close()
 	p.client.Close()
 	return nil
 }
@@ -0,0 +1,136 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/security"
+)
+
+// Config stores user specified webhook producer configuration
+type Config struct {
+	// Endpoint is the sink uri without the parameters used by TiCDC.
+	Endpoint string
+	// BatchSize is the max number of messages posted in one request.
+	BatchSize       int
+	MaxRetry        int
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	Timeout         time.Duration
+	Headers         http.Header
+	Credential      *security.Credential
+}
+
+// NewConfig returns a default webhook configuration
+func NewConfig() *Config {
+	return &Config{
+		BatchSize:       64,
+		MaxRetry:        3,
+		RetryBackoff:    500 * time.Millisecond,
+		MaxRetryBackoff: 10 * time.Second,
+		Timeout:         10 * time.Second,
+		Headers:         make(http.Header),
+		Credential:      &security.Credential{},
+	}
+}
+
+// Initialize the webhook configuration
+func (c *Config) Initialize(sinkURI *url.URL, replicaConfig *config.ReplicaConfig, opts map[string]string) error {
+	params := sinkURI.Query()
+	// pop returns the value of the parameter and removes it from the
+	// parameters which are sent to the endpoint.
+	pop := func(name string) string {
+		s := params.Get(name)
+		params.Del(name)
+		return s
+	}
+
+	s := pop("batch-size")
+	if s != "" {
+		a, err := strconv.Atoi(s)
+		if err != nil || a <= 0 {
+			return cerror.ErrWebhookInvalidConfig.GenWithStack("invalid batch-size %s", s)
+		}
+		c.BatchSize = a
+	}
+
+	s = pop("max-retry")
+	if s != "" {
+		a, err := strconv.Atoi(s)
+		if err != nil || a < 0 {
+			return cerror.ErrWebhookInvalidConfig.GenWithStack("invalid max-retry %s", s)
+		}
+		c.MaxRetry = a
+	}
+
+	for name, d := range map[string]*time.Duration{
+		"retry-backoff":     &c.RetryBackoff,
+		"max-retry-backoff": &c.MaxRetryBackoff,
+		"timeout":           &c.Timeout,
+	} {
+		s = pop(name)
+		if s != "" {
+			a, err := time.ParseDuration(s)
+			if err != nil || a <= 0 {
+				return cerror.ErrWebhookInvalidConfig.GenWithStack("invalid %s %s", name, s)
+			}
+			*d = a
+		}
+	}
+	if c.MaxRetryBackoff < c.RetryBackoff {
+		return cerror.ErrWebhookInvalidConfig.GenWithStack(
+			"max-retry-backoff %s is less than retry-backoff %s", c.MaxRetryBackoff, c.RetryBackoff)
+	}
+
+	// every header is specified as `name:value`, and the parameter can be repeated.
+	for _, header := range params["header"] {
+		i := strings.Index(header, ":")
+		if i <= 0 {
+			return cerror.ErrWebhookInvalidConfig.GenWithStack("invalid header %s", header)
+		}
+		c.Headers.Add(strings.TrimSpace(header[:i]), strings.TrimSpace(header[i+1:]))
+	}
+	params.Del("header")
+
+	c.Credential.CAPath = pop("ca")
+	c.Credential.CertPath = pop("cert")
+	c.Credential.KeyPath = pop("key")
+
+	// These options are not used by the producer itself, but the encoders
+	s = pop("protocol")
+	if s != "" {
+		replicaConfig.Sink.Protocol = s
+	}
+	for _, name := range []string{
+		"max-message-bytes", "max-batch-size", "enable-tidb-extension",
+		"enable-debezium-schema", "schema-change-topic",
+	} {
+		s = pop(name)
+		if s != "" {
+			opts[name] = s
+		}
+	}
+
+	endpoint := *sinkURI
+	endpoint.RawQuery = params.Encode()
+	c.Endpoint = endpoint.String()
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook provides an mq Producer implementation which posts the
+// encoded messages to an HTTP endpoint.
+//
+// SinkURL format like:
+// http(s)://{host}/{path}?batch-size=64&header=Authorization:Bearer%20{token}
+//
+// The messages are posted in batches as the JSON encoded Batch. The key and
+// value of a message are the bytes built by the encoder of the protocol, so
+// they are base64 encoded in the JSON.
+//
+// The query parameters used by TiCDC are removed from the endpoint, the others
+// are kept and sent to the endpoint. A batch is retried with backoff if the
+// request fails or the endpoint responds 408, 429 or 5xx, and the messages are
+// only regarded as flushed after the endpoint responds 2xx.
+package webhook
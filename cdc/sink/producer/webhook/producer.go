@@ -0,0 +1,241 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/sink/codec"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/httputil"
+	"github.com/pingcap/ticdc/pkg/retry"
+	"go.uber.org/zap"
+)
+
+// maxErrorBodySize limits the size of the response body kept in the error.
+const maxErrorBodySize = 1024
+
+// Message is a message posted to the webhook endpoint.
+type Message struct {
+	Topic    string              `json:"topic"`
+	Key      []byte              `json:"key,omitempty"`
+	Value    []byte              `json:"value"`
+	Schema   string              `json:"schema,omitempty"`
+	Table    string              `json:"table,omitempty"`
+	CommitTs uint64              `json:"commit-ts"`
+	Type     model.MqMessageType `json:"type"`
+	Protocol codec.Protocol      `json:"protocol"`
+}
+
+// Batch is the body of a request posted to the webhook endpoint.
+type Batch struct {
+	Messages []*Message `json:"messages"`
+}
+
+func newMessage(topic string, message *codec.MQMessage) *Message {
+	m := &Message{
+		Topic:    topic,
+		Key:      message.Key,
+		Value:    message.Value,
+		CommitTs: message.Ts,
+		Type:     message.Type,
+		Protocol: message.Protocol,
+	}
+	if message.Schema != nil {
+		m.Schema = *message.Schema
+	}
+	if message.Table != nil {
+		m.Table = *message.Table
+	}
+	return m
+}
+
+// statusError is returned if the endpoint doesn't respond 2xx.
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.code, e.body)
+}
+
+// retryable returns whether the request may succeed if it's retried.
+func (e *statusError) retryable() bool {
+	return e.code == http.StatusRequestTimeout || e.code == http.StatusTooManyRequests || e.code >= 500
+}
+
+// Producer posts the messages to a webhook endpoint. All the messages are
+// written in order, so the producer only has one partition.
+type Producer struct {
+	config *Config
+	client *httputil.Client
+
+	bufferLock sync.Mutex
+	buffer     []*Message
+	closed     bool
+
+	// flushLock serializes the flushes, so that the batches are posted in order.
+	flushLock sync.Mutex
+}
+
+// NewProducer creates a webhook producer.
+func NewProducer(config *Config) (*Producer, error) {
+	client, err := httputil.NewClient(config.Credential)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrWebhookInvalidConfig, err)
+	}
+	client.Timeout = config.Timeout
+	return &Producer{
+		config: config,
+		client: client,
+	}, nil
+}
+
+// AsyncSendMessage buffers the message until the next Flush.
+func (p *Producer) AsyncSendMessage(
+	ctx context.Context, topic string, message *codec.MQMessage, partition int32,
+) error {
+	p.bufferLock.Lock()
+	defer p.bufferLock.Unlock()
+	if p.closed {
+		return nil
+	}
+	p.buffer = append(p.buffer, newMessage(topic, message))
+	return nil
+}
+
+// SyncBroadcastMessage posts the message and all the buffered messages.
+func (p *Producer) SyncBroadcastMessage(ctx context.Context, topic string, message *codec.MQMessage) error {
+	if err := p.AsyncSendMessage(ctx, topic, message, 0); err != nil {
+		return err
+	}
+	return p.Flush(ctx)
+}
+
+// Flush posts all the buffered messages in batches, and returns after the
+// endpoint has accepted all of them. The messages that are not accepted are
+// kept and posted again in the next Flush.
+func (p *Producer) Flush(ctx context.Context) error {
+	p.flushLock.Lock()
+	defer p.flushLock.Unlock()
+
+	p.bufferLock.Lock()
+	messages := p.buffer
+	p.buffer = nil
+	p.bufferLock.Unlock()
+
+	for len(messages) > 0 {
+		n := p.config.BatchSize
+		if n > len(messages) {
+			n = len(messages)
+		}
+		if err := p.post(ctx, messages[:n]); err != nil {
+			p.bufferLock.Lock()
+			if !p.closed {
+				p.buffer = append(messages, p.buffer...)
+			}
+			p.bufferLock.Unlock()
+			return err
+		}
+		messages = messages[n:]
+	}
+	return nil
+}
+
+func (p *Producer) post(ctx context.Context, messages []*Message) error {
+	body, err := json.Marshal(&Batch{Messages: messages})
+	if err != nil {
+		return cerror.WrapError(cerror.ErrWebhookSendMessage, err)
+	}
+	err = retry.Do(ctx, func() error {
+		err := p.send(ctx, body)
+		if err != nil {
+			log.Warn("post messages to webhook failed",
+				zap.Int("messages", len(messages)), zap.Error(err))
+		}
+		return err
+	}, retry.WithMaxTries(int64(p.config.MaxRetry)+1),
+		retry.WithBackoffBaseDelay(p.config.RetryBackoff.Milliseconds()),
+		retry.WithBackoffMaxDelay(p.config.MaxRetryBackoff.Milliseconds()),
+		retry.WithIsRetryableErr(func(err error) bool {
+			if ctx.Err() != nil {
+				return false
+			}
+			if e, ok := errors.Cause(err).(*statusError); ok {
+				return e.retryable()
+			}
+			return true
+		}))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrWebhookSendMessage, err)
+	}
+	return nil
+}
+
+func (p *Producer) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for name, values := range p.config.Headers {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		// drain the body so that the connection can be reused.
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	return errors.Trace(&statusError{code: resp.StatusCode, body: string(respBody)})
+}
+
+// GetPartitionNum returns 1, because the messages are posted in order.
+func (p *Producer) GetPartitionNum() int32 {
+	return 1
+}
+
+// CreateTopic does nothing, the topic is only a field of the message.
+func (p *Producer) CreateTopic(topic string) error {
+	return nil
+}
+
+// Close drops the buffered messages and closes the idle connections.
+func (p *Producer) Close() error {
+	p.bufferLock.Lock()
+	defer p.bufferLock.Unlock()
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	p.buffer = nil
+	p.client.CloseIdleConnections()
+	return nil
+}
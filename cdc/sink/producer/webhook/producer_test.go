@@ -0,0 +1,214 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/sink/codec"
+	"github.com/stretchr/testify/require"
+)
+
+// mockEndpoint records the batches it accepts, and responds the statuses in
+// order before it accepts any request.
+type mockEndpoint struct {
+	sync.Mutex
+	statuses []int
+	requests int
+	batches  []*Batch
+	headers  []http.Header
+}
+
+func (e *mockEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.Lock()
+	defer e.Unlock()
+	e.requests++
+	if len(e.statuses) > 0 {
+		status := e.statuses[0]
+		e.statuses = e.statuses[1:]
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("mock error"))
+		return
+	}
+	batch := &Batch{}
+	if err := json.NewDecoder(r.Body).Decode(batch); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	e.batches = append(e.batches, batch)
+	e.headers = append(e.headers, r.Header)
+}
+
+func (e *mockEndpoint) accepted() []*Message {
+	e.Lock()
+	defer e.Unlock()
+	var messages []*Message
+	for _, batch := range e.batches {
+		messages = append(messages, batch.Messages...)
+	}
+	return messages
+}
+
+func (e *mockEndpoint) stats() (requests, batches int) {
+	e.Lock()
+	defer e.Unlock()
+	return e.requests, len(e.batches)
+}
+
+func newTestProducer(t *testing.T, endpoint *mockEndpoint, batchSize int) (*Producer, func()) {
+	server := httptest.NewServer(endpoint)
+	cfg := NewConfig()
+	cfg.Endpoint = server.URL + "/events"
+	cfg.BatchSize = batchSize
+	cfg.RetryBackoff = time.Millisecond
+	cfg.MaxRetryBackoff = 10 * time.Millisecond
+	cfg.Headers.Set("X-Tenant", "test")
+	p, err := NewProducer(cfg)
+	require.Nil(t, err)
+	return p, func() {
+		require.Nil(t, p.Close())
+		server.Close()
+	}
+}
+
+func newTestMessage(i int) *codec.MQMessage {
+	schema, table := "test", "t"
+	return codec.NewMQMessage(codec.ProtocolCanalJSON, []byte{byte(i)}, []byte{'v', byte(i)},
+		uint64(i), model.MqMessageTypeRow, &schema, &table)
+}
+
+func TestProducerFlush(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &mockEndpoint{}
+	p, closer := newTestProducer(t, endpoint, 2)
+	defer closer()
+	ctx := context.Background()
+
+	require.Equal(t, int32(1), p.GetPartitionNum())
+	require.Nil(t, p.CreateTopic("any"))
+	for i := 0; i < 5; i++ {
+		require.Nil(t, p.AsyncSendMessage(ctx, "topic", newTestMessage(i), 0))
+	}
+	// nothing is posted before the flush.
+	require.Len(t, endpoint.accepted(), 0)
+
+	require.Nil(t, p.Flush(ctx))
+	_, batches := endpoint.stats()
+	require.Equal(t, 3, batches)
+	messages := endpoint.accepted()
+	require.Len(t, messages, 5)
+	for i, msg := range messages {
+		require.Equal(t, &Message{
+			Topic:    "topic",
+			Key:      []byte{byte(i)},
+			Value:    []byte{'v', byte(i)},
+			Schema:   "test",
+			Table:    "t",
+			CommitTs: uint64(i),
+			Type:     model.MqMessageTypeRow,
+			Protocol: codec.ProtocolCanalJSON,
+		}, msg)
+	}
+	endpoint.Lock()
+	require.Equal(t, "test", endpoint.headers[0].Get("X-Tenant"))
+	require.Equal(t, "application/json", endpoint.headers[0].Get("Content-Type"))
+	endpoint.Unlock()
+
+	// an empty flush doesn't post anything.
+	require.Nil(t, p.Flush(ctx))
+	requests, _ := endpoint.stats()
+	require.Equal(t, 3, requests)
+
+	// a broadcast message is posted at once.
+	require.Nil(t, p.SyncBroadcastMessage(ctx, "topic", newTestMessage(5)))
+	require.Len(t, endpoint.accepted(), 6)
+}
+
+func TestProducerRetry(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &mockEndpoint{statuses: []int{
+		http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusRequestTimeout,
+	}}
+	p, closer := newTestProducer(t, endpoint, 64)
+	defer closer()
+	ctx := context.Background()
+
+	require.Nil(t, p.AsyncSendMessage(ctx, "topic", newTestMessage(0), 0))
+	require.Nil(t, p.Flush(ctx))
+	requests, _ := endpoint.stats()
+	require.Equal(t, 4, requests)
+	require.Len(t, endpoint.accepted(), 1)
+}
+
+func TestProducerFailure(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &mockEndpoint{statuses: []int{
+		http.StatusInternalServerError, http.StatusInternalServerError,
+		http.StatusInternalServerError, http.StatusInternalServerError,
+		http.StatusBadRequest,
+	}}
+	p, closer := newTestProducer(t, endpoint, 1)
+	defer closer()
+	ctx := context.Background()
+
+	require.Nil(t, p.AsyncSendMessage(ctx, "topic", newTestMessage(0), 0))
+	require.Nil(t, p.AsyncSendMessage(ctx, "topic", newTestMessage(1), 0))
+	// the request is tried 1 + max-retry times.
+	err := p.Flush(ctx)
+	require.Regexp(t, ".*ErrWebhookSendMessage.*unexpected status 500: mock error.*", err)
+	requests, _ := endpoint.stats()
+	require.Equal(t, 4, requests)
+
+	// a client error is not retried.
+	err = p.Flush(ctx)
+	require.Regexp(t, ".*ErrWebhookSendMessage.*unexpected status 400.*", err)
+	requests, _ = endpoint.stats()
+	require.Equal(t, 5, requests)
+
+	// the messages which are not accepted are posted in the next flush.
+	require.Nil(t, p.AsyncSendMessage(ctx, "topic", newTestMessage(2), 0))
+	require.Nil(t, p.Flush(ctx))
+	messages := endpoint.accepted()
+	require.Len(t, messages, 3)
+	for i, msg := range messages {
+		require.Equal(t, uint64(i), msg.CommitTs)
+	}
+}
+
+func TestProducerClose(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &mockEndpoint{}
+	p, closer := newTestProducer(t, endpoint, 64)
+	ctx := context.Background()
+
+	require.Nil(t, p.AsyncSendMessage(ctx, "topic", newTestMessage(0), 0))
+	closer()
+	require.Nil(t, p.Close())
+	// the producer ignores the messages after it's closed.
+	require.Nil(t, p.AsyncSendMessage(ctx, "topic", newTestMessage(1), 0))
+	require.Nil(t, p.Flush(ctx))
+	requests, _ := endpoint.stats()
+	require.Equal(t, 0, requests)
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+// rateLimiter limits the rows and the bytes emitted by all the table sinks of
+// a changefeed on a capture, a zero limit means unlimited. The limits can be
+// changed at any time, the waiting rows are throttled by the new limits.
+type rateLimiter struct {
+	rows  *rate.Limiter
+	bytes *rate.Limiter
+
+	mu                sync.Mutex
+	maxRowsPerSecond  uint64
+	maxBytesPerSecond uint64
+
+	metricThrottledDuration prometheus.Counter
+}
+
+func newRateLimiter(metricThrottledDuration prometheus.Counter) *rateLimiter {
+	return &rateLimiter{
+		rows:                    rate.NewLimiter(rate.Inf, 0),
+		bytes:                   rate.NewLimiter(rate.Inf, 0),
+		metricThrottledDuration: metricThrottledDuration,
+	}
+}
+
+// setLimit updates the limits, it returns true if the limits are changed.
+func (l *rateLimiter) setLimit(maxRowsPerSecond, maxBytesPerSecond uint64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.maxRowsPerSecond == maxRowsPerSecond && l.maxBytesPerSecond == maxBytesPerSecond {
+		return false
+	}
+	l.maxRowsPerSecond, l.maxBytesPerSecond = maxRowsPerSecond, maxBytesPerSecond
+	setLimit(l.rows, maxRowsPerSecond)
+	setLimit(l.bytes, maxBytesPerSecond)
+	return true
+}
+
+// setLimit sets the limit of the limiter, the burst is the quota of one second.
+func setLimit(l *rate.Limiter, limit uint64) {
+	if limit == 0 {
+		l.SetLimit(rate.Inf)
+		return
+	}
+	// the limits are validated by the cli and the api, clamp them anyway in
+	// case the config in etcd is modified by other ways.
+	if limit > config.MaxRateLimit {
+		limit = config.MaxRateLimit
+	}
+	// the burst is set before the limit, so a waiter never sees a finite
+	// limit with a zero burst.
+	l.SetBurst(int(limit))
+	l.SetLimit(rate.Limit(limit))
+}
+
+// wait blocks until the rows are allowed to be emitted by the limits.
+func (l *rateLimiter) wait(ctx context.Context, rows []*model.RowChangedEvent) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	size := 0
+	for _, row := range rows {
+		size += int(row.ApproximateSize)
+	}
+	throttled, err := waitN(ctx, l.rows, len(rows))
+	if err == nil {
+		var d time.Duration
+		d, err = waitN(ctx, l.bytes, size)
+		throttled += d
+	}
+	if throttled > 0 {
+		l.metricThrottledDuration.Add(throttled.Seconds())
+	}
+	return err
+}
+
+// waitN takes n tokens from the limiter, the tokens are taken in chunks of at
+// most the burst. It returns the time spent on waiting.
+func waitN(ctx context.Context, l *rate.Limiter, n int) (time.Duration, error) {
+	var throttled time.Duration
+	for n > 0 {
+		k := n
+		if l.Limit() != rate.Inf && k > l.Burst() {
+			k = l.Burst()
+		}
+		r := l.ReserveN(time.Now(), k)
+		if !r.OK() {
+			// the limit is decreased concurrently, retry with the new burst
+			continue
+		}
+		if delay := r.Delay(); delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				r.Cancel()
+				return throttled, errors.Trace(ctx.Err())
+			case <-timer.C:
+			}
+			throttled += delay
+		}
+		n -= k
+	}
+	return throttled, nil
+}
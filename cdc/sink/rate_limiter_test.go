@@ -0,0 +1,79 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func makeRows(n int, size int64) []*model.RowChangedEvent {
+	rows := make([]*model.RowChangedEvent, 0, n)
+	for i := 0; i < n; i++ {
+		rows = append(rows, &model.RowChangedEvent{ApproximateSize: size})
+	}
+	return rows
+}
+
+func throttledSeconds(t *testing.T, counter prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	require.Nil(t, counter.Write(m))
+	return m.GetCounter().GetValue()
+}
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "test"})
+	l := newRateLimiter(counter)
+
+	// unlimited
+	require.Nil(t, l.wait(ctx, makeRows(10000, 1024)))
+	require.Zero(t, throttledSeconds(t, counter))
+	require.False(t, l.setLimit(0, 0))
+
+	// the rows more than the burst are waited in chunks
+	require.True(t, l.setLimit(100, 0))
+	require.False(t, l.setLimit(100, 0))
+	start := time.Now()
+	require.Nil(t, l.wait(ctx, makeRows(150, 1024)))
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(400*time.Millisecond))
+	require.Greater(t, throttledSeconds(t, counter), 0.3)
+
+	// the bytes are limited as well
+	require.True(t, l.setLimit(0, 1000))
+	require.Nil(t, l.wait(ctx, makeRows(1, 1000)))
+	cctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	require.Regexp(t, ".*context deadline exceeded.*", l.wait(cctx, makeRows(1, 1000)))
+
+	// a huge limit is clamped rather than overflowing the burst
+	require.True(t, l.setLimit(math.MaxUint64, math.MaxUint64))
+	require.Equal(t, config.MaxRateLimit, l.rows.Burst())
+	require.Equal(t, config.MaxRateLimit, l.bytes.Burst())
+	require.Nil(t, l.wait(ctx, makeRows(100, 1024)))
+
+	// the limits are removed
+	require.True(t, l.setLimit(0, 0))
+	start = time.Now()
+	require.Nil(t, l.wait(ctx, makeRows(10000, 1024)))
+	require.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+}
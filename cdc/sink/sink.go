@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/ticdc/pkg/config"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/filter"
+	filterV2 "github.com/pingcap/tidb-tools/pkg/table-filter"
 )
 
 // Sink options keys
@@ -93,15 +94,29 @@ func init() {
 	}
 	sinkIniterMap["pulsar+ssl"] = sinkIniterMap["pulsar"]
 
-	// register local sink
+	// register webhook sink
+	sinkIniterMap["http"] = func(ctx context.Context, changefeedID model.ChangeFeedID, sinkURI *url.URL,
+		filter *filter.Filter, config *config.ReplicaConfig, opts map[string]string, errCh chan error) (Sink, error) {
+		return newWebhookSink(ctx, sinkURI, filter, config, opts, errCh)
+	}
+	sinkIniterMap["https"] = sinkIniterMap["http"]
+
+	// register local sink, it writes the rows in the specified protocol to
+	// per-table directories if the protocol is specified in the sink uri.
 	sinkIniterMap["local"] = func(ctx context.Context, changefeedID model.ChangeFeedID, sinkURI *url.URL,
 		filter *filter.Filter, config *config.ReplicaConfig, opts map[string]string, errCh chan error) (Sink, error) {
+		if sinkURI.Query().Get("protocol") != "" {
+			return cdclog.NewStorageSink(ctx, sinkURI, filter, config)
+		}
 		return cdclog.NewLocalFileSink(ctx, sinkURI, errCh)
 	}
 
 	// register s3 sink
 	sinkIniterMap["s3"] = func(ctx context.Context, changefeedID model.ChangeFeedID, sinkURI *url.URL,
 		filter *filter.Filter, config *config.ReplicaConfig, opts map[string]string, errCh chan error) (Sink, error) {
+		if sinkURI.Query().Get("protocol") != "" {
+			return cdclog.NewStorageSink(ctx, sinkURI, filter, config)
+		}
 		return cdclog.NewS3Sink(ctx, sinkURI, errCh)
 	}
 }
@@ -125,6 +140,12 @@ func Validate(ctx context.Context, sinkURI string, cfg *config.ReplicaConfig, op
 	if err != nil {
 		return err
 	}
+	if err := validateSplitTables(sinkURI, cfg); err != nil {
+		return err
+	}
+	if err := cfg.Sink.ValidateRateLimit(); err != nil {
+		return err
+	}
 	errCh := make(chan error)
 	// TODO: find a better way to verify a sinkURI is valid
 	s, err := New(ctx, "sink-verify", sinkURI, sinkFilter, cfg, opts, errCh)
@@ -144,3 +165,28 @@ func Validate(ctx context.Context, sinkURI string, cfg *config.ReplicaConfig, op
 	}
 	return nil
 }
+
+// validateSplitTables checks the tables split into key ranges can be replicated
+// by the sink. The rows of a split table are emitted by several captures, only
+// the MQ sinks allow it since they only keep the order of the rows of a key.
+func validateSplitTables(sinkURIStr string, cfg *config.ReplicaConfig) error {
+	if cfg.Scheduler == nil || len(cfg.Scheduler.SplitTables) == 0 {
+		return nil
+	}
+	if _, err := filterV2.Parse(cfg.Scheduler.SplitTables); err != nil {
+		return cerror.WrapError(cerror.ErrFilterRuleInvalid, err)
+	}
+	if cfg.Cyclic.IsEnabled() {
+		return cerror.ErrSinkInvalidConfig.GenWithStack("split tables can't be replicated when cyclic replication is enabled")
+	}
+	sinkURI, err := url.Parse(sinkURIStr)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	switch strings.ToLower(sinkURI.Scheme) {
+	case "kafka", "kafka+ssl", "pulsar", "pulsar+ssl":
+		return nil
+	}
+	return cerror.ErrSinkInvalidConfig.GenWithStack(
+		"split tables are only supported by the MQ sinks, but the sink scheme is %s", sinkURI.Scheme)
+}
@@ -41,4 +41,12 @@ func TestValidateSink(t *testing.T) {
 	sinkURI = "blackhole://"
 	err = Validate(ctx, sinkURI, replicateConfig, opts)
 	require.Nil(t, err)
+
+	// test split tables
+	replicateConfig.Scheduler.SplitTables = []string{"test.orders"}
+	err = Validate(ctx, sinkURI, replicateConfig, opts)
+	require.Regexp(t, ".*only supported by the MQ sinks.*", err)
+	require.Nil(t, validateSplitTables("kafka://127.0.0.1:9092/test", replicateConfig))
+	replicateConfig.Scheduler.SplitTables = []string{"test.orders["}
+	require.Regexp(t, ".*ErrFilterRuleInvalid.*", validateSplitTables("kafka://127.0.0.1:9092/test", replicateConfig))
 }
@@ -34,8 +34,9 @@ type SyncpointStore interface {
 	Close() error
 }
 
-// NewSyncpointStore creates a new Spyncpoint sink with the sink-uri
-func NewSyncpointStore(ctx context.Context, changefeedID model.ChangeFeedID, sinkURIStr string) (SyncpointStore, error) {
+// NewSyncpointStore creates a new Spyncpoint sink with the sink-uri, the MQ
+// sinks send the syncpoints by the sink s.
+func NewSyncpointStore(ctx context.Context, changefeedID model.ChangeFeedID, sinkURIStr string, s Sink) (SyncpointStore, error) {
 	// parse sinkURI as a URI
 	sinkURI, err := url.Parse(sinkURIStr)
 	if err != nil {
@@ -44,6 +45,12 @@ func NewSyncpointStore(ctx context.Context, changefeedID model.ChangeFeedID, sin
 	switch strings.ToLower(sinkURI.Scheme) {
 	case "mysql", "tidb", "mysql+ssl", "tidb+ssl":
 		return newMySQLSyncpointStore(ctx, changefeedID, sinkURI)
+	case "kafka", "kafka+ssl", "pulsar", "pulsar+ssl":
+		mqSink, ok := s.(*mqSink)
+		if !ok {
+			return nil, cerror.ErrSinkURIInvalid.GenWithStack("the sink of scheme (%s) is not a MQ sink", sinkURI.Scheme)
+		}
+		return newMQSyncpointStore(ctx, mqSink)
 	default:
 		return nil, cerror.ErrSinkURIInvalid.GenWithStack("the sink scheme (%s) is not supported", sinkURI.Scheme)
 	}
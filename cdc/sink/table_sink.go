@@ -38,6 +38,9 @@ func (t *tableSink) Initialize(ctx context.Context, tableInfo []*model.SimpleTab
 }
 
 func (t *tableSink) EmitRowChangedEvents(ctx context.Context, rows ...*model.RowChangedEvent) error {
+	if err := t.manager.limiter.wait(ctx, rows); err != nil {
+		return errors.Trace(err)
+	}
 	t.buffer = append(t.buffer, rows...)
 	t.manager.metricsTableSinkTotalRows.Add(float64(len(rows)))
 	if t.redoManager.Enabled() {
@@ -21,6 +21,7 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,14 +48,15 @@ import (
 // Sarama configuration options
 var (
 	kafkaAddrs           []string
-	kafkaTopic           string
-	kafkaPartitionNum    int32
+	kafkaTopics          []string
+	kafkaPartitionNums   = make(map[string]int32)
 	kafkaGroupID         = fmt.Sprintf("ticdc_kafka_consumer_%s", uuid.New().String())
 	kafkaVersion         = "2.4.0"
 	kafkaMaxMessageBytes = math.MaxInt64
 	kafkaMaxBatchSize    = math.MaxInt64
 
 	downstreamURIStr string
+	enableSyncpoint  bool
 
 	logPath       string
 	logLevel      string
@@ -67,6 +69,7 @@ func init() {
 
 	flag.StringVar(&upstreamURIStr, "upstream-uri", "", "Kafka uri")
 	flag.StringVar(&downstreamURIStr, "downstream-uri", "", "downstream sink uri")
+	flag.BoolVar(&enableSyncpoint, "enable-syncpoint", false, "record the consistent cuts of the syncpoints in the downstream")
 	flag.StringVar(&logPath, "log-file", "cdc_kafka_consumer.log", "log file path")
 	flag.StringVar(&logLevel, "log-level", "info", "log file path")
 	flag.StringVar(&timezone, "tz", "System", "Specify time zone of Kafka consumer")
@@ -99,9 +102,16 @@ func init() {
 	if s != "" {
 		kafkaGroupID = s
 	}
-	kafkaTopic = strings.TrimFunc(upstreamURI.Path, func(r rune) bool {
+	// The path of upstream-uri is a comma separated list of topics, for
+	// consuming the topics of a changefeed with per-table topic dispatchers.
+	topicList := strings.TrimFunc(upstreamURI.Path, func(r rune) bool {
 		return r == '/'
 	})
+	for _, topic := range strings.Split(topicList, ",") {
+		if topic != "" {
+			kafkaTopics = append(kafkaTopics, topic)
+		}
+	}
 	kafkaAddrs = strings.Split(upstreamURI.Host, ",")
 
 	config, err := newSaramaConfig()
@@ -109,19 +119,37 @@ func init() {
 		log.Fatal("Error creating sarama config", zap.Error(err))
 	}
 
-	s = upstreamURI.Query().Get("partition-num")
-	if s == "" {
-		partition, err := getPartitionNum(kafkaAddrs, kafkaTopic, config)
+	s = upstreamURI.Query().Get("topic-regex")
+	if s != "" {
+		topicRegex, err := regexp.Compile(s)
 		if err != nil {
-			log.Fatal("can not get partition number", zap.String("topic", kafkaTopic), zap.Error(err))
+			log.Fatal("invalid topic-regex of upstream-uri", zap.Error(err))
 		}
-		kafkaPartitionNum = partition
-	} else {
-		c, err := strconv.Atoi(s)
+		topics, err := getTopicsByRegex(kafkaAddrs, topicRegex, config)
 		if err != nil {
-			log.Fatal("invalid partition-num of upstream-uri")
+			log.Fatal("can not list topics", zap.String("topic-regex", s), zap.Error(err))
+		}
+		kafkaTopics = append(kafkaTopics, topics...)
+	}
+	if len(kafkaTopics) == 0 {
+		log.Fatal("no topic is specified by upstream-uri", zap.String("upstream-uri", upstreamURIStr))
+	}
+
+	s = upstreamURI.Query().Get("partition-num")
+	for _, topic := range kafkaTopics {
+		if s == "" {
+			partition, err := getPartitionNum(kafkaAddrs, topic, config)
+			if err != nil {
+				log.Fatal("can not get partition number", zap.String("topic", topic), zap.Error(err))
+			}
+			kafkaPartitionNums[topic] = partition
+		} else {
+			c, err := strconv.Atoi(s)
+			if err != nil {
+				log.Fatal("invalid partition-num of upstream-uri")
+			}
+			kafkaPartitionNums[topic] = int32(c)
 		}
-		kafkaPartitionNum = int32(c)
 	}
 
 	s = upstreamURI.Query().Get("max-message-bytes")
@@ -167,6 +195,26 @@ func getPartitionNum(address []string, topic string, cfg *sarama.Config) (int32,
 	return topicDetail.NumPartitions, nil
 }
 
+func getTopicsByRegex(address []string, topicRegex *regexp.Regexp, cfg *sarama.Config) ([]string, error) {
+	admin, err := sarama.NewClusterAdmin(address, cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer admin.Close()
+	topics, err := admin.ListTopics()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var matched []string
+	for topic := range topics {
+		if topicRegex.MatchString(topic) {
+			matched = append(matched, topic)
+		}
+	}
+	log.Info("get topics by regex", zap.Stringer("topic-regex", topicRegex), zap.Strings("topics", matched))
+	return matched, nil
+}
+
 func waitTopicCreated(address []string, topic string, cfg *sarama.Config) error {
 	admin, err := sarama.NewClusterAdmin(address, cfg)
 	if err != nil {
@@ -229,9 +277,11 @@ func main() {
 	if err != nil {
 		log.Fatal("Error creating sarama config", zap.Error(err))
 	}
-	err = waitTopicCreated(kafkaAddrs, kafkaTopic, config)
-	if err != nil {
-		log.Fatal("wait topic created failed", zap.Error(err))
+	for _, topic := range kafkaTopics {
+		err = waitTopicCreated(kafkaAddrs, topic, config)
+		if err != nil {
+			log.Fatal("wait topic created failed", zap.Error(err))
+		}
 	}
 	/**
 	 * Setup a new Sarama consumer group
@@ -255,7 +305,7 @@ func main() {
 			// `Consume` should be called inside an infinite loop, when a
 			// server-side rebalance happens, the consumer session will need to be
 			// recreated to get the new claims
-			if err := client.Consume(ctx, strings.Split(kafkaTopic, ","), consumer); err != nil {
+			if err := client.Consume(ctx, kafkaTopics, consumer); err != nil {
 				log.Fatal("Error from consumer: %v", zap.Error(err))
 			}
 			// check if context was cancelled, signaling that the consumer should stop
@@ -298,9 +348,13 @@ type Consumer struct {
 	maxDDLReceivedTs uint64
 	ddlListMu        sync.Mutex
 
-	sinks []*struct {
+	// sinks holds a sink for each partition of each topic, the global
+	// resolved ts is the minimal resolved ts of all of them. The minimal
+	// syncpoint ts of all of them is a consistent cut once it's resolved.
+	sinks map[string][]*struct {
 		sink.Sink
-		resolvedTs uint64
+		resolvedTs  uint64
+		syncpointTs uint64
 	}
 	sinksMu sync.Mutex
 
@@ -308,6 +362,9 @@ type Consumer struct {
 	fakeTableIDGenerator *fakeTableIDGenerator
 
 	globalResolvedTs uint64
+
+	syncpointStore  sink.SyncpointStore
+	lastSyncpointTs uint64
 }
 
 // NewConsumer creates a new cdc kafka consumer
@@ -326,23 +383,45 @@ func NewConsumer(ctx context.Context) (*Consumer, error) {
 	c.fakeTableIDGenerator = &fakeTableIDGenerator{
 		tableIDs: make(map[string]int64),
 	}
-	c.sinks = make([]*struct {
+	c.sinks = make(map[string][]*struct {
 		sink.Sink
-		resolvedTs uint64
-	}, kafkaPartitionNum)
+		resolvedTs  uint64
+		syncpointTs uint64
+	}, len(kafkaTopics))
 	ctx, cancel := context.WithCancel(ctx)
 	errCh := make(chan error, 1)
 	opts := map[string]string{}
-	for i := 0; i < int(kafkaPartitionNum); i++ {
-		s, err := sink.New(ctx, "kafka-consumer", downstreamURIStr, filter, config.GetDefaultReplicaConfig(), opts, errCh)
+	for _, topic := range kafkaTopics {
+		partitionNum := kafkaPartitionNums[topic]
+		sinks := make([]*struct {
+			sink.Sink
+			resolvedTs  uint64
+			syncpointTs uint64
+		}, partitionNum)
+		for i := 0; i < int(partitionNum); i++ {
+			s, err := sink.New(ctx, "kafka-consumer", downstreamURIStr, filter, config.GetDefaultReplicaConfig(), opts, errCh)
+			if err != nil {
+				cancel()
+				return nil, errors.Trace(err)
+			}
+			sinks[i] = &struct {
+				sink.Sink
+				resolvedTs  uint64
+				syncpointTs uint64
+			}{Sink: s}
+		}
+		c.sinks[topic] = sinks
+	}
+	if enableSyncpoint {
+		c.syncpointStore, err = sink.NewSyncpointStore(ctx, "kafka-consumer", downstreamURIStr, nil)
 		if err != nil {
 			cancel()
 			return nil, errors.Trace(err)
 		}
-		c.sinks[i] = &struct {
-			sink.Sink
-			resolvedTs uint64
-		}{Sink: s}
+		if err := c.syncpointStore.CreateSynctable(ctx); err != nil {
+			cancel()
+			return nil, errors.Trace(err)
+		}
 	}
 	sink, err := sink.New(ctx, "kafka-consumer", downstreamURIStr, filter, config.GetDefaultReplicaConfig(), opts, errCh)
 	if err != nil {
@@ -378,16 +457,18 @@ func (c *Consumer) Cleanup(sarama.ConsumerGroupSession) error {
 // ConsumeClaim must start a consumer loop of ConsumerGroupClaim's Messages().
 func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	ctx := context.TODO()
+	topic := claim.Topic()
 	partition := claim.Partition()
 	c.sinksMu.Lock()
-	sink := c.sinks[partition]
+	sinks := c.sinks[topic]
 	c.sinksMu.Unlock()
-	if sink == nil {
+	if int(partition) >= len(sinks) || sinks[partition] == nil {
 		panic("sink should initialized")
 	}
+	sink := sinks[partition]
 ClaimMessages:
 	for message := range claim.Messages() {
-		log.Info("Message claimed", zap.Int32("partition", message.Partition), zap.ByteString("key", message.Key), zap.ByteString("value", message.Value))
+		log.Info("Message claimed", zap.String("topic", message.Topic), zap.Int32("partition", message.Partition), zap.ByteString("key", message.Key), zap.ByteString("value", message.Value))
 		batchDecoder, err := codec.NewJSONEventBatchDecoder(message.Key, message.Value)
 		if err != nil {
 			return errors.Trace(err)
@@ -427,6 +508,7 @@ ClaimMessages:
 					log.Debug("filter fallback row", zap.ByteString("row", message.Key),
 						zap.Uint64("globalResolvedTs", globalResolvedTs),
 						zap.Uint64("sinkResolvedTs", sink.resolvedTs),
+						zap.String("topic", topic),
 						zap.Int32("partition", partition))
 					break ClaimMessages
 				}
@@ -452,9 +534,27 @@ ClaimMessages:
 				if resolvedTs < ts {
 					log.Debug("update sink resolved ts",
 						zap.Uint64("ts", ts),
+						zap.String("topic", topic),
 						zap.Int32("partition", partition))
 					atomic.StoreUint64(&sink.resolvedTs, ts)
 				}
+			case model.MqMessageTypeSyncpoint:
+				ts, err := batchDecoder.NextSyncpointEvent()
+				if err != nil {
+					log.Fatal("decode message value failed", zap.ByteString("value", message.Value))
+				}
+				log.Debug("syncpoint received",
+					zap.Uint64("ts", ts),
+					zap.String("topic", topic),
+					zap.Int32("partition", partition))
+				// all the rows committed before the syncpoint precede it, so
+				// the syncpoint resolves the partition as well.
+				if atomic.LoadUint64(&sink.resolvedTs) < ts {
+					atomic.StoreUint64(&sink.resolvedTs, ts)
+				}
+				if atomic.LoadUint64(&sink.syncpointTs) < ts {
+					atomic.StoreUint64(&sink.syncpointTs, ts)
+				}
 			}
 			session.MarkMessage(message, "")
 		}
@@ -505,13 +605,16 @@ func (c *Consumer) popDDL() *model.DDLEvent {
 
 func (c *Consumer) forEachSink(fn func(sink *struct {
 	sink.Sink
-	resolvedTs uint64
+	resolvedTs  uint64
+	syncpointTs uint64
 }) error) error {
 	c.sinksMu.Lock()
 	defer c.sinksMu.Unlock()
-	for _, sink := range c.sinks {
-		if err := fn(sink); err != nil {
-			return errors.Trace(err)
+	for _, sinks := range c.sinks {
+		for _, sink := range sinks {
+			if err := fn(sink); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 	return nil
@@ -531,7 +634,8 @@ func (c *Consumer) Run(ctx context.Context) error {
 		globalResolvedTs := uint64(math.MaxUint64)
 		err := c.forEachSink(func(sink *struct {
 			sink.Sink
-			resolvedTs uint64
+			resolvedTs  uint64
+			syncpointTs uint64
 		}) error {
 			resolvedTs := atomic.LoadUint64(&sink.resolvedTs)
 			if resolvedTs < globalResolvedTs {
@@ -547,7 +651,8 @@ func (c *Consumer) Run(ctx context.Context) error {
 			// flush DMLs
 			err := c.forEachSink(func(sink *struct {
 				sink.Sink
-				resolvedTs uint64
+				resolvedTs  uint64
+				syncpointTs uint64
 			}) error {
 				return syncFlushRowChangedEvents(ctx, sink, todoDDL.CommitTs)
 			})
@@ -567,6 +672,9 @@ func (c *Consumer) Run(ctx context.Context) error {
 		if todoDDL != nil && todoDDL.CommitTs < globalResolvedTs {
 			globalResolvedTs = todoDDL.CommitTs
 		}
+		if err := c.handleSyncpoint(ctx, globalResolvedTs); err != nil {
+			return errors.Trace(err)
+		}
 		if lastGlobalResolvedTs == globalResolvedTs {
 			continue
 		}
@@ -576,7 +684,8 @@ func (c *Consumer) Run(ctx context.Context) error {
 
 		err = c.forEachSink(func(sink *struct {
 			sink.Sink
-			resolvedTs uint64
+			resolvedTs  uint64
+			syncpointTs uint64
 		}) error {
 			return syncFlushRowChangedEvents(ctx, sink, globalResolvedTs)
 		})
@@ -586,6 +695,48 @@ func (c *Consumer) Run(ctx context.Context) error {
 	}
 }
 
+// handleSyncpoint materializes the consistent cut of the minimal syncpoint
+// received by all the partitions, if it's resolved.
+func (c *Consumer) handleSyncpoint(ctx context.Context, globalResolvedTs uint64) error {
+	syncpointTs := uint64(math.MaxUint64)
+	err := c.forEachSink(func(sink *struct {
+		sink.Sink
+		resolvedTs  uint64
+		syncpointTs uint64
+	}) error {
+		ts := atomic.LoadUint64(&sink.syncpointTs)
+		if ts < syncpointTs {
+			syncpointTs = ts
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if syncpointTs == math.MaxUint64 || syncpointTs <= c.lastSyncpointTs || syncpointTs > globalResolvedTs {
+		return nil
+	}
+	// flush DMLs
+	err = c.forEachSink(func(sink *struct {
+		sink.Sink
+		resolvedTs  uint64
+		syncpointTs uint64
+	}) error {
+		return syncFlushRowChangedEvents(ctx, sink, syncpointTs)
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if c.syncpointStore != nil {
+		if err := c.syncpointStore.SinkSyncpoint(ctx, "kafka-consumer", syncpointTs); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	c.lastSyncpointTs = syncpointTs
+	log.Info("consistent cut reached", zap.Uint64("syncpointTs", syncpointTs))
+	return nil
+}
+
 func syncFlushRowChangedEvents(ctx context.Context, sink sink.Sink, resolvedTs uint64) error {
 	for {
 		select {
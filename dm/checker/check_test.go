@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb-tools/pkg/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	router "github.com/pingcap/tidb-tools/pkg/table-router"
 
 	"github.com/pingcap/ticdc/dm/dm/config"
@@ -48,6 +50,13 @@ func ignoreExcept(itemMap map[string]struct{}) []string {
 		config.TableSchemaChecking,
 		config.ShardTableSchemaChecking,
 		config.ShardAutoIncrementIDChecking,
+		config.TargetDBPrivilegeChecking,
+		config.ForeignKeyChecking,
+		config.TriggerChecking,
+		config.StoredRoutineChecking,
+		config.SpatialColumnChecking,
+		config.CharsetChecking,
+		config.DumpDiskSpaceChecking,
 	}
 	ignoreCheckingItems := make([]string, 0, len(items)-len(itemMap))
 	for _, i := range items {
@@ -403,3 +412,156 @@ func (s *testCheckerSuite) TestSameTargetTableDetection(c *tc.C) {
 	mock.ExpectQuery("SHOW CREATE TABLE .*").WillReturnRows(sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow(tb1, fmt.Sprintf(createTable1, tb2)))
 	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.ErrorMatches, "(.|\n)*same table name in case-insensitive(.|\n)*")
 }
+
+func (s *testCheckerSuite) TestTargetPrivilegeChecking(c *tc.C) {
+	schema := "db_1"
+	cfgs := []*config.SubTaskConfig{
+		{
+			MetaSchema:          "dm_meta",
+			IgnoreCheckingItems: ignoreExcept(map[string]struct{}{config.TargetDBPrivilegeChecking: {}}),
+		},
+	}
+
+	mock := conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"DATABASE"}).AddRow(schema))
+	mock.ExpectQuery("SHOW FULL TABLES").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_" + schema, "Table_type"}).AddRow("t_1", "BASE TABLE"))
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT SELECT,INSERT,UPDATE,DELETE ON *.* TO 'haha'@'%'").
+		AddRow("GRANT ALL PRIVILEGES ON `dm\\_meta`.* TO 'haha'@'%'"))
+	err := CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt)
+	c.Assert(err, tc.ErrorMatches, "(.|\n)*lack of CREATE,DROP,ALTER,INDEX privilege on schema db_1(.|\n)*")
+	c.Assert(err, tc.Not(tc.ErrorMatches), "(.|\n)*on schema dm_meta(.|\n)*")
+
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"DATABASE"}).AddRow(schema))
+	mock.ExpectQuery("SHOW FULL TABLES").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_" + schema, "Table_type"}).AddRow("t_1", "BASE TABLE"))
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT ALL PRIVILEGES ON *.* TO 'haha'@'%'"))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.IsNil)
+}
+
+func (s *testCheckerSuite) TestTargetPrivilegeChecker(c *tc.C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, tc.IsNil)
+	dbinfo := &dbutil.DBConfig{}
+	schemas := []string{"db_1"}
+
+	// the privileges granted through the roles are expanded
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT USAGE ON *.* TO 'haha'@'10.0.0.%'").
+		AddRow("GRANT `r_1`@`%` TO 'haha'@'10.0.0.%'"))
+	mock.ExpectQuery("SHOW GRANTS USING `r_1`@`%`").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT ALL PRIVILEGES ON *.* TO 'haha'@'10.0.0.%'").
+		AddRow("GRANT `r_1`@`%` TO 'haha'@'10.0.0.%'"))
+	result := newTargetPrivilegeChecker(db, dbinfo, schemas).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateSuccess)
+
+	// the real host of the user is used in the instruction
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT USAGE ON *.* TO 'haha'@'localhost'"))
+	result = newTargetPrivilegeChecker(db, dbinfo, schemas).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateFailure)
+	c.Assert(result.Instruction, tc.Equals, "GRANT SELECT,INSERT,UPDATE,DELETE,CREATE,DROP,ALTER,INDEX ON `db_1`.* TO 'haha'@'localhost';")
+
+	// the DB names of the grants are patterns with wildcards
+	schemas = []string{"db_1", "dbx1", "app_1"}
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT USAGE ON *.* TO 'haha'@'%'").
+		AddRow("GRANT ALL PRIVILEGES ON `db\\_%`.* TO 'haha'@'%'").
+		AddRow("GRANT ALL PRIVILEGES ON `APP_%`.* TO 'haha'@'%'"))
+	result = newTargetPrivilegeChecker(db, dbinfo, schemas).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateFailure)
+	c.Assert(result.Errors, tc.HasLen, 1)
+	c.Assert(result.Errors[0].ShortErr, tc.Equals, "lack of SELECT,INSERT,UPDATE,DELETE,CREATE,DROP,ALTER,INDEX privilege on schema dbx1")
+	c.Assert(mock.ExpectationsWereMet(), tc.IsNil)
+}
+
+func (s *testCheckerSuite) TestSpatialColumnChecking(c *tc.C) {
+	schema := "db_1"
+	cfgs := []*config.SubTaskConfig{
+		{
+			IgnoreCheckingItems: ignoreExcept(map[string]struct{}{config.SpatialColumnChecking: {}}),
+		},
+	}
+
+	mock := conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"DATABASE"}).AddRow(schema))
+	mock.ExpectQuery("SHOW FULL TABLES").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_" + schema, "Table_type"}).AddRow("t_1", "BASE TABLE"))
+	mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME, COLUMN_NAME, DATA_TYPE FROM information_schema.COLUMNS").WithArgs(schema).
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "COLUMN_NAME", "DATA_TYPE"}).AddRow(schema, "t_1", "pos", "point"))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt),
+		tc.ErrorMatches, "(.|\n)*table `db_1`.`t_1` has column pos of spatial type point(.|\n)*")
+
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"DATABASE"}).AddRow(schema))
+	mock.ExpectQuery("SHOW FULL TABLES").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_" + schema, "Table_type"}).AddRow("t_1", "BASE TABLE"))
+	mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME, COLUMN_NAME, DATA_TYPE FROM information_schema.COLUMNS").WithArgs(schema).
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "COLUMN_NAME", "DATA_TYPE"}))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.IsNil)
+}
+
+func (s *testCheckerSuite) TestSourceObjectChecker(c *tc.C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, tc.IsNil)
+	dbinfo := &dbutil.DBConfig{}
+	tables := map[string][]string{"db_1": {"t_1", "t_2"}}
+
+	// objects of the tables not migrated are ignored
+	mock.ExpectQuery("SELECT CONSTRAINT_SCHEMA, TABLE_NAME, CONSTRAINT_NAME, REFERENCED_TABLE_NAME FROM information_schema.REFERENTIAL_CONSTRAINTS").
+		WithArgs("db_1").
+		WillReturnRows(sqlmock.NewRows([]string{"CONSTRAINT_SCHEMA", "TABLE_NAME", "CONSTRAINT_NAME", "REFERENCED_TABLE_NAME"}).
+			AddRow("db_1", "t_2", "fk_1", "t_1").AddRow("db_1", "t_3", "fk_2", "t_1"))
+	result := newSourceObjectChecker(config.ForeignKeyChecking, db, dbinfo, tables).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateWarning)
+	c.Assert(result.Errors, tc.HasLen, 1)
+	c.Assert(result.Errors[0].ShortErr, tc.Equals, "table `db_1`.`t_2` has foreign key fk_1 referencing table t_1")
+
+	mock.ExpectQuery("SELECT ROUTINE_SCHEMA, '', ROUTINE_NAME, ROUTINE_TYPE FROM information_schema.ROUTINES").
+		WithArgs("db_1").
+		WillReturnRows(sqlmock.NewRows([]string{"ROUTINE_SCHEMA", "", "ROUTINE_NAME", "ROUTINE_TYPE"}).AddRow("db_1", "", "p_1", "PROCEDURE"))
+	result = newSourceObjectChecker(config.StoredRoutineChecking, db, dbinfo, tables).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateWarning)
+	c.Assert(result.Errors, tc.HasLen, 1)
+	c.Assert(result.Errors[0].ShortErr, tc.Equals, "schema `db_1` has stored routine p_1 of type PROCEDURE")
+
+	mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME, COLUMN_NAME, CHARACTER_SET_NAME FROM information_schema.COLUMNS").
+		WithArgs("db_1").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "COLUMN_NAME", "CHARACTER_SET_NAME"}).
+			AddRow("db_1", "t_1", "a", "utf8mb4").AddRow("db_1", "t_1", "b", "ucs2").AddRow("db_1", "t_2", "c", "utf8mb3"))
+	result = newSourceObjectChecker(config.CharsetChecking, db, dbinfo, tables).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateWarning)
+	c.Assert(result.Errors, tc.HasLen, 1)
+	c.Assert(result.Errors[0].ShortErr, tc.Equals, "table `db_1`.`t_1` has column b of charset ucs2, which may be unsupported")
+
+	mock.ExpectQuery("SELECT TRIGGER_SCHEMA, EVENT_OBJECT_TABLE, TRIGGER_NAME, EVENT_MANIPULATION FROM information_schema.TRIGGERS").
+		WithArgs("db_1").
+		WillReturnRows(sqlmock.NewRows([]string{"TRIGGER_SCHEMA", "EVENT_OBJECT_TABLE", "TRIGGER_NAME", "EVENT_MANIPULATION"}))
+	result = newSourceObjectChecker(config.TriggerChecking, db, dbinfo, tables).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateSuccess)
+	c.Assert(mock.ExpectationsWereMet(), tc.IsNil)
+}
+
+func (s *testCheckerSuite) TestDumpDiskSpaceChecker(c *tc.C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, tc.IsNil)
+	dbinfo := &dbutil.DBConfig{}
+	tables := map[string][]string{"db_1": {"t_1"}}
+	dir := c.MkDir() + "/dumped_data.test"
+
+	// the tables not migrated are ignored
+	mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME, DATA_LENGTH FROM information_schema.TABLES").WithArgs("db_1").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "DATA_LENGTH"}).
+			AddRow("db_1", "t_2", int64(1)<<62))
+	result := newDumpDiskSpaceChecker(db, dbinfo, tables, dir).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateSuccess)
+
+	// the space of DM-worker is not checked on DM-master
+	mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME, DATA_LENGTH FROM information_schema.TABLES").WithArgs("db_1").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "DATA_LENGTH"}).
+			AddRow("db_1", "t_1", 1024).AddRow("db_1", "t_2", int64(1)<<62))
+	result = newDumpDiskSpaceChecker(db, dbinfo, tables, dir).Check(context.Background())
+	c.Assert(result.State, tc.Equals, check.StateWarning)
+	c.Assert(result.Errors, tc.HasLen, 1)
+	c.Assert(result.Errors[0].ShortErr, tc.Matches, "the estimated size of dumped data is 1KiB, the available space of dump directory .* on DM-worker is not checked")
+	c.Assert(mock.ExpectationsWereMet(), tc.IsNil)
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -120,6 +121,15 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 	_, checkingShardID := c.checkingItems[config.ShardAutoIncrementIDChecking]
 	_, checkingShard := c.checkingItems[config.ShardTableSchemaChecking]
 	_, checkSchema := c.checkingItems[config.TableSchemaChecking]
+	_, checkTargetPrivilege := c.checkingItems[config.TargetDBPrivilegeChecking]
+	_, checkDumpDiskSpace := c.checkingItems[config.DumpDiskSpaceChecking]
+	objectItems := make([]string, 0, len(sourceObjects))
+	for item := range sourceObjects {
+		if _, ok := c.checkingItems[item]; ok {
+			objectItems = append(objectItems, item)
+		}
+	}
+	sort.Strings(objectItems)
 
 	for _, instance := range c.instances {
 		bw, err := filter.New(instance.cfg.CaseSensitive, instance.cfg.BAList)
@@ -184,7 +194,7 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 			c.checkList = append(c.checkList, check.NewSourceReplicationPrivilegeChecker(instance.sourceDB.DB, instance.sourceDBinfo))
 		}
 
-		if !checkingShard && !checkSchema {
+		if !checkingShard && !checkSchema && !checkTargetPrivilege && !checkDumpDiskSpace && len(objectItems) == 0 {
 			continue
 		}
 
@@ -199,9 +209,17 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 		}
 
 		checkTables := make(map[string][]string)
+		targetSchemas := map[string]struct{}{instance.cfg.MetaSchema: {}}
 		for name, tables := range mapping {
 			for _, table := range tables {
 				checkTables[table.Schema] = append(checkTables[table.Schema], table.Name)
+				if checkTargetPrivilege {
+					targetSchema, _, err := r.Route(table.Schema, table.Name)
+					if err != nil {
+						return terror.ErrTaskCheckGenTableRouter.Delegate(err)
+					}
+					targetSchemas[targetSchema] = struct{}{}
+				}
 				if _, ok := sharding[name]; !ok {
 					sharding[name] = make(map[string]map[string][]string)
 				}
@@ -221,6 +239,19 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 		if checkSchema {
 			c.checkList = append(c.checkList, check.NewTablesChecker(instance.sourceDB.DB, instance.sourceDBinfo, checkTables))
 		}
+		if checkTargetPrivilege {
+			schemas := make([]string, 0, len(targetSchemas))
+			for schema := range targetSchemas {
+				schemas = append(schemas, schema)
+			}
+			c.checkList = append(c.checkList, newTargetPrivilegeChecker(instance.targetDB.DB, instance.targetDBInfo, schemas))
+		}
+		for _, item := range objectItems {
+			c.checkList = append(c.checkList, newSourceObjectChecker(item, instance.sourceDB.DB, instance.sourceDBinfo, checkTables))
+		}
+		if checkDumpDiskSpace {
+			c.checkList = append(c.checkList, newDumpDiskSpaceChecker(instance.sourceDB.DB, instance.sourceDBinfo, checkTables, instance.cfg.Dir))
+		}
 	}
 
 	if checkingShard {
@@ -357,6 +388,17 @@ func (c *Checker) updateInstruction(result *check.Results) {
 	}
 }
 
+// markCheckError marks the result as failed by the error, or as warning if
+// the check is canceled.
+func markCheckError(result *check.Result, err error) {
+	state := check.StateFailure
+	if errors.Is(err, context.Canceled) {
+		state = check.StateWarning
+	}
+	result.State = state
+	result.Errors = append(result.Errors, &check.Error{Severity: state, ShortErr: err.Error()})
+}
+
 // Close implements Unit interface.
 func (c *Checker) Close() {
 	if c.closed.Load() {
@@ -0,0 +1,122 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/tidb-tools/pkg/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+
+	"github.com/pingcap/ticdc/dm/pkg/storage"
+)
+
+// dumpDiskSpaceChecker estimates the size of the dumped data by the data length
+// of the migrated tables, and warns about the space needed in the dump directory.
+type dumpDiskSpaceChecker struct {
+	db     *sql.DB
+	dbinfo *dbutil.DBConfig
+	tables map[string][]string // schema => [tables]
+	dir    string
+}
+
+func newDumpDiskSpaceChecker(db *sql.DB, dbinfo *dbutil.DBConfig, tables map[string][]string, dir string) check.Checker {
+	return &dumpDiskSpaceChecker{db: db, dbinfo: dbinfo, tables: tables, dir: dir}
+}
+
+// Check implements the check.Checker interface.
+func (dc *dumpDiskSpaceChecker) Check(ctx context.Context) *check.Result {
+	result := &check.Result{
+		Name:  dc.Name(),
+		Desc:  "check disk space of dump directory",
+		State: check.StateSuccess,
+		Extra: fmt.Sprintf("address of db instance - %s:%d, dump directory - %s", dc.dbinfo.Host, dc.dbinfo.Port, dc.dir),
+	}
+	// the space of external storage is not checked.
+	if len(dc.tables) == 0 || !storage.IsLocalDiskPath(dc.dir) {
+		return result
+	}
+
+	estimated, err := dc.estimateDumpSize(ctx)
+	if err != nil {
+		markCheckError(result, err)
+		return result
+	}
+	if estimated == 0 {
+		return result
+	}
+
+	// the checker runs on DM-master during precheck, but the dump directory is on the host of
+	// DM-worker, so the available space can't be checked here. we only tell the user how much
+	// space the dumped data needs.
+	result.State = check.StateWarning
+	result.Errors = append(result.Errors, &check.Error{
+		Severity: check.StateWarning,
+		ShortErr: fmt.Sprintf("the estimated size of dumped data is %s, the available space of dump directory %s on DM-worker is not checked",
+			units.BytesSize(float64(estimated)), dc.dir),
+	})
+	result.Instruction = "please make sure the dump directory of DM-worker has enough space, or set `dir` of loader config to a directory with enough space"
+	return result
+}
+
+func (dc *dumpDiskSpaceChecker) estimateDumpSize(ctx context.Context) (uint64, error) {
+	schemas := make([]string, 0, len(dc.tables))
+	for schema := range dc.tables {
+		schemas = append(schemas, schema)
+	}
+	sort.Strings(schemas)
+	args := make([]interface{}, 0, len(schemas))
+	for _, schema := range schemas {
+		args = append(args, schema)
+	}
+	query := fmt.Sprintf("SELECT TABLE_SCHEMA, TABLE_NAME, DATA_LENGTH FROM information_schema.TABLES WHERE TABLE_SCHEMA IN (%s)",
+		strings.TrimSuffix(strings.Repeat("?, ", len(schemas)), ", "))
+
+	rows, err := dc.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	tables := make(map[string]struct{})
+	for schema, tbs := range dc.tables {
+		for _, tb := range tbs {
+			tables[dbutil.TableName(schema, tb)] = struct{}{}
+		}
+	}
+	var total uint64
+	for rows.Next() {
+		var (
+			schema, table string
+			length        sql.NullInt64
+		)
+		if err = rows.Scan(&schema, &table, &length); err != nil {
+			return 0, err
+		}
+		if _, ok := tables[dbutil.TableName(schema, table)]; ok && length.Int64 > 0 {
+			total += uint64(length.Int64)
+		}
+	}
+	return total, rows.Err()
+}
+
+// Name implements the check.Checker interface.
+func (dc *dumpDiskSpaceChecker) Name() string {
+	return "dump directory disk space checker"
+}
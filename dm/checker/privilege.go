@@ -0,0 +1,188 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/stringutil"
+)
+
+// targetPrivileges are the privileges needed to create the migrated tables and
+// write the checkpoints to the meta schema in target DB.
+var targetPrivileges = []mysql.PrivilegeType{
+	mysql.SelectPriv,
+	mysql.InsertPriv,
+	mysql.UpdatePriv,
+	mysql.DeletePriv,
+	mysql.CreatePriv,
+	mysql.DropPriv,
+	mysql.AlterPriv,
+	mysql.IndexPriv,
+}
+
+// targetPrivilegeChecker checks the privileges of target DB on the target
+// schemas and the meta schema.
+type targetPrivilegeChecker struct {
+	db      *sql.DB
+	dbinfo  *dbutil.DBConfig
+	schemas []string
+}
+
+func newTargetPrivilegeChecker(db *sql.DB, dbinfo *dbutil.DBConfig, schemas []string) check.Checker {
+	return &targetPrivilegeChecker{db: db, dbinfo: dbinfo, schemas: schemas}
+}
+
+// Check implements the check.Checker interface.
+func (pc *targetPrivilegeChecker) Check(ctx context.Context) *check.Result {
+	result := &check.Result{
+		Name:  pc.Name(),
+		Desc:  "check privileges of target DB",
+		State: check.StateFailure,
+		Extra: fmt.Sprintf("address of db instance - %s:%d", pc.dbinfo.Host, pc.dbinfo.Port),
+	}
+
+	// the privileges granted through the roles are expanded by ShowGrants
+	grants, err := dbutil.ShowGrants(ctx, pc.db, "", "")
+	if err != nil {
+		markCheckError(result, err)
+		return result
+	}
+
+	verifyTargetPrivileges(result, grants, pc.schemas)
+	return result
+}
+
+// Name implements the check.Checker interface.
+func (pc *targetPrivilegeChecker) Name() string {
+	return "target db privilege checker"
+}
+
+// verifyTargetPrivileges checks that every privilege in targetPrivileges is
+// granted globally or on each of the schemas.
+func verifyTargetPrivileges(result *check.Result, grants []string, schemas []string) {
+	if len(grants) == 0 {
+		result.Errors = append(result.Errors, check.NewError("there is no such grant defined for current user"))
+		return
+	}
+
+	var (
+		user     *auth.UserIdentity
+		global   = make(map[mysql.PrivilegeType]struct{})
+		schemaDB = make(map[string]map[mysql.PrivilegeType]struct{})
+	)
+	for _, grant := range grants {
+		node, err := parser.New().ParseOneStmt(grant, "", "")
+		if err != nil {
+			result.Errors = append(result.Errors, check.NewError("parse grant %s: %v", grant, err))
+			return
+		}
+		if roleStmt, ok := node.(*ast.GrantRoleStmt); ok && user == nil && len(roleStmt.Users) > 0 {
+			user = roleStmt.Users[0]
+		}
+		grantStmt, ok := node.(*ast.GrantStmt)
+		if !ok {
+			// the privileges of the roles are shown by the other grants
+			continue
+		}
+		if user == nil && len(grantStmt.Users) > 0 {
+			user = grantStmt.Users[0].User
+		}
+
+		var privs map[mysql.PrivilegeType]struct{}
+		switch grantStmt.Level.Level {
+		case ast.GrantLevelGlobal:
+			privs = global
+		case ast.GrantLevelDB:
+			pattern := strings.ToLower(grantStmt.Level.DBName)
+			if schemaDB[pattern] == nil {
+				schemaDB[pattern] = make(map[mysql.PrivilegeType]struct{})
+			}
+			privs = schemaDB[pattern]
+		default:
+			// table level privileges are not enough to create tables
+			continue
+		}
+		for _, privElem := range grantStmt.Privs {
+			if privElem.Priv == mysql.AllPriv {
+				for _, p := range targetPrivileges {
+					privs[p] = struct{}{}
+				}
+			} else if len(privElem.Cols) == 0 {
+				privs[privElem.Priv] = struct{}{}
+			}
+		}
+	}
+
+	grantee := "CURRENT_USER()"
+	if user != nil {
+		grantee = fmt.Sprintf("'%s'@'%s'", user.Username, user.Hostname)
+	}
+	sorted := append([]string(nil), schemas...)
+	sort.Strings(sorted)
+	instructions := make([]string, 0, len(sorted))
+	for _, schema := range sorted {
+		schemaPrivs := matchGrantDBPatterns(schemaDB, schema)
+		lackPrivs := make([]string, 0, len(targetPrivileges))
+		for _, p := range targetPrivileges {
+			if _, ok := global[p]; ok {
+				continue
+			}
+			if _, ok := schemaPrivs[p]; ok {
+				continue
+			}
+			lackPrivs = append(lackPrivs, strings.ToUpper(mysql.Priv2Str[p]))
+		}
+		if len(lackPrivs) == 0 {
+			continue
+		}
+		privileges := strings.Join(lackPrivs, ",")
+		result.Errors = append(result.Errors, check.NewError("lack of %s privilege on schema %s", privileges, schema))
+		instructions = append(instructions, fmt.Sprintf("GRANT %s ON %s.* TO %s;", privileges, dbutil.ColumnName(schema), grantee))
+	}
+
+	if len(instructions) > 0 {
+		result.Instruction = strings.Join(instructions, " ")
+		return
+	}
+	result.State = check.StateSuccess
+}
+
+// matchGrantDBPatterns returns the privileges of the DB level grants matching schema.
+// like MySQL, the DB names of the grants are patterns, `_` and `%` are wildcards
+// unless they are escaped by `\`.
+func matchGrantDBPatterns(schemaDB map[string]map[mysql.PrivilegeType]struct{}, schema string) map[mysql.PrivilegeType]struct{} {
+	privs := make(map[mysql.PrivilegeType]struct{})
+	schema = strings.ToLower(schema)
+	for pattern, granted := range schemaDB {
+		patChars, patTypes := stringutil.CompilePattern(pattern, '\\')
+		if !stringutil.DoMatch(schema, patChars, patTypes) {
+			continue
+		}
+		for p := range granted {
+			privs[p] = struct{}{}
+		}
+	}
+	return privs
+}
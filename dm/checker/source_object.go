@@ -0,0 +1,188 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb/parser/charset"
+
+	"github.com/pingcap/ticdc/dm/dm/config"
+)
+
+// sourceObject describes a kind of object in source DB which TiDB doesn't
+// support or handles differently.
+type sourceObject struct {
+	name     string
+	desc     string
+	severity check.State
+	// query selects schema, table, object name and detail of the objects,
+	// %s is replaced by the placeholders of the schemas. table is empty if
+	// the object doesn't belong to a table.
+	query string
+	// unsupported filters the objects by detail, nil means all the objects
+	// are unsupported.
+	unsupported func(detail string) bool
+	// errFormat formats the error with the table (or schema), object name and detail.
+	errFormat   string
+	instruction string
+}
+
+var sourceObjects = map[string]*sourceObject{
+	config.ForeignKeyChecking: {
+		name:     "source db foreign key checker",
+		desc:     "check foreign keys of source tables",
+		severity: check.StateWarning,
+		query: "SELECT CONSTRAINT_SCHEMA, TABLE_NAME, CONSTRAINT_NAME, REFERENCED_TABLE_NAME " +
+			"FROM information_schema.REFERENTIAL_CONSTRAINTS WHERE CONSTRAINT_SCHEMA IN (%s)",
+		errFormat:   "table %s has foreign key %s referencing table %s",
+		instruction: "TiDB doesn't check foreign key constraints or run the cascading actions, please make sure the migration doesn't rely on them",
+	},
+	config.TriggerChecking: {
+		name:     "source db trigger checker",
+		desc:     "check triggers of source tables",
+		severity: check.StateWarning,
+		query: "SELECT TRIGGER_SCHEMA, EVENT_OBJECT_TABLE, TRIGGER_NAME, EVENT_MANIPULATION " +
+			"FROM information_schema.TRIGGERS WHERE TRIGGER_SCHEMA IN (%s)",
+		errFormat:   "table %s has trigger %s on %s",
+		instruction: "triggers are not migrated, the changes made by them are migrated as row changes",
+	},
+	config.StoredRoutineChecking: {
+		name:     "source db stored routine checker",
+		desc:     "check stored procedures and functions of source DB",
+		severity: check.StateWarning,
+		query: "SELECT ROUTINE_SCHEMA, '', ROUTINE_NAME, ROUTINE_TYPE " +
+			"FROM information_schema.ROUTINES WHERE ROUTINE_SCHEMA IN (%s)",
+		errFormat:   "schema %s has stored routine %s of type %s",
+		instruction: "TiDB doesn't support stored procedures and functions, they are not migrated",
+	},
+	config.SpatialColumnChecking: {
+		name:     "source db spatial column checker",
+		desc:     "check spatial columns of source tables",
+		severity: check.StateFailure,
+		query: "SELECT TABLE_SCHEMA, TABLE_NAME, COLUMN_NAME, DATA_TYPE FROM information_schema.COLUMNS WHERE TABLE_SCHEMA IN (%s) " +
+			"AND DATA_TYPE IN ('geometry', 'point', 'linestring', 'polygon', 'multipoint', 'multilinestring', 'multipolygon', 'geometrycollection', 'geomcollection')",
+		errFormat:   "table %s has column %s of spatial type %s",
+		instruction: "TiDB doesn't support spatial types, please filter out the table or change the column type",
+	},
+	config.CharsetChecking: {
+		name: "source db charset checker",
+		desc: "check charsets of source tables",
+		// the charsets unknown by DM may still be supported by the target TiDB
+		severity: check.StateWarning,
+		query: "SELECT TABLE_SCHEMA, TABLE_NAME, COLUMN_NAME, CHARACTER_SET_NAME " +
+			"FROM information_schema.COLUMNS WHERE TABLE_SCHEMA IN (%s) AND CHARACTER_SET_NAME IS NOT NULL",
+		unsupported: func(cs string) bool {
+			// MySQL 8.0.30 reports utf8 as utf8mb3
+			if strings.EqualFold(cs, "utf8mb3") {
+				cs = charset.CharsetUTF8
+			}
+			_, err := charset.GetCharsetInfo(cs)
+			return err != nil
+		},
+		errFormat:   "table %s has column %s of charset %s, which may be unsupported",
+		instruction: "please make sure the charset is supported by the target TiDB, otherwise filter out the table or convert the column to a supported charset",
+	},
+}
+
+// sourceObjectChecker checks a kind of object of the migrated tables in source DB.
+type sourceObjectChecker struct {
+	object *sourceObject
+	db     *sql.DB
+	dbinfo *dbutil.DBConfig
+	tables map[string][]string // schema => [tables]
+}
+
+func newSourceObjectChecker(item string, db *sql.DB, dbinfo *dbutil.DBConfig, tables map[string][]string) check.Checker {
+	return &sourceObjectChecker{object: sourceObjects[item], db: db, dbinfo: dbinfo, tables: tables}
+}
+
+// Check implements the check.Checker interface.
+func (oc *sourceObjectChecker) Check(ctx context.Context) *check.Result {
+	result := &check.Result{
+		Name:  oc.Name(),
+		Desc:  oc.object.desc,
+		State: check.StateSuccess,
+		Extra: fmt.Sprintf("address of db instance - %s:%d", oc.dbinfo.Host, oc.dbinfo.Port),
+	}
+	if len(oc.tables) == 0 {
+		return result
+	}
+
+	schemas := make([]string, 0, len(oc.tables))
+	tables := make(map[string]map[string]struct{}, len(oc.tables))
+	for schema, tbs := range oc.tables {
+		schemas = append(schemas, schema)
+		tables[schema] = make(map[string]struct{}, len(tbs))
+		for _, tb := range tbs {
+			tables[schema][tb] = struct{}{}
+		}
+	}
+	sort.Strings(schemas)
+	args := make([]interface{}, 0, len(schemas))
+	for _, schema := range schemas {
+		args = append(args, schema)
+	}
+	query := fmt.Sprintf(oc.object.query, strings.TrimSuffix(strings.Repeat("?, ", len(schemas)), ", "))
+
+	rows, err := oc.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		markCheckError(result, err)
+		return result
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var schema, table, name, detail sql.NullString
+		if err = rows.Scan(&schema, &table, &name, &detail); err != nil {
+			markCheckError(result, err)
+			return result
+		}
+		object := dbutil.ColumnName(schema.String)
+		if table.String != "" {
+			if _, ok := tables[schema.String][table.String]; !ok {
+				continue
+			}
+			object = dbutil.TableName(schema.String, table.String)
+		}
+		if oc.object.unsupported != nil && !oc.object.unsupported(detail.String) {
+			continue
+		}
+		result.State = oc.object.severity
+		result.Errors = append(result.Errors, &check.Error{
+			Severity: oc.object.severity,
+			ShortErr: fmt.Sprintf(oc.object.errFormat, object, name.String, detail.String),
+		})
+	}
+	if err = rows.Err(); err != nil {
+		markCheckError(result, err)
+		return result
+	}
+
+	if len(result.Errors) > 0 {
+		result.Instruction = oc.object.instruction
+	}
+	return result
+}
+
+// Name implements the check.Checker interface.
+func (oc *sourceObjectChecker) Name() string {
+	return oc.object.name
+}
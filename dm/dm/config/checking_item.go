@@ -34,6 +34,13 @@ const (
 	TableSchemaChecking          = "table_schema"
 	ShardTableSchemaChecking     = "schema_of_shard_tables"
 	ShardAutoIncrementIDChecking = "auto_increment_ID"
+	TargetDBPrivilegeChecking    = "target_privilege"
+	ForeignKeyChecking           = "foreign_key"
+	TriggerChecking              = "trigger"
+	StoredRoutineChecking        = "stored_routine"
+	SpatialColumnChecking        = "spatial_column"
+	CharsetChecking              = "charset"
+	DumpDiskSpaceChecking        = "dump_disk_space"
 )
 
 // AllCheckingItems contains all checking items.
@@ -49,6 +56,13 @@ var AllCheckingItems = map[string]string{
 	TableSchemaChecking:          "table schema compatibility checking item",
 	ShardTableSchemaChecking:     "consistent schema of shard tables checking item",
 	ShardAutoIncrementIDChecking: "conflict auto increment ID of shard tables checking item",
+	TargetDBPrivilegeChecking:    "privileges of target DB checking item",
+	ForeignKeyChecking:           "foreign keys of source tables checking item",
+	TriggerChecking:              "triggers of source tables checking item",
+	StoredRoutineChecking:        "stored procedures and functions of source DB checking item",
+	SpatialColumnChecking:        "spatial columns of source tables checking item",
+	CharsetChecking:              "charsets of source tables checking item",
+	DumpDiskSpaceChecking:        "disk space of dump directory checking item",
 }
 
 // MaxSourceIDLength is the max length for dm-worker source id.
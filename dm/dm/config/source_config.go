@@ -31,6 +31,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
 
 	"github.com/pingcap/ticdc/dm/pkg/binlog"
 	"github.com/pingcap/ticdc/dm/pkg/gtid"
@@ -58,6 +59,14 @@ type PurgeConfig struct {
 	Interval    int64 `yaml:"interval" toml:"interval" json:"interval"`             // check whether need to purge at this @Interval (seconds)
 	Expires     int64 `yaml:"expires" toml:"expires" json:"expires"`                // if file's modified time is older than @Expires (hours), then it can be purged
 	RemainSpace int64 `yaml:"remain-space" toml:"remain-space" json:"remain-space"` // if remain space in @RelayBaseDir less than @RemainSpace (GB), then it can be purged
+	// any new config item, we mark it omitempty
+	Archive ArchiveConfig `yaml:"archive,omitempty" toml:"archive" json:"archive"`
+}
+
+// ArchiveConfig is the configuration for archiving relay log files to external storage before purging them.
+type ArchiveConfig struct {
+	Storage      string `yaml:"storage" toml:"storage" json:"storage"`                   // URL of the external storage like `s3://bucket/prefix`, empty means not archive
+	ExpiresHours int64  `yaml:"expires-hours" toml:"expires-hours" json:"expires-hours"` // if archived file is older than @ExpiresHours, then it's removed from external storage, 0 means keep forever
 }
 
 // SourceConfig is the configuration for source.
@@ -225,6 +234,15 @@ func (c *SourceConfig) Verify() error {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
 
+	if len(c.Purge.Archive.Storage) > 0 {
+		if _, err = bstorage.ParseBackend(c.Purge.Archive.Storage, nil); err != nil {
+			return terror.ErrConfigRelayArchiveInvalid.Delegate(err, c.Purge.Archive.Storage)
+		}
+	}
+	if c.Purge.Archive.ExpiresHours < 0 {
+		return terror.ErrConfigRelayArchiveInvalid.Generate(c.Purge.Archive.Storage)
+	}
+
 	return nil
 }
 
@@ -224,6 +224,31 @@ func (t *testConfig) TestConfigVerify(c *C) {
 			},
 			"",
 		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.Purge.Archive.Storage = "s3://bucket/relay"
+				cfg.Purge.Archive.ExpiresHours = 720
+				return cfg
+			},
+			"",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.Purge.Archive.Storage = "unknown://bucket/relay"
+				return cfg
+			},
+			".*relay log archive config with storage unknown://bucket/relay is invalid.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.Purge.Archive.ExpiresHours = -1
+				return cfg
+			},
+			".*relay log archive config with storage  is invalid.*",
+		},
 	}
 
 	for _, tc := range testCases {
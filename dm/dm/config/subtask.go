@@ -19,9 +19,12 @@ import (
 	"flag"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/go-mysql-org/go-mysql/mysql"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	"github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb-tools/pkg/filter"
@@ -29,8 +32,11 @@ import (
 	lcfg "github.com/pingcap/tidb/br/pkg/lightning/config"
 	"go.uber.org/zap"
 
+	"github.com/pingcap/ticdc/dm/pkg/binlog"
 	"github.com/pingcap/ticdc/dm/pkg/dumpling"
+	"github.com/pingcap/ticdc/dm/pkg/gtid"
 	"github.com/pingcap/ticdc/dm/pkg/log"
+	"github.com/pingcap/ticdc/dm/pkg/storage"
 	"github.com/pingcap/ticdc/dm/pkg/terror"
 	"github.com/pingcap/ticdc/dm/pkg/utils"
 )
@@ -270,6 +276,13 @@ type SubTaskConfig struct {
 	LoaderConfig   // Loader configuration
 	SyncerConfig   // Syncer configuration
 
+	ValidatorCfg ValidatorConfig `toml:"validator" json:"validator"`
+
+	// StopAt is a binlog position, a GTID set or a time, the sync unit finishes after replicated all binlog events
+	// before it. for a time, the binlog events at the same second are also replicated. it's set by
+	// `start-task --stop-at` and empty means never stop.
+	StopAt string `toml:"stop-at" json:"stop-at"`
+
 	// compatible with standalone dm unit
 	LogLevel  string `toml:"log-level" json:"log-level"`
 	LogFile   string `toml:"log-file" json:"log-file"`
@@ -419,11 +432,12 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 		c.MetaSchema = defaultMetaSchema
 	}
 
-	dirSuffix := "." + c.Name
-	if !strings.HasSuffix(c.LoaderConfig.Dir, dirSuffix) { // check to support multiple times calling
-		// if not ends with the task name, we append the task name to the tail
-		c.LoaderConfig.Dir += dirSuffix
+	// append the task name to the tail of dir, it's safe to call Adjust multiple times.
+	newDir, err := storage.AdjustPath(c.LoaderConfig.Dir, c.Name)
+	if err != nil {
+		return terror.ErrConfigLoaderDirInvalid.Delegate(err, c.LoaderConfig.Dir)
 	}
+	c.LoaderConfig.Dir = newDir
 
 	if c.SyncerConfig.QueueSize == 0 {
 		c.SyncerConfig.QueueSize = defaultQueueSize
@@ -431,6 +445,12 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	if c.SyncerConfig.CheckpointFlushInterval == 0 {
 		c.SyncerConfig.CheckpointFlushInterval = defaultCheckpointFlushInterval
 	}
+	if err := c.ValidatorCfg.Adjust(); err != nil {
+		return err
+	}
+	if err := c.VerifyStopAt(); err != nil {
+		return err
+	}
 
 	c.From.AdjustWithTimeZone(c.Timezone)
 	c.To.AdjustWithTimeZone(c.Timezone)
@@ -475,6 +495,61 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	return nil
 }
 
+// VerifyStopAt verifies the format of StopAt, and whether it can be used by the subtask.
+func (c *SubTaskConfig) VerifyStopAt() error {
+	if len(c.StopAt) == 0 {
+		return nil
+	}
+	if c.Mode == ModeFull {
+		return terror.ErrConfigStopAtInvalid.Generatef("stop-at %s is not supported by task mode %s", c.StopAt, c.Mode)
+	}
+	stopAt, err := ParseStopAt(c.StopAt, c.Flavor, time.UTC)
+	if err != nil {
+		return err
+	}
+	if stopAt.GTID != nil && !c.EnableGTID {
+		return terror.ErrConfigStopAtInvalid.Generatef("stop-at %s with GTID set needs enable-gtid of source %s", c.StopAt, c.SourceID)
+	}
+	return nil
+}
+
+// StopAt is the parsed SubTaskConfig.StopAt, only one of the fields is set.
+type StopAt struct {
+	Pos  *mysql.Position
+	GTID gtid.Set
+	Time *time.Time
+}
+
+// stopAtTimeLayout is the layout of time in stop-at, RFC3339 is also supported.
+const stopAtTimeLayout = "2006-01-02 15:04:05"
+
+// ParseStopAt parses stop-at in the format of
+//   - binlog position like `mysql-bin.000003:1234`
+//   - GTID set like `3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14`
+//   - time like `2021-12-01 08:00:00` in location loc, or `2021-12-01T08:00:00+08:00`.
+func ParseStopAt(stopAt, flavor string, loc *time.Location) (*StopAt, error) {
+	if t, err := time.ParseInLocation(stopAtTimeLayout, stopAt, loc); err == nil {
+		return &StopAt{Time: &t}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, stopAt); err == nil {
+		return &StopAt{Time: &t}, nil
+	}
+
+	if idx := strings.LastIndex(stopAt, ":"); idx > 0 && binlog.VerifyFilename(stopAt[:idx]) {
+		pos, err := strconv.ParseUint(stopAt[idx+1:], 10, 32)
+		if err != nil {
+			return nil, terror.ErrConfigStopAtInvalid.Delegate(err, stopAt)
+		}
+		return &StopAt{Pos: &mysql.Position{Name: stopAt[:idx], Pos: uint32(pos)}}, nil
+	}
+
+	gset, err := gtid.ParserGTID(flavor, stopAt)
+	if err != nil {
+		return nil, terror.ErrConfigStopAtInvalid.Delegate(err, stopAt)
+	}
+	return &StopAt{GTID: gset}, nil
+}
+
 // Parse parses flag definitions from the argument list.
 func (c *SubTaskConfig) Parse(arguments []string, verifyDecryptPassword bool) error {
 	// Parse first to get config file.
@@ -552,3 +627,12 @@ func (c *SubTaskConfig) Clone() (*SubTaskConfig, error) {
 func (c *SubTaskConfig) NeedUseLightning() bool {
 	return (c.Mode == ModeAll || c.Mode == ModeFull) && c.TiDB.Backend != ""
 }
+
+// LocalDir returns a local directory for the files which must be in local disk, like the sorted KV files of
+// lightning. it's LoaderConfig.Dir if the dumped files are in local disk.
+func (c *SubTaskConfig) LocalDir() string {
+	if storage.IsLocalDiskPath(c.LoaderConfig.Dir) {
+		return c.LoaderConfig.Dir
+	}
+	return defaultDir + "." + c.Name
+}
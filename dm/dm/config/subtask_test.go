@@ -15,9 +15,13 @@ package config
 
 import (
 	"reflect"
+	"time"
 
+	"github.com/go-mysql-org/go-mysql/mysql"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/ticdc/dm/pkg/terror"
 )
 
 func (t *testConfig) TestSubTask(c *C) {
@@ -206,3 +210,41 @@ func (t *testConfig) TestDBConfigClone(c *C) {
 	c.Assert(a, DeepEquals, b)
 	c.Assert(a.Security, Not(Equals), b.Security)
 }
+
+func (t *testConfig) TestParseStopAt(c *C) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+
+	stopAt, err := ParseStopAt("mysql-bin.000003:1234", mysql.MySQLFlavor, loc)
+	c.Assert(err, IsNil)
+	c.Assert(stopAt.Pos, DeepEquals, &mysql.Position{Name: "mysql-bin.000003", Pos: 1234})
+	c.Assert(stopAt.GTID, IsNil)
+	c.Assert(stopAt.Time, IsNil)
+
+	stopAt, err = ParseStopAt("3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14", mysql.MySQLFlavor, loc)
+	c.Assert(err, IsNil)
+	c.Assert(stopAt.Pos, IsNil)
+	c.Assert(stopAt.GTID.String(), Equals, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14")
+
+	stopAt, err = ParseStopAt("2021-12-01 08:00:00", mysql.MySQLFlavor, loc)
+	c.Assert(err, IsNil)
+	c.Assert(stopAt.Time.Unix(), Equals, time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC).Unix())
+	stopAt, err = ParseStopAt("2021-12-01T08:00:00Z", mysql.MySQLFlavor, loc)
+	c.Assert(err, IsNil)
+	c.Assert(stopAt.Time.Unix(), Equals, time.Date(2021, 12, 1, 8, 0, 0, 0, time.UTC).Unix())
+
+	for _, s := range []string{"mysql-bin.000003:abc", "mysql-bin.000003:4294967296", "abc", "2021-12-01"} {
+		_, err = ParseStopAt(s, mysql.MySQLFlavor, loc)
+		c.Assert(terror.ErrConfigStopAtInvalid.Equal(err), IsTrue, Commentf("stop-at %s", s))
+	}
+
+	cfg := &SubTaskConfig{Mode: ModeIncrement, SourceID: "mysql-replica-01", Flavor: mysql.MySQLFlavor}
+	c.Assert(cfg.VerifyStopAt(), IsNil)
+	cfg.StopAt = "mysql-bin.000003:1234"
+	c.Assert(cfg.VerifyStopAt(), IsNil)
+	cfg.StopAt = "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14"
+	c.Assert(terror.ErrConfigStopAtInvalid.Equal(cfg.VerifyStopAt()), IsTrue)
+	cfg.EnableGTID = true
+	c.Assert(cfg.VerifyStopAt(), IsNil)
+	cfg.Mode = ModeFull
+	c.Assert(terror.ErrConfigStopAtInvalid.Equal(cfg.VerifyStopAt()), IsTrue)
+}
@@ -20,6 +20,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/dustin/go-humanize"
@@ -50,6 +51,12 @@ const (
 	tidbTxnOptimistic = "optimistic"
 )
 
+// continuous validator mode.
+const (
+	ValidationNone = "none"
+	ValidationFull = "full"
+)
+
 // default config item values.
 var (
 	// TaskConfig.
@@ -71,6 +78,11 @@ var (
 	defaultBatch                   = 100
 	defaultQueueSize               = 1024 // do not give too large default value to avoid OOM
 	defaultCheckpointFlushInterval = 30   // in seconds
+	// ValidatorConfig.
+	defaultValidatorWorkerCount = 4
+	defaultValidateInterval     = 10 * time.Second
+	defaultRowErrorDelay        = 30 * time.Minute
+	defaultMaxPendingRowCount   = 1000000
 
 	// TargetDBConfig.
 	defaultSessionCfg = []struct {
@@ -129,6 +141,9 @@ type MySQLInstance struct {
 	Syncer           *SyncerConfig `yaml:"syncer"`
 	// SyncerThread is alias for WorkerCount in SyncerConfig, and its priority is higher than WorkerCount
 	SyncerThread int `yaml:"syncer-thread"`
+
+	ValidatorConfigName string           `yaml:"validator-config-name"`
+	Validator           *ValidatorConfig `yaml:"validator"`
 }
 
 // VerifyAndAdjust does verification on configs, and adjust some configs.
@@ -154,6 +169,9 @@ func (m *MySQLInstance) VerifyAndAdjust() error {
 	if len(m.SyncerConfigName) > 0 && m.Syncer != nil {
 		return terror.ErrConfigSyncerCfgConflict.Generate()
 	}
+	if len(m.ValidatorConfigName) > 0 && m.Validator != nil {
+		return terror.ErrConfigValidatorCfgConflict.Generate()
+	}
 
 	if len(m.BAListName) == 0 && len(m.BWListName) != 0 {
 		m.BAListName = m.BWListName
@@ -276,6 +294,72 @@ func (m *SyncerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// ValidatorConfig represents the continuous validator's specific config, the
+// validator re-reads the rows applied by the syncer from the downstream and
+// compares them with the expected ones.
+type ValidatorConfig struct {
+	// Mode is "none" or "full", "full" validates all the columns of the rows.
+	Mode        string `yaml:"mode" toml:"mode" json:"mode"`
+	WorkerCount int    `yaml:"worker-count" toml:"worker-count" json:"worker-count"`
+	// ValidateInterval is the interval between two validation rounds, a row
+	// change is validated at least ValidateInterval after it's applied.
+	ValidateInterval Duration `yaml:"validate-interval" toml:"validate-interval" json:"validate-interval"`
+	// RowErrorDelay is how long a mismatched row is retried, the row is marked
+	// as failed if it still mismatches after the delay.
+	RowErrorDelay Duration `yaml:"row-error-delay" toml:"row-error-delay" json:"row-error-delay"`
+	// MaxPendingRowCount limits the row changes waiting to be validated, the
+	// new row changes are skipped once it's reached.
+	MaxPendingRowCount int `yaml:"max-pending-row-count" toml:"max-pending-row-count" json:"max-pending-row-count"`
+}
+
+// DefaultValidatorConfig return default validator config for task.
+func DefaultValidatorConfig() ValidatorConfig {
+	return ValidatorConfig{
+		Mode:               ValidationNone,
+		WorkerCount:        defaultValidatorWorkerCount,
+		ValidateInterval:   Duration{Duration: defaultValidateInterval},
+		RowErrorDelay:      Duration{Duration: defaultRowErrorDelay},
+		MaxPendingRowCount: defaultMaxPendingRowCount,
+	}
+}
+
+// alias to avoid infinite recursion for UnmarshalYAML.
+type rawValidatorConfig ValidatorConfig
+
+// UnmarshalYAML implements Unmarshaler.UnmarshalYAML.
+func (m *ValidatorConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	raw := rawValidatorConfig(DefaultValidatorConfig())
+	if err := unmarshal(&raw); err != nil {
+		return terror.ErrConfigYamlTransform.Delegate(err, "unmarshal validator config")
+	}
+	*m = ValidatorConfig(raw) // raw used only internal, so no deep copy
+	return nil
+}
+
+// Adjust verifies and adjusts the validator config.
+func (m *ValidatorConfig) Adjust() error {
+	switch m.Mode {
+	case "":
+		m.Mode = ValidationNone
+	case ValidationNone, ValidationFull:
+	default:
+		return terror.ErrConfigValidatorInvalidMode.Generate(m.Mode)
+	}
+	if m.WorkerCount <= 0 {
+		m.WorkerCount = defaultValidatorWorkerCount
+	}
+	if m.ValidateInterval.Duration <= 0 {
+		m.ValidateInterval.Duration = defaultValidateInterval
+	}
+	if m.RowErrorDelay.Duration <= 0 {
+		m.RowErrorDelay.Duration = defaultRowErrorDelay
+	}
+	if m.MaxPendingRowCount <= 0 {
+		m.MaxPendingRowCount = defaultMaxPendingRowCount
+	}
+	return nil
+}
+
 // TaskConfig is the configuration for Task.
 type TaskConfig struct {
 	*flag.FlagSet `yaml:"-" toml:"-" json:"-"`
@@ -326,6 +410,8 @@ type TaskConfig struct {
 	Loaders   map[string]*LoaderConfig   `yaml:"loaders" toml:"loaders" json:"loaders"`
 	Syncers   map[string]*SyncerConfig   `yaml:"syncers" toml:"syncers" json:"syncers"`
 
+	Validators map[string]*ValidatorConfig `yaml:"validators" toml:"validators" json:"validators"`
+
 	CleanDumpFile bool `yaml:"clean-dump-file" toml:"clean-dump-file" json:"clean-dump-file"`
 	// deprecated
 	EnableANSIQuotes bool `yaml:"ansi-quotes" toml:"ansi-quotes" json:"ansi-quotes"`
@@ -356,6 +442,7 @@ func NewTaskConfig() *TaskConfig {
 		Mydumpers:               make(map[string]*MydumperConfig),
 		Loaders:                 make(map[string]*LoaderConfig),
 		Syncers:                 make(map[string]*SyncerConfig),
+		Validators:              make(map[string]*ValidatorConfig),
 		CleanDumpFile:           true,
 	}
 	cfg.FlagSet = flag.NewFlagSet("task", flag.ContinueOnError)
@@ -412,7 +499,7 @@ func (c *TaskConfig) RawDecode(data string) error {
 }
 
 // find unused items in config.
-var configRefPrefixes = []string{"RouteRules", "FilterRules", "ColumnMappingRules", "Mydumper", "Loader", "Syncer", "ExprFilter"}
+var configRefPrefixes = []string{"RouteRules", "FilterRules", "ColumnMappingRules", "Mydumper", "Loader", "Syncer", "ExprFilter", "Validator"}
 
 const (
 	routeRulesIdx = iota
@@ -422,6 +509,7 @@ const (
 	loaderIdx
 	syncerIdx
 	exprFilterIdx
+	validatorIdx
 )
 
 // adjust adjusts and verifies config.
@@ -628,6 +716,23 @@ func (c *TaskConfig) adjust() error {
 			log.L().Warn("`disable-causality` is no longer take effect")
 		}
 
+		if len(inst.ValidatorConfigName) > 0 {
+			rule, ok := c.Validators[inst.ValidatorConfigName]
+			if !ok {
+				return terror.ErrConfigValidatorCfgNotFound.Generate(i, inst.ValidatorConfigName)
+			}
+			globalConfigReferCount[configRefPrefixes[validatorIdx]+inst.ValidatorConfigName]++
+			inst.Validator = new(ValidatorConfig)
+			*inst.Validator = *rule // ref validator config
+		}
+		if inst.Validator == nil {
+			defaultCfg := DefaultValidatorConfig()
+			inst.Validator = &defaultCfg
+		}
+		if err := inst.Validator.Adjust(); err != nil {
+			return err
+		}
+
 		for _, name := range inst.ExpressionFilters {
 			if _, ok := c.ExprFilter[name]; !ok {
 				return terror.ErrConfigExprFilterNotFound.Generate(i, name)
@@ -688,6 +793,11 @@ func (c *TaskConfig) adjust() error {
 			unusedConfigs = append(unusedConfigs, exprFilter)
 		}
 	}
+	for validator := range c.Validators {
+		if globalConfigReferCount[configRefPrefixes[validatorIdx]+validator] == 0 {
+			unusedConfigs = append(unusedConfigs, validator)
+		}
+	}
 
 	if len(unusedConfigs) != 0 {
 		sort.Strings(unusedConfigs)
@@ -824,7 +934,9 @@ type MySQLInstanceForDowngrade struct {
 	Syncer             *SyncerConfig   `yaml:"syncer"`
 	SyncerThread       int             `yaml:"syncer-thread"`
 	// new config item
-	ExpressionFilters []string `yaml:"expression-filters,omitempty"`
+	ExpressionFilters   []string         `yaml:"expression-filters,omitempty"`
+	ValidatorConfigName string           `yaml:"validator-config-name,omitempty"`
+	Validator           *ValidatorConfig `yaml:"validator,omitempty"`
 }
 
 // NewMySQLInstancesForDowngrade creates []* MySQLInstanceForDowngrade.
@@ -832,23 +944,25 @@ func NewMySQLInstancesForDowngrade(mysqlInstances []*MySQLInstance) []*MySQLInst
 	mysqlInstancesForDowngrade := make([]*MySQLInstanceForDowngrade, 0, len(mysqlInstances))
 	for _, m := range mysqlInstances {
 		newMySQLInstance := &MySQLInstanceForDowngrade{
-			SourceID:           m.SourceID,
-			Meta:               m.Meta,
-			FilterRules:        m.FilterRules,
-			ColumnMappingRules: m.ColumnMappingRules,
-			RouteRules:         m.RouteRules,
-			BWListName:         m.BWListName,
-			BAListName:         m.BAListName,
-			MydumperConfigName: m.MydumperConfigName,
-			Mydumper:           m.Mydumper,
-			MydumperThread:     m.MydumperThread,
-			LoaderConfigName:   m.LoaderConfigName,
-			Loader:             m.Loader,
-			LoaderThread:       m.LoaderThread,
-			SyncerConfigName:   m.SyncerConfigName,
-			Syncer:             m.Syncer,
-			SyncerThread:       m.SyncerThread,
-			ExpressionFilters:  m.ExpressionFilters,
+			SourceID:            m.SourceID,
+			Meta:                m.Meta,
+			FilterRules:         m.FilterRules,
+			ColumnMappingRules:  m.ColumnMappingRules,
+			RouteRules:          m.RouteRules,
+			BWListName:          m.BWListName,
+			BAListName:          m.BAListName,
+			MydumperConfigName:  m.MydumperConfigName,
+			Mydumper:            m.Mydumper,
+			MydumperThread:      m.MydumperThread,
+			LoaderConfigName:    m.LoaderConfigName,
+			Loader:              m.Loader,
+			LoaderThread:        m.LoaderThread,
+			SyncerConfigName:    m.SyncerConfigName,
+			Syncer:              m.Syncer,
+			SyncerThread:        m.SyncerThread,
+			ExpressionFilters:   m.ExpressionFilters,
+			ValidatorConfigName: m.ValidatorConfigName,
+			Validator:           m.Validator,
 		}
 		mysqlInstancesForDowngrade = append(mysqlInstancesForDowngrade, newMySQLInstance)
 	}
@@ -933,6 +1047,7 @@ type TaskConfigForDowngrade struct {
 	OnlineDDL        bool                         `yaml:"online-ddl,omitempty"`
 	ShadowTableRules []string                     `yaml:"shadow-table-rules,omitempty"`
 	TrashTableRules  []string                     `yaml:"trash-table-rules,omitempty"`
+	Validators       map[string]*ValidatorConfig  `yaml:"validators,omitempty"`
 }
 
 // NewTaskConfigForDowngrade create new TaskConfigForDowngrade.
@@ -967,6 +1082,7 @@ func NewTaskConfigForDowngrade(taskConfig *TaskConfig) *TaskConfigForDowngrade {
 		OnlineDDL:               taskConfig.OnlineDDL,
 		ShadowTableRules:        taskConfig.ShadowTableRules,
 		TrashTableRules:         taskConfig.TrashTableRules,
+		Validators:              taskConfig.Validators,
 	}
 }
 
@@ -980,6 +1096,11 @@ func (c *TaskConfigForDowngrade) omitDefaultVals() {
 	if len(c.TrashTableRules) == 1 && c.TrashTableRules[0] == DefaultTrashTableRules {
 		c.TrashTableRules = nil
 	}
+	for _, inst := range c.MySQLInstances {
+		if inst.Validator != nil && *inst.Validator == DefaultValidatorConfig() {
+			inst.Validator = nil
+		}
+	}
 }
 
 // Yaml returns YAML format representation of config.
@@ -15,7 +15,6 @@ package config
 
 import (
 	"fmt"
-	"strings"
 
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	"github.com/pingcap/tidb-tools/pkg/column-mapping"
@@ -24,6 +23,7 @@ import (
 
 	"github.com/pingcap/ticdc/dm/openapi"
 	"github.com/pingcap/ticdc/dm/pkg/log"
+	"github.com/pingcap/ticdc/dm/pkg/storage"
 	"github.com/pingcap/ticdc/dm/pkg/terror"
 )
 
@@ -91,6 +91,7 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]DBConfig) ([]*
 		cfg.MydumperConfig = *inst.Mydumper
 		cfg.LoaderConfig = *inst.Loader
 		cfg.SyncerConfig = *inst.Syncer
+		cfg.ValidatorCfg = *inst.Validator
 
 		cfg.CleanDumpFile = c.CleanDumpFile
 
@@ -301,6 +302,7 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.Loaders = make(map[string]*LoaderConfig)
 	c.Syncers = make(map[string]*SyncerConfig)
 	c.ExprFilter = make(map[string]*ExpressionFilter)
+	c.Validators = make(map[string]*ValidatorConfig)
 
 	baListMap := make(map[string]string, len(stCfgs))
 	routeMap := make(map[string]string, len(stCfgs))
@@ -310,8 +312,9 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	syncMap := make(map[string]string, len(stCfgs))
 	cmMap := make(map[string]string, len(stCfgs))
 	exprFilterMap := make(map[string]string, len(stCfgs))
-	var baListIdx, routeIdx, filterIdx, dumpIdx, loadIdx, syncIdx, cmIdx, efIdx int
-	var baListName, routeName, filterName, dumpName, loadName, syncName, cmName, efName string
+	validatorMap := make(map[string]string, len(stCfgs))
+	var baListIdx, routeIdx, filterIdx, dumpIdx, loadIdx, syncIdx, validatorIdx, cmIdx, efIdx int
+	var baListName, routeName, filterName, dumpName, loadName, syncName, validatorName, cmName, efName string
 
 	// NOTE:
 	// - we choose to ref global configs for instances now.
@@ -338,14 +341,18 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 
 		loadName, loadIdx = getGenerateName(stCfg.LoaderConfig, loadIdx, "load", loadMap)
 		loaderCfg := stCfg.LoaderConfig
-		dirSuffix := "." + c.Name
 		// if ends with the task name, we remove to get user input dir.
-		loaderCfg.Dir = strings.TrimSuffix(loaderCfg.Dir, dirSuffix)
+		if dir, err := storage.TrimPath(loaderCfg.Dir, c.Name); err == nil {
+			loaderCfg.Dir = dir
+		}
 		c.Loaders[loadName] = &loaderCfg
 
 		syncName, syncIdx = getGenerateName(stCfg.SyncerConfig, syncIdx, "sync", syncMap)
 		c.Syncers[syncName] = &stCfg.SyncerConfig
 
+		validatorName, validatorIdx = getGenerateName(stCfg.ValidatorCfg, validatorIdx, "validator", validatorMap)
+		c.Validators[validatorName] = &stCfg.ValidatorCfg
+
 		exprFilterNames := make([]string, 0, len(stCfg.ExprFilter))
 		for _, f := range stCfg.ExprFilter {
 			efName, efIdx = getGenerateName(f, efIdx, "expr-filter", exprFilterMap)
@@ -361,16 +368,17 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 		}
 
 		c.MySQLInstances = append(c.MySQLInstances, &MySQLInstance{
-			SourceID:           stCfg.SourceID,
-			Meta:               stCfg.Meta,
-			FilterRules:        filterNames,
-			ColumnMappingRules: cmNames,
-			RouteRules:         routeNames,
-			BAListName:         baListName,
-			MydumperConfigName: dumpName,
-			LoaderConfigName:   loadName,
-			SyncerConfigName:   syncName,
-			ExpressionFilters:  exprFilterNames,
+			SourceID:            stCfg.SourceID,
+			Meta:                stCfg.Meta,
+			FilterRules:         filterNames,
+			ColumnMappingRules:  cmNames,
+			RouteRules:          routeNames,
+			BAListName:          baListName,
+			MydumperConfigName:  dumpName,
+			LoaderConfigName:    loadName,
+			SyncerConfigName:    syncName,
+			ExpressionFilters:   exprFilterNames,
+			ValidatorConfigName: validatorName,
 		})
 	}
 	return c
@@ -408,9 +416,10 @@ func SubTaskConfigsToOpenAPITask(subTaskConfigMap map[string]map[string]SubTaskC
 		}
 		taskSourceConfig.SourceConf = sourceConfList
 
-		dirSuffix := "." + oneSubtaskConfig.Name
 		// if ends with the task name, we remove to get user input dir.
-		oneSubtaskConfig.LoaderConfig.Dir = strings.TrimSuffix(oneSubtaskConfig.LoaderConfig.Dir, dirSuffix)
+		if dir, err := storage.TrimPath(oneSubtaskConfig.LoaderConfig.Dir, oneSubtaskConfig.Name); err == nil {
+			oneSubtaskConfig.LoaderConfig.Dir = dir
+		}
 		taskSourceConfig.FullMigrateConf = &openapi.TaskFullMigrateConf{
 			DataDir:       &oneSubtaskConfig.LoaderConfig.Dir,
 			ExportThreads: &oneSubtaskConfig.MydumperConfig.Threads,
@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	. "github.com/pingcap/check"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
@@ -702,6 +703,7 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 				EnableGTID:              true,
 				SafeMode:                true,
 			},
+			ValidatorCfg:     DefaultValidatorConfig(),
 			CleanDumpFile:    true,
 			EnableANSIQuotes: true,
 		}
@@ -752,6 +754,8 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 				SyncerConfigName:   "sync-01",
 				Syncer:             nil,
 				SyncerThread:       0,
+
+				ValidatorConfigName: "validator-01",
 			},
 			{
 				SourceID:           source2,
@@ -771,6 +775,8 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 				Syncer:             nil,
 				SyncerThread:       0,
 				ExpressionFilters:  []string{"expr-filter-01"},
+
+				ValidatorConfigName: "validator-01",
 			},
 		},
 		OnlineDDL: onlineDDL,
@@ -802,6 +808,9 @@ func (t *testConfig) TestGenAndFromSubTaskConfigs(c *C) {
 		ExprFilter: map[string]*ExpressionFilter{
 			"expr-filter-01": &exprFilter1,
 		},
+		Validators: map[string]*ValidatorConfig{
+			"validator-01": &stCfg1.ValidatorCfg,
+		},
 		CleanDumpFile: stCfg1.CleanDumpFile,
 	}
 
@@ -899,6 +908,12 @@ func (t *testConfig) TestMySQLInstance(c *C) {
 	c.Assert(terror.ErrConfigSyncerCfgConflict.Equal(err), IsTrue)
 	m.SyncerConfigName = ""
 
+	m.Validator = &ValidatorConfig{}
+	m.ValidatorConfigName = cfgName
+	err = m.VerifyAndAdjust()
+	c.Assert(terror.ErrConfigValidatorCfgConflict.Equal(err), IsTrue)
+	m.ValidatorConfigName = ""
+
 	c.Assert(m.VerifyAndAdjust(), IsNil)
 }
 
@@ -950,6 +965,59 @@ func (t *testConfig) TestDefaultConfig(c *C) {
 	c.Assert(cfg.MySQLInstances[0].Mydumper.ChunkFilesize, Equals, defaultChunkFilesize)
 }
 
+func (t *testConfig) TestValidatorConfig(c *C) {
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = "all"
+	cfg.TargetDB = &DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1"})
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(*cfg.MySQLInstances[0].Validator, DeepEquals, DefaultValidatorConfig())
+
+	// refer to a validator config, the zero values are adjusted to the default ones
+	cfg.MySQLInstances[0].Validator = nil
+	cfg.MySQLInstances[0].ValidatorConfigName = "validator-01"
+	err := cfg.adjust()
+	c.Assert(terror.ErrConfigValidatorCfgNotFound.Equal(err), IsTrue)
+	cfg.Validators["validator-01"] = &ValidatorConfig{Mode: ValidationFull, WorkerCount: 2}
+	c.Assert(cfg.adjust(), IsNil)
+	validator := cfg.MySQLInstances[0].Validator
+	c.Assert(validator.Mode, Equals, ValidationFull)
+	c.Assert(validator.WorkerCount, Equals, 2)
+	c.Assert(validator.ValidateInterval.Duration, Equals, defaultValidateInterval)
+	c.Assert(validator.RowErrorDelay.Duration, Equals, defaultRowErrorDelay)
+
+	cfg.Validators["validator-01"].Mode = "fast"
+	cfg.MySQLInstances[0].Validator = nil
+	err = cfg.adjust()
+	c.Assert(terror.ErrConfigValidatorInvalidMode.Equal(err), IsTrue)
+
+	// decode from yaml
+	taskConfig := `
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    validator-config-name: "global"
+validators:
+  global:
+    mode: full
+    validate-interval: 1m
+`
+	cfg = NewTaskConfig()
+	c.Assert(cfg.Decode(taskConfig), IsNil)
+	validator = cfg.MySQLInstances[0].Validator
+	c.Assert(validator.Mode, Equals, ValidationFull)
+	c.Assert(validator.WorkerCount, Equals, defaultValidatorWorkerCount)
+	c.Assert(validator.ValidateInterval.Duration, Equals, time.Minute)
+	c.Assert(validator.RowErrorDelay.Duration, Equals, defaultRowErrorDelay)
+}
+
 func (t *testConfig) TestExclusiveAndWrongExprFilterFields(c *C) {
 	cfg := NewTaskConfig()
 	cfg.Name = "test"
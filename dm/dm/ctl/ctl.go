@@ -80,6 +80,7 @@ func NewRootCmd() *cobra.Command {
 		master.NewShardDDLLockCmd(),
 		master.NewSourceTableSchemaCmd(),
 		master.NewConfigCmd(),
+		master.NewValidationCmd(),
 		newDecryptCmd(),
 		newEncryptCmd(),
 	)
@@ -32,11 +32,12 @@ import (
 // NewStartTaskCmd creates a StartTask command.
 func NewStartTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "start-task [-s source ...] [--remove-meta] <config-file>",
+		Use:   "start-task [-s source ...] [--remove-meta] [--stop-at position|gtid|time] <config-file>",
 		Short: "Starts a task as defined in the configuration file",
 		RunE:  startTaskFunc,
 	}
 	cmd.Flags().BoolP("remove-meta", "", false, "whether to remove task's meta data")
+	cmd.Flags().StringP("stop-at", "", "", "stop the incremental replication after all binlog events before it are replicated. The format like \"mysql-bin.000001:4\", a GTID set or \"2006-01-02 15:04:05\", a binlog position or GTID set needs exactly one source")
 	return cmd
 }
 
@@ -77,6 +78,12 @@ func startTaskFunc(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	stopAt, err := cmd.Flags().GetString("stop-at")
+	if err != nil {
+		common.PrintLinesf("error in parse `--stop-at`")
+		return err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -89,6 +96,7 @@ func startTaskFunc(cmd *cobra.Command, _ []string) error {
 			Task:       string(content),
 			Sources:    sources,
 			RemoveMeta: removeMeta,
+			StopAt:     stopAt,
 		},
 		&resp,
 	)
@@ -0,0 +1,87 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/ticdc/dm/dm/ctl/common"
+	"github.com/pingcap/ticdc/dm/dm/pb"
+)
+
+// NewValidationCmd creates a validation command.
+func NewValidationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validation <command>",
+		Short: "query the results of the continuous data validation",
+	}
+	cmd.AddCommand(
+		newValidationStatusCmd(),
+		newValidationShowErrorsCmd(),
+	)
+
+	return cmd
+}
+
+func newValidationStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status <task-name>",
+		Short: "show the status of the continuous validators of a task",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cmd.Help()
+			}
+			taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			resp := &pb.GetValidationStatusResponse{}
+			err := common.SendRequest(ctx, "GetValidationStatus", &pb.GetValidationStatusRequest{TaskName: taskName}, &resp)
+			if err != nil {
+				return err
+			}
+			common.PrettyPrintResponse(resp)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newValidationShowErrorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-errors <task-name>",
+		Short: "show the row changes mismatched in the downstream of a task",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cmd.Help()
+			}
+			taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			resp := &pb.GetValidationErrorResponse{}
+			err := common.SendRequest(ctx, "GetValidationError", &pb.GetValidationErrorRequest{TaskName: taskName}, &resp)
+			if err != nil {
+				return err
+			}
+			common.PrettyPrintResponse(resp)
+			return nil
+		},
+	}
+	return cmd
+}
@@ -615,6 +615,70 @@ func (s *Server) DMAPIGetTaskStatus(c *gin.Context, taskName string, params open
 	c.IndentedJSON(http.StatusOK, resp)
 }
 
+// DMAPIGetTaskValidationStatus url is: (GET /api/v1/tasks/{task-name}/validation/status).
+func (s *Server) DMAPIGetTaskValidationStatus(c *gin.Context, taskName string) {
+	if len(s.getTaskResources(taskName)) == 0 {
+		_ = c.Error(terror.ErrSchedulerTaskNotExist.Generate(taskName))
+		return
+	}
+	resp, err := s.GetValidationStatus(c.Request.Context(), &pb.GetValidationStatusRequest{TaskName: taskName})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if !resp.Result {
+		_ = c.Error(terror.ErrOpenAPICommonError.New(resp.Msg))
+		return
+	}
+	statusList := make([]openapi.ValidationStatus, 0, len(resp.Validators))
+	for _, status := range resp.Validators {
+		statusList = append(statusList, openapi.ValidationStatus{
+			TaskName:          status.Task,
+			SourceName:        status.Source,
+			Mode:              status.Mode,
+			Stage:             status.Stage.String(),
+			ProcessedRowCount: status.ProcessedRowCount,
+			PendingRowCount:   status.PendingRowCount,
+			ErrorRowCount:     status.ErrorRowCount,
+			SkippedRowCount:   status.SkippedRowCount,
+		})
+	}
+	c.IndentedJSON(http.StatusOK, openapi.GetTaskValidationStatusResponse{Total: len(statusList), Data: statusList})
+}
+
+// DMAPIGetTaskValidationErrors url is: (GET /api/v1/tasks/{task-name}/validation/errors).
+func (s *Server) DMAPIGetTaskValidationErrors(c *gin.Context, taskName string) {
+	if len(s.getTaskResources(taskName)) == 0 {
+		_ = c.Error(terror.ErrSchedulerTaskNotExist.Generate(taskName))
+		return
+	}
+	resp, err := s.GetValidationError(c.Request.Context(), &pb.GetValidationErrorRequest{TaskName: taskName})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if !resp.Result {
+		_ = c.Error(terror.ErrOpenAPICommonError.New(resp.Msg))
+		return
+	}
+	errorList := make([]openapi.ValidationError, 0, len(resp.Errors))
+	for _, e := range resp.Errors {
+		errorList = append(errorList, openapi.ValidationError{
+			Id:           e.Id,
+			SourceName:   e.Source,
+			SourceTable:  e.SrcTable,
+			TargetTable:  e.DstTable,
+			RowKey:       e.RowKey,
+			ExpectedData: e.ExpectedData,
+			ActualData:   e.ActualData,
+			Status:       e.Status,
+			RetryCount:   int(e.RetryCount),
+			Time:         e.Time,
+		})
+	}
+	c.IndentedJSON(http.StatusOK, openapi.GetTaskValidationErrorsResponse{Total: len(errorList), Data: errorList})
+}
+
 // DMAPIPauseTask pause task url is: (POST /api/v1/tasks/{task-name}/pause).
 func (s *Server) DMAPIPauseTask(c *gin.Context, taskName string) {
 	var sourceName openapi.SchemaNameList
@@ -450,6 +450,15 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 		}
 	}
 
+	// stop-at is saved in subtask configs, so it's still honoured after DM-worker restarts
+	if len(req.StopAt) > 0 && len(sourceRespCh) == 0 {
+		if err = setSubTaskStopAt(stCfgs, req.StopAt); err != nil {
+			resp.Msg = err.Error()
+			// nolint:nilerr
+			return resp, nil
+		}
+	}
+
 	var sourceResps []*pb.CommonWorkerResponse
 	// there are invalid sourceCfgs
 	if len(sourceRespCh) > 0 {
@@ -511,6 +520,28 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 	return resp, nil
 }
 
+// setSubTaskStopAt sets stop-at of the subtasks. binlog positions and GTID sets are only
+// meaningful to the source they come from, so they can only be used with exactly one subtask.
+func setSubTaskStopAt(stCfgs []*config.SubTaskConfig, stopAt string) error {
+	for _, stCfg := range stCfgs {
+		stCfg.StopAt = stopAt
+		if err := stCfg.VerifyStopAt(); err != nil {
+			return err
+		}
+	}
+	if len(stCfgs) > 1 {
+		parsed, err := config.ParseStopAt(stopAt, stCfgs[0].Flavor, time.UTC)
+		if err != nil {
+			return err
+		}
+		if parsed.Time == nil {
+			return terror.ErrConfigStopAtInvalid.Generatef(
+				"stop-at %s with binlog position or GTID set can only be used with one source, please specify the source by `-s`", stopAt)
+		}
+	}
+	return nil
+}
+
 // OperateTask implements MasterServer.OperateTask.
 func (s *Server) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*pb.OperateTaskResponse, error) {
 	var (
@@ -1452,7 +1483,7 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 
 	ctctx := tcontext.NewContext(ctx, log.With(zap.String("job", "remove metadata")))
 
-	sqls := make([]string, 0, 4)
+	sqls := make([]string, 0, 5)
 	// clear loader and syncer checkpoints
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.LoaderCheckpoint(taskName))))
@@ -1462,6 +1493,8 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 		dbutil.TableName(metaSchema, cputil.SyncerShardMeta(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.SyncerOnlineDDL(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.ValidatorErrorChange(taskName))))
 
 	_, err = dbConn.ExecuteSQL(ctctx, nil, taskName, sqls)
 	if err == nil {
@@ -2227,6 +2260,133 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*
 	}, nil
 }
 
+// GetValidationStatus implements MasterServer.GetValidationStatus.
+func (s *Server) GetValidationStatus(ctx context.Context, req *pb.GetValidationStatusRequest) (*pb.GetValidationStatusResponse, error) {
+	var (
+		resp2 *pb.GetValidationStatusResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	workerReq := &workerrpc.Request{
+		Type:                workerrpc.CmdGetValidationStatus,
+		GetValidationStatus: req,
+	}
+	workerResps, err := s.sendValidationRequest(ctx, req.TaskName, workerReq)
+	if err != nil {
+		// nolint:nilerr
+		return &pb.GetValidationStatusResponse{Msg: err.Error()}, nil
+	}
+
+	resp := &pb.GetValidationStatusResponse{Result: true}
+	msgs := make([]string, 0, len(workerResps))
+	for _, workerResp := range workerResps {
+		if workerResp.err != nil {
+			msgs = append(msgs, fmt.Sprintf("source %s: %s", workerResp.source, workerResp.err))
+			continue
+		}
+		status := workerResp.resp.GetValidationStatus
+		if !status.Result {
+			msgs = append(msgs, fmt.Sprintf("source %s: %s", workerResp.source, status.Msg))
+			continue
+		}
+		resp.Validators = append(resp.Validators, status.Validators...)
+	}
+	if len(msgs) > 0 {
+		resp.Result = false
+		resp.Msg = strings.Join(msgs, "\n")
+	}
+	return resp, nil
+}
+
+// GetValidationError implements MasterServer.GetValidationError.
+func (s *Server) GetValidationError(ctx context.Context, req *pb.GetValidationErrorRequest) (*pb.GetValidationErrorResponse, error) {
+	var (
+		resp2 *pb.GetValidationErrorResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	workerReq := &workerrpc.Request{
+		Type:               workerrpc.CmdGetValidationError,
+		GetValidationError: req,
+	}
+	workerResps, err := s.sendValidationRequest(ctx, req.TaskName, workerReq)
+	if err != nil {
+		// nolint:nilerr
+		return &pb.GetValidationErrorResponse{Msg: err.Error()}, nil
+	}
+
+	resp := &pb.GetValidationErrorResponse{Result: true}
+	msgs := make([]string, 0, len(workerResps))
+	for _, workerResp := range workerResps {
+		if workerResp.err != nil {
+			msgs = append(msgs, fmt.Sprintf("source %s: %s", workerResp.source, workerResp.err))
+			continue
+		}
+		errs := workerResp.resp.GetValidationError
+		if !errs.Result {
+			msgs = append(msgs, fmt.Sprintf("source %s: %s", workerResp.source, errs.Msg))
+			continue
+		}
+		resp.Errors = append(resp.Errors, errs.Errors...)
+	}
+	if len(msgs) > 0 {
+		resp.Result = false
+		resp.Msg = strings.Join(msgs, "\n")
+	}
+	return resp, nil
+}
+
+type validationWorkerResp struct {
+	source string
+	resp   *workerrpc.Response
+	err    error
+}
+
+// sendValidationRequest sends the validation request to the workers of all sources of the task,
+// the responses are sorted by the sources.
+func (s *Server) sendValidationRequest(ctx context.Context, taskName string, req *workerrpc.Request) ([]*validationWorkerResp, error) {
+	sources := s.getTaskResources(taskName)
+	if len(sources) == 0 {
+		return nil, terror.ErrSchedulerTaskNotExist.Generate(taskName)
+	}
+
+	workerRespCh := make(chan *validationWorkerResp, len(sources))
+	var wg sync.WaitGroup
+	for _, source := range sources {
+		wg.Add(1)
+		go func(source string) {
+			defer wg.Done()
+			workerResp := &validationWorkerResp{source: source}
+			worker := s.scheduler.GetWorkerBySource(source)
+			if worker == nil {
+				workerResp.err = errors.Errorf("source %s relevant worker-client not found", source)
+			} else {
+				workerResp.resp, workerResp.err = worker.SendRequest(ctx, req, s.cfg.RPCTimeout)
+			}
+			workerRespCh <- workerResp
+		}(source)
+	}
+	wg.Wait()
+	close(workerRespCh)
+
+	workerResps := make([]*validationWorkerResp, 0, len(sources))
+	for workerResp := range workerRespCh {
+		workerResps = append(workerResps, workerResp)
+	}
+	sort.Slice(workerResps, func(i, j int) bool {
+		return workerResps[i].source < workerResps[j].source
+	})
+	return workerResps, nil
+}
+
 // TransferSource implements MasterServer.TransferSource.
 func (s *Server) TransferSource(ctx context.Context, req *pb.TransferSourceRequest) (*pb.TransferSourceResponse, error) {
 	var (
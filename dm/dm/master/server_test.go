@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/check"
 	"github.com/pingcap/errors"
@@ -875,6 +876,47 @@ func (t *testMaster) TestStartTask(c *check.C) {
 	t.clearSchedulerEnv(c, cancel, &wg)
 }
 
+func (t *testMaster) TestSetSubTaskStopAt(c *check.C) {
+	newSubTaskCfgs := func(sources ...string) []*config.SubTaskConfig {
+		stCfgs := make([]*config.SubTaskConfig, 0, len(sources))
+		for _, source := range sources {
+			stCfg := &config.SubTaskConfig{
+				SourceID: source,
+				Mode:     config.ModeAll,
+				Flavor:   mysql.MySQLFlavor,
+			}
+			stCfg.EnableGTID = true
+			stCfgs = append(stCfgs, stCfg)
+		}
+		return stCfgs
+	}
+	var (
+		pos  = "mysql-bin.000003:1000"
+		gset = "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14"
+		ts   = "2021-12-01 08:00:00"
+	)
+
+	// a time can be used with all sources
+	stCfgs := newSubTaskCfgs("source-1", "source-2")
+	c.Assert(setSubTaskStopAt(stCfgs, ts), check.IsNil)
+	for _, stCfg := range stCfgs {
+		c.Assert(stCfg.StopAt, check.Equals, ts)
+	}
+
+	// a binlog position or GTID set can only be used with one source
+	for _, stopAt := range []string{pos, gset} {
+		stCfgs = newSubTaskCfgs("source-1")
+		c.Assert(setSubTaskStopAt(stCfgs, stopAt), check.IsNil)
+		c.Assert(stCfgs[0].StopAt, check.Equals, stopAt)
+		err := setSubTaskStopAt(newSubTaskCfgs("source-1", "source-2"), stopAt)
+		c.Assert(terror.ErrConfigStopAtInvalid.Equal(err), check.IsTrue)
+	}
+
+	// the invalid stop-at
+	err := setSubTaskStopAt(newSubTaskCfgs("source-1"), "invalid")
+	c.Assert(terror.ErrConfigStopAtInvalid.Equal(err), check.IsTrue)
+}
+
 func (t *testMaster) TestStartTaskWithRemoveMeta(c *check.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -933,6 +975,7 @@ func (t *testMaster) TestStartTaskWithRemoveMeta(c *check.C) {
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerShardMeta(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerOnlineDDL(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorErrorChange(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c.Assert(len(server.pessimist.Locks()), check.Greater, 0)
 
@@ -1025,6 +1068,7 @@ func (t *testMaster) TestStartTaskWithRemoveMeta(c *check.C) {
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerShardMeta(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerOnlineDDL(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorErrorChange(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c.Assert(len(server.optimist.Locks()), check.Greater, 0)
 
@@ -47,6 +47,9 @@ const (
 	CmdOperateV1Meta
 	CmdHandleError
 	CmdGetWorkerCfg
+
+	CmdGetValidationStatus
+	CmdGetValidationError
 )
 
 // Request wraps all dm-worker rpc requests.
@@ -62,6 +65,9 @@ type Request struct {
 	OperateV1Meta *pb.OperateV1MetaRequest
 	HandleError   *pb.HandleWorkerErrorRequest
 	GetWorkerCfg  *pb.GetWorkerCfgRequest
+
+	GetValidationStatus *pb.GetValidationStatusRequest
+	GetValidationError  *pb.GetValidationErrorRequest
 }
 
 // Response wraps all dm-worker rpc responses.
@@ -77,6 +83,9 @@ type Response struct {
 	OperateV1Meta *pb.OperateV1MetaResponse
 	HandleError   *pb.CommonWorkerResponse
 	GetWorkerCfg  *pb.GetWorkerCfgResponse
+
+	GetValidationStatus *pb.GetValidationStatusResponse
+	GetValidationError  *pb.GetValidationErrorResponse
 }
 
 // Client is a client that sends RPC.
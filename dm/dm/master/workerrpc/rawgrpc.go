@@ -118,6 +118,10 @@ func callRPC(ctx context.Context, client pb.WorkerClient, req *Request) (*Respon
 		resp.HandleError, err = client.HandleError(ctx, req.HandleError)
 	case CmdGetWorkerCfg:
 		resp.GetWorkerCfg, err = client.GetWorkerCfg(ctx, req.GetWorkerCfg)
+	case CmdGetValidationStatus:
+		resp.GetValidationStatus, err = client.GetValidationStatus(ctx, req.GetValidationStatus)
+	case CmdGetValidationError:
+		resp.GetValidationError, err = client.GetValidationError(ctx, req.GetValidationError)
 	default:
 		return nil, terror.ErrMasterGRPCInvalidReqType.Generate(req.Type)
 	}
@@ -155,6 +155,7 @@ type StartTaskRequest struct {
 	Task       string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	Sources    []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
 	RemoveMeta bool     `protobuf:"varint,3,opt,name=removeMeta,proto3" json:"removeMeta,omitempty"`
+	StopAt     string   `protobuf:"bytes,4,opt,name=stopAt,proto3" json:"stopAt,omitempty"`
 }
 
 func (m *StartTaskRequest) Reset()         { *m = StartTaskRequest{} }
@@ -211,6 +212,13 @@ func (m *StartTaskRequest) GetRemoveMeta() bool {
 	return false
 }
 
+func (m *StartTaskRequest) GetStopAt() string {
+	if m != nil {
+		return m.StopAt
+	}
+	return ""
+}
+
 type StartTaskResponse struct {
 	Result  bool                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg     string                  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -3171,135 +3179,137 @@ func init() {
 func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
 
 var fileDescriptor_f9bef11f2a341f03 = []byte{
-	// 2041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdf, 0x6f, 0xe3, 0xc6,
-	0xf1, 0x17, 0x25, 0x9d, 0x2c, 0x8f, 0x6c, 0x45, 0x5e, 0xcb, 0x32, 0x8f, 0xe7, 0xd3, 0x39, 0xfb,
-	0x4d, 0x02, 0xc3, 0xf8, 0xe2, 0x8c, 0x73, 0xfb, 0x14, 0x20, 0x05, 0x72, 0xd2, 0xe5, 0x62, 0xd4,
-	0x57, 0xa7, 0xf4, 0x5d, 0x9a, 0xa0, 0x40, 0x11, 0x4a, 0x5a, 0xc9, 0x84, 0x29, 0x92, 0x47, 0x52,
-	0x76, 0x8d, 0x43, 0x5e, 0xfa, 0x07, 0xf4, 0x07, 0xfa, 0x90, 0xc7, 0x3e, 0xf4, 0x3f, 0xe9, 0x53,
-	0x1f, 0x03, 0x14, 0x28, 0xfa, 0x58, 0xdc, 0xf5, 0x0f, 0x29, 0x76, 0x76, 0x49, 0x2e, 0x7f, 0xc8,
-	0xad, 0x02, 0xd4, 0x6f, 0x9c, 0x99, 0xd5, 0xcc, 0x67, 0x7e, 0xec, 0xec, 0xec, 0x0a, 0xda, 0x93,
-	0xf9, 0xdc, 0x0a, 0x23, 0x16, 0x3c, 0xf6, 0x03, 0x2f, 0xf2, 0x48, 0xd5, 0x1f, 0x19, 0xed, 0xc9,
-	0xfc, 0xda, 0x0b, 0x2e, 0x63, 0x9e, 0xb1, 0x37, 0xf3, 0xbc, 0x99, 0xc3, 0x8e, 0x2c, 0xdf, 0x3e,
-	0xb2, 0x5c, 0xd7, 0x8b, 0xac, 0xc8, 0xf6, 0xdc, 0x50, 0x48, 0xe9, 0x37, 0xd0, 0x39, 0x8f, 0xac,
-	0x20, 0x7a, 0x69, 0x85, 0x97, 0x26, 0x7b, 0xbd, 0x60, 0x61, 0x44, 0x08, 0xd4, 0x23, 0x2b, 0xbc,
-	0xd4, 0xb5, 0x7d, 0xed, 0x60, 0xdd, 0xc4, 0x6f, 0xa2, 0xc3, 0x5a, 0xe8, 0x2d, 0x82, 0x31, 0x0b,
-	0xf5, 0xea, 0x7e, 0xed, 0x60, 0xdd, 0x8c, 0x49, 0xd2, 0x07, 0x08, 0xd8, 0xdc, 0xbb, 0x62, 0x2f,
-	0x58, 0x64, 0xe9, 0xb5, 0x7d, 0xed, 0xa0, 0x69, 0x2a, 0x1c, 0xfa, 0x1a, 0xb6, 0x14, 0x0b, 0xa1,
-	0xef, 0xb9, 0x21, 0x23, 0x3d, 0x68, 0x04, 0x2c, 0x5c, 0x38, 0x11, 0x1a, 0x69, 0x9a, 0x92, 0x22,
-	0x1d, 0xa8, 0xcd, 0xc3, 0x99, 0x5e, 0x45, 0xcb, 0xfc, 0x93, 0x1c, 0xa7, 0x86, 0x6b, 0xfb, 0xb5,
-	0x83, 0xd6, 0xb1, 0xfe, 0xd8, 0x1f, 0x3d, 0x1e, 0x78, 0xf3, 0xb9, 0xe7, 0xfe, 0x02, 0xfd, 0x8c,
-	0x95, 0x26, 0x90, 0xe8, 0xaf, 0x80, 0x9c, 0xf9, 0x2c, 0xb0, 0x22, 0xa6, 0xba, 0x65, 0x40, 0xd5,
-	0xf3, 0xd1, 0x5e, 0xfb, 0x18, 0xb8, 0x12, 0x2e, 0x3c, 0xf3, 0xcd, 0xaa, 0xe7, 0x73, 0x97, 0x5d,
-	0x6b, 0xce, 0xa4, 0x61, 0xfc, 0x56, 0x5d, 0xae, 0x65, 0x5c, 0xa6, 0xbf, 0xd3, 0x60, 0x3b, 0x63,
-	0x40, 0x7a, 0x75, 0x9b, 0x85, 0xd4, 0xe3, 0x6a, 0x99, 0xc7, 0xb5, 0x52, 0x8f, 0xeb, 0xff, 0xad,
-	0xc7, 0x9f, 0xc2, 0xd6, 0x2b, 0x7f, 0x92, 0x73, 0x78, 0xa5, 0x3c, 0xd2, 0x00, 0x88, 0xaa, 0xe2,
-	0x4e, 0x12, 0xf5, 0x19, 0xf4, 0x7e, 0xbe, 0x60, 0xc1, 0xcd, 0x79, 0x64, 0x45, 0x8b, 0xf0, 0xd4,
-	0x0e, 0x23, 0x05, 0x3b, 0x26, 0x44, 0x2b, 0x4f, 0x48, 0x0e, 0xfb, 0x15, 0xec, 0x16, 0xf4, 0xac,
-	0xec, 0xc0, 0x93, 0xbc, 0x03, 0xbb, 0xdc, 0x01, 0x45, 0x6f, 0x11, 0xff, 0x00, 0xb6, 0xcf, 0x2f,
-	0xbc, 0xeb, 0xe1, 0xf0, 0xf4, 0xd4, 0x1b, 0x5f, 0x86, 0x3f, 0x2c, 0xf0, 0x7f, 0xd2, 0x60, 0x4d,
-	0x6a, 0x20, 0x6d, 0xa8, 0x9e, 0x0c, 0xe5, 0xef, 0xaa, 0x27, 0xc3, 0x44, 0x53, 0x55, 0xd1, 0x44,
-	0xa0, 0x3e, 0xf7, 0x26, 0x4c, 0x96, 0x0c, 0x7e, 0x93, 0x2e, 0xdc, 0xf3, 0xae, 0x5d, 0x16, 0xe8,
-	0x75, 0x64, 0x0a, 0x82, 0xaf, 0x1c, 0x0e, 0x4f, 0x43, 0xfd, 0x1e, 0x1a, 0xc4, 0x6f, 0x1e, 0x8f,
-	0xf0, 0xc6, 0x1d, 0xb3, 0x89, 0xde, 0x40, 0xae, 0xa4, 0x88, 0x01, 0xcd, 0x85, 0x2b, 0x25, 0x6b,
-	0x28, 0x49, 0x68, 0x3a, 0x86, 0x6e, 0xd6, 0xcd, 0x95, 0x63, 0xfb, 0x3e, 0xdc, 0x73, 0xf8, 0x4f,
-	0x65, 0x64, 0x5b, 0x3c, 0xb2, 0x52, 0x9d, 0x29, 0x24, 0xd4, 0x81, 0xee, 0x2b, 0x97, 0x7f, 0xc6,
-	0x7c, 0x19, 0xcc, 0x7c, 0x48, 0x28, 0x6c, 0x04, 0xcc, 0x77, 0xac, 0x31, 0x3b, 0x43, 0x8f, 0x85,
-	0x95, 0x0c, 0x8f, 0xec, 0x43, 0x6b, 0xea, 0x05, 0x63, 0x66, 0x62, 0x1b, 0x92, 0x4d, 0x49, 0x65,
-	0xd1, 0x4f, 0x61, 0x27, 0x67, 0x6d, 0x55, 0x9f, 0xa8, 0x09, 0xf7, 0x65, 0x13, 0x88, 0xcb, 0xdb,
-	0xb1, 0x6e, 0x62, 0xd4, 0x0f, 0x94, 0x56, 0x80, 0xde, 0xa2, 0x54, 0xf6, 0x82, 0xe5, 0xb5, 0xf0,
-	0x9d, 0x06, 0x46, 0x99, 0x52, 0x09, 0xee, 0x56, 0xad, 0xff, 0xdb, 0x0e, 0xf3, 0x9d, 0x06, 0xbb,
-	0x5f, 0x2c, 0x82, 0x59, 0x99, 0xb3, 0x8a, 0x3f, 0x5a, 0xf6, 0x70, 0x30, 0xa0, 0x69, 0xbb, 0xd6,
-	0x38, 0xb2, 0xaf, 0x98, 0x44, 0x95, 0xd0, 0x58, 0xdb, 0xf6, 0x5c, 0x64, 0xa7, 0x66, 0xe2, 0x37,
-	0x5f, 0x3f, 0xb5, 0x1d, 0x86, 0x5b, 0x5f, 0x94, 0x72, 0x42, 0x63, 0xe5, 0x2e, 0x46, 0x43, 0x3b,
-	0xd0, 0xef, 0xa1, 0x44, 0x52, 0xf4, 0xd7, 0xa0, 0x17, 0x81, 0xdd, 0x49, 0xfb, 0xfa, 0x0a, 0x3a,
-	0x83, 0x0b, 0x36, 0xbe, 0xfc, 0x4f, 0x4d, 0xb7, 0x07, 0x0d, 0x16, 0x04, 0x03, 0x57, 0x64, 0xa6,
-	0x66, 0x4a, 0x8a, 0xc7, 0xed, 0xda, 0x0a, 0x5c, 0x2e, 0x10, 0x41, 0x88, 0x49, 0xfa, 0x09, 0x6c,
-	0x29, 0x9a, 0x57, 0x2e, 0xcd, 0x0b, 0xe8, 0xca, 0x2a, 0x3a, 0x47, 0xa8, 0x31, 0xb8, 0x3d, 0xa5,
-	0x7e, 0x36, 0xb8, 0x7f, 0x42, 0x9c, 0x16, 0xd0, 0xd8, 0x73, 0xa7, 0xf6, 0x4c, 0x56, 0xa5, 0xa4,
-	0x78, 0x52, 0x84, 0xc7, 0x27, 0x43, 0x79, 0x12, 0x26, 0x34, 0x5d, 0xc0, 0x4e, 0xce, 0xd2, 0x9d,
-	0x44, 0xfe, 0x19, 0xec, 0x98, 0x6c, 0x66, 0xf3, 0xd1, 0x27, 0x5e, 0x72, 0xeb, 0xb9, 0x61, 0x4d,
-	0x26, 0x01, 0x0b, 0x43, 0x69, 0x36, 0x26, 0xe9, 0x53, 0xe8, 0xe5, 0xd5, 0xac, 0x1c, 0xeb, 0x9f,
-	0x40, 0xf7, 0x6c, 0x3a, 0x75, 0x6c, 0x97, 0xbd, 0x60, 0xf3, 0x51, 0x06, 0x49, 0x74, 0xe3, 0x27,
-	0x48, 0xf8, 0x77, 0xd9, 0x98, 0xc1, 0x3b, 0x51, 0xee, 0xf7, 0x2b, 0x43, 0xf8, 0x71, 0x92, 0xee,
-	0x53, 0x66, 0x4d, 0x52, 0x08, 0x85, 0x74, 0x0b, 0xb1, 0x48, 0x37, 0x1a, 0xce, 0xfe, 0x6a, 0x65,
-	0xc3, 0xbf, 0xd5, 0x00, 0x5e, 0xe0, 0x00, 0x7a, 0xe2, 0x4e, 0xbd, 0xd2, 0xe0, 0x1b, 0xd0, 0x9c,
-	0xa3, 0x5f, 0x27, 0x43, 0xfc, 0x65, 0xdd, 0x4c, 0x68, 0x7e, 0x6a, 0x59, 0x8e, 0x9d, 0x34, 0x68,
-	0x41, 0xf0, 0x5f, 0xf8, 0x8c, 0x05, 0xaf, 0xcc, 0x53, 0xd1, 0x9e, 0xd6, 0xcd, 0x84, 0xe6, 0xc3,
-	0xe6, 0xd8, 0xb1, 0x99, 0x1b, 0xa1, 0x54, 0x9c, 0x6b, 0x0a, 0x87, 0x8e, 0x00, 0x44, 0x22, 0x97,
-	0xe2, 0x21, 0x50, 0xe7, 0xd9, 0x8f, 0x53, 0xc0, 0xbf, 0x39, 0x8e, 0x30, 0xb2, 0x66, 0xf1, 0x91,
-	0x2a, 0x08, 0xec, 0x37, 0x58, 0x6e, 0xb2, 0x13, 0x49, 0x8a, 0x9e, 0x42, 0x87, 0x4f, 0x18, 0x22,
-	0x68, 0x22, 0x67, 0x71, 0x68, 0xb4, 0xb4, 0xaa, 0xcb, 0x26, 0xca, 0xd8, 0x76, 0x2d, 0xb5, 0x4d,
-	0x7f, 0x26, 0xb4, 0x89, 0x28, 0x2e, 0xd5, 0x76, 0x00, 0x6b, 0x62, 0xd0, 0x17, 0x27, 0x46, 0xeb,
-	0xb8, 0xcd, 0xd3, 0x99, 0x86, 0xde, 0x8c, 0xc5, 0xb1, 0x3e, 0x11, 0x85, 0xdb, 0xf4, 0x89, 0x4b,
-	0x42, 0x46, 0x5f, 0x1a, 0x3a, 0x33, 0x16, 0xd3, 0x3f, 0x6b, 0xb0, 0x26, 0xd4, 0x84, 0xe4, 0x31,
-	0x34, 0x1c, 0xf4, 0x1a, 0x55, 0xb5, 0x8e, 0xbb, 0x58, 0x53, 0xb9, 0x58, 0x7c, 0x5e, 0x31, 0xe5,
-	0x2a, 0xbe, 0x5e, 0xc0, 0xc2, 0x28, 0x28, 0xeb, 0x55, 0x6f, 0xf9, 0x7a, 0xb1, 0x8a, 0xaf, 0x17,
-	0x66, 0x31, 0x42, 0xca, 0x7a, 0xd5, 0x1b, 0xbe, 0x5e, 0xac, 0x7a, 0xda, 0x84, 0x86, 0xa8, 0x25,
-	0x7e, 0xc9, 0x40, 0xbd, 0x99, 0x1d, 0xd8, 0xcb, 0xc0, 0x6d, 0x26, 0xb0, 0x7a, 0x19, 0x58, 0xcd,
-	0xc4, 0x7c, 0x2f, 0x63, 0xbe, 0x19, 0x9b, 0xe1, 0xe5, 0xc1, 0xd3, 0x17, 0x57, 0xa3, 0x20, 0x28,
-	0x03, 0xa2, 0x9a, 0x5c, 0xb9, 0xed, 0x7d, 0x08, 0x6b, 0x02, 0x7c, 0x66, 0x28, 0x92, 0xa1, 0x36,
-	0x63, 0x19, 0xfd, 0xbb, 0x96, 0xf6, 0xf2, 0xf1, 0x05, 0x9b, 0x5b, 0xcb, 0x7b, 0x39, 0x8a, 0xd3,
-	0x0b, 0x4d, 0x61, 0x70, 0x5c, 0x7a, 0xa1, 0xe1, 0x5b, 0x6e, 0x62, 0x45, 0xd6, 0xc8, 0x0a, 0x93,
-	0x63, 0x37, 0xa6, 0xb9, 0xf7, 0x91, 0x35, 0x72, 0x98, 0x3c, 0x75, 0x05, 0x81, 0x9b, 0x03, 0xed,
-	0xe9, 0x0d, 0xb9, 0x39, 0x90, 0xe2, 0xab, 0xa7, 0xce, 0x22, 0xbc, 0xd0, 0xd7, 0xc4, 0x96, 0x46,
-	0x82, 0xa3, 0xe1, 0xa3, 0xa4, 0xde, 0x44, 0x26, 0x7e, 0xab, 0x27, 0x87, 0xf4, 0xeb, 0x4e, 0x4e,
-	0x8e, 0x43, 0xe8, 0x3e, 0x67, 0xd1, 0xf9, 0x62, 0xc4, 0x8f, 0xd6, 0xc1, 0x74, 0x76, 0xcb, 0xc1,
-	0x41, 0x5f, 0xc1, 0x4e, 0x6e, 0xed, 0xca, 0x10, 0x09, 0xd4, 0xc7, 0xd3, 0x59, 0x1c, 0x70, 0xfc,
-	0xa6, 0x43, 0xd8, 0x7c, 0xce, 0x22, 0xc5, 0xf6, 0x23, 0xe5, 0xa8, 0x90, 0x83, 0xdd, 0x60, 0x3a,
-	0x7b, 0x79, 0xe3, 0xb3, 0x5b, 0xce, 0x8d, 0x53, 0x68, 0xc7, 0x5a, 0x56, 0x46, 0xd5, 0x81, 0xda,
-	0x78, 0x9a, 0x8c, 0x84, 0xe3, 0xe9, 0x8c, 0xee, 0xc0, 0xf6, 0x73, 0x26, 0xf7, 0x65, 0x8a, 0x8c,
-	0x1e, 0x60, 0xb4, 0x14, 0xb6, 0x34, 0x25, 0x15, 0x68, 0xa9, 0x82, 0x3f, 0x68, 0x40, 0x3e, 0xb7,
-	0xdc, 0x89, 0xc3, 0x9e, 0x05, 0x81, 0x17, 0x2c, 0x9d, 0x83, 0x51, 0xfa, 0x83, 0x8a, 0x74, 0x0f,
-	0xd6, 0x47, 0xb6, 0xeb, 0x78, 0xb3, 0x2f, 0xbc, 0x50, 0x56, 0x69, 0xca, 0xc0, 0x12, 0x7b, 0xed,
-	0x24, 0x77, 0x1d, 0xfe, 0x4d, 0x43, 0xd8, 0xce, 0x40, 0xba, 0x93, 0x02, 0x7b, 0x0e, 0x3b, 0x2f,
-	0x03, 0xcb, 0x0d, 0xa7, 0x2c, 0xc8, 0x0e, 0x5f, 0xe9, 0x79, 0xa2, 0xa9, 0xe7, 0x89, 0xd2, 0x76,
-	0x84, 0x65, 0x49, 0xf1, 0xe1, 0x24, 0xaf, 0x68, 0xe5, 0x03, 0x7a, 0x92, 0x3c, 0x54, 0x64, 0x06,
-	0xf6, 0x87, 0x4a, 0x56, 0x36, 0x95, 0x7b, 0xc4, 0x97, 0xc7, 0xf1, 0x20, 0x28, 0x91, 0x56, 0x97,
-	0x20, 0x15, 0xa9, 0x89, 0x91, 0x46, 0x49, 0x8b, 0xba, 0xc3, 0xe9, 0xfb, 0x70, 0x04, 0xcd, 0x78,
-	0x7c, 0x25, 0xdb, 0xf0, 0xde, 0x89, 0x7b, 0x65, 0x39, 0xf6, 0x24, 0x66, 0x75, 0x2a, 0xe4, 0x3d,
-	0x68, 0xe1, 0xcb, 0x93, 0x60, 0x75, 0x34, 0xd2, 0x81, 0x0d, 0xf1, 0xc4, 0x21, 0x39, 0x55, 0xd2,
-	0x06, 0x38, 0x8f, 0x3c, 0x5f, 0xd2, 0x35, 0xa4, 0x2f, 0xbc, 0x6b, 0x49, 0xd7, 0x0f, 0x7f, 0x0a,
-	0xcd, 0x78, 0x66, 0x52, 0x6c, 0xc4, 0xac, 0x4e, 0x85, 0x6c, 0xc1, 0xe6, 0xb3, 0x2b, 0x7b, 0x1c,
-	0x25, 0x2c, 0x8d, 0xec, 0xc2, 0xf6, 0xc0, 0x72, 0xc7, 0xcc, 0xc9, 0x0a, 0xaa, 0x87, 0x5f, 0xc1,
-	0x9a, 0xdc, 0xd6, 0x1c, 0x9a, 0xd4, 0xc5, 0xc9, 0x4e, 0x85, 0x6c, 0x40, 0x93, 0x37, 0x19, 0xa4,
-	0x34, 0x0e, 0x43, 0xec, 0x39, 0xa4, 0x11, 0xa6, 0x88, 0x02, 0xd2, 0x02, 0x26, 0x42, 0x44, 0xba,
-	0x7e, 0x38, 0x84, 0xf5, 0x24, 0x83, 0xa4, 0x0b, 0x1d, 0xa9, 0x3b, 0xe1, 0x75, 0x2a, 0xdc, 0x77,
-	0x0c, 0x06, 0xf2, 0xbe, 0x3c, 0xee, 0x68, 0x22, 0x3c, 0x9e, 0x1f, 0x33, 0xaa, 0xc7, 0x7f, 0x69,
-	0x43, 0x43, 0x98, 0x25, 0x5f, 0xc3, 0x7a, 0xf2, 0x68, 0x47, 0xf0, 0x18, 0xce, 0xbf, 0x12, 0x1a,
-	0x3b, 0x39, 0xae, 0x48, 0x0f, 0x7d, 0xf4, 0x9b, 0xbf, 0xfd, 0xeb, 0x8f, 0xd5, 0xfb, 0xb4, 0x7b,
-	0x64, 0xf9, 0x76, 0x78, 0x74, 0xf5, 0xc4, 0x72, 0xfc, 0x0b, 0xeb, 0xc9, 0x11, 0xdf, 0xdc, 0xe1,
-	0xc7, 0xda, 0x21, 0x99, 0x42, 0x4b, 0x79, 0x3b, 0x23, 0x3d, 0xae, 0xa6, 0xf8, 0x5a, 0x67, 0xec,
-	0x16, 0xf8, 0xd2, 0xc0, 0x47, 0x68, 0x60, 0xdf, 0x78, 0x50, 0x66, 0xe0, 0xe8, 0x0d, 0xef, 0x8d,
-	0xdf, 0x72, 0x3b, 0x9f, 0x00, 0xa4, 0xef, 0x59, 0x04, 0xd1, 0x16, 0x9e, 0xc8, 0x8c, 0x5e, 0x9e,
-	0x2d, 0x8d, 0x54, 0x88, 0x03, 0x2d, 0xe5, 0xe9, 0x87, 0x18, 0xb9, 0xb7, 0x20, 0xe5, 0xad, 0xca,
-	0x78, 0x50, 0x2a, 0x93, 0x9a, 0x3e, 0x40, 0xb8, 0x7d, 0xb2, 0x97, 0x83, 0x1b, 0xe2, 0x52, 0x89,
-	0x97, 0x0c, 0x60, 0x43, 0x7d, 0x61, 0x21, 0xe8, 0x7d, 0xc9, 0xd3, 0x92, 0xa1, 0x17, 0x05, 0x09,
-	0xe4, 0xcf, 0x60, 0x33, 0xf3, 0xa6, 0x41, 0x70, 0x71, 0xd9, 0xa3, 0x8a, 0x71, 0xbf, 0x44, 0x92,
-	0xe8, 0xf9, 0x1a, 0x7a, 0xc5, 0x37, 0x08, 0x8c, 0xe2, 0x43, 0x25, 0x29, 0xc5, 0x77, 0x00, 0xa3,
-	0xbf, 0x4c, 0x9c, 0xa8, 0x3e, 0x83, 0x4e, 0xfe, 0xae, 0x4e, 0x30, 0x7c, 0x4b, 0x9e, 0x16, 0x8c,
-	0xbd, 0x72, 0x61, 0xa2, 0xf0, 0x63, 0x58, 0x4f, 0x2e, 0xca, 0xa2, 0x50, 0xf3, 0x37, 0x72, 0x51,
-	0xa8, 0x85, 0xdb, 0x34, 0xad, 0x90, 0x19, 0x6c, 0x66, 0xee, 0xae, 0x22, 0x5e, 0x65, 0x17, 0x67,
-	0x11, 0xaf, 0xd2, 0x8b, 0x2e, 0x7d, 0x1f, 0x13, 0xfc, 0xc0, 0xe8, 0xe5, 0x13, 0x2c, 0xda, 0x14,
-	0x2f, 0xc5, 0x13, 0x68, 0x67, 0xaf, 0x99, 0xe4, 0xbe, 0x68, 0xba, 0x25, 0x37, 0x58, 0xc3, 0x28,
-	0x13, 0x25, 0x98, 0x03, 0xd8, 0xcc, 0xdc, 0x16, 0x25, 0xe6, 0x92, 0x0b, 0xa8, 0xc4, 0x5c, 0x76,
-	0xb5, 0xa4, 0xff, 0x8f, 0x98, 0x3f, 0x3a, 0xfc, 0x20, 0x87, 0x59, 0x0e, 0x9d, 0x47, 0x6f, 0xf8,
-	0xd4, 0xf1, 0x6d, 0x5c, 0x9c, 0x97, 0x49, 0x9c, 0x44, 0x33, 0xcb, 0xc4, 0x29, 0x73, 0xe3, 0xcc,
-	0xc4, 0x29, 0x7b, 0xab, 0xa4, 0x1f, 0xa2, 0xcd, 0x47, 0x86, 0x91, 0xb3, 0x29, 0x86, 0xf2, 0xa3,
-	0x37, 0x9e, 0x8f, 0xdb, 0xf6, 0x97, 0x00, 0xe9, 0x58, 0x2d, 0xb6, 0x6d, 0x61, 0xb2, 0x17, 0xdb,
-	0xb6, 0x38, 0x7d, 0xd3, 0x3e, 0xda, 0xd0, 0x49, 0xaf, 0xdc, 0x2f, 0x32, 0x4d, 0x33, 0x2e, 0xc6,
-	0xd5, 0x4c, 0xc6, 0xd5, 0xf1, 0x3a, 0x9b, 0xf1, 0xcc, 0x80, 0x4a, 0xf7, 0xd1, 0x8a, 0x61, 0xec,
-	0xe4, 0x33, 0x8e, 0xcb, 0xb8, 0x13, 0x0e, 0x4e, 0x78, 0xe9, 0xe0, 0x28, 0xec, 0x94, 0xcd, 0x9d,
-	0xc2, 0x4e, 0xe9, 0x94, 0x19, 0x77, 0x3a, 0xd2, 0xcf, 0xdb, 0x59, 0x8c, 0xd4, 0x66, 0x47, 0x5e,
-	0x42, 0x43, 0x4c, 0x82, 0x64, 0x4b, 0x2a, 0x53, 0xf4, 0x13, 0x95, 0x25, 0x15, 0xff, 0x1f, 0x2a,
-	0x7e, 0x48, 0x6e, 0x6b, 0xa1, 0xe4, 0x1b, 0x68, 0x29, 0xc3, 0x93, 0xe8, 0xd3, 0xc5, 0x01, 0x4f,
-	0xf4, 0xe9, 0x92, 0x29, 0x6b, 0x69, 0x94, 0x18, 0x5f, 0x85, 0xdb, 0x62, 0x00, 0x1b, 0xea, 0x70,
-	0x29, 0x9a, 0x5e, 0xc9, 0x14, 0x6a, 0xe8, 0x45, 0x41, 0xb2, 0x21, 0x4e, 0xa0, 0x9d, 0x9d, 0x92,
-	0xc4, 0xde, 0x2a, 0x1d, 0xc1, 0xc4, 0xde, 0x2a, 0x1f, 0xaa, 0x68, 0x85, 0xe3, 0x51, 0xc7, 0x18,
-	0xa2, 0x1e, 0x41, 0x99, 0xa6, 0xa4, 0x17, 0x05, 0xb1, 0x92, 0xa7, 0xfa, 0x5f, 0xdf, 0xf6, 0xb5,
-	0xef, 0xdf, 0xf6, 0xb5, 0x7f, 0xbe, 0xed, 0x6b, 0xbf, 0x7f, 0xd7, 0xaf, 0x7c, 0xff, 0xae, 0x5f,
-	0xf9, 0xc7, 0xbb, 0x7e, 0x65, 0xd4, 0xc0, 0x7f, 0xdc, 0x7e, 0xf4, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x25, 0x0e, 0xf8, 0xc2, 0xb5, 0x1b, 0x00, 0x00,
+	// 2079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x32, 0x45, 0x3d, 0x4a, 0x0c, 0x35, 0x22, 0xa9, 0xe5, 0x58, 0xa6, 0x95, 0x6d,
+	0x12, 0x08, 0x42, 0x61, 0xc1, 0x6a, 0x4f, 0x01, 0x52, 0xc0, 0x26, 0x1d, 0x47, 0xa8, 0x5c, 0xa5,
+	0x2b, 0xcb, 0x75, 0x50, 0xa0, 0xe8, 0x92, 0x1c, 0x52, 0x84, 0x96, 0xbb, 0xeb, 0xdd, 0xa5, 0x14,
+	0xc1, 0xc8, 0xa5, 0x1f, 0xa0, 0x7f, 0xd0, 0x43, 0x8e, 0x3d, 0xf4, 0xcb, 0xf4, 0x18, 0xa0, 0x40,
+	0xd1, 0x63, 0x61, 0xf7, 0x1b, 0xf4, 0x0b, 0x14, 0xf3, 0x66, 0x76, 0x38, 0xbb, 0x5c, 0x2a, 0x65,
+	0x80, 0xea, 0xb6, 0xef, 0xbd, 0xe1, 0xef, 0xfd, 0x9d, 0x37, 0x6f, 0x86, 0x50, 0x1d, 0x4c, 0x26,
+	0x4e, 0x14, 0xb3, 0xf0, 0x51, 0x10, 0xfa, 0xb1, 0x4f, 0x0a, 0x41, 0x8f, 0x56, 0x07, 0x93, 0x6b,
+	0x3f, 0xbc, 0x4c, 0x78, 0x74, 0x77, 0xe4, 0xfb, 0x23, 0x97, 0x1d, 0x3a, 0xc1, 0xf8, 0xd0, 0xf1,
+	0x3c, 0x3f, 0x76, 0xe2, 0xb1, 0xef, 0x45, 0x42, 0x6a, 0x7d, 0x0d, 0xb5, 0xb3, 0xd8, 0x09, 0xe3,
+	0x97, 0x4e, 0x74, 0x69, 0xb3, 0x37, 0x53, 0x16, 0xc5, 0x84, 0xc0, 0x6a, 0xec, 0x44, 0x97, 0xa6,
+	0xb1, 0x67, 0xec, 0xaf, 0xdb, 0xf8, 0x4d, 0x4c, 0x58, 0x8b, 0xfc, 0x69, 0xd8, 0x67, 0x91, 0x59,
+	0xd8, 0x2b, 0xee, 0xaf, 0xdb, 0x09, 0x49, 0xda, 0x00, 0x21, 0x9b, 0xf8, 0x57, 0xec, 0x05, 0x8b,
+	0x1d, 0xb3, 0xb8, 0x67, 0xec, 0x97, 0x6d, 0x8d, 0x43, 0x9a, 0x50, 0x8a, 0x62, 0x3f, 0x78, 0x12,
+	0x9b, 0xab, 0x88, 0x27, 0x29, 0xeb, 0x0d, 0x6c, 0x69, 0x9a, 0xa3, 0xc0, 0xf7, 0x22, 0xc6, 0x17,
+	0x87, 0x2c, 0x9a, 0xba, 0x31, 0x2a, 0x2f, 0xdb, 0x92, 0x22, 0x35, 0x28, 0x4e, 0xa2, 0x91, 0x59,
+	0x40, 0x04, 0xfe, 0x49, 0x8e, 0x66, 0x06, 0x15, 0xf7, 0x8a, 0xfb, 0x95, 0x23, 0xf3, 0x51, 0xd0,
+	0x7b, 0xd4, 0xf1, 0x27, 0x13, 0xdf, 0xfb, 0x15, 0xfa, 0x9f, 0x80, 0x2a, 0x53, 0xad, 0xdf, 0x00,
+	0x39, 0x0d, 0x58, 0xe8, 0xc4, 0x4c, 0x77, 0x97, 0x42, 0xc1, 0x0f, 0x50, 0x5f, 0xf5, 0x08, 0x38,
+	0x08, 0x17, 0x9e, 0x06, 0x76, 0xc1, 0x0f, 0x78, 0x28, 0x3c, 0x67, 0xc2, 0xa4, 0x62, 0xfc, 0x26,
+	0x66, 0x5a, 0xf3, 0x2c, 0x14, 0xd6, 0x1f, 0x0c, 0xd8, 0x4e, 0x29, 0x90, 0x5e, 0xdd, 0xa6, 0x61,
+	0xe6, 0x71, 0x21, 0xcf, 0xe3, 0x62, 0xae, 0xc7, 0xab, 0xff, 0xab, 0xc7, 0x4f, 0x60, 0xeb, 0x3c,
+	0x18, 0x64, 0x1c, 0x5e, 0x2a, 0xbf, 0x56, 0x08, 0x44, 0x87, 0xb8, 0x93, 0x44, 0x7d, 0x0e, 0xcd,
+	0x5f, 0x4e, 0x59, 0x78, 0x73, 0x16, 0x3b, 0xf1, 0x34, 0x3a, 0x19, 0x47, 0xb1, 0x66, 0x3b, 0x26,
+	0xc4, 0xc8, 0x4f, 0x48, 0xc6, 0xf6, 0x2b, 0xd8, 0x99, 0xc3, 0x59, 0xda, 0x81, 0xc7, 0x59, 0x07,
+	0x76, 0xb8, 0x03, 0x1a, 0xee, 0xbc, 0xfd, 0x1d, 0xd8, 0x3e, 0xbb, 0xf0, 0xaf, 0xbb, 0xdd, 0x93,
+	0x13, 0xbf, 0x7f, 0x19, 0xfd, 0xb0, 0xc0, 0xff, 0xc5, 0x80, 0x35, 0x89, 0x40, 0xaa, 0x50, 0x38,
+	0xee, 0xca, 0xdf, 0x15, 0x8e, 0xbb, 0x0a, 0xa9, 0xa0, 0x21, 0x11, 0x58, 0x9d, 0xf8, 0x03, 0x26,
+	0x4b, 0x06, 0xbf, 0x49, 0x1d, 0xee, 0xf9, 0xd7, 0x1e, 0x0b, 0xe5, 0xde, 0x13, 0x04, 0x5f, 0xd9,
+	0xed, 0x9e, 0x44, 0xe6, 0x3d, 0x54, 0x88, 0xdf, 0xb8, 0x4d, 0x6f, 0xbc, 0x3e, 0x1b, 0x98, 0x25,
+	0xe4, 0x4a, 0x8a, 0x50, 0x28, 0x4f, 0x3d, 0x29, 0x59, 0x43, 0x89, 0xa2, 0xad, 0x3e, 0xd4, 0xd3,
+	0x6e, 0x2e, 0x1d, 0xdb, 0x0f, 0xe1, 0x9e, 0xcb, 0x7f, 0x2a, 0x23, 0x5b, 0xe1, 0x91, 0x95, 0x70,
+	0xb6, 0x90, 0x58, 0x2e, 0xd4, 0xcf, 0x3d, 0xfe, 0x99, 0xf0, 0x65, 0x30, 0xb3, 0x21, 0xb1, 0x60,
+	0x23, 0x64, 0x81, 0xeb, 0xf4, 0xd9, 0x29, 0x7a, 0x2c, 0xb4, 0xa4, 0x78, 0x64, 0x0f, 0x2a, 0x43,
+	0x3f, 0xec, 0x33, 0x1b, 0xdb, 0x93, 0x6c, 0x56, 0x3a, 0xcb, 0x7a, 0x02, 0x8d, 0x8c, 0xb6, 0x65,
+	0x7d, 0xb2, 0x6c, 0x68, 0xc9, 0x26, 0x90, 0x94, 0xb7, 0xeb, 0xdc, 0x24, 0x56, 0xdf, 0xd7, 0x5a,
+	0x01, 0x7a, 0x8b, 0x52, 0xd9, 0x0b, 0x16, 0xd7, 0xc2, 0xb7, 0x06, 0xd0, 0x3c, 0x50, 0x69, 0xdc,
+	0xad, 0xa8, 0xff, 0xdf, 0x0e, 0xf3, 0xad, 0x01, 0x3b, 0x5f, 0x4e, 0xc3, 0x51, 0x9e, 0xb3, 0x9a,
+	0x3f, 0x46, 0xfa, 0xd0, 0xa0, 0x50, 0x1e, 0x7b, 0x4e, 0x3f, 0x1e, 0x5f, 0x31, 0x69, 0x95, 0xa2,
+	0xb1, 0xb6, 0xc7, 0x13, 0x91, 0x9d, 0xa2, 0x8d, 0xdf, 0x7c, 0xfd, 0x70, 0xec, 0x32, 0xdc, 0xfa,
+	0xa2, 0x94, 0x15, 0x8d, 0x95, 0x3b, 0xed, 0x75, 0xc7, 0xa1, 0x79, 0x4f, 0x1e, 0x30, 0x48, 0x59,
+	0x5f, 0x83, 0x39, 0x6f, 0xd8, 0x9d, 0xb4, 0xaf, 0xd7, 0x50, 0xeb, 0x5c, 0xb0, 0xfe, 0xe5, 0xf7,
+	0x35, 0xdd, 0x26, 0x94, 0x58, 0x18, 0x76, 0x3c, 0x91, 0x99, 0xa2, 0x2d, 0x29, 0x1e, 0xb7, 0x6b,
+	0x27, 0xf4, 0xb8, 0x40, 0x04, 0x21, 0x21, 0xad, 0xcf, 0x60, 0x4b, 0x43, 0x5e, 0xba, 0x34, 0x2f,
+	0xa0, 0x2e, 0xab, 0xe8, 0x0c, 0x4d, 0x4d, 0x8c, 0xdb, 0xd5, 0xea, 0x67, 0x83, 0xfb, 0x27, 0xc4,
+	0xb3, 0x02, 0xea, 0xfb, 0xde, 0x70, 0x3c, 0x92, 0x55, 0x29, 0x29, 0x9e, 0x14, 0xe1, 0xf1, 0x71,
+	0x57, 0x9e, 0x84, 0x8a, 0xb6, 0xa6, 0xd0, 0xc8, 0x68, 0xba, 0x93, 0xc8, 0x3f, 0x83, 0x86, 0xcd,
+	0x46, 0xe3, 0x28, 0x66, 0x61, 0xb2, 0xe4, 0xd6, 0x73, 0xc3, 0x19, 0x0c, 0x42, 0x16, 0x45, 0x52,
+	0x6d, 0x42, 0x5a, 0x4f, 0xa1, 0x99, 0x85, 0x59, 0x3a, 0xd6, 0x3f, 0x83, 0xfa, 0xe9, 0x70, 0xe8,
+	0x8e, 0x3d, 0xf6, 0x82, 0x4d, 0x7a, 0x29, 0x4b, 0xe2, 0x9b, 0x40, 0x59, 0xc2, 0xbf, 0xf3, 0xc6,
+	0x0c, 0xde, 0x89, 0x32, 0xbf, 0x5f, 0xda, 0x84, 0x9f, 0xaa, 0x74, 0x9f, 0x30, 0x67, 0xc0, 0xc2,
+	0x85, 0xe9, 0x16, 0x62, 0x91, 0x6e, 0x54, 0x9c, 0xfe, 0xd5, 0xd2, 0x8a, 0x7f, 0x6f, 0x00, 0xbc,
+	0xc0, 0xc1, 0xf4, 0xd8, 0x1b, 0xfa, 0xb9, 0xc1, 0xa7, 0x50, 0x9e, 0xa0, 0x5f, 0xc7, 0x5d, 0xfc,
+	0xe5, 0xaa, 0xad, 0x68, 0x7e, 0x6a, 0x39, 0xee, 0x58, 0x35, 0x68, 0x41, 0xf0, 0x5f, 0x04, 0x8c,
+	0x85, 0xe7, 0xf6, 0x89, 0x68, 0x4f, 0xeb, 0xb6, 0xa2, 0xf9, 0x10, 0xda, 0x77, 0xc7, 0xcc, 0x8b,
+	0xcf, 0x6d, 0x75, 0xae, 0x69, 0x1c, 0xab, 0x07, 0x20, 0x12, 0xb9, 0xd0, 0x1e, 0x02, 0xab, 0x3c,
+	0xfb, 0x49, 0x0a, 0xf8, 0x37, 0xb7, 0x23, 0x8a, 0x9d, 0x51, 0x72, 0xa4, 0x0a, 0x02, 0xfb, 0x0d,
+	0x96, 0x9b, 0x1a, 0x68, 0x91, 0xb2, 0x4e, 0xa0, 0xc6, 0x27, 0x0c, 0x11, 0x34, 0x91, 0xb3, 0x24,
+	0x34, 0xc6, 0xac, 0xaa, 0xf3, 0x26, 0xca, 0x44, 0x77, 0x71, 0xa6, 0xdb, 0xfa, 0x85, 0x40, 0x13,
+	0x51, 0x5c, 0x88, 0xb6, 0x0f, 0x6b, 0xe2, 0x02, 0x20, 0x4e, 0x8c, 0xca, 0x51, 0x95, 0xa7, 0x73,
+	0x16, 0x7a, 0x3b, 0x11, 0x27, 0x78, 0x22, 0x0a, 0xb7, 0xe1, 0x89, 0xcb, 0x43, 0x0a, 0x6f, 0x16,
+	0x3a, 0x3b, 0x11, 0x5b, 0x7f, 0x35, 0x60, 0x4d, 0xc0, 0x44, 0xe4, 0x11, 0x94, 0x5c, 0xf4, 0x1a,
+	0xa1, 0x2a, 0x47, 0x75, 0xac, 0xa9, 0x4c, 0x2c, 0xbe, 0x58, 0xb1, 0xe5, 0x2a, 0xbe, 0x5e, 0x98,
+	0x65, 0x16, 0xd2, 0xeb, 0x75, 0x6f, 0xf9, 0x7a, 0xb1, 0x8a, 0xaf, 0x17, 0x6a, 0xcd, 0x62, 0x7a,
+	0xbd, 0xee, 0x0d, 0x5f, 0x2f, 0x56, 0x3d, 0x2d, 0x43, 0x49, 0xd4, 0x12, 0xbf, 0x64, 0x20, 0x6e,
+	0x6a, 0x07, 0x36, 0x53, 0xe6, 0x96, 0x95, 0x59, 0xcd, 0x94, 0x59, 0x65, 0xa5, 0xbe, 0x99, 0x52,
+	0x5f, 0x4e, 0xd4, 0xf0, 0xf2, 0xe0, 0xe9, 0x4b, 0xaa, 0x51, 0x10, 0x16, 0x03, 0xa2, 0xab, 0x5c,
+	0xba, 0xed, 0x7d, 0x0c, 0x6b, 0xc2, 0xf8, 0xd4, 0x50, 0x24, 0x43, 0x6d, 0x27, 0x32, 0xeb, 0x1f,
+	0xc6, 0xac, 0x97, 0xf7, 0x2f, 0xd8, 0xc4, 0x59, 0xdc, 0xcb, 0x51, 0x3c, 0xbb, 0xd0, 0xcc, 0x0d,
+	0x8e, 0x0b, 0x2f, 0x34, 0x7c, 0xcb, 0x0d, 0x9c, 0xd8, 0xe9, 0x39, 0x91, 0x3a, 0x76, 0x13, 0x9a,
+	0x7b, 0x1f, 0x3b, 0x3d, 0x97, 0xc9, 0x53, 0x57, 0x10, 0xb8, 0x39, 0x50, 0x9f, 0x59, 0x92, 0x9b,
+	0x03, 0x29, 0xbe, 0x7a, 0xe8, 0x4e, 0xa3, 0x0b, 0x73, 0x4d, 0x6c, 0x69, 0x24, 0xb8, 0x35, 0x7c,
+	0x94, 0x34, 0xcb, 0xc8, 0xc4, 0x6f, 0xfd, 0xe4, 0x90, 0x7e, 0xdd, 0xc9, 0xc9, 0x71, 0x00, 0xf5,
+	0xe7, 0x2c, 0x3e, 0x9b, 0xf6, 0xf8, 0xd1, 0xda, 0x19, 0x8e, 0x6e, 0x39, 0x38, 0xac, 0x73, 0x68,
+	0x64, 0xd6, 0x2e, 0x6d, 0x22, 0x81, 0xd5, 0xfe, 0x70, 0x94, 0x04, 0x1c, 0xbf, 0xad, 0x2e, 0x6c,
+	0x3e, 0x67, 0xb1, 0xa6, 0xfb, 0xa1, 0x76, 0x54, 0xc8, 0xc1, 0xae, 0x33, 0x1c, 0xbd, 0xbc, 0x09,
+	0xd8, 0x2d, 0xe7, 0xc6, 0x09, 0x54, 0x13, 0x94, 0xa5, 0xad, 0xaa, 0x41, 0xb1, 0x3f, 0x54, 0x23,
+	0x61, 0x7f, 0x38, 0xb2, 0x1a, 0xb0, 0xfd, 0x9c, 0xc9, 0x7d, 0x39, 0xb3, 0xcc, 0xda, 0x87, 0x7a,
+	0x9a, 0x2d, 0x55, 0x49, 0x00, 0x63, 0x06, 0xf0, 0x27, 0x03, 0xc8, 0x17, 0x8e, 0x37, 0x70, 0xd9,
+	0xb3, 0x30, 0xf4, 0xc3, 0x85, 0x73, 0x30, 0x4a, 0x7f, 0x50, 0x91, 0xee, 0xc2, 0x7a, 0x6f, 0xec,
+	0xb9, 0xfe, 0xe8, 0x4b, 0x3f, 0x92, 0x55, 0x3a, 0x63, 0x60, 0x89, 0xbd, 0x71, 0xd5, 0x5d, 0x87,
+	0x7f, 0x5b, 0x11, 0x6c, 0xa7, 0x4c, 0xba, 0x93, 0x02, 0x7b, 0x0e, 0x8d, 0x97, 0xa1, 0xe3, 0x45,
+	0x43, 0x16, 0xa6, 0x87, 0xaf, 0xd9, 0x79, 0x62, 0xe8, 0xe7, 0x89, 0xd6, 0x76, 0x84, 0x66, 0x49,
+	0xf1, 0xe1, 0x24, 0x0b, 0xb4, 0xf4, 0x01, 0x3d, 0x50, 0x0f, 0x15, 0xa9, 0x81, 0xfd, 0x81, 0x96,
+	0x95, 0x4d, 0xed, 0x1e, 0xf1, 0xea, 0x28, 0x19, 0x04, 0xa5, 0xa5, 0x85, 0x05, 0x96, 0x8a, 0xd4,
+	0x24, 0x96, 0xc6, 0xaa, 0x45, 0xdd, 0xe1, 0xf4, 0x7d, 0xd0, 0x83, 0x72, 0x32, 0xbe, 0x92, 0x6d,
+	0xf8, 0xe0, 0xd8, 0xbb, 0x72, 0xdc, 0xf1, 0x20, 0x61, 0xd5, 0x56, 0xc8, 0x07, 0x50, 0xc1, 0x97,
+	0x27, 0xc1, 0xaa, 0x19, 0xa4, 0x06, 0x1b, 0xe2, 0x89, 0x43, 0x72, 0x0a, 0xa4, 0x0a, 0x70, 0x16,
+	0xfb, 0x81, 0xa4, 0x8b, 0x48, 0x5f, 0xf8, 0xd7, 0x92, 0x5e, 0x3d, 0xf8, 0x39, 0x94, 0x93, 0x99,
+	0x49, 0xd3, 0x91, 0xb0, 0x6a, 0x2b, 0x64, 0x0b, 0x36, 0x9f, 0x5d, 0x8d, 0xfb, 0xb1, 0x62, 0x19,
+	0x64, 0x07, 0xb6, 0x3b, 0x8e, 0xd7, 0x67, 0x6e, 0x5a, 0x50, 0x38, 0x78, 0x0d, 0x6b, 0x72, 0x5b,
+	0x73, 0xd3, 0x24, 0x16, 0x27, 0x6b, 0x2b, 0x64, 0x03, 0xca, 0xbc, 0xc9, 0x20, 0x65, 0x70, 0x33,
+	0xc4, 0x9e, 0x43, 0x1a, 0xcd, 0x14, 0x51, 0x40, 0x5a, 0x98, 0x89, 0x26, 0x22, 0xbd, 0x7a, 0xd0,
+	0x85, 0x75, 0x95, 0x41, 0x52, 0x87, 0x9a, 0xc4, 0x56, 0xbc, 0xda, 0x0a, 0xf7, 0x1d, 0x83, 0x81,
+	0xbc, 0x57, 0x47, 0x35, 0x43, 0x84, 0xc7, 0x0f, 0x12, 0x46, 0xe1, 0xe8, 0x3f, 0x1f, 0x40, 0x49,
+	0xa8, 0x25, 0x5f, 0xc1, 0xba, 0x7a, 0xb4, 0x23, 0x78, 0x0c, 0x67, 0x5f, 0x0f, 0x69, 0x23, 0xc3,
+	0x15, 0xe9, 0xb1, 0x1e, 0xfe, 0xee, 0xef, 0xff, 0xfe, 0x73, 0xa1, 0x65, 0xd5, 0xf9, 0x43, 0x64,
+	0x74, 0x78, 0xf5, 0xd8, 0x71, 0x83, 0x0b, 0xe7, 0xf1, 0x21, 0xdf, 0xdc, 0xd1, 0xa7, 0xc6, 0x01,
+	0x19, 0x42, 0x45, 0x7b, 0x3b, 0x23, 0x4d, 0x0e, 0x33, 0xff, 0x5a, 0x47, 0x77, 0xe6, 0xf8, 0x52,
+	0xc1, 0x27, 0xa8, 0x60, 0x8f, 0xde, 0xcf, 0x53, 0x70, 0xf8, 0x96, 0xf7, 0xc6, 0x6f, 0xb8, 0x9e,
+	0xcf, 0x00, 0x66, 0xef, 0x59, 0x04, 0xad, 0x9d, 0x7b, 0x22, 0xa3, 0xcd, 0x2c, 0x5b, 0x2a, 0x59,
+	0x21, 0x2e, 0x54, 0xb4, 0xa7, 0x1f, 0x42, 0x33, 0x6f, 0x41, 0xda, 0x5b, 0x15, 0xbd, 0x9f, 0x2b,
+	0x93, 0x48, 0x1f, 0xa1, 0xb9, 0x6d, 0xb2, 0x9b, 0x31, 0x37, 0xc2, 0xa5, 0xd2, 0x5e, 0xd2, 0x81,
+	0x0d, 0xfd, 0x85, 0x85, 0xa0, 0xf7, 0x39, 0x4f, 0x4b, 0xd4, 0x9c, 0x17, 0x28, 0x93, 0x3f, 0x87,
+	0xcd, 0xd4, 0x9b, 0x06, 0xc1, 0xc5, 0x79, 0x8f, 0x2a, 0xb4, 0x95, 0x23, 0x51, 0x38, 0x5f, 0x41,
+	0x73, 0xfe, 0x0d, 0x02, 0xa3, 0xf8, 0x40, 0x4b, 0xca, 0xfc, 0x3b, 0x00, 0x6d, 0x2f, 0x12, 0x2b,
+	0xe8, 0x53, 0xa8, 0x65, 0xef, 0xea, 0x04, 0xc3, 0xb7, 0xe0, 0x69, 0x81, 0xee, 0xe6, 0x0b, 0x15,
+	0xe0, 0xa7, 0xb0, 0xae, 0x2e, 0xca, 0xa2, 0x50, 0xb3, 0x37, 0x72, 0xda, 0xc8, 0x70, 0xd5, 0x6f,
+	0x47, 0xb0, 0x99, 0xba, 0xbb, 0x8a, 0x78, 0xe5, 0x5d, 0x9c, 0x69, 0x2b, 0x47, 0x22, 0x71, 0x3e,
+	0xc4, 0x04, 0xdf, 0xa7, 0xcd, 0x6c, 0x82, 0x71, 0x19, 0x96, 0xfc, 0x31, 0x54, 0xd3, 0xd7, 0x4c,
+	0xd2, 0x12, 0x4d, 0x37, 0xe7, 0x06, 0x4b, 0x69, 0x9e, 0x48, 0xd9, 0x1c, 0xc2, 0x66, 0xea, 0xb6,
+	0x28, 0x6d, 0xce, 0xb9, 0x80, 0xd2, 0x56, 0x8e, 0x44, 0xe2, 0xfc, 0x18, 0x6d, 0xfe, 0xe4, 0xe0,
+	0xa3, 0x8c, 0xcd, 0x72, 0xe8, 0x3c, 0x7c, 0xcb, 0xa7, 0x8e, 0x6f, 0x92, 0xe2, 0xbc, 0x54, 0x71,
+	0x12, 0xcd, 0x2c, 0x15, 0xa7, 0xd4, 0x8d, 0x93, 0xb6, 0x72, 0x24, 0x52, 0xe7, 0xc7, 0xa8, 0xf3,
+	0x21, 0xa5, 0x19, 0x9d, 0x62, 0x28, 0x3f, 0x7c, 0xeb, 0x07, 0xb8, 0x6d, 0x7f, 0x0d, 0x30, 0x1b,
+	0xab, 0xc5, 0xb6, 0x9d, 0x9b, 0xec, 0x69, 0x33, 0xcb, 0x96, 0x3a, 0xda, 0xa8, 0xc3, 0x24, 0xcd,
+	0x7c, 0xbf, 0xc8, 0x10, 0x36, 0x53, 0x33, 0x67, 0x3a, 0xe3, 0xfa, 0x78, 0x4d, 0x5b, 0x39, 0x12,
+	0xa9, 0x65, 0x0f, 0xb5, 0x50, 0xda, 0xc8, 0x66, 0x1c, 0x97, 0x71, 0x27, 0x5c, 0xd8, 0x4c, 0x0d,
+	0x8e, 0x42, 0x4f, 0xde, 0xdc, 0x49, 0x5b, 0x39, 0x92, 0x74, 0xa7, 0x23, 0xed, 0xac, 0x9e, 0x69,
+	0x4f, 0x6f, 0x76, 0xe4, 0x25, 0x94, 0xc4, 0x24, 0x48, 0xb6, 0x24, 0x98, 0x86, 0x4f, 0x74, 0x96,
+	0x04, 0xfe, 0x11, 0x02, 0x3f, 0x20, 0xb7, 0xb5, 0x50, 0xf2, 0x5b, 0xa8, 0x68, 0xc3, 0x93, 0xe8,
+	0xd3, 0xf3, 0x03, 0x1e, 0xdd, 0x99, 0xe3, 0x7f, 0x4f, 0x94, 0x18, 0x5f, 0x85, 0xdb, 0xa2, 0x03,
+	0x1b, 0xfa, 0x70, 0x29, 0x9a, 0x5e, 0xce, 0x14, 0x4a, 0xcd, 0x79, 0x81, 0xda, 0x10, 0xc7, 0x50,
+	0x4d, 0x4f, 0x49, 0x62, 0x6f, 0xe5, 0x8e, 0x60, 0x94, 0xe6, 0x89, 0x14, 0x54, 0x07, 0x36, 0xf4,
+	0x31, 0x86, 0xe8, 0x47, 0x50, 0xaa, 0x29, 0x99, 0xf3, 0x02, 0x05, 0xf2, 0x1a, 0x07, 0xe9, 0x57,
+	0xfc, 0xf8, 0xc5, 0x3f, 0xe0, 0xe4, 0xf9, 0xd1, 0x96, 0x2e, 0x64, 0x05, 0x09, 0xe4, 0xc3, 0x85,
+	0x72, 0x85, 0x7c, 0x0e, 0x24, 0xb5, 0x40, 0xe4, 0xe5, 0xc1, 0xdc, 0x0f, 0x53, 0xe9, 0x69, 0x2f,
+	0x12, 0x27, 0xb0, 0x4f, 0xcd, 0xbf, 0xbd, 0x6b, 0x1b, 0xdf, 0xbd, 0x6b, 0x1b, 0xff, 0x7a, 0xd7,
+	0x36, 0xfe, 0xf8, 0xbe, 0xbd, 0xf2, 0xdd, 0xfb, 0xf6, 0xca, 0x3f, 0xdf, 0xb7, 0x57, 0x7a, 0x25,
+	0xfc, 0xeb, 0xf0, 0x27, 0xff, 0x1d, 0x00, 0xb9, 0x1d, 0xad, 0x31, 0x7e, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3348,6 +3358,10 @@ type MasterClient interface {
 	GetMasterCfg(ctx context.Context, in *GetMasterCfgRequest, opts ...grpc.CallOption) (*GetMasterCfgResponse, error)
 	TransferSource(ctx context.Context, in *TransferSourceRequest, opts ...grpc.CallOption) (*TransferSourceResponse, error)
 	OperateRelay(ctx context.Context, in *OperateRelayRequest, opts ...grpc.CallOption) (*OperateRelayResponse, error)
+	// GetValidationStatus gets the status of the continuous validators of a task.
+	GetValidationStatus(ctx context.Context, in *GetValidationStatusRequest, opts ...grpc.CallOption) (*GetValidationStatusResponse, error)
+	// GetValidationError gets the row changes mismatched in the downstream of a task.
+	GetValidationError(ctx context.Context, in *GetValidationErrorRequest, opts ...grpc.CallOption) (*GetValidationErrorResponse, error)
 }
 
 type masterClient struct {
@@ -3547,6 +3561,24 @@ func (c *masterClient) OperateRelay(ctx context.Context, in *OperateRelayRequest
 	return out, nil
 }
 
+func (c *masterClient) GetValidationStatus(ctx context.Context, in *GetValidationStatusRequest, opts ...grpc.CallOption) (*GetValidationStatusResponse, error) {
+	out := new(GetValidationStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/GetValidationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) GetValidationError(ctx context.Context, in *GetValidationErrorRequest, opts ...grpc.CallOption) (*GetValidationErrorResponse, error) {
+	out := new(GetValidationErrorResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/GetValidationError", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MasterServer is the server API for Master service.
 type MasterServer interface {
 	StartTask(context.Context, *StartTaskRequest) (*StartTaskResponse, error)
@@ -3583,6 +3615,10 @@ type MasterServer interface {
 	GetMasterCfg(context.Context, *GetMasterCfgRequest) (*GetMasterCfgResponse, error)
 	TransferSource(context.Context, *TransferSourceRequest) (*TransferSourceResponse, error)
 	OperateRelay(context.Context, *OperateRelayRequest) (*OperateRelayResponse, error)
+	// GetValidationStatus gets the status of the continuous validators of a task.
+	GetValidationStatus(context.Context, *GetValidationStatusRequest) (*GetValidationStatusResponse, error)
+	// GetValidationError gets the row changes mismatched in the downstream of a task.
+	GetValidationError(context.Context, *GetValidationErrorRequest) (*GetValidationErrorResponse, error)
 }
 
 // UnimplementedMasterServer can be embedded to have forward compatible implementations.
@@ -3652,6 +3688,12 @@ func (*UnimplementedMasterServer) TransferSource(ctx context.Context, req *Trans
 func (*UnimplementedMasterServer) OperateRelay(ctx context.Context, req *OperateRelayRequest) (*OperateRelayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OperateRelay not implemented")
 }
+func (*UnimplementedMasterServer) GetValidationStatus(ctx context.Context, req *GetValidationStatusRequest) (*GetValidationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidationStatus not implemented")
+}
+func (*UnimplementedMasterServer) GetValidationError(ctx context.Context, req *GetValidationErrorRequest) (*GetValidationErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidationError not implemented")
+}
 
 func RegisterMasterServer(s *grpc.Server, srv MasterServer) {
 	s.RegisterService(&_Master_serviceDesc, srv)
@@ -4035,6 +4077,42 @@ func _Master_OperateRelay_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Master_GetValidationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).GetValidationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/GetValidationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).GetValidationStatus(ctx, req.(*GetValidationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_GetValidationError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidationErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).GetValidationError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/GetValidationError",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).GetValidationError(ctx, req.(*GetValidationErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Master_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Master",
 	HandlerType: (*MasterServer)(nil),
@@ -4123,6 +4201,14 @@ var _Master_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OperateRelay",
 			Handler:    _Master_OperateRelay_Handler,
 		},
+		{
+			MethodName: "GetValidationStatus",
+			Handler:    _Master_GetValidationStatus_Handler,
+		},
+		{
+			MethodName: "GetValidationError",
+			Handler:    _Master_GetValidationError_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dmmaster.proto",
@@ -4148,6 +4234,13 @@ func (m *StartTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StopAt) > 0 {
+		i -= len(m.StopAt)
+		copy(dAtA[i:], m.StopAt)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StopAt)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.RemoveMeta {
 		i--
 		if m.RemoveMeta {
@@ -6520,6 +6613,10 @@ func (m *StartTaskRequest) Size() (n int) {
 	if m.RemoveMeta {
 		n += 2
 	}
+	l = len(m.StopAt)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
@@ -7677,6 +7774,38 @@ func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RemoveMeta = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
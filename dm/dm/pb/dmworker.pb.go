@@ -2496,973 +2496,975 @@ func (m *GetWorkerCfgResponse) GetCfg() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("pb.TaskOp", TaskOp_name, TaskOp_value)
-	proto.RegisterEnum("pb.Stage", Stage_name, Stage_value)
-	proto.RegisterEnum("pb.UnitType", UnitType_name, UnitType_value)
-	proto.RegisterEnum("pb.RelayOp", RelayOp_name, RelayOp_value)
-	proto.RegisterEnum("pb.SchemaOp", SchemaOp_name, SchemaOp_value)
-	proto.RegisterEnum("pb.V1MetaOp", V1MetaOp_name, V1MetaOp_value)
-	proto.RegisterEnum("pb.ErrorOp", ErrorOp_name, ErrorOp_value)
-	proto.RegisterType((*QueryStatusRequest)(nil), "pb.QueryStatusRequest")
-	proto.RegisterType((*CommonWorkerResponse)(nil), "pb.CommonWorkerResponse")
-	proto.RegisterType((*QueryStatusResponse)(nil), "pb.QueryStatusResponse")
-	proto.RegisterType((*CheckStatus)(nil), "pb.CheckStatus")
-	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
-	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
-	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
-	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
-	proto.RegisterType((*SourceStatus)(nil), "pb.SourceStatus")
-	proto.RegisterType((*RelayStatus)(nil), "pb.RelayStatus")
-	proto.RegisterType((*SubTaskStatus)(nil), "pb.SubTaskStatus")
-	proto.RegisterType((*SubTaskStatusList)(nil), "pb.SubTaskStatusList")
-	proto.RegisterType((*CheckError)(nil), "pb.CheckError")
-	proto.RegisterType((*DumpError)(nil), "pb.DumpError")
-	proto.RegisterType((*LoadError)(nil), "pb.LoadError")
-	proto.RegisterType((*SyncSQLError)(nil), "pb.SyncSQLError")
-	proto.RegisterType((*SyncError)(nil), "pb.SyncError")
-	proto.RegisterType((*SourceError)(nil), "pb.SourceError")
-	proto.RegisterType((*RelayError)(nil), "pb.RelayError")
-	proto.RegisterType((*SubTaskError)(nil), "pb.SubTaskError")
-	proto.RegisterType((*SubTaskErrorList)(nil), "pb.SubTaskErrorList")
-	proto.RegisterType((*ProcessResult)(nil), "pb.ProcessResult")
-	proto.RegisterType((*ProcessError)(nil), "pb.ProcessError")
-	proto.RegisterType((*PurgeRelayRequest)(nil), "pb.PurgeRelayRequest")
-	proto.RegisterType((*OperateWorkerSchemaRequest)(nil), "pb.OperateWorkerSchemaRequest")
-	proto.RegisterType((*V1SubTaskMeta)(nil), "pb.V1SubTaskMeta")
-	proto.RegisterType((*OperateV1MetaRequest)(nil), "pb.OperateV1MetaRequest")
-	proto.RegisterType((*OperateV1MetaResponse)(nil), "pb.OperateV1MetaResponse")
-	proto.RegisterMapType((map[string]*V1SubTaskMeta)(nil), "pb.OperateV1MetaResponse.MetaEntry")
-	proto.RegisterType((*HandleWorkerErrorRequest)(nil), "pb.HandleWorkerErrorRequest")
-	proto.RegisterType((*GetWorkerCfgRequest)(nil), "pb.GetWorkerCfgRequest")
-	proto.RegisterType((*GetWorkerCfgResponse)(nil), "pb.GetWorkerCfgResponse")
+type GetValidationStatusRequest struct {
+	TaskName string `protobuf:"bytes,1,opt,name=taskName,proto3" json:"taskName,omitempty"`
 }
 
-func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
-
-var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2022 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1c, 0x49,
-	0x15, 0x9f, 0x9e, 0x9e, 0x19, 0xcf, 0xbc, 0x19, 0x3b, 0x9d, 0xb2, 0xb3, 0x0c, 0x26, 0x0c, 0x56,
-	0x67, 0x15, 0x8c, 0x0f, 0xd6, 0xc6, 0x2c, 0x5a, 0xb4, 0x12, 0x10, 0x6c, 0x67, 0x9d, 0x05, 0x07,
-	0x27, 0x6d, 0x67, 0x39, 0xa2, 0x9a, 0xee, 0xf2, 0xb8, 0xe5, 0x9e, 0xee, 0x4e, 0x57, 0xb7, 0xad,
-	0x39, 0x20, 0x3e, 0x02, 0x5c, 0x38, 0x20, 0x71, 0xe5, 0xba, 0x47, 0x3e, 0x02, 0x70, 0x8c, 0x90,
-	0x90, 0x10, 0x27, 0x94, 0x7c, 0x0d, 0x0e, 0xe8, 0xbd, 0xaa, 0xee, 0xae, 0xb1, 0x67, 0x12, 0x72,
-	0xe0, 0xd6, 0xef, 0x4f, 0xbd, 0xf7, 0xea, 0x57, 0xef, 0x4f, 0x55, 0xc3, 0x5a, 0x30, 0xbd, 0x4e,
-	0xb2, 0x4b, 0x91, 0xed, 0xa6, 0x59, 0x92, 0x27, 0xac, 0x99, 0x8e, 0xdd, 0x6d, 0x60, 0x2f, 0x0a,
-	0x91, 0xcd, 0x4e, 0x73, 0x9e, 0x17, 0xd2, 0x13, 0xaf, 0x0a, 0x21, 0x73, 0xc6, 0xa0, 0x15, 0xf3,
-	0xa9, 0x18, 0x5a, 0x5b, 0xd6, 0x76, 0xcf, 0xa3, 0x6f, 0x37, 0x85, 0x8d, 0x83, 0x64, 0x3a, 0x4d,
-	0xe2, 0x5f, 0x92, 0x0d, 0x4f, 0xc8, 0x34, 0x89, 0xa5, 0x60, 0x1f, 0x41, 0x27, 0x13, 0xb2, 0x88,
-	0x72, 0xd2, 0xee, 0x7a, 0x9a, 0x62, 0x0e, 0xd8, 0x53, 0x39, 0x19, 0x36, 0xc9, 0x04, 0x7e, 0xa2,
-	0xa6, 0x4c, 0x8a, 0xcc, 0x17, 0x43, 0x9b, 0x98, 0x9a, 0x42, 0xbe, 0x8a, 0x6b, 0xd8, 0x52, 0x7c,
-	0x45, 0xb9, 0x5f, 0x5b, 0xb0, 0x3e, 0x17, 0xdc, 0x07, 0x7b, 0xfc, 0x14, 0x06, 0xca, 0x87, 0xb2,
-	0x40, 0x7e, 0xfb, 0x7b, 0xce, 0x6e, 0x3a, 0xde, 0x3d, 0x35, 0xf8, 0xde, 0x9c, 0x16, 0xfb, 0x0c,
-	0x56, 0x65, 0x31, 0x3e, 0xe3, 0xf2, 0x52, 0x2f, 0x6b, 0x6d, 0xd9, 0xdb, 0xfd, 0xbd, 0xbb, 0xb4,
-	0xcc, 0x14, 0x78, 0xf3, 0x7a, 0xee, 0x9f, 0x2c, 0xe8, 0x1f, 0x5c, 0x08, 0x5f, 0xd3, 0x18, 0x68,
-	0xca, 0xa5, 0x14, 0x41, 0x19, 0xa8, 0xa2, 0xd8, 0x06, 0xb4, 0xf3, 0x24, 0xe7, 0x11, 0x85, 0xda,
-	0xf6, 0x14, 0xc1, 0x46, 0x00, 0xb2, 0xf0, 0x7d, 0x21, 0xe5, 0x79, 0x11, 0x51, 0xa8, 0x6d, 0xcf,
-	0xe0, 0xa0, 0xb5, 0x73, 0x1e, 0x46, 0x22, 0x20, 0x98, 0xda, 0x9e, 0xa6, 0xd8, 0x10, 0x56, 0xae,
-	0x79, 0x16, 0x87, 0xf1, 0x64, 0xd8, 0x26, 0x41, 0x49, 0xe2, 0x8a, 0x40, 0xe4, 0x3c, 0x8c, 0x86,
-	0x9d, 0x2d, 0x6b, 0x7b, 0xe0, 0x69, 0xca, 0x1d, 0x00, 0x1c, 0x16, 0xd3, 0x54, 0x47, 0xfd, 0x67,
-	0x0b, 0xe0, 0x38, 0xe1, 0x81, 0x0e, 0xfa, 0x63, 0x58, 0x3d, 0x0f, 0xe3, 0x50, 0x5e, 0x88, 0x60,
-	0x7f, 0x96, 0x0b, 0x49, 0xb1, 0xdb, 0xde, 0x3c, 0x13, 0x83, 0xa5, 0xa8, 0x95, 0x4a, 0x93, 0x54,
-	0x0c, 0x0e, 0xdb, 0x84, 0x6e, 0x9a, 0x25, 0x93, 0x4c, 0x48, 0xa9, 0x4f, 0xbb, 0xa2, 0x71, 0xed,
-	0x54, 0xe4, 0x7c, 0x3f, 0x8c, 0xa3, 0x64, 0xa2, 0xcf, 0xdc, 0xe0, 0xb0, 0x87, 0xb0, 0x56, 0x53,
-	0x47, 0x67, 0x5f, 0x1e, 0xd2, 0xbe, 0x7a, 0xde, 0x0d, 0xae, 0xfb, 0x7b, 0x0b, 0x56, 0x4f, 0x2f,
-	0x78, 0x16, 0x84, 0xf1, 0xe4, 0x28, 0x4b, 0x8a, 0x14, 0x37, 0x9c, 0xf3, 0x6c, 0x22, 0x72, 0x9d,
-	0xb9, 0x9a, 0xc2, 0x7c, 0x3e, 0x3c, 0x3c, 0xc6, 0x38, 0x6d, 0xcc, 0x67, 0xfc, 0x56, 0xfb, 0xcc,
-	0x64, 0x7e, 0x9c, 0xf8, 0x3c, 0x0f, 0x93, 0x58, 0x87, 0x39, 0xcf, 0xa4, 0x9c, 0x9d, 0xc5, 0x3e,
-	0x81, 0x6e, 0x53, 0xce, 0x12, 0x85, 0xfb, 0x2b, 0x62, 0x2d, 0x69, 0x93, 0xa4, 0xa2, 0xdd, 0x7f,
-	0xd8, 0x00, 0xa7, 0xb3, 0xd8, 0xd7, 0x80, 0x6e, 0x41, 0x9f, 0x80, 0x79, 0x72, 0x25, 0xe2, 0xbc,
-	0x84, 0xd3, 0x64, 0xa1, 0x31, 0x22, 0xcf, 0xd2, 0x12, 0xca, 0x8a, 0x66, 0xf7, 0xa1, 0x97, 0x09,
-	0x5f, 0xc4, 0x39, 0x0a, 0x6d, 0x12, 0xd6, 0x0c, 0xe6, 0xc2, 0x60, 0xca, 0x65, 0x2e, 0xb2, 0x39,
-	0x30, 0xe7, 0x78, 0x6c, 0x07, 0x1c, 0x93, 0x3e, 0xca, 0xc3, 0x40, 0x03, 0x7a, 0x8b, 0x8f, 0xf6,
-	0x68, 0x13, 0xa5, 0xbd, 0x8e, 0xb2, 0x67, 0xf2, 0xd0, 0x9e, 0x49, 0x93, 0xbd, 0x15, 0x65, 0xef,
-	0x26, 0x1f, 0xed, 0x8d, 0xa3, 0xc4, 0xbf, 0x0c, 0xe3, 0x09, 0x1d, 0x40, 0x97, 0xa0, 0x9a, 0xe3,
-	0xb1, 0x1f, 0x81, 0x53, 0xc4, 0x99, 0x90, 0x49, 0x74, 0x25, 0x02, 0x3a, 0x47, 0x39, 0xec, 0x19,
-	0x15, 0x67, 0x9e, 0xb0, 0x77, 0x4b, 0xd5, 0x38, 0x21, 0x50, 0x45, 0xa6, 0x4f, 0x68, 0x04, 0x30,
-	0xa6, 0x40, 0xce, 0x66, 0xa9, 0x18, 0xf6, 0x55, 0x96, 0xd5, 0x1c, 0xf6, 0x09, 0xac, 0x4b, 0xe1,
-	0x27, 0x71, 0x20, 0xf7, 0xc5, 0x45, 0x18, 0x07, 0xcf, 0x08, 0x8b, 0xe1, 0x80, 0x20, 0x5e, 0x24,
-	0x72, 0xff, 0x68, 0xc1, 0xc0, 0x6c, 0x1b, 0x46, 0x43, 0xb3, 0x96, 0x34, 0xb4, 0xa6, 0xd9, 0xd0,
-	0xd8, 0xf7, 0xaa, 0xc6, 0xa5, 0x1a, 0x11, 0xed, 0xef, 0x79, 0x96, 0x60, 0x85, 0x7b, 0x24, 0xa8,
-	0x7a, 0xd9, 0x23, 0xe8, 0x67, 0x22, 0xe2, 0xb3, 0xaa, 0x03, 0xa1, 0xfe, 0x1d, 0xd4, 0xf7, 0x6a,
-	0xb6, 0x67, 0xea, 0xb8, 0x7f, 0x6d, 0x42, 0xdf, 0x10, 0xde, 0xca, 0x0d, 0xeb, 0x7f, 0xcc, 0x8d,
-	0xe6, 0x92, 0xdc, 0xd8, 0x2a, 0x43, 0x2a, 0xc6, 0x87, 0x61, 0xa6, 0xcb, 0xc5, 0x64, 0x55, 0x1a,
-	0x73, 0xc9, 0x68, 0xb2, 0xd8, 0x36, 0xdc, 0x31, 0x48, 0x23, 0x15, 0x6f, 0xb2, 0xd9, 0x2e, 0x30,
-	0x62, 0x1d, 0xf0, 0xdc, 0xbf, 0x78, 0x99, 0xea, 0xd3, 0xe9, 0xd0, 0x11, 0x2f, 0x90, 0xb0, 0xef,
-	0x40, 0x5b, 0xe6, 0x7c, 0x22, 0x28, 0x15, 0xd7, 0xf6, 0x7a, 0x94, 0x3a, 0xc8, 0xf0, 0x14, 0xdf,
-	0x00, 0xbf, 0xfb, 0x1e, 0xf0, 0xdd, 0xff, 0x34, 0x61, 0x75, 0xae, 0xd1, 0x2f, 0x1a, 0x88, 0xb5,
-	0xc7, 0xe6, 0x12, 0x8f, 0x5b, 0xd0, 0x2a, 0xe2, 0x50, 0x1d, 0xf6, 0xda, 0xde, 0x00, 0xe5, 0x2f,
-	0xe3, 0x30, 0xc7, 0xec, 0xf3, 0x48, 0x62, 0xc4, 0xd4, 0x7a, 0x5f, 0x42, 0x7c, 0x02, 0xeb, 0x75,
-	0xea, 0x1f, 0x1e, 0x1e, 0x1f, 0x27, 0xfe, 0x65, 0xd5, 0x19, 0x17, 0x89, 0x18, 0x53, 0xe3, 0x90,
-	0x4a, 0xf8, 0x69, 0x43, 0x0d, 0xc4, 0xef, 0x42, 0xdb, 0xc7, 0x01, 0x45, 0x28, 0xe9, 0x84, 0x32,
-	0x26, 0xd6, 0xd3, 0x86, 0xa7, 0xe4, 0xec, 0x63, 0x68, 0x05, 0xc5, 0x34, 0xd5, 0x58, 0xad, 0xa1,
-	0x5e, 0x3d, 0x32, 0x9e, 0x36, 0x3c, 0x92, 0xa2, 0x56, 0x94, 0xf0, 0x60, 0xd8, 0xab, 0xb5, 0xea,
-	0x49, 0x82, 0x5a, 0x28, 0x45, 0x2d, 0xac, 0x49, 0xaa, 0x4f, 0xad, 0x55, 0xb7, 0x47, 0xd4, 0x42,
-	0xe9, 0x7e, 0x17, 0x3a, 0x52, 0x25, 0xf2, 0x8f, 0xe1, 0xee, 0x1c, 0xfa, 0xc7, 0xa1, 0x24, 0xa8,
-	0x94, 0x78, 0x68, 0x2d, 0x9b, 0xc6, 0xe5, 0xfa, 0x11, 0x00, 0xed, 0xe9, 0x49, 0x96, 0x25, 0x59,
-	0x79, 0x2b, 0xb0, 0xaa, 0x5b, 0x81, 0xfb, 0x6d, 0xe8, 0xe1, 0x5e, 0xde, 0x21, 0xc6, 0x4d, 0x2c,
-	0x13, 0xa7, 0x30, 0xa0, 0xe8, 0x5f, 0x1c, 0x2f, 0xd1, 0x60, 0x7b, 0xb0, 0xa1, 0x46, 0xb3, 0x4a,
-	0xe7, 0xe7, 0x89, 0x0c, 0x69, 0xc0, 0xa8, 0xc2, 0x5a, 0x28, 0xc3, 0x11, 0x20, 0xd0, 0xdc, 0xe9,
-	0x8b, 0xe3, 0x72, 0x5e, 0x96, 0xb4, 0xfb, 0x03, 0xe8, 0xa1, 0x47, 0xe5, 0x6e, 0x1b, 0x3a, 0x24,
-	0x28, 0x71, 0x70, 0x2a, 0x38, 0x75, 0x40, 0x9e, 0x96, 0xbb, 0xbf, 0xb5, 0xa0, 0xaf, 0xda, 0x95,
-	0x5a, 0xf9, 0xa1, 0xdd, 0x6a, 0x6b, 0x6e, 0x79, 0x59, 0xef, 0xa6, 0xc5, 0x5d, 0x00, 0x6a, 0x38,
-	0x4a, 0xa1, 0x55, 0x1f, 0x6f, 0xcd, 0xf5, 0x0c, 0x0d, 0x3c, 0x98, 0x9a, 0x5a, 0x00, 0xed, 0x1f,
-	0x9a, 0x30, 0xd0, 0x47, 0xaa, 0x54, 0xfe, 0x4f, 0x65, 0xa7, 0x2b, 0xa3, 0x65, 0x56, 0xc6, 0xc3,
-	0xb2, 0x32, 0xda, 0xf5, 0x36, 0xea, 0x2c, 0xaa, 0x0b, 0xe3, 0x81, 0x2e, 0x8c, 0x0e, 0xa9, 0xad,
-	0x96, 0x85, 0x51, 0x6a, 0xa9, 0xba, 0x78, 0xa0, 0xeb, 0x62, 0xa5, 0x56, 0xaa, 0x52, 0xaa, 0x2a,
-	0x8b, 0x07, 0xba, 0x2c, 0xba, 0xb5, 0x52, 0x75, 0xcc, 0x55, 0x55, 0xac, 0x40, 0x9b, 0x8e, 0xd3,
-	0xfd, 0x1c, 0x1c, 0x13, 0x1a, 0xaa, 0x89, 0x87, 0x5a, 0x38, 0x97, 0x0a, 0x86, 0x92, 0xa7, 0xd7,
-	0xbe, 0x82, 0xd5, 0xb9, 0xa6, 0x82, 0xb3, 0x31, 0x94, 0x07, 0x3c, 0xf6, 0x45, 0x54, 0x5d, 0x4e,
-	0x0d, 0x8e, 0x91, 0x64, 0xcd, 0xda, 0xb2, 0x36, 0x31, 0x97, 0x64, 0xc6, 0x15, 0xd3, 0x9e, 0xbb,
-	0x62, 0xfe, 0xdd, 0x82, 0x81, 0xb9, 0x00, 0x6f, 0xa9, 0x4f, 0xb2, 0xec, 0x20, 0x09, 0xd4, 0x69,
-	0xb6, 0xbd, 0x92, 0xc4, 0xd4, 0xc7, 0xcf, 0x88, 0x4b, 0xa9, 0x33, 0xb0, 0xa2, 0xb5, 0xec, 0xd4,
-	0x4f, 0xd2, 0xf2, 0xd1, 0x50, 0xd1, 0x5a, 0x76, 0x2c, 0xae, 0x44, 0xa4, 0x47, 0x4d, 0x45, 0xa3,
-	0xb7, 0x67, 0x42, 0x4a, 0x4c, 0x13, 0xd5, 0x21, 0x4b, 0x12, 0x57, 0x79, 0xfc, 0xfa, 0x80, 0x17,
-	0x52, 0xe8, 0xdb, 0x4d, 0x45, 0x23, 0x2c, 0xf8, 0xb8, 0xe1, 0x59, 0x52, 0xc4, 0xe5, 0x9d, 0xc6,
-	0xe0, 0xb8, 0xd7, 0x70, 0xf7, 0x79, 0x91, 0x4d, 0x04, 0x25, 0x71, 0xf9, 0x56, 0xda, 0x84, 0x6e,
-	0x18, 0x73, 0x3f, 0x0f, 0xaf, 0x84, 0x46, 0xb2, 0xa2, 0x31, 0x7f, 0xf3, 0x70, 0x2a, 0xf4, 0xa5,
-	0x8e, 0xbe, 0x51, 0xff, 0x3c, 0x8c, 0x04, 0xe5, 0xb5, 0xde, 0x52, 0x49, 0x53, 0x89, 0xaa, 0xe9,
-	0xaa, 0x5f, 0x42, 0x8a, 0x72, 0xff, 0x65, 0xc1, 0xe6, 0x49, 0x2a, 0x32, 0x9e, 0x0b, 0xf5, 0xfa,
-	0x3a, 0xf5, 0x2f, 0xc4, 0x94, 0x97, 0x21, 0xdc, 0x87, 0x66, 0x92, 0x92, 0x73, 0x9d, 0xef, 0x4a,
-	0x7c, 0x92, 0x7a, 0xcd, 0x24, 0xa5, 0x20, 0xb8, 0xbc, 0xd4, 0xd8, 0xd2, 0xf7, 0xd2, 0xa7, 0xd8,
-	0x26, 0x74, 0x03, 0x9e, 0xf3, 0x31, 0x97, 0xa2, 0xc4, 0xb4, 0xa4, 0xe9, 0xd5, 0xc2, 0xc7, 0x51,
-	0x89, 0xa8, 0x22, 0xc8, 0x12, 0x79, 0xd3, 0x68, 0x6a, 0x0a, 0xb5, 0xcf, 0xa3, 0x42, 0x5e, 0x10,
-	0x8c, 0x5d, 0x4f, 0x11, 0x18, 0x4b, 0x95, 0xf3, 0x5d, 0x95, 0xe2, 0x6e, 0x0e, 0xab, 0x5f, 0x3d,
-	0xd2, 0x69, 0xfb, 0x4c, 0xe4, 0x9c, 0x6d, 0x1a, 0xdb, 0x01, 0xdc, 0x0e, 0x4a, 0xf4, 0x66, 0xde,
-	0x5b, 0xfd, 0x65, 0xcb, 0xb0, 0x8d, 0x96, 0x51, 0x22, 0xd0, 0xa2, 0x14, 0xa5, 0x6f, 0xf7, 0x53,
-	0xd8, 0xd0, 0x88, 0x7e, 0xf5, 0x08, 0xbd, 0x2e, 0xc5, 0x52, 0x89, 0x95, 0x7b, 0xf7, 0x2f, 0x16,
-	0xdc, 0xbb, 0xb1, 0xec, 0x83, 0x1f, 0xa5, 0x9f, 0x41, 0x0b, 0x1f, 0x32, 0x43, 0x9b, 0x4a, 0xeb,
-	0x01, 0xfa, 0x58, 0x68, 0x72, 0x17, 0x89, 0x27, 0x71, 0x9e, 0xcd, 0x3c, 0x5a, 0xb0, 0xf9, 0x33,
-	0xe8, 0x55, 0x2c, 0xb4, 0x7b, 0x29, 0x66, 0x65, 0xf7, 0xbc, 0x14, 0x33, 0x9c, 0xed, 0x57, 0x3c,
-	0x2a, 0x14, 0x34, 0x7a, 0x40, 0xce, 0x01, 0xeb, 0x29, 0xf9, 0xe7, 0xcd, 0x1f, 0x5a, 0xee, 0xaf,
-	0x61, 0xf8, 0x94, 0xc7, 0x41, 0xa4, 0xf3, 0x49, 0x15, 0xb5, 0x86, 0xe0, 0x5b, 0x06, 0x04, 0x7d,
-	0xb4, 0x42, 0xd2, 0x77, 0x64, 0xd3, 0x7d, 0xe8, 0x8d, 0xcb, 0x71, 0xa6, 0x81, 0xaf, 0x19, 0x74,
-	0xe6, 0xaf, 0x22, 0xa9, 0x1f, 0x50, 0xf4, 0xed, 0xde, 0x83, 0xf5, 0x23, 0x91, 0x2b, 0xdf, 0x07,
-	0xe7, 0x13, 0xed, 0xd9, 0xdd, 0x86, 0x8d, 0x79, 0xb6, 0x06, 0xd7, 0x01, 0xdb, 0x3f, 0xaf, 0x46,
-	0x85, 0x7f, 0x3e, 0xd9, 0xf9, 0x15, 0x74, 0x54, 0x56, 0xb0, 0x55, 0xe8, 0x7d, 0x19, 0x5f, 0xf1,
-	0x28, 0x0c, 0x4e, 0x52, 0xa7, 0xc1, 0xba, 0xd0, 0x3a, 0xcd, 0x93, 0xd4, 0xb1, 0x58, 0x0f, 0xda,
-	0xcf, 0xb1, 0xac, 0x9d, 0x26, 0x03, 0xe8, 0x60, 0xe7, 0x9b, 0x0a, 0xc7, 0x46, 0xf6, 0x69, 0xce,
-	0xb3, 0xdc, 0x69, 0x21, 0xfb, 0x65, 0x1a, 0xf0, 0x5c, 0x38, 0x6d, 0xb6, 0x06, 0xf0, 0xd3, 0x22,
-	0x4f, 0xb4, 0x5a, 0x67, 0xe7, 0x37, 0xa4, 0x36, 0x41, 0xdf, 0x03, 0x6d, 0x9f, 0x68, 0xa7, 0xc1,
-	0x56, 0xc0, 0xfe, 0x85, 0xb8, 0x76, 0x2c, 0xd6, 0x87, 0x15, 0xaf, 0x88, 0xf1, 0xa9, 0xad, 0x7c,
-	0x90, 0xbb, 0xc0, 0xb1, 0x51, 0x80, 0x41, 0xa4, 0x22, 0x70, 0x5a, 0x6c, 0x00, 0xdd, 0x2f, 0xf4,
-	0xdb, 0xd9, 0x69, 0xa3, 0x08, 0xd5, 0x70, 0x4d, 0x07, 0x45, 0xe4, 0x10, 0xa9, 0x15, 0xa4, 0x68,
-	0x15, 0x52, 0xdd, 0x9d, 0x13, 0xe8, 0x96, 0x63, 0x8b, 0xdd, 0x81, 0xbe, 0x8e, 0x01, 0x59, 0x4e,
-	0x03, 0x37, 0x41, 0xc3, 0xc9, 0xb1, 0x70, 0xc3, 0x38, 0x80, 0x9c, 0x26, 0x7e, 0xe1, 0x94, 0x71,
-	0x6c, 0x02, 0x61, 0x16, 0xfb, 0x4e, 0x0b, 0x15, 0xa9, 0x5b, 0x39, 0xc1, 0xce, 0x33, 0x58, 0xa1,
-	0xcf, 0x13, 0x3c, 0xc4, 0x35, 0x6d, 0x4f, 0x73, 0x9c, 0x06, 0xe2, 0x88, 0xde, 0x95, 0xb6, 0x85,
-	0x78, 0xd0, 0x76, 0x14, 0xdd, 0xc4, 0x10, 0x14, 0x36, 0x8a, 0x61, 0xef, 0xc4, 0xd0, 0x2d, 0xdb,
-	0x0c, 0x5b, 0x87, 0x3b, 0x25, 0x46, 0x9a, 0xa5, 0x0c, 0x1e, 0x89, 0x5c, 0x31, 0x1c, 0x8b, 0xec,
-	0x57, 0x64, 0x13, 0x61, 0xf5, 0xc4, 0x34, 0xb9, 0x12, 0x9a, 0x63, 0xa3, 0x47, 0x9c, 0x6a, 0x9a,
-	0x6e, 0xe1, 0x02, 0xa4, 0xcf, 0xb0, 0xcd, 0x38, 0xed, 0x9d, 0xc7, 0xd0, 0x2d, 0x4b, 0xd1, 0xf0,
-	0x57, 0xb2, 0x2a, 0x7f, 0x8a, 0xe1, 0x58, 0xb5, 0x03, 0xcd, 0x69, 0xee, 0x3c, 0xa6, 0x11, 0x84,
-	0x99, 0x6c, 0x00, 0xa0, 0x39, 0x3a, 0x73, 0x2e, 0xc3, 0x54, 0x9f, 0xab, 0x48, 0x23, 0xee, 0x57,
-	0xb9, 0x73, 0x25, 0xb2, 0xdc, 0xb1, 0xf7, 0xbe, 0xb6, 0xa1, 0xa3, 0xb2, 0x93, 0x3d, 0x86, 0xbe,
-	0xf1, 0x6f, 0x8a, 0x7d, 0x84, 0x75, 0x72, 0xfb, 0x4f, 0xda, 0xe6, 0x37, 0x6e, 0xf1, 0x55, 0x4a,
-	0xbb, 0x0d, 0xf6, 0x13, 0x80, 0x7a, 0x9a, 0xb0, 0x7b, 0x34, 0x62, 0x6f, 0x4e, 0x97, 0xcd, 0x21,
-	0xdd, 0x43, 0x16, 0xfc, 0x77, 0x73, 0x1b, 0xec, 0xe7, 0xb0, 0xaa, 0x1b, 0x87, 0xc2, 0x8c, 0x8d,
-	0x8c, 0x5e, 0xb2, 0x60, 0x4e, 0xbc, 0xd3, 0xd8, 0x17, 0x95, 0x31, 0x85, 0x17, 0x1b, 0x2e, 0x68,
-	0x4c, 0xca, 0xcc, 0x37, 0x97, 0xb6, 0x2c, 0xb7, 0xc1, 0x8e, 0xa0, 0xaf, 0x1a, 0x8b, 0x1a, 0xfb,
-	0xf7, 0x51, 0x77, 0x59, 0xa7, 0x79, 0x67, 0x40, 0x07, 0x30, 0x30, 0x7b, 0x01, 0x23, 0x24, 0x17,
-	0x34, 0x0d, 0x65, 0x64, 0x51, 0xdb, 0x70, 0x1b, 0xfb, 0xc3, 0xbf, 0xbd, 0x19, 0x59, 0xaf, 0xdf,
-	0x8c, 0xac, 0x7f, 0xbf, 0x19, 0x59, 0xbf, 0x7b, 0x3b, 0x6a, 0xbc, 0x7e, 0x3b, 0x6a, 0xfc, 0xf3,
-	0xed, 0xa8, 0x31, 0xee, 0xd0, 0x3f, 0xd0, 0xef, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x89, 0xaa,
-	0x52, 0x17, 0x15, 0x15, 0x00, 0x00,
+func (m *GetValidationStatusRequest) Reset()         { *m = GetValidationStatusRequest{} }
+func (m *GetValidationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidationStatusRequest) ProtoMessage()    {}
+func (*GetValidationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{31}
+}
+func (m *GetValidationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidationStatusRequest.Merge(m, src)
+}
+func (m *GetValidationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidationStatusRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_GetValidationStatusRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetValidationStatusRequest) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
+}
 
-// WorkerClient is the client API for Worker service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WorkerClient interface {
-	QueryStatus(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error)
-	// PurgeRelay purges relay log files for this dm-worker
-	PurgeRelay(ctx context.Context, in *PurgeRelayRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
-	// Operate (get/set/remove) schema for a specified table in tracker.
-	// a `set`/`remove` operation should be an one-time operation (only take effect once),
-	// so we use a gRPC method rather than a etcd operation now (no persistent operation state).
-	OperateSchema(ctx context.Context, in *OperateWorkerSchemaRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
-	OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error)
-	HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
-	GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error)
+type ValidationStatus struct {
+	Task              string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Source            string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Mode              string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Stage             Stage  `protobuf:"varint,4,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
+	ProcessedRowCount int64  `protobuf:"varint,5,opt,name=processedRowCount,proto3" json:"processedRowCount,omitempty"`
+	PendingRowCount   int64  `protobuf:"varint,6,opt,name=pendingRowCount,proto3" json:"pendingRowCount,omitempty"`
+	ErrorRowCount     int64  `protobuf:"varint,7,opt,name=errorRowCount,proto3" json:"errorRowCount,omitempty"`
+	SkippedRowCount   int64  `protobuf:"varint,8,opt,name=skippedRowCount,proto3" json:"skippedRowCount,omitempty"`
 }
 
-type workerClient struct {
-	cc *grpc.ClientConn
+func (m *ValidationStatus) Reset()         { *m = ValidationStatus{} }
+func (m *ValidationStatus) String() string { return proto.CompactTextString(m) }
+func (*ValidationStatus) ProtoMessage()    {}
+func (*ValidationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{32}
+}
+func (m *ValidationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationStatus.Merge(m, src)
+}
+func (m *ValidationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationStatus.DiscardUnknown(m)
 }
 
-func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
-	return &workerClient{cc}
+var xxx_messageInfo_ValidationStatus proto.InternalMessageInfo
+
+func (m *ValidationStatus) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
 }
 
-func (c *workerClient) QueryStatus(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error) {
-	out := new(QueryStatusResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/QueryStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationStatus) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return ""
 }
 
-func (c *workerClient) PurgeRelay(ctx context.Context, in *PurgeRelayRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
-	out := new(CommonWorkerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/PurgeRelay", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationStatus) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	return out, nil
+	return ""
 }
 
-func (c *workerClient) OperateSchema(ctx context.Context, in *OperateWorkerSchemaRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
-	out := new(CommonWorkerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/OperateSchema", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationStatus) GetStage() Stage {
+	if m != nil {
+		return m.Stage
 	}
-	return out, nil
+	return Stage_InvalidStage
 }
 
-func (c *workerClient) OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error) {
-	out := new(OperateV1MetaResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/OperateV1Meta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationStatus) GetProcessedRowCount() int64 {
+	if m != nil {
+		return m.ProcessedRowCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *workerClient) HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
-	out := new(CommonWorkerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/HandleError", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationStatus) GetPendingRowCount() int64 {
+	if m != nil {
+		return m.PendingRowCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *workerClient) GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error) {
-	out := new(GetWorkerCfgResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/GetWorkerCfg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationStatus) GetErrorRowCount() int64 {
+	if m != nil {
+		return m.ErrorRowCount
 	}
-	return out, nil
+	return 0
 }
 
-// WorkerServer is the server API for Worker service.
-type WorkerServer interface {
-	QueryStatus(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
-	// PurgeRelay purges relay log files for this dm-worker
-	PurgeRelay(context.Context, *PurgeRelayRequest) (*CommonWorkerResponse, error)
-	// Operate (get/set/remove) schema for a specified table in tracker.
-	// a `set`/`remove` operation should be an one-time operation (only take effect once),
-	// so we use a gRPC method rather than a etcd operation now (no persistent operation state).
-	OperateSchema(context.Context, *OperateWorkerSchemaRequest) (*CommonWorkerResponse, error)
-	OperateV1Meta(context.Context, *OperateV1MetaRequest) (*OperateV1MetaResponse, error)
-	HandleError(context.Context, *HandleWorkerErrorRequest) (*CommonWorkerResponse, error)
-	GetWorkerCfg(context.Context, *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error)
+func (m *ValidationStatus) GetSkippedRowCount() int64 {
+	if m != nil {
+		return m.SkippedRowCount
+	}
+	return 0
 }
 
-// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
-type UnimplementedWorkerServer struct {
+type GetValidationStatusResponse struct {
+	Result     bool                `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg        string              `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Validators []*ValidationStatus `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (*UnimplementedWorkerServer) QueryStatus(ctx context.Context, req *QueryStatusRequest) (*QueryStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryStatus not implemented")
+func (m *GetValidationStatusResponse) Reset()         { *m = GetValidationStatusResponse{} }
+func (m *GetValidationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValidationStatusResponse) ProtoMessage()    {}
+func (*GetValidationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{33}
 }
-func (*UnimplementedWorkerServer) PurgeRelay(ctx context.Context, req *PurgeRelayRequest) (*CommonWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgeRelay not implemented")
+func (m *GetValidationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedWorkerServer) OperateSchema(ctx context.Context, req *OperateWorkerSchemaRequest) (*CommonWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateSchema not implemented")
+func (m *GetValidationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedWorkerServer) OperateV1Meta(ctx context.Context, req *OperateV1MetaRequest) (*OperateV1MetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateV1Meta not implemented")
+func (m *GetValidationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidationStatusResponse.Merge(m, src)
 }
-func (*UnimplementedWorkerServer) HandleError(ctx context.Context, req *HandleWorkerErrorRequest) (*CommonWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleError not implemented")
+func (m *GetValidationStatusResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedWorkerServer) GetWorkerCfg(ctx context.Context, req *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerCfg not implemented")
+func (m *GetValidationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidationStatusResponse.DiscardUnknown(m)
 }
 
-func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
-	s.RegisterService(&_Worker_serviceDesc, srv)
-}
+var xxx_messageInfo_GetValidationStatusResponse proto.InternalMessageInfo
 
-func _Worker_QueryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).QueryStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/QueryStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).QueryStatus(ctx, req.(*QueryStatusRequest))
+func (m *GetValidationStatusResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Worker_PurgeRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurgeRelayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).PurgeRelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/PurgeRelay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).PurgeRelay(ctx, req.(*PurgeRelayRequest))
+func (m *GetValidationStatusResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Worker_OperateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateWorkerSchemaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).OperateSchema(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/OperateSchema",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).OperateSchema(ctx, req.(*OperateWorkerSchemaRequest))
+func (m *GetValidationStatusResponse) GetValidators() []*ValidationStatus {
+	if m != nil {
+		return m.Validators
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Worker_OperateV1Meta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateV1MetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).OperateV1Meta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/OperateV1Meta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).OperateV1Meta(ctx, req.(*OperateV1MetaRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetValidationErrorRequest struct {
+	TaskName string `protobuf:"bytes,1,opt,name=taskName,proto3" json:"taskName,omitempty"`
 }
 
-func _Worker_HandleError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HandleWorkerErrorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetValidationErrorRequest) Reset()         { *m = GetValidationErrorRequest{} }
+func (m *GetValidationErrorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidationErrorRequest) ProtoMessage()    {}
+func (*GetValidationErrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{34}
+}
+func (m *GetValidationErrorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidationErrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidationErrorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(WorkerServer).HandleError(ctx, in)
+}
+func (m *GetValidationErrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidationErrorRequest.Merge(m, src)
+}
+func (m *GetValidationErrorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidationErrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidationErrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidationErrorRequest proto.InternalMessageInfo
+
+func (m *GetValidationErrorRequest) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/HandleError",
+	return ""
+}
+
+type ValidationError struct {
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source       string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SrcTable     string `protobuf:"bytes,3,opt,name=srcTable,proto3" json:"srcTable,omitempty"`
+	DstTable     string `protobuf:"bytes,4,opt,name=dstTable,proto3" json:"dstTable,omitempty"`
+	RowKey       string `protobuf:"bytes,5,opt,name=rowKey,proto3" json:"rowKey,omitempty"`
+	ExpectedData string `protobuf:"bytes,6,opt,name=expectedData,proto3" json:"expectedData,omitempty"`
+	ActualData   string `protobuf:"bytes,7,opt,name=actualData,proto3" json:"actualData,omitempty"`
+	Status       string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	RetryCount   int64  `protobuf:"varint,9,opt,name=retryCount,proto3" json:"retryCount,omitempty"`
+	Time         string `protobuf:"bytes,10,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *ValidationError) Reset()         { *m = ValidationError{} }
+func (m *ValidationError) String() string { return proto.CompactTextString(m) }
+func (*ValidationError) ProtoMessage()    {}
+func (*ValidationError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{35}
+}
+func (m *ValidationError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).HandleError(ctx, req.(*HandleWorkerErrorRequest))
+}
+func (m *ValidationError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationError.Merge(m, src)
+}
+func (m *ValidationError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationError proto.InternalMessageInfo
+
+func (m *ValidationError) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Worker_GetWorkerCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkerCfgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ValidationError) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if interceptor == nil {
-		return srv.(WorkerServer).GetWorkerCfg(ctx, in)
+	return ""
+}
+
+func (m *ValidationError) GetSrcTable() string {
+	if m != nil {
+		return m.SrcTable
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/GetWorkerCfg",
+	return ""
+}
+
+func (m *ValidationError) GetDstTable() string {
+	if m != nil {
+		return m.DstTable
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).GetWorkerCfg(ctx, req.(*GetWorkerCfgRequest))
+	return ""
+}
+
+func (m *ValidationError) GetRowKey() string {
+	if m != nil {
+		return m.RowKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Worker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Worker",
-	HandlerType: (*WorkerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryStatus",
-			Handler:    _Worker_QueryStatus_Handler,
-		},
-		{
-			MethodName: "PurgeRelay",
-			Handler:    _Worker_PurgeRelay_Handler,
-		},
-		{
-			MethodName: "OperateSchema",
-			Handler:    _Worker_OperateSchema_Handler,
-		},
-		{
-			MethodName: "OperateV1Meta",
-			Handler:    _Worker_OperateV1Meta_Handler,
-		},
-		{
-			MethodName: "HandleError",
-			Handler:    _Worker_HandleError_Handler,
-		},
-		{
-			MethodName: "GetWorkerCfg",
-			Handler:    _Worker_GetWorkerCfg_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dmworker.proto",
+func (m *ValidationError) GetExpectedData() string {
+	if m != nil {
+		return m.ExpectedData
+	}
+	return ""
 }
 
-func (m *QueryStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidationError) GetActualData() string {
+	if m != nil {
+		return m.ActualData
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidationError) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *QueryStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidationError) GetRetryCount() int64 {
+	if m != nil {
+		return m.RetryCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CommonWorkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidationError) GetTime() string {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CommonWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetValidationErrorResponse struct {
+	Result bool               `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg    string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Errors []*ValidationError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (m *CommonWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Worker) > 0 {
-		i -= len(m.Worker)
-		copy(dAtA[i:], m.Worker)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *GetValidationErrorResponse) Reset()         { *m = GetValidationErrorResponse{} }
+func (m *GetValidationErrorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValidationErrorResponse) ProtoMessage()    {}
+func (*GetValidationErrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{36}
+}
+func (m *GetValidationErrorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidationErrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidationErrorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetValidationErrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidationErrorResponse.Merge(m, src)
+}
+func (m *GetValidationErrorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidationErrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidationErrorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetValidationErrorResponse proto.InternalMessageInfo
+
+func (m *GetValidationErrorResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetValidationErrorResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func (m *QueryStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SubTaskStatus) > 0 {
-		for iNdEx := len(m.SubTaskStatus) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubTaskStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.SourceStatus != nil {
-		{
-			size, err := m.SourceStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *GetValidationErrorResponse) GetErrors() []*ValidationError {
+	if m != nil {
+		return m.Errors
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CheckStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterEnum("pb.TaskOp", TaskOp_name, TaskOp_value)
+	proto.RegisterEnum("pb.Stage", Stage_name, Stage_value)
+	proto.RegisterEnum("pb.UnitType", UnitType_name, UnitType_value)
+	proto.RegisterEnum("pb.RelayOp", RelayOp_name, RelayOp_value)
+	proto.RegisterEnum("pb.SchemaOp", SchemaOp_name, SchemaOp_value)
+	proto.RegisterEnum("pb.V1MetaOp", V1MetaOp_name, V1MetaOp_value)
+	proto.RegisterEnum("pb.ErrorOp", ErrorOp_name, ErrorOp_value)
+	proto.RegisterType((*QueryStatusRequest)(nil), "pb.QueryStatusRequest")
+	proto.RegisterType((*CommonWorkerResponse)(nil), "pb.CommonWorkerResponse")
+	proto.RegisterType((*QueryStatusResponse)(nil), "pb.QueryStatusResponse")
+	proto.RegisterType((*CheckStatus)(nil), "pb.CheckStatus")
+	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
+	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
+	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
+	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
+	proto.RegisterType((*SourceStatus)(nil), "pb.SourceStatus")
+	proto.RegisterType((*RelayStatus)(nil), "pb.RelayStatus")
+	proto.RegisterType((*SubTaskStatus)(nil), "pb.SubTaskStatus")
+	proto.RegisterType((*SubTaskStatusList)(nil), "pb.SubTaskStatusList")
+	proto.RegisterType((*CheckError)(nil), "pb.CheckError")
+	proto.RegisterType((*DumpError)(nil), "pb.DumpError")
+	proto.RegisterType((*LoadError)(nil), "pb.LoadError")
+	proto.RegisterType((*SyncSQLError)(nil), "pb.SyncSQLError")
+	proto.RegisterType((*SyncError)(nil), "pb.SyncError")
+	proto.RegisterType((*SourceError)(nil), "pb.SourceError")
+	proto.RegisterType((*RelayError)(nil), "pb.RelayError")
+	proto.RegisterType((*SubTaskError)(nil), "pb.SubTaskError")
+	proto.RegisterType((*SubTaskErrorList)(nil), "pb.SubTaskErrorList")
+	proto.RegisterType((*ProcessResult)(nil), "pb.ProcessResult")
+	proto.RegisterType((*ProcessError)(nil), "pb.ProcessError")
+	proto.RegisterType((*PurgeRelayRequest)(nil), "pb.PurgeRelayRequest")
+	proto.RegisterType((*OperateWorkerSchemaRequest)(nil), "pb.OperateWorkerSchemaRequest")
+	proto.RegisterType((*V1SubTaskMeta)(nil), "pb.V1SubTaskMeta")
+	proto.RegisterType((*OperateV1MetaRequest)(nil), "pb.OperateV1MetaRequest")
+	proto.RegisterType((*OperateV1MetaResponse)(nil), "pb.OperateV1MetaResponse")
+	proto.RegisterMapType((map[string]*V1SubTaskMeta)(nil), "pb.OperateV1MetaResponse.MetaEntry")
+	proto.RegisterType((*HandleWorkerErrorRequest)(nil), "pb.HandleWorkerErrorRequest")
+	proto.RegisterType((*GetWorkerCfgRequest)(nil), "pb.GetWorkerCfgRequest")
+	proto.RegisterType((*GetWorkerCfgResponse)(nil), "pb.GetWorkerCfgResponse")
+	proto.RegisterType((*GetValidationStatusRequest)(nil), "pb.GetValidationStatusRequest")
+	proto.RegisterType((*ValidationStatus)(nil), "pb.ValidationStatus")
+	proto.RegisterType((*GetValidationStatusResponse)(nil), "pb.GetValidationStatusResponse")
+	proto.RegisterType((*GetValidationErrorRequest)(nil), "pb.GetValidationErrorRequest")
+	proto.RegisterType((*ValidationError)(nil), "pb.ValidationError")
+	proto.RegisterType((*GetValidationErrorResponse)(nil), "pb.GetValidationErrorResponse")
 }
 
-func (m *CheckStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
 
-func (m *CheckStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Warning != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Warning))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Failed != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Failed))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Successful != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Successful))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Total != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Passed {
-		i--
-		if m.Passed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_51a1b9e17fd67b10 = []byte{
+	// 2328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0xdc, 0xc6,
+	0xf5, 0x5f, 0x72, 0x7f, 0xbf, 0x5d, 0xc9, 0xf4, 0x48, 0xc9, 0x77, 0xb3, 0x71, 0x36, 0x02, 0x13,
+	0xf8, 0xab, 0xaa, 0x85, 0x10, 0xab, 0x29, 0x1c, 0x04, 0x68, 0xeb, 0x5a, 0x72, 0xe4, 0x34, 0x72,
+	0x6c, 0x53, 0xb6, 0xdb, 0x5b, 0x41, 0x91, 0xa3, 0x15, 0x21, 0x2e, 0x49, 0x93, 0x43, 0xa9, 0x7b,
+	0x08, 0xfa, 0x27, 0xb4, 0x97, 0x1e, 0x82, 0xf6, 0xda, 0x6b, 0x8f, 0xfd, 0x13, 0xda, 0x1e, 0x83,
+	0x02, 0x05, 0x8a, 0x5e, 0x5a, 0xd8, 0xff, 0x46, 0x0f, 0xc5, 0x7b, 0x33, 0x24, 0x87, 0xab, 0x5d,
+	0xbb, 0x2e, 0xd0, 0x1b, 0xdf, 0xe7, 0xbd, 0x79, 0xf3, 0xe6, 0xcd, 0xfb, 0x35, 0xbb, 0xb0, 0xee,
+	0xcf, 0x2e, 0xe3, 0xf4, 0x9c, 0xa7, 0xbb, 0x49, 0x1a, 0x8b, 0x98, 0x99, 0xc9, 0x89, 0xbd, 0x0d,
+	0xec, 0x71, 0xce, 0xd3, 0xf9, 0xb1, 0x70, 0x45, 0x9e, 0x39, 0xfc, 0x79, 0xce, 0x33, 0xc1, 0x18,
+	0xb4, 0x22, 0x77, 0xc6, 0x47, 0xc6, 0x96, 0xb1, 0xdd, 0x77, 0xe8, 0xdb, 0x4e, 0x60, 0x73, 0x3f,
+	0x9e, 0xcd, 0xe2, 0xe8, 0x27, 0xa4, 0xc3, 0xe1, 0x59, 0x12, 0x47, 0x19, 0x67, 0x6f, 0x43, 0x27,
+	0xe5, 0x59, 0x1e, 0x0a, 0x92, 0xee, 0x39, 0x8a, 0x62, 0x16, 0x34, 0x67, 0xd9, 0x74, 0x64, 0x92,
+	0x0a, 0xfc, 0x44, 0xc9, 0x2c, 0xce, 0x53, 0x8f, 0x8f, 0x9a, 0x04, 0x2a, 0x0a, 0x71, 0x69, 0xd7,
+	0xa8, 0x25, 0x71, 0x49, 0xd9, 0xbf, 0x37, 0x60, 0xa3, 0x66, 0xdc, 0x1b, 0xef, 0xf8, 0x31, 0x0c,
+	0xe5, 0x1e, 0x52, 0x03, 0xed, 0x3b, 0xd8, 0xb3, 0x76, 0x93, 0x93, 0xdd, 0x63, 0x0d, 0x77, 0x6a,
+	0x52, 0xec, 0x36, 0xac, 0x65, 0xf9, 0xc9, 0x13, 0x37, 0x3b, 0x57, 0xcb, 0x5a, 0x5b, 0xcd, 0xed,
+	0xc1, 0xde, 0x75, 0x5a, 0xa6, 0x33, 0x9c, 0xba, 0x9c, 0xfd, 0x3b, 0x03, 0x06, 0xfb, 0x67, 0xdc,
+	0x53, 0x34, 0x1a, 0x9a, 0xb8, 0x59, 0xc6, 0xfd, 0xc2, 0x50, 0x49, 0xb1, 0x4d, 0x68, 0x8b, 0x58,
+	0xb8, 0x21, 0x99, 0xda, 0x76, 0x24, 0xc1, 0x26, 0x00, 0x59, 0xee, 0x79, 0x3c, 0xcb, 0x4e, 0xf3,
+	0x90, 0x4c, 0x6d, 0x3b, 0x1a, 0x82, 0xda, 0x4e, 0xdd, 0x20, 0xe4, 0x3e, 0xb9, 0xa9, 0xed, 0x28,
+	0x8a, 0x8d, 0xa0, 0x7b, 0xe9, 0xa6, 0x51, 0x10, 0x4d, 0x47, 0x6d, 0x62, 0x14, 0x24, 0xae, 0xf0,
+	0xb9, 0x70, 0x83, 0x70, 0xd4, 0xd9, 0x32, 0xb6, 0x87, 0x8e, 0xa2, 0xec, 0x21, 0xc0, 0x41, 0x3e,
+	0x4b, 0x94, 0xd5, 0x7f, 0x30, 0x00, 0x8e, 0x62, 0xd7, 0x57, 0x46, 0x7f, 0x08, 0x6b, 0xa7, 0x41,
+	0x14, 0x64, 0x67, 0xdc, 0xbf, 0x3b, 0x17, 0x3c, 0x23, 0xdb, 0x9b, 0x4e, 0x1d, 0x44, 0x63, 0xc9,
+	0x6a, 0x29, 0x62, 0x92, 0x88, 0x86, 0xb0, 0x31, 0xf4, 0x92, 0x34, 0x9e, 0xa6, 0x3c, 0xcb, 0xd4,
+	0x6d, 0x97, 0x34, 0xae, 0x9d, 0x71, 0xe1, 0xde, 0x0d, 0xa2, 0x30, 0x9e, 0xaa, 0x3b, 0xd7, 0x10,
+	0x76, 0x13, 0xd6, 0x2b, 0xea, 0xf0, 0xc9, 0xe7, 0x07, 0x74, 0xae, 0xbe, 0xb3, 0x80, 0xda, 0xbf,
+	0x36, 0x60, 0xed, 0xf8, 0xcc, 0x4d, 0xfd, 0x20, 0x9a, 0x1e, 0xa6, 0x71, 0x9e, 0xe0, 0x81, 0x85,
+	0x9b, 0x4e, 0xb9, 0x50, 0x91, 0xab, 0x28, 0x8c, 0xe7, 0x83, 0x83, 0x23, 0xb4, 0xb3, 0x89, 0xf1,
+	0x8c, 0xdf, 0xf2, 0x9c, 0x69, 0x26, 0x8e, 0x62, 0xcf, 0x15, 0x41, 0x1c, 0x29, 0x33, 0xeb, 0x20,
+	0xc5, 0xec, 0x3c, 0xf2, 0xc8, 0xe9, 0x4d, 0x8a, 0x59, 0xa2, 0xf0, 0x7c, 0x79, 0xa4, 0x38, 0x6d,
+	0xe2, 0x94, 0xb4, 0xfd, 0xd7, 0x26, 0xc0, 0xf1, 0x3c, 0xf2, 0x94, 0x43, 0xb7, 0x60, 0x40, 0x8e,
+	0xb9, 0x77, 0xc1, 0x23, 0x51, 0xb8, 0x53, 0x87, 0x50, 0x19, 0x91, 0x4f, 0x92, 0xc2, 0x95, 0x25,
+	0xcd, 0x6e, 0x40, 0x3f, 0xe5, 0x1e, 0x8f, 0x04, 0x32, 0x9b, 0xc4, 0xac, 0x00, 0x66, 0xc3, 0x70,
+	0xe6, 0x66, 0x82, 0xa7, 0x35, 0x67, 0xd6, 0x30, 0xb6, 0x03, 0x96, 0x4e, 0x1f, 0x8a, 0xc0, 0x57,
+	0x0e, 0xbd, 0x82, 0xa3, 0x3e, 0x3a, 0x44, 0xa1, 0xaf, 0x23, 0xf5, 0xe9, 0x18, 0xea, 0xd3, 0x69,
+	0xd2, 0xd7, 0x95, 0xfa, 0x16, 0x71, 0xd4, 0x77, 0x12, 0xc6, 0xde, 0x79, 0x10, 0x4d, 0xe9, 0x02,
+	0x7a, 0xe4, 0xaa, 0x1a, 0xc6, 0xbe, 0x0f, 0x56, 0x1e, 0xa5, 0x3c, 0x8b, 0xc3, 0x0b, 0xee, 0xd3,
+	0x3d, 0x66, 0xa3, 0xbe, 0x96, 0x71, 0xfa, 0x0d, 0x3b, 0x57, 0x44, 0xb5, 0x1b, 0x02, 0x99, 0x64,
+	0x92, 0xc2, 0x28, 0x3b, 0x21, 0x43, 0x9e, 0xcc, 0x13, 0x3e, 0x1a, 0xc8, 0x28, 0xab, 0x10, 0xf6,
+	0x11, 0x6c, 0x64, 0xdc, 0x8b, 0x23, 0x3f, 0xbb, 0xcb, 0xcf, 0x82, 0xc8, 0x7f, 0x40, 0xbe, 0x18,
+	0x0d, 0xc9, 0xc5, 0xcb, 0x58, 0xf6, 0x6f, 0x0d, 0x18, 0xea, 0x65, 0x43, 0x2b, 0x68, 0xc6, 0x8a,
+	0x82, 0x66, 0xea, 0x05, 0x8d, 0x7d, 0xab, 0x2c, 0x5c, 0xb2, 0x10, 0xd1, 0xf9, 0x1e, 0xa5, 0x31,
+	0x66, 0xb8, 0x43, 0x8c, 0xb2, 0x96, 0xdd, 0x82, 0x41, 0xca, 0x43, 0x77, 0x5e, 0x56, 0x20, 0x94,
+	0xbf, 0x86, 0xf2, 0x4e, 0x05, 0x3b, 0xba, 0x8c, 0xfd, 0x27, 0x13, 0x06, 0x1a, 0xf3, 0x4a, 0x6c,
+	0x18, 0xff, 0x61, 0x6c, 0x98, 0x2b, 0x62, 0x63, 0xab, 0x30, 0x29, 0x3f, 0x39, 0x08, 0x52, 0x95,
+	0x2e, 0x3a, 0x54, 0x4a, 0xd4, 0x82, 0x51, 0x87, 0xd8, 0x36, 0x5c, 0xd3, 0x48, 0x2d, 0x14, 0x17,
+	0x61, 0xb6, 0x0b, 0x8c, 0xa0, 0x7d, 0x57, 0x78, 0x67, 0x4f, 0x13, 0x75, 0x3b, 0x1d, 0xba, 0xe2,
+	0x25, 0x1c, 0xf6, 0x3e, 0xb4, 0x33, 0xe1, 0x4e, 0x39, 0x85, 0xe2, 0xfa, 0x5e, 0x9f, 0x42, 0x07,
+	0x01, 0x47, 0xe2, 0x9a, 0xf3, 0x7b, 0xaf, 0x71, 0xbe, 0xfd, 0x2f, 0x13, 0xd6, 0x6a, 0x85, 0x7e,
+	0x59, 0x43, 0xac, 0x76, 0x34, 0x57, 0xec, 0xb8, 0x05, 0xad, 0x3c, 0x0a, 0xe4, 0x65, 0xaf, 0xef,
+	0x0d, 0x91, 0xff, 0x34, 0x0a, 0x04, 0x46, 0x9f, 0x43, 0x1c, 0xcd, 0xa6, 0xd6, 0xeb, 0x02, 0xe2,
+	0x23, 0xd8, 0xa8, 0x42, 0xff, 0xe0, 0xe0, 0xe8, 0x28, 0xf6, 0xce, 0xcb, 0xca, 0xb8, 0x8c, 0xc5,
+	0x98, 0x6c, 0x87, 0x94, 0xc2, 0xf7, 0x1b, 0xb2, 0x21, 0xfe, 0x3f, 0xb4, 0x3d, 0x6c, 0x50, 0xa3,
+	0x6e, 0x15, 0x50, 0x5a, 0xc7, 0xba, 0xdf, 0x70, 0x24, 0x9f, 0x7d, 0x08, 0x2d, 0x3f, 0x9f, 0x25,
+	0xca, 0x57, 0xeb, 0x28, 0x57, 0xb5, 0x8c, 0xfb, 0x0d, 0x87, 0xb8, 0x28, 0x15, 0xc6, 0xae, 0x3f,
+	0xea, 0x57, 0x52, 0x55, 0x27, 0x41, 0x29, 0xe4, 0xa2, 0x14, 0xe6, 0xe4, 0x08, 0x2a, 0xa9, 0xaa,
+	0x3c, 0xa2, 0x14, 0x72, 0xef, 0xf6, 0xa0, 0x93, 0xc9, 0x40, 0xfe, 0x01, 0x5c, 0xaf, 0x79, 0xff,
+	0x28, 0xc8, 0xc8, 0x55, 0x92, 0x3d, 0x32, 0x56, 0x75, 0xe3, 0x62, 0xfd, 0x04, 0x80, 0xce, 0x74,
+	0x2f, 0x4d, 0xe3, 0xb4, 0x98, 0x0a, 0x8c, 0x72, 0x2a, 0xb0, 0xdf, 0x83, 0x3e, 0x9e, 0xe5, 0x15,
+	0x6c, 0x3c, 0xc4, 0x2a, 0x76, 0x02, 0x43, 0xb2, 0xfe, 0xf1, 0xd1, 0x0a, 0x09, 0xb6, 0x07, 0x9b,
+	0xb2, 0x35, 0xcb, 0x70, 0x7e, 0x14, 0x67, 0x01, 0x35, 0x18, 0x99, 0x58, 0x4b, 0x79, 0xd8, 0x02,
+	0x38, 0xaa, 0x3b, 0x7e, 0x7c, 0x54, 0xf4, 0xcb, 0x82, 0xb6, 0xbf, 0x07, 0x7d, 0xdc, 0x51, 0x6e,
+	0xb7, 0x0d, 0x1d, 0x62, 0x14, 0x7e, 0xb0, 0x4a, 0x77, 0x2a, 0x83, 0x1c, 0xc5, 0xb7, 0x7f, 0x69,
+	0xc0, 0x40, 0x96, 0x2b, 0xb9, 0xf2, 0x4d, 0xab, 0xd5, 0x56, 0x6d, 0x79, 0x91, 0xef, 0xba, 0xc6,
+	0x5d, 0x00, 0x2a, 0x38, 0x52, 0xa0, 0x55, 0x5d, 0x6f, 0x85, 0x3a, 0x9a, 0x04, 0x5e, 0x4c, 0x45,
+	0x2d, 0x71, 0xed, 0xd7, 0x26, 0x0c, 0xd5, 0x95, 0x4a, 0x91, 0xff, 0x51, 0xda, 0xa9, 0xcc, 0x68,
+	0xe9, 0x99, 0x71, 0xb3, 0xc8, 0x8c, 0x76, 0x75, 0x8c, 0x2a, 0x8a, 0xaa, 0xc4, 0xf8, 0x40, 0x25,
+	0x46, 0x87, 0xc4, 0xd6, 0x8a, 0xc4, 0x28, 0xa4, 0x88, 0x89, 0x42, 0x94, 0x17, 0xdd, 0x4a, 0xa8,
+	0x0c, 0xa9, 0x32, 0x2d, 0x3e, 0x50, 0x69, 0xd1, 0xab, 0x84, 0xca, 0x6b, 0x2e, 0xb3, 0xa2, 0x0b,
+	0x6d, 0xba, 0x4e, 0xfb, 0x53, 0xb0, 0x74, 0xd7, 0x50, 0x4e, 0xdc, 0x54, 0xcc, 0x5a, 0x28, 0x68,
+	0x42, 0x8e, 0x5a, 0xfb, 0x1c, 0xd6, 0x6a, 0x45, 0x05, 0x7b, 0x63, 0x90, 0xed, 0xbb, 0x91, 0xc7,
+	0xc3, 0x72, 0x38, 0xd5, 0x10, 0x2d, 0xc8, 0xcc, 0x4a, 0xb3, 0x52, 0x51, 0x0b, 0x32, 0x6d, 0xc4,
+	0x6c, 0xd6, 0x46, 0xcc, 0xbf, 0x18, 0x30, 0xd4, 0x17, 0xe0, 0x94, 0x7a, 0x2f, 0x4d, 0xf7, 0x63,
+	0x5f, 0xde, 0x66, 0xdb, 0x29, 0x48, 0x0c, 0x7d, 0xfc, 0x0c, 0xdd, 0x2c, 0x53, 0x11, 0x58, 0xd2,
+	0x8a, 0x77, 0xec, 0xc5, 0x49, 0xf1, 0x68, 0x28, 0x69, 0xc5, 0x3b, 0xe2, 0x17, 0x3c, 0x54, 0xad,
+	0xa6, 0xa4, 0x71, 0xb7, 0x07, 0x3c, 0xcb, 0x30, 0x4c, 0x64, 0x85, 0x2c, 0x48, 0x5c, 0xe5, 0xb8,
+	0x97, 0xfb, 0x6e, 0x9e, 0x71, 0x35, 0xdd, 0x94, 0x34, 0xba, 0x05, 0x1f, 0x37, 0x6e, 0x1a, 0xe7,
+	0x51, 0x31, 0xd3, 0x68, 0x88, 0x7d, 0x09, 0xd7, 0x1f, 0xe5, 0xe9, 0x94, 0x53, 0x10, 0x17, 0x6f,
+	0xa5, 0x31, 0xf4, 0x82, 0xc8, 0xf5, 0x44, 0x70, 0xc1, 0x95, 0x27, 0x4b, 0x1a, 0xe3, 0x57, 0x04,
+	0x33, 0xae, 0x86, 0x3a, 0xfa, 0x46, 0xf9, 0xd3, 0x20, 0xe4, 0x14, 0xd7, 0xea, 0x48, 0x05, 0x4d,
+	0x29, 0x2a, 0xbb, 0xab, 0x7a, 0x09, 0x49, 0xca, 0xfe, 0xbb, 0x01, 0xe3, 0x87, 0x09, 0x4f, 0x5d,
+	0xc1, 0xe5, 0xeb, 0xeb, 0xd8, 0x3b, 0xe3, 0x33, 0xb7, 0x30, 0xe1, 0x06, 0x98, 0x71, 0x32, 0x32,
+	0xaa, 0x78, 0x97, 0xec, 0x87, 0x89, 0x63, 0xc6, 0x09, 0x19, 0xe1, 0x66, 0xe7, 0xca, 0xb7, 0xf4,
+	0xbd, 0xf2, 0x29, 0x36, 0x86, 0x9e, 0xef, 0x0a, 0xf7, 0xc4, 0xcd, 0x78, 0xe1, 0xd3, 0x82, 0xa6,
+	0x57, 0x8b, 0x7b, 0x12, 0x16, 0x1e, 0x95, 0x04, 0x69, 0xa2, 0xdd, 0x94, 0x37, 0x15, 0x85, 0xd2,
+	0xa7, 0x61, 0x9e, 0x9d, 0x91, 0x1b, 0x7b, 0x8e, 0x24, 0xd0, 0x96, 0x32, 0xe6, 0x7b, 0x32, 0xc4,
+	0x6d, 0x01, 0x6b, 0xcf, 0x6e, 0xa9, 0xb0, 0x7d, 0xc0, 0x85, 0xcb, 0xc6, 0xda, 0x71, 0x00, 0x8f,
+	0x83, 0x1c, 0x75, 0x98, 0xd7, 0x66, 0x7f, 0x51, 0x32, 0x9a, 0x5a, 0xc9, 0x28, 0x3c, 0xd0, 0xa2,
+	0x10, 0xa5, 0x6f, 0xfb, 0x63, 0xd8, 0x54, 0x1e, 0x7d, 0x76, 0x0b, 0x77, 0x5d, 0xe9, 0x4b, 0xc9,
+	0x96, 0xdb, 0xdb, 0x7f, 0x34, 0xe0, 0xad, 0x85, 0x65, 0x6f, 0xfc, 0x28, 0xbd, 0x0d, 0x2d, 0x7c,
+	0xc8, 0x8c, 0x9a, 0x94, 0x5a, 0x1f, 0xe0, 0x1e, 0x4b, 0x55, 0xee, 0x22, 0x71, 0x2f, 0x12, 0xe9,
+	0xdc, 0xa1, 0x05, 0xe3, 0x1f, 0x43, 0xbf, 0x84, 0x50, 0xef, 0x39, 0x9f, 0x17, 0xd5, 0xf3, 0x9c,
+	0xcf, 0xb1, 0xb7, 0x5f, 0xb8, 0x61, 0x2e, 0x5d, 0xa3, 0x1a, 0x64, 0xcd, 0xb1, 0x8e, 0xe4, 0x7f,
+	0x6a, 0x7e, 0x62, 0xd8, 0x5f, 0xc1, 0xe8, 0xbe, 0x1b, 0xf9, 0xa1, 0x8a, 0x27, 0x99, 0xd4, 0xca,
+	0x05, 0xef, 0x6a, 0x2e, 0x18, 0xa0, 0x16, 0xe2, 0xbe, 0x22, 0x9a, 0x6e, 0x40, 0xff, 0xa4, 0x68,
+	0x67, 0xca, 0xf1, 0x15, 0x40, 0x77, 0xfe, 0x3c, 0xcc, 0xd4, 0x03, 0x8a, 0xbe, 0xed, 0xb7, 0x60,
+	0xe3, 0x90, 0x0b, 0xb9, 0xf7, 0xfe, 0xe9, 0x54, 0xed, 0x6c, 0x6f, 0xc3, 0x66, 0x1d, 0x56, 0xce,
+	0xb5, 0xa0, 0xe9, 0x9d, 0x96, 0xad, 0xc2, 0x3b, 0x9d, 0xda, 0x9f, 0xc0, 0xf8, 0x90, 0x8b, 0x67,
+	0x6e, 0x18, 0xf8, 0xf4, 0x50, 0xab, 0xff, 0x7e, 0x81, 0x0f, 0x2a, 0x37, 0x3b, 0xff, 0xb2, 0xea,
+	0x1d, 0x25, 0x6d, 0xff, 0xc6, 0x04, 0x6b, 0x71, 0x5d, 0x79, 0x2a, 0x63, 0x69, 0x8e, 0x98, 0xb5,
+	0x1c, 0x61, 0xd0, 0x9a, 0x61, 0x19, 0x53, 0x11, 0x86, 0xdf, 0x55, 0x58, 0xb6, 0x56, 0x84, 0xe5,
+	0x77, 0xe0, 0x7a, 0x22, 0xcb, 0x21, 0xf7, 0x9d, 0xf8, 0x72, 0x3f, 0xce, 0x23, 0x41, 0x89, 0xd4,
+	0x74, 0xae, 0x32, 0x70, 0x4c, 0x4e, 0x78, 0x84, 0xaf, 0x9e, 0x52, 0xb6, 0x43, 0xb2, 0x8b, 0x30,
+	0xbe, 0x62, 0xa9, 0x12, 0x97, 0x72, 0x5d, 0xf9, 0x5a, 0xaf, 0x81, 0xa8, 0x2f, 0x3b, 0x0f, 0x92,
+	0x44, 0xdb, 0xbb, 0x27, 0xf5, 0x2d, 0xc0, 0xf6, 0x57, 0xf0, 0xee, 0x52, 0xbf, 0xfe, 0x17, 0x3f,
+	0xbd, 0xc0, 0x85, 0xd4, 0x82, 0x6d, 0x44, 0xc6, 0xfa, 0x26, 0x85, 0xe4, 0xa2, 0x6e, 0x4d, 0xce,
+	0xbe, 0x0d, 0xef, 0xd4, 0xb6, 0xaf, 0xc5, 0xe5, 0xab, 0x6e, 0xf5, 0x6b, 0x13, 0xae, 0x2d, 0x2c,
+	0x63, 0xeb, 0x60, 0x06, 0xbe, 0x7a, 0x6f, 0x9b, 0x81, 0xbf, 0xf2, 0x42, 0xc7, 0xd0, 0xcb, 0x52,
+	0xef, 0x09, 0xd5, 0x36, 0x55, 0x91, 0x0b, 0x1a, 0x79, 0x7e, 0x26, 0x24, 0xaf, 0x28, 0x88, 0x8a,
+	0x26, 0x67, 0xc4, 0x97, 0x5f, 0xf0, 0xb9, 0xaa, 0x88, 0x8a, 0xc2, 0x87, 0x17, 0xff, 0x79, 0xc2,
+	0x3d, 0xc1, 0xfd, 0x03, 0x57, 0x14, 0x85, 0xb1, 0x86, 0x61, 0xab, 0x71, 0x3d, 0x91, 0xbb, 0x21,
+	0x49, 0xa8, 0x56, 0x53, 0x21, 0x64, 0xab, 0x1c, 0x77, 0x7b, 0xca, 0x56, 0xa2, 0x70, 0x5d, 0xca,
+	0x45, 0x3a, 0x97, 0x97, 0xd8, 0xa7, 0xb3, 0x69, 0x48, 0xd9, 0x71, 0x40, 0x05, 0x72, 0x30, 0xe3,
+	0x76, 0xb6, 0x90, 0x2b, 0xca, 0xa9, 0x6f, 0x7c, 0xa5, 0xdf, 0x2e, 0xa7, 0x02, 0x79, 0x9d, 0x1b,
+	0xf5, 0xeb, 0xac, 0x0d, 0x06, 0x3b, 0x3f, 0x83, 0x8e, 0x2c, 0xdb, 0x6c, 0x0d, 0xfa, 0x9f, 0x47,
+	0x74, 0xc7, 0x0f, 0x13, 0xab, 0xc1, 0x7a, 0xd0, 0x3a, 0x16, 0x71, 0x62, 0x19, 0xac, 0x0f, 0xed,
+	0x47, 0xd8, 0x77, 0x2d, 0x93, 0x01, 0x74, 0x70, 0x34, 0x99, 0x71, 0xab, 0x89, 0xf0, 0xb1, 0x70,
+	0x53, 0x61, 0xb5, 0x10, 0x7e, 0x9a, 0xf8, 0xae, 0xe0, 0x56, 0x9b, 0xad, 0x03, 0xfc, 0x28, 0x17,
+	0xb1, 0x12, 0xeb, 0xec, 0xfc, 0x82, 0xc4, 0xa6, 0x58, 0x1c, 0x86, 0x4a, 0x3f, 0xd1, 0x56, 0x83,
+	0x75, 0xa1, 0xf9, 0x25, 0xbf, 0xb4, 0x0c, 0x36, 0x80, 0xae, 0x93, 0x47, 0xf8, 0x5b, 0x98, 0xdc,
+	0x83, 0xb6, 0xf3, 0xad, 0x26, 0x32, 0xd0, 0x88, 0x84, 0xfb, 0x56, 0x8b, 0x0d, 0xa1, 0xf7, 0x99,
+	0xfa, 0x71, 0xcb, 0x6a, 0x23, 0x0b, 0xc5, 0x70, 0x4d, 0x07, 0x59, 0xb4, 0x21, 0x52, 0x5d, 0xa4,
+	0x68, 0x15, 0x52, 0xbd, 0x9d, 0x87, 0xd0, 0x2b, 0xe6, 0x4a, 0x76, 0x0d, 0x06, 0xca, 0x06, 0x84,
+	0xac, 0x06, 0x1e, 0x82, 0xa6, 0x47, 0xcb, 0xc0, 0x03, 0xe3, 0x84, 0x68, 0x99, 0xf8, 0x85, 0x63,
+	0xa0, 0xd5, 0x24, 0x27, 0xcc, 0x23, 0xcf, 0x6a, 0xa1, 0x20, 0x8d, 0x13, 0x96, 0xbf, 0xf3, 0x00,
+	0xba, 0xf4, 0xf9, 0x10, 0xab, 0xec, 0xba, 0xd2, 0xa7, 0x10, 0xab, 0x81, 0x7e, 0xc4, 0xdd, 0xa5,
+	0xb4, 0x81, 0xfe, 0xa0, 0xe3, 0x48, 0xda, 0x44, 0x13, 0xa4, 0x6f, 0x24, 0xd0, 0xdc, 0x89, 0xa0,
+	0x57, 0xcc, 0x01, 0x6c, 0x03, 0xae, 0x15, 0x3e, 0x52, 0x90, 0x54, 0x78, 0xc8, 0x85, 0x04, 0x2c,
+	0x83, 0xf4, 0x97, 0xa4, 0x89, 0x6e, 0x75, 0xf8, 0x2c, 0xbe, 0xe0, 0x0a, 0x69, 0xe2, 0x8e, 0x38,
+	0x76, 0x2a, 0xba, 0x85, 0x0b, 0x8e, 0x02, 0x95, 0x0c, 0x56, 0x7b, 0xe7, 0x0e, 0xf4, 0x8a, 0x5e,
+	0xa9, 0xed, 0x57, 0x40, 0xe5, 0x7e, 0x12, 0xb0, 0x8c, 0x6a, 0x03, 0x85, 0x98, 0x3b, 0x77, 0xa0,
+	0xab, 0x5a, 0x8d, 0xe6, 0x00, 0x85, 0xa8, 0xc8, 0x39, 0x0f, 0x12, 0x75, 0xaf, 0x3c, 0x09, 0x5d,
+	0xaf, 0x8c, 0x9d, 0x0b, 0x9e, 0x0a, 0xab, 0xb9, 0xf7, 0x8f, 0x16, 0x74, 0x64, 0xfb, 0x60, 0x77,
+	0x60, 0xa0, 0xfd, 0x78, 0xcc, 0xde, 0xc6, 0x60, 0xbd, 0xfa, 0x53, 0xf7, 0xf8, 0xff, 0xae, 0xe0,
+	0x32, 0x2f, 0xec, 0x06, 0xfb, 0x21, 0x40, 0x35, 0xee, 0xb1, 0xb7, 0x68, 0x06, 0x5e, 0x1c, 0xff,
+	0xc6, 0x23, 0x7a, 0x28, 0x2c, 0xf9, 0x61, 0xdc, 0x6e, 0xb0, 0x2f, 0x60, 0x4d, 0x75, 0x76, 0xe9,
+	0x33, 0x36, 0xd1, 0x9a, 0xfd, 0x92, 0x41, 0xee, 0x95, 0xca, 0x3e, 0x2b, 0x95, 0x49, 0x7f, 0xb1,
+	0xd1, 0x92, 0xc9, 0x41, 0xaa, 0x79, 0x67, 0xe5, 0x4c, 0x61, 0x37, 0xd8, 0x21, 0x0c, 0x64, 0xe7,
+	0x97, 0x45, 0xf2, 0x06, 0xca, 0xae, 0x1a, 0x05, 0x5e, 0x69, 0xd0, 0x3e, 0x0c, 0xf5, 0x66, 0xcd,
+	0xc8, 0x93, 0x4b, 0xba, 0xfa, 0x78, 0x74, 0x95, 0x51, 0x2a, 0xf9, 0x29, 0x0d, 0x02, 0x57, 0xfa,
+	0xf1, 0x44, 0x2d, 0x59, 0xd1, 0xe0, 0xc7, 0xef, 0xaf, 0xe4, 0x97, 0x9a, 0x9f, 0x02, 0xbb, 0x5a,
+	0xf5, 0xd8, 0x7b, 0x57, 0x16, 0xd6, 0xce, 0x3b, 0x59, 0xc5, 0x2e, 0xd4, 0xde, 0x1d, 0xfd, 0xf9,
+	0xc5, 0xc4, 0xf8, 0xe6, 0xc5, 0xc4, 0xf8, 0xe7, 0x8b, 0x89, 0xf1, 0xab, 0x97, 0x93, 0xc6, 0x37,
+	0x2f, 0x27, 0x8d, 0xbf, 0xbd, 0x9c, 0x34, 0x4e, 0x3a, 0xf4, 0xaf, 0xca, 0x77, 0xff, 0x3d, 0x00,
+	0x61, 0xee, 0x40, 0x2b, 0x67, 0x19, 0x00, 0x00,
 }
 
-func (m *DumpStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WorkerClient is the client API for Worker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkerClient interface {
+	QueryStatus(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error)
+	// PurgeRelay purges relay log files for this dm-worker
+	PurgeRelay(ctx context.Context, in *PurgeRelayRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	// Operate (get/set/remove) schema for a specified table in tracker.
+	// a `set`/`remove` operation should be an one-time operation (only take effect once),
+	// so we use a gRPC method rather than a etcd operation now (no persistent operation state).
+	OperateSchema(ctx context.Context, in *OperateWorkerSchemaRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error)
+	HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error)
+	// GetValidationStatus gets the status of the continuous validator of a subtask.
+	GetValidationStatus(ctx context.Context, in *GetValidationStatusRequest, opts ...grpc.CallOption) (*GetValidationStatusResponse, error)
+	// GetValidationError gets the row changes mismatched in the downstream found by the continuous validator.
+	GetValidationError(ctx context.Context, in *GetValidationErrorRequest, opts ...grpc.CallOption) (*GetValidationErrorResponse, error)
 }
 
-func (m *DumpStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type workerClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *DumpStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
+	return &workerClient{cc}
 }
 
-func (m *LoadStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) QueryStatus(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error) {
+	out := new(QueryStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/QueryStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LoadStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) PurgeRelay(ctx context.Context, in *PurgeRelayRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/PurgeRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetaBinlogGTID) > 0 {
-		i -= len(m.MetaBinlogGTID)
-		copy(dAtA[i:], m.MetaBinlogGTID)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlogGTID)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.MetaBinlog) > 0 {
-		i -= len(m.MetaBinlog)
-		copy(dAtA[i:], m.MetaBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlog)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Progress) > 0 {
-		i -= len(m.Progress)
-		copy(dAtA[i:], m.Progress)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Progress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TotalBytes != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalBytes))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.FinishedBytes != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedBytes))
-		i--
-		dAtA[i] = 0x8
+func (c *workerClient) OperateSchema(ctx context.Context, in *OperateWorkerSchemaRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/OperateSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ShardingGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error) {
+	out := new(OperateV1MetaResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/OperateV1Meta", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ShardingGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/HandleError", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ShardingGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Unsynced) > 0 {
-		for iNdEx := len(m.Unsynced) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Unsynced[iNdEx])
-			copy(dAtA[i:], m.Unsynced[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Unsynced[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Synced) > 0 {
-		for iNdEx := len(m.Synced) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Synced[iNdEx])
-			copy(dAtA[i:], m.Synced[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Synced[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.FirstLocation) > 0 {
-		i -= len(m.FirstLocation)
-		copy(dAtA[i:], m.FirstLocation)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.FirstLocation)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DDLs) > 0 {
-		for iNdEx := len(m.DDLs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DDLs[iNdEx])
-			copy(dAtA[i:], m.DDLs[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.DDLs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *workerClient) GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error) {
+	out := new(GetWorkerCfgResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/GetWorkerCfg", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *workerClient) GetValidationStatus(ctx context.Context, in *GetValidationStatusRequest, opts ...grpc.CallOption) (*GetValidationStatusResponse, error) {
+	out := new(GetValidationStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/GetValidationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) GetValidationError(ctx context.Context, in *GetValidationErrorRequest, opts ...grpc.CallOption) (*GetValidationErrorResponse, error) {
+	out := new(GetValidationErrorResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/GetValidationError", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WorkerServer is the server API for Worker service.
+type WorkerServer interface {
+	QueryStatus(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
+	// PurgeRelay purges relay log files for this dm-worker
+	PurgeRelay(context.Context, *PurgeRelayRequest) (*CommonWorkerResponse, error)
+	// Operate (get/set/remove) schema for a specified table in tracker.
+	// a `set`/`remove` operation should be an one-time operation (only take effect once),
+	// so we use a gRPC method rather than a etcd operation now (no persistent operation state).
+	OperateSchema(context.Context, *OperateWorkerSchemaRequest) (*CommonWorkerResponse, error)
+	OperateV1Meta(context.Context, *OperateV1MetaRequest) (*OperateV1MetaResponse, error)
+	HandleError(context.Context, *HandleWorkerErrorRequest) (*CommonWorkerResponse, error)
+	GetWorkerCfg(context.Context, *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error)
+	// GetValidationStatus gets the status of the continuous validator of a subtask.
+	GetValidationStatus(context.Context, *GetValidationStatusRequest) (*GetValidationStatusResponse, error)
+	// GetValidationError gets the row changes mismatched in the downstream found by the continuous validator.
+	GetValidationError(context.Context, *GetValidationErrorRequest) (*GetValidationErrorResponse, error)
 }
 
-func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SecondsBehindMaster != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.SecondsBehindMaster))
-		i--
-		dAtA[i] = 0x60
+// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkerServer struct {
+}
+
+func (*UnimplementedWorkerServer) QueryStatus(ctx context.Context, req *QueryStatusRequest) (*QueryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStatus not implemented")
+}
+func (*UnimplementedWorkerServer) PurgeRelay(ctx context.Context, req *PurgeRelayRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeRelay not implemented")
+}
+func (*UnimplementedWorkerServer) OperateSchema(ctx context.Context, req *OperateWorkerSchemaRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateSchema not implemented")
+}
+func (*UnimplementedWorkerServer) OperateV1Meta(ctx context.Context, req *OperateV1MetaRequest) (*OperateV1MetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateV1Meta not implemented")
+}
+func (*UnimplementedWorkerServer) HandleError(ctx context.Context, req *HandleWorkerErrorRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleError not implemented")
+}
+func (*UnimplementedWorkerServer) GetWorkerCfg(ctx context.Context, req *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerCfg not implemented")
+}
+func (*UnimplementedWorkerServer) GetValidationStatus(ctx context.Context, req *GetValidationStatusRequest) (*GetValidationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidationStatus not implemented")
+}
+func (*UnimplementedWorkerServer) GetValidationError(ctx context.Context, req *GetValidationErrorRequest) (*GetValidationErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidationError not implemented")
+}
+
+func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
+	s.RegisterService(&_Worker_serviceDesc, srv)
+}
+
+func _Worker_QueryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BinlogType) > 0 {
-		i -= len(m.BinlogType)
-		copy(dAtA[i:], m.BinlogType)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogType)))
-		i--
-		dAtA[i] = 0x5a
+	if interceptor == nil {
+		return srv.(WorkerServer).QueryStatus(ctx, in)
 	}
-	if m.Synced {
-		i--
-		if m.Synced {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/QueryStatus",
 	}
-	if len(m.UnresolvedGroups) > 0 {
-		for iNdEx := len(m.UnresolvedGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnresolvedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).QueryStatus(ctx, req.(*QueryStatusRequest))
 	}
-	if len(m.BlockingDDLs) > 0 {
-		for iNdEx := len(m.BlockingDDLs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BlockingDDLs[iNdEx])
-			copy(dAtA[i:], m.BlockingDDLs[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.BlockingDDLs[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_PurgeRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SyncerBinlogGtid) > 0 {
-		i -= len(m.SyncerBinlogGtid)
-		copy(dAtA[i:], m.SyncerBinlogGtid)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlogGtid)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(WorkerServer).PurgeRelay(ctx, in)
 	}
-	if len(m.SyncerBinlog) > 0 {
-		i -= len(m.SyncerBinlog)
-		copy(dAtA[i:], m.SyncerBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlog)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/PurgeRelay",
 	}
-	if len(m.MasterBinlogGtid) > 0 {
-		i -= len(m.MasterBinlogGtid)
-		copy(dAtA[i:], m.MasterBinlogGtid)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).PurgeRelay(ctx, req.(*PurgeRelayRequest))
 	}
-	if len(m.MasterBinlog) > 0 {
-		i -= len(m.MasterBinlog)
-		copy(dAtA[i:], m.MasterBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_OperateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateWorkerSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RecentTps != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.RecentTps))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(WorkerServer).OperateSchema(ctx, in)
 	}
-	if m.TotalTps != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalTps))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/OperateSchema",
 	}
-	if m.TotalEvents != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalEvents))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).OperateSchema(ctx, req.(*OperateWorkerSchemaRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SourceStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_OperateV1Meta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateV1MetaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SourceStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(WorkerServer).OperateV1Meta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/OperateV1Meta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).OperateV1Meta(ctx, req.(*OperateV1MetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RelayStatus != nil {
-		{
-			size, err := m.RelayStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func _Worker_HandleError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleWorkerErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(WorkerServer).HandleError(ctx, in)
 	}
-	if len(m.Worker) > 0 {
-		i -= len(m.Worker)
-		copy(dAtA[i:], m.Worker)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/HandleError",
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).HandleError(ctx, req.(*HandleWorkerErrorRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RelayStatus) Marshal() (dAtA []byte, err error) {
+func _Worker_GetWorkerCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerCfgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).GetWorkerCfg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/GetWorkerCfg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).GetWorkerCfg(ctx, req.(*GetWorkerCfgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_GetValidationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).GetValidationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/GetValidationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).GetValidationStatus(ctx, req.(*GetValidationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_GetValidationError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidationErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).GetValidationError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/GetValidationError",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).GetValidationError(ctx, req.(*GetValidationErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Worker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Worker",
+	HandlerType: (*WorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryStatus",
+			Handler:    _Worker_QueryStatus_Handler,
+		},
+		{
+			MethodName: "PurgeRelay",
+			Handler:    _Worker_PurgeRelay_Handler,
+		},
+		{
+			MethodName: "OperateSchema",
+			Handler:    _Worker_OperateSchema_Handler,
+		},
+		{
+			MethodName: "OperateV1Meta",
+			Handler:    _Worker_OperateV1Meta_Handler,
+		},
+		{
+			MethodName: "HandleError",
+			Handler:    _Worker_HandleError_Handler,
+		},
+		{
+			MethodName: "GetWorkerCfg",
+			Handler:    _Worker_GetWorkerCfg_Handler,
+		},
+		{
+			MethodName: "GetValidationStatus",
+			Handler:    _Worker_GetValidationStatus_Handler,
+		},
+		{
+			MethodName: "GetValidationError",
+			Handler:    _Worker_GetValidationError_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dmworker.proto",
+}
+
+func (m *QueryStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3472,82 +3474,81 @@ func (m *RelayStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RelayStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Stage != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.RelayCatchUpMaster {
-		i--
-		if m.RelayCatchUpMaster {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0xa
 	}
-	if len(m.RelayBinlogGtid) > 0 {
-		i -= len(m.RelayBinlogGtid)
-		copy(dAtA[i:], m.RelayBinlogGtid)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlogGtid)))
-		i--
-		dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *CommonWorkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RelayBinlog) > 0 {
-		i -= len(m.RelayBinlog)
-		copy(dAtA[i:], m.RelayBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlog)))
+	return dAtA[:n], nil
+}
+
+func (m *CommonWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommonWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.RelaySubDir) > 0 {
-		i -= len(m.RelaySubDir)
-		copy(dAtA[i:], m.RelaySubDir)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelaySubDir)))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.MasterBinlogGtid) > 0 {
-		i -= len(m.MasterBinlogGtid)
-		copy(dAtA[i:], m.MasterBinlogGtid)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MasterBinlog) > 0 {
-		i -= len(m.MasterBinlog)
-		copy(dAtA[i:], m.MasterBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
+	if m.Result {
 		i--
-		dAtA[i] = 0xa
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubTaskStatus) Marshal() (dAtA []byte, err error) {
+func (m *QueryStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3557,35 +3558,33 @@ func (m *SubTaskStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubTaskStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		{
-			size := m.Status.Size()
-			i -= size
-			if _, err := m.Status.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.SubTaskStatus) > 0 {
+		for iNdEx := len(m.SubTaskStatus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubTaskStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
 			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.UnresolvedDDLLockID) > 0 {
-		i -= len(m.UnresolvedDDLLockID)
-		copy(dAtA[i:], m.UnresolvedDDLLockID)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.UnresolvedDDLLockID)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Result != nil {
+	if m.SourceStatus != nil {
 		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SourceStatus.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3593,127 +3592,29 @@ func (m *SubTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.Unit != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Unit))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Stage != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SubTaskStatus_Msg) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubTaskStatus_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Msg)
-	copy(dAtA[i:], m.Msg)
-	i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-	i--
-	dAtA[i] = 0x32
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskStatus_Check) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubTaskStatus_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Check != nil {
-		{
-			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskStatus_Dump) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubTaskStatus_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Dump != nil {
-		{
-			size, err := m.Dump.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
+	if m.Result {
 		i--
-		dAtA[i] = 0x42
-	}
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskStatus_Load) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubTaskStatus_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Load != nil {
-		{
-			size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SubTaskStatus_Sync) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *SubTaskStatus_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Sync != nil {
-		{
-			size, err := m.Sync.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskStatusList) Marshal() (dAtA []byte, err error) {
+func (m *CheckStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3723,34 +3624,57 @@ func (m *SubTaskStatusList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubTaskStatusList) MarshalTo(dAtA []byte) (int, error) {
+func (m *CheckStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskStatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CheckStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Status) > 0 {
-		for iNdEx := len(m.Status) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Status[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Warning != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Warning))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failed != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Successful != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Successful))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CheckError) Marshal() (dAtA []byte, err error) {
+func (m *DumpStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3760,27 +3684,20 @@ func (m *CheckError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckError) MarshalTo(dAtA []byte) (int, error) {
+func (m *DumpStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CheckError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DumpStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DumpError) Marshal() (dAtA []byte, err error) {
+func (m *LoadStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3790,27 +3707,51 @@ func (m *DumpError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DumpError) MarshalTo(dAtA []byte) (int, error) {
+func (m *LoadStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DumpError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+	if len(m.MetaBinlogGTID) > 0 {
+		i -= len(m.MetaBinlogGTID)
+		copy(dAtA[i:], m.MetaBinlogGTID)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlogGTID)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if len(m.MetaBinlog) > 0 {
+		i -= len(m.MetaBinlog)
+		copy(dAtA[i:], m.MetaBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlog)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Progress) > 0 {
+		i -= len(m.Progress)
+		copy(dAtA[i:], m.Progress)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Progress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TotalBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FinishedBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedBytes))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LoadError) Marshal() (dAtA []byte, err error) {
+func (m *ShardingGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3820,71 +3761,61 @@ func (m *LoadError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LoadError) MarshalTo(dAtA []byte) (int, error) {
+func (m *ShardingGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LoadError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ShardingGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SyncSQLError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Unsynced) > 0 {
+		for iNdEx := len(m.Unsynced) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Unsynced[iNdEx])
+			copy(dAtA[i:], m.Unsynced[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Unsynced[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SyncSQLError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SyncSQLError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ErrorSQL) > 0 {
-		i -= len(m.ErrorSQL)
-		copy(dAtA[i:], m.ErrorSQL)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrorSQL)))
+	if len(m.Synced) > 0 {
+		for iNdEx := len(m.Synced) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Synced[iNdEx])
+			copy(dAtA[i:], m.Synced[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Synced[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FirstLocation) > 0 {
+		i -= len(m.FirstLocation)
+		copy(dAtA[i:], m.FirstLocation)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.FirstLocation)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.FailedBinlogPosition) > 0 {
-		i -= len(m.FailedBinlogPosition)
-		copy(dAtA[i:], m.FailedBinlogPosition)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.FailedBinlogPosition)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.DDLs) > 0 {
+		for iNdEx := len(m.DDLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DDLs[iNdEx])
+			copy(dAtA[i:], m.DDLs[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.DDLs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Target)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncError) Marshal() (dAtA []byte, err error) {
+func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3894,20 +3825,42 @@ func (m *SyncError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncError) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Errors) > 0 {
-		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+	if m.SecondsBehindMaster != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.SecondsBehindMaster))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.BinlogType) > 0 {
+		i -= len(m.BinlogType)
+		copy(dAtA[i:], m.BinlogType)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogType)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Synced {
+		i--
+		if m.Synced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.UnresolvedGroups) > 0 {
+		for iNdEx := len(m.UnresolvedGroups) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnresolvedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3915,13 +3868,65 @@ func (m *SyncError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintDmworker(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BlockingDDLs) > 0 {
+		for iNdEx := len(m.BlockingDDLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockingDDLs[iNdEx])
+			copy(dAtA[i:], m.BlockingDDLs[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.BlockingDDLs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
 		}
 	}
+	if len(m.SyncerBinlogGtid) > 0 {
+		i -= len(m.SyncerBinlogGtid)
+		copy(dAtA[i:], m.SyncerBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlogGtid)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SyncerBinlog) > 0 {
+		i -= len(m.SyncerBinlog)
+		copy(dAtA[i:], m.SyncerBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlog)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MasterBinlogGtid) > 0 {
+		i -= len(m.MasterBinlogGtid)
+		copy(dAtA[i:], m.MasterBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MasterBinlog) > 0 {
+		i -= len(m.MasterBinlog)
+		copy(dAtA[i:], m.MasterBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RecentTps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RecentTps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalTps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalTps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalEvents != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalEvents))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SourceError) Marshal() (dAtA []byte, err error) {
+func (m *SourceStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3931,19 +3936,19 @@ func (m *SourceError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SourceError) MarshalTo(dAtA []byte) (int, error) {
+func (m *SourceStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SourceError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RelayError != nil {
+	if m.RelayStatus != nil {
 		{
-			size, err := m.RelayError.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RelayStatus.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3953,10 +3958,15 @@ func (m *SourceError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.SourceError) > 0 {
-		i -= len(m.SourceError)
-		copy(dAtA[i:], m.SourceError)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SourceError)))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3977,7 +3987,7 @@ func (m *SourceError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RelayError) Marshal() (dAtA []byte, err error) {
+func (m *RelayStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3987,27 +3997,82 @@ func (m *RelayError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RelayError) MarshalTo(dAtA []byte) (int, error) {
+func (m *RelayStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelayError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RelayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Stage != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RelayCatchUpMaster {
+		i--
+		if m.RelayCatchUpMaster {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RelayBinlogGtid) > 0 {
+		i -= len(m.RelayBinlogGtid)
+		copy(dAtA[i:], m.RelayBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlogGtid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RelayBinlog) > 0 {
+		i -= len(m.RelayBinlog)
+		copy(dAtA[i:], m.RelayBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlog)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelaySubDir) > 0 {
+		i -= len(m.RelaySubDir)
+		copy(dAtA[i:], m.RelaySubDir)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelaySubDir)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MasterBinlogGtid) > 0 {
+		i -= len(m.MasterBinlogGtid)
+		copy(dAtA[i:], m.MasterBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MasterBinlog) > 0 {
+		i -= len(m.MasterBinlog)
+		copy(dAtA[i:], m.MasterBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubTaskError) Marshal() (dAtA []byte, err error) {
+func (m *SubTaskStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4017,26 +4082,45 @@ func (m *SubTaskError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubTaskError) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
+	if m.Status != nil {
 		{
-			size := m.Error.Size()
+			size := m.Status.Size()
 			i -= size
-			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+			if _, err := m.Status.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 		}
 	}
-	if m.Unit != 0 {
+	if len(m.UnresolvedDDLLockID) > 0 {
+		i -= len(m.UnresolvedDDLLockID)
+		copy(dAtA[i:], m.UnresolvedDDLLockID)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.UnresolvedDDLLockID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Unit != 0 {
 		i = encodeVarintDmworker(dAtA, i, uint64(m.Unit))
 		i--
 		dAtA[i] = 0x18
@@ -4056,26 +4140,26 @@ func (m *SubTaskError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SubTaskError_Msg) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Msg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskError_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= len(m.Msg)
 	copy(dAtA[i:], m.Msg)
 	i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x32
 	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Check) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Check) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskError_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.Check != nil {
 		{
@@ -4087,16 +4171,16 @@ func (m *SubTaskError_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x3a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Dump) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Dump) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskError_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.Dump != nil {
 		{
@@ -4108,16 +4192,16 @@ func (m *SubTaskError_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Load) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Load) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskError_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.Load != nil {
 		{
@@ -4129,16 +4213,16 @@ func (m *SubTaskError_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x4a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Sync) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Sync) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskError_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskStatus_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.Sync != nil {
 		{
@@ -4150,11 +4234,11 @@ func (m *SubTaskError_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x52
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SubTaskErrorList) Marshal() (dAtA []byte, err error) {
+func (m *SubTaskStatusList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4164,20 +4248,20 @@ func (m *SubTaskErrorList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubTaskErrorList) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskStatusList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubTaskErrorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskStatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		for iNdEx := len(m.Error) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Status) > 0 {
+		for iNdEx := len(m.Status) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Error[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Status[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4191,7 +4275,7 @@ func (m *SubTaskErrorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProcessResult) Marshal() (dAtA []byte, err error) {
+func (m *CheckError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4201,51 +4285,27 @@ func (m *ProcessResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProcessResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *CheckError) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProcessResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CheckError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Errors) > 0 {
-		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.IsCanceled {
-		i--
-		if m.IsCanceled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProcessError) Marshal() (dAtA []byte, err error) {
+func (m *DumpError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4255,67 +4315,57 @@ func (m *ProcessError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProcessError) MarshalTo(dAtA []byte) (int, error) {
+func (m *DumpError) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProcessError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DumpError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Workaround) > 0 {
-		i -= len(m.Workaround)
-		copy(dAtA[i:], m.Workaround)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Workaround)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.RawCause) > 0 {
-		i -= len(m.RawCause)
-		copy(dAtA[i:], m.RawCause)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RawCause)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ErrLevel) > 0 {
-		i -= len(m.ErrLevel)
-		copy(dAtA[i:], m.ErrLevel)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrLevel)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ErrScope) > 0 {
-		i -= len(m.ErrScope)
-		copy(dAtA[i:], m.ErrScope)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrScope)))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if len(m.ErrClass) > 0 {
-		i -= len(m.ErrClass)
-		copy(dAtA[i:], m.ErrClass)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrClass)))
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *LoadError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ErrCode != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.ErrCode))
+	return dAtA[:n], nil
+}
+
+func (m *LoadError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoadError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeRelayRequest) Marshal() (dAtA []byte, err error) {
+func (m *SyncSQLError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4325,49 +4375,41 @@ func (m *PurgeRelayRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncSQLError) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncSQLError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubDir) > 0 {
-		i -= len(m.SubDir)
-		copy(dAtA[i:], m.SubDir)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SubDir)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Filename)))
+	if len(m.ErrorSQL) > 0 {
+		i -= len(m.ErrorSQL)
+		copy(dAtA[i:], m.ErrorSQL)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrorSQL)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Time != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Time))
+	if len(m.FailedBinlogPosition) > 0 {
+		i -= len(m.FailedBinlogPosition)
+		copy(dAtA[i:], m.FailedBinlogPosition)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.FailedBinlogPosition)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Inactive {
-		i--
-		if m.Inactive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperateWorkerSchemaRequest) Marshal() (dAtA []byte, err error) {
+func (m *SyncError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4377,80 +4419,34 @@ func (m *OperateWorkerSchemaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperateWorkerSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncError) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateWorkerSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sync {
-		i--
-		if m.Sync {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Flush {
-		i--
-		if m.Flush {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Schema)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Table)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Database) > 0 {
-		i -= len(m.Database)
-		copy(dAtA[i:], m.Database)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Database)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Op != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *V1SubTaskMeta) Marshal() (dAtA []byte, err error) {
+func (m *SourceError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4460,44 +4456,53 @@ func (m *V1SubTaskMeta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *V1SubTaskMeta) MarshalTo(dAtA []byte) (int, error) {
+func (m *SourceError) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *V1SubTaskMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SourceError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+	if m.RelayError != nil {
+		{
+			size, err := m.RelayError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+	if len(m.SourceError) > 0 {
+		i -= len(m.SourceError)
+		copy(dAtA[i:], m.SourceError)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SourceError)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Stage != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperateV1MetaRequest) Marshal() (dAtA []byte, err error) {
+func (m *RelayError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4507,25 +4512,27 @@ func (m *OperateV1MetaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperateV1MetaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RelayError) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateV1MetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RelayError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperateV1MetaResponse) Marshal() (dAtA []byte, err error) {
+func (m *SubTaskError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4535,763 +4542,1077 @@ func (m *OperateV1MetaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperateV1MetaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskError) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateV1MetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Meta) > 0 {
-		for k := range m.Meta {
-			v := m.Meta[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintDmworker(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDmworker(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDmworker(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+	if m.Unit != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Unit))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.Result {
+	if m.Stage != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
 		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HandleWorkerErrorRequest) Marshal() (dAtA []byte, err error) {
+func (m *SubTaskError_Msg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HandleWorkerErrorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskError_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Msg)
+	copy(dAtA[i:], m.Msg)
+	i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskError_Check) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HandleWorkerErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskError_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sqls) > 0 {
-		for iNdEx := len(m.Sqls) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sqls[iNdEx])
-			copy(dAtA[i:], m.Sqls[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Sqls[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if m.Check != nil {
+		{
+			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.BinlogPos) > 0 {
-		i -= len(m.BinlogPos)
-		copy(dAtA[i:], m.BinlogPos)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogPos)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Op != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *GetWorkerCfgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWorkerCfgRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskError_Dump) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetWorkerCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskError_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	if m.Dump != nil {
+		{
+			size, err := m.Dump.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	return len(dAtA) - i, nil
 }
-
-func (m *GetWorkerCfgResponse) Marshal() (dAtA []byte, err error) {
+func (m *SubTaskError_Load) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetWorkerCfgResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubTaskError_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Load != nil {
+		{
+			size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskError_Sync) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetWorkerCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubTaskError_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cfg) > 0 {
-		i -= len(m.Cfg)
-		copy(dAtA[i:], m.Cfg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Cfg)))
+	if m.Sync != nil {
+		{
+			size, err := m.Sync.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-
-func encodeVarintDmworker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDmworker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SubTaskErrorList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+
+func (m *SubTaskErrorList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommonWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SubTaskErrorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Error) > 0 {
+		for iNdEx := len(m.Error) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Error[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProcessResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SourceStatus != nil {
-		l = m.SourceStatus.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.SubTaskStatus) > 0 {
-		for _, e := range m.SubTaskStatus {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
+	if m.IsCanceled {
+		i--
+		if m.IsCanceled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CheckStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProcessError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Passed {
-		n += 2
+	if len(m.Workaround) > 0 {
+		i -= len(m.Workaround)
+		copy(dAtA[i:], m.Workaround)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Workaround)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Total != 0 {
-		n += 1 + sovDmworker(uint64(m.Total))
+	if len(m.RawCause) > 0 {
+		i -= len(m.RawCause)
+		copy(dAtA[i:], m.RawCause)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RawCause)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Successful != 0 {
-		n += 1 + sovDmworker(uint64(m.Successful))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Failed != 0 {
-		n += 1 + sovDmworker(uint64(m.Failed))
+	if len(m.ErrLevel) > 0 {
+		i -= len(m.ErrLevel)
+		copy(dAtA[i:], m.ErrLevel)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrLevel)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Warning != 0 {
-		n += 1 + sovDmworker(uint64(m.Warning))
+	if len(m.ErrScope) > 0 {
+		i -= len(m.ErrScope)
+		copy(dAtA[i:], m.ErrScope)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrScope)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.ErrClass) > 0 {
+		i -= len(m.ErrClass)
+		copy(dAtA[i:], m.ErrClass)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrClass)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-
-func (m *DumpStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ErrCode != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ErrCode))
+		i--
+		dAtA[i] = 0x8
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LoadStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinishedBytes != 0 {
-		n += 1 + sovDmworker(uint64(m.FinishedBytes))
-	}
-	if m.TotalBytes != 0 {
-		n += 1 + sovDmworker(uint64(m.TotalBytes))
-	}
-	l = len(m.Progress)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.MetaBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.MetaBinlogGTID)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+func (m *PurgeRelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ShardingGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PurgeRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if len(m.DDLs) > 0 {
-		for _, s := range m.DDLs {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if len(m.SubDir) > 0 {
+		i -= len(m.SubDir)
+		copy(dAtA[i:], m.SubDir)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SubDir)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.FirstLocation)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Synced) > 0 {
-		for _, s := range m.Synced {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if m.Time != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Unsynced) > 0 {
-		for _, s := range m.Unsynced {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
+	if m.Inactive {
+		i--
+		if m.Inactive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperateWorkerSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateWorkerSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateWorkerSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TotalEvents != 0 {
-		n += 1 + sovDmworker(uint64(m.TotalEvents))
-	}
-	if m.TotalTps != 0 {
-		n += 1 + sovDmworker(uint64(m.TotalTps))
-	}
-	if m.RecentTps != 0 {
-		n += 1 + sovDmworker(uint64(m.RecentTps))
-	}
-	l = len(m.MasterBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.MasterBinlogGtid)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Sync {
+		i--
+		if m.Sync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.SyncerBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Flush {
+		i--
+		if m.Flush {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.SyncerBinlogGtid)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.BlockingDDLs) > 0 {
-		for _, s := range m.BlockingDDLs {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.UnresolvedGroups) > 0 {
-		for _, e := range m.UnresolvedGroups {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Synced {
-		n += 2
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BinlogType)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SecondsBehindMaster != 0 {
-		n += 1 + sovDmworker(uint64(m.SecondsBehindMaster))
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SourceStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *V1SubTaskMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *V1SubTaskMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *V1SubTaskMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Stage != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.RelayStatus != nil {
-		l = m.RelayStatus.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RelayStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperateV1MetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateV1MetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateV1MetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MasterBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.MasterBinlogGtid)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.RelaySubDir)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.RelayBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.RelayBinlogGtid)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateV1MetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.RelayCatchUpMaster {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *OperateV1MetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateV1MetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		for k := range m.Meta {
+			v := m.Meta[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintDmworker(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDmworker(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDmworker(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Stage != 0 {
-		n += 1 + sovDmworker(uint64(m.Stage))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SubTaskStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HandleWorkerErrorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HandleWorkerErrorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandleWorkerErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if m.Stage != 0 {
-		n += 1 + sovDmworker(uint64(m.Stage))
-	}
-	if m.Unit != 0 {
-		n += 1 + sovDmworker(uint64(m.Unit))
+	if len(m.Sqls) > 0 {
+		for iNdEx := len(m.Sqls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sqls[iNdEx])
+			copy(dAtA[i:], m.Sqls[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Sqls[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.BinlogPos) > 0 {
+		i -= len(m.BinlogPos)
+		copy(dAtA[i:], m.BinlogPos)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogPos)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.UnresolvedDDLLockID)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Status != nil {
-		n += m.Status.Size()
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SubTaskStatus_Msg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetWorkerCfgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerCfgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Msg)
-	n += 1 + l + sovDmworker(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskStatus_Check) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetWorkerCfgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerCfgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Check != nil {
-		l = m.Check.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Cfg) > 0 {
+		i -= len(m.Cfg)
+		copy(dAtA[i:], m.Cfg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Cfg)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskStatus_Dump) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetValidationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Dump != nil {
-		l = m.Dump.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.TaskName) > 0 {
+		i -= len(m.TaskName)
+		copy(dAtA[i:], m.TaskName)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.TaskName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskStatus_Load) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ValidationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Load != nil {
-		l = m.Load.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.SkippedRowCount != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.SkippedRowCount))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-func (m *SubTaskStatus_Sync) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ErrorRowCount != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ErrorRowCount))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.Sync != nil {
-		l = m.Sync.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.PendingRowCount != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.PendingRowCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-func (m *SubTaskStatusList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ProcessedRowCount != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ProcessedRowCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		for _, e := range m.Status {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if m.Stage != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *CheckError) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DumpError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+func (m *GetValidationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LoadError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+func (m *GetValidationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncSQLError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetValidationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.FailedBinlogPosition)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ErrorSQL)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Errors) > 0 {
-		for _, e := range m.Errors {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+func (m *GetValidationErrorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SourceError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.SourceError)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if m.RelayError != nil {
-		l = m.RelayError.Size()
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+func (m *GetValidationErrorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelayError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetValidationErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.TaskName) > 0 {
+		i -= len(m.TaskName)
+		copy(dAtA[i:], m.TaskName)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.TaskName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SubTaskError) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidationError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidationError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Stage != 0 {
-		n += 1 + sovDmworker(uint64(m.Stage))
+	if m.RetryCount != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Unit != 0 {
-		n += 1 + sovDmworker(uint64(m.Unit))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Error != nil {
-		n += m.Error.Size()
+	if len(m.ActualData) > 0 {
+		i -= len(m.ActualData)
+		copy(dAtA[i:], m.ActualData)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ActualData)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *SubTaskError_Msg) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ExpectedData) > 0 {
+		i -= len(m.ExpectedData)
+		copy(dAtA[i:], m.ExpectedData)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ExpectedData)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	n += 1 + l + sovDmworker(uint64(l))
-	return n
+	if len(m.RowKey) > 0 {
+		i -= len(m.RowKey)
+		copy(dAtA[i:], m.RowKey)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RowKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DstTable) > 0 {
+		i -= len(m.DstTable)
+		copy(dAtA[i:], m.DstTable)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.DstTable)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcTable) > 0 {
+		i -= len(m.SrcTable)
+		copy(dAtA[i:], m.SrcTable)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SrcTable)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Check) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetValidationErrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidationErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidationErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Check != nil {
-		l = m.Check.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Dump) Size() (n int) {
+
+func encodeVarintDmworker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDmworker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Dump != nil {
-		l = m.Dump.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
 	return n
 }
-func (m *SubTaskError_Load) Size() (n int) {
+
+func (m *CommonWorkerResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Load != nil {
-		l = m.Load.Size()
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	return n
-}
-func (m *SubTaskError_Sync) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Sync != nil {
-		l = m.Sync.Size()
+	l = len(m.Worker)
+	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
 	return n
 }
-func (m *SubTaskErrorList) Size() (n int) {
+
+func (m *QueryStatusResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		for _, e := range m.Error {
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.SourceStatus != nil {
+		l = m.SourceStatus.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.SubTaskStatus) > 0 {
+		for _, e := range m.SubTaskStatus {
 			l = e.Size()
 			n += 1 + l + sovDmworker(uint64(l))
 		}
@@ -5299,20 +5620,26 @@ func (m *SubTaskErrorList) Size() (n int) {
 	return n
 }
 
-func (m *ProcessResult) Size() (n int) {
+func (m *CheckStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.IsCanceled {
+	if m.Passed {
 		n += 2
 	}
-	if len(m.Errors) > 0 {
-		for _, e := range m.Errors {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if m.Total != 0 {
+		n += 1 + sovDmworker(uint64(m.Total))
+	}
+	if m.Successful != 0 {
+		n += 1 + sovDmworker(uint64(m.Successful))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovDmworker(uint64(m.Failed))
+	}
+	if m.Warning != 0 {
+		n += 1 + sovDmworker(uint64(m.Warning))
 	}
 	l = len(m.Detail)
 	if l > 0 {
@@ -5321,222 +5648,1499 @@ func (m *ProcessResult) Size() (n int) {
 	return n
 }
 
-func (m *ProcessError) Size() (n int) {
+func (m *DumpStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ErrCode != 0 {
-		n += 1 + sovDmworker(uint64(m.ErrCode))
-	}
-	l = len(m.ErrClass)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	return n
+}
+
+func (m *LoadStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.ErrScope)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	var l int
+	_ = l
+	if m.FinishedBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.FinishedBytes))
 	}
-	l = len(m.ErrLevel)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.TotalBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalBytes))
 	}
-	l = len(m.Message)
+	l = len(m.Progress)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	l = len(m.RawCause)
+	l = len(m.MetaBinlog)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	l = len(m.Workaround)
+	l = len(m.MetaBinlogGTID)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
 	return n
 }
 
-func (m *PurgeRelayRequest) Size() (n int) {
+func (m *ShardingGroup) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Inactive {
-		n += 2
-	}
-	if m.Time != 0 {
-		n += 1 + sovDmworker(uint64(m.Time))
-	}
-	l = len(m.Filename)
+	l = len(m.Target)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	l = len(m.SubDir)
+	if len(m.DDLs) > 0 {
+		for _, s := range m.DDLs {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	l = len(m.FirstLocation)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
+	if len(m.Synced) > 0 {
+		for _, s := range m.Synced {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	if len(m.Unsynced) > 0 {
+		for _, s := range m.Unsynced {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *OperateWorkerSchemaRequest) Size() (n int) {
+func (m *SyncStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmworker(uint64(m.Op))
+	if m.TotalEvents != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalEvents))
 	}
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.TotalTps != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalTps))
 	}
-	l = len(m.Source)
+	if m.RecentTps != 0 {
+		n += 1 + sovDmworker(uint64(m.RecentTps))
+	}
+	l = len(m.MasterBinlog)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	l = len(m.Database)
+	l = len(m.MasterBinlogGtid)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	l = len(m.Table)
+	l = len(m.SyncerBinlog)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	l = len(m.Schema)
+	l = len(m.SyncerBinlogGtid)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	if m.Flush {
-		n += 2
+	if len(m.BlockingDDLs) > 0 {
+		for _, s := range m.BlockingDDLs {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
 	}
-	if m.Sync {
+	if len(m.UnresolvedGroups) > 0 {
+		for _, e := range m.UnresolvedGroups {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	if m.Synced {
 		n += 2
 	}
-	return n
-}
-
-func (m *V1SubTaskMeta) Size() (n int) {
+	l = len(m.BinlogType)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.SecondsBehindMaster != 0 {
+		n += 1 + sovDmworker(uint64(m.SecondsBehindMaster))
+	}
+	return n
+}
+
+func (m *SourceStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmworker(uint64(m.Op))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RelayStatus != nil {
+		l = m.RelayStatus.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MasterBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.MasterBinlogGtid)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RelaySubDir)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RelayBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RelayBinlogGtid)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RelayCatchUpMaster {
+		n += 2
 	}
 	if m.Stage != 0 {
 		n += 1 + sovDmworker(uint64(m.Stage))
 	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SubTaskStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	l = len(m.Task)
+	if m.Stage != 0 {
+		n += 1 + sovDmworker(uint64(m.Stage))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovDmworker(uint64(m.Unit))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.UnresolvedDDLLockID)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
+	if m.Status != nil {
+		n += m.Status.Size()
+	}
 	return n
 }
 
-func (m *OperateV1MetaRequest) Size() (n int) {
+func (m *SubTaskStatus_Msg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmworker(uint64(m.Op))
+	l = len(m.Msg)
+	n += 1 + l + sovDmworker(uint64(l))
+	return n
+}
+func (m *SubTaskStatus_Check) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Check != nil {
+		l = m.Check.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskStatus_Dump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dump != nil {
+		l = m.Dump.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskStatus_Load) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Load != nil {
+		l = m.Load.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskStatus_Sync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sync != nil {
+		l = m.Sync.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskStatusList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		for _, e := range m.Status {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *OperateV1MetaResponse) Size() (n int) {
+func (m *CheckError) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *DumpError) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	l = len(m.Msg)
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
-	if len(m.Meta) > 0 {
-		for k, v := range m.Meta {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovDmworker(uint64(l))
+	return n
+}
+
+func (m *LoadError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncSQLError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.FailedBinlogPosition)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.ErrorSQL)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SourceError)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RelayError != nil {
+		l = m.RelayError.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SubTaskError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmworker(uint64(m.Stage))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovDmworker(uint64(m.Unit))
+	}
+	if m.Error != nil {
+		n += m.Error.Size()
+	}
+	return n
+}
+
+func (m *SubTaskError_Msg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	n += 1 + l + sovDmworker(uint64(l))
+	return n
+}
+func (m *SubTaskError_Check) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Check != nil {
+		l = m.Check.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskError_Dump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dump != nil {
+		l = m.Dump.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskError_Load) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Load != nil {
+		l = m.Load.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskError_Sync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sync != nil {
+		l = m.Sync.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskErrorList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		for _, e := range m.Error {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProcessResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsCanceled {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ErrCode != 0 {
+		n += 1 + sovDmworker(uint64(m.ErrCode))
+	}
+	l = len(m.ErrClass)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.ErrScope)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.ErrLevel)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RawCause)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Workaround)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *PurgeRelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inactive {
+		n += 2
+	}
+	if m.Time != 0 {
+		n += 1 + sovDmworker(uint64(m.Time))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SubDir)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *OperateWorkerSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Flush {
+		n += 2
+	}
+	if m.Sync {
+		n += 2
+	}
+	return n
+}
+
+func (m *V1SubTaskMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmworker(uint64(m.Stage))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *OperateV1MetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	return n
+}
+
+func (m *OperateV1MetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Meta) > 0 {
+		for k, v := range m.Meta {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovDmworker(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovDmworker(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovDmworker(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *HandleWorkerErrorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.BinlogPos)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Sqls) > 0 {
+		for _, s := range m.Sqls {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetWorkerCfgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetWorkerCfgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cfg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *GetValidationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskName)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmworker(uint64(m.Stage))
+	}
+	if m.ProcessedRowCount != 0 {
+		n += 1 + sovDmworker(uint64(m.ProcessedRowCount))
+	}
+	if m.PendingRowCount != 0 {
+		n += 1 + sovDmworker(uint64(m.PendingRowCount))
+	}
+	if m.ErrorRowCount != 0 {
+		n += 1 + sovDmworker(uint64(m.ErrorRowCount))
+	}
+	if m.SkippedRowCount != 0 {
+		n += 1 + sovDmworker(uint64(m.SkippedRowCount))
+	}
+	return n
+}
+
+func (m *GetValidationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetValidationErrorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskName)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidationError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDmworker(uint64(m.Id))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SrcTable)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.DstTable)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RowKey)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.ExpectedData)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.ActualData)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovDmworker(uint64(m.RetryCount))
+	}
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *GetValidationErrorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDmworker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDmworker(x uint64) (n int) {
+	return sovDmworker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommonWorkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommonWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceStatus == nil {
+				m.SourceStatus = &SourceStatus{}
+			}
+			if err := m.SourceStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubTaskStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubTaskStatus = append(m.SubTaskStatus, &SubTaskStatus{})
+			if err := m.SubTaskStatus[len(m.SubTaskStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			m.Successful = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Successful |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			m.Warning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Warning |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			mapEntrySize := 1 + len(k) + sovDmworker(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovDmworker(uint64(mapEntrySize))
-		}
-	}
-	return n
-}
-
-func (m *HandleWorkerErrorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmworker(uint64(m.Op))
-	}
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.BinlogPos)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if len(m.Sqls) > 0 {
-		for _, s := range m.Sqls {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
+			if byteLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
+			if m.Detail == nil {
+				m.Detail = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *GetWorkerCfgRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *GetWorkerCfgResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Cfg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDmworker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDmworker(x uint64) (n int) {
-	return sovDmworker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *DumpStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5559,44 +7163,12 @@ func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DumpStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DumpStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -5618,7 +7190,7 @@ func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
+func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5641,17 +7213,17 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommonWorkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LoadStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommonWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LoadStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBytes", wireType)
 			}
-			var v int
+			m.FinishedBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -5661,15 +7233,33 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FinishedBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5697,11 +7287,11 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Progress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaBinlog", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5729,11 +7319,11 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.MetaBinlog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaBinlogGTID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5761,7 +7351,7 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			m.MetaBinlogGTID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5784,7 +7374,7 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5807,17 +7397,17 @@ func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardingGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardingGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -5827,15 +7417,27 @@ func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5863,13 +7465,13 @@ func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstLocation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -5879,33 +7481,61 @@ func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceStatus == nil {
-				m.SourceStatus = &SourceStatus{}
-			}
-			if err := m.SourceStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Synced = append(m.Synced, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubTaskStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsynced", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -5915,25 +7545,23 @@ func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubTaskStatus = append(m.SubTaskStatus, &SubTaskStatus{})
-			if err := m.SubTaskStatus[len(m.SubTaskStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5956,7 +7584,7 @@ func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5979,17 +7607,17 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEvents", wireType)
 			}
-			var v int
+			m.TotalEvents = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -5999,17 +7627,16 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TotalEvents |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Passed = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTps", wireType)
 			}
-			m.Total = 0
+			m.TotalTps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6019,16 +7646,16 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int32(b&0x7F) << shift
+				m.TotalTps |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentTps", wireType)
 			}
-			m.Successful = 0
+			m.RecentTps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6038,16 +7665,16 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
+				m.RecentTps |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
 			}
-			m.Failed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6057,16 +7684,29 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
 			}
-			m.Warning = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6076,16 +7716,29 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Warning |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlog", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6095,76 +7748,193 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
-			if m.Detail == nil {
-				m.Detail = []byte{}
+			m.SyncerBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlogGtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncerBinlogGtid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockingDDLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockingDDLs = append(m.BlockingDDLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnresolvedGroups = append(m.UnresolvedGroups, &ShardingGroup{})
+			if err := m.UnresolvedGroups[len(m.UnresolvedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synced = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DumpStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.BinlogType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsBehindMaster", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SecondsBehindMaster = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsBehindMaster |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DumpStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DumpStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -6186,7 +7956,7 @@ func (m *DumpStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadStatus) Unmarshal(dAtA []byte) error {
+func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6209,17 +7979,17 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.FinishedBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6229,33 +7999,27 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinishedBytes |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			m.TotalBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalBytes |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6283,13 +8047,13 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Progress = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6299,29 +8063,33 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaBinlog = string(dAtA[iNdEx:postIndex])
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaBinlogGTID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6331,23 +8099,27 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaBinlogGTID = string(dAtA[iNdEx:postIndex])
+			if m.RelayStatus == nil {
+				m.RelayStatus = &RelayStatus{}
+			}
+			if err := m.RelayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6370,7 +8142,7 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
+func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6393,15 +8165,15 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardingGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardingGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6429,11 +8201,11 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6461,11 +8233,11 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
+			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelaySubDir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6493,11 +8265,11 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstLocation = string(dAtA[iNdEx:postIndex])
+			m.RelaySubDir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlog", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6525,11 +8297,11 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Synced = append(m.Synced, string(dAtA[iNdEx:postIndex]))
+			m.RelayBinlog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unsynced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlogGtid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6557,7 +8329,82 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
+			m.RelayBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayCatchUpMaster", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RelayCatchUpMaster = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6580,7 +8427,7 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6603,17 +8450,17 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEvents", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.TotalEvents = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6623,16 +8470,29 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalEvents |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			m.TotalTps = 0
+			m.Stage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6642,16 +8502,16 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalTps |= int64(b&0x7F) << shift
+				m.Stage |= Stage(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentTps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			m.RecentTps = 0
+			m.Unit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6661,16 +8521,16 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecentTps |= int64(b&0x7F) << shift
+				m.Unit |= UnitType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6680,27 +8540,31 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedDDLLockID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6728,11 +8592,11 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			m.UnresolvedDDLLockID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6760,13 +8624,13 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncerBinlog = string(dAtA[iNdEx:postIndex])
+			m.Status = &SubTaskStatus_Msg{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6776,29 +8640,32 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncerBinlogGtid = string(dAtA[iNdEx:postIndex])
+			v := &CheckStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Status = &SubTaskStatus_Check{v}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockingDDLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6808,27 +8675,30 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockingDDLs = append(m.BlockingDDLs, string(dAtA[iNdEx:postIndex]))
+			v := &DumpStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Status = &SubTaskStatus_Dump{v}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6855,36 +8725,17 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnresolvedGroups = append(m.UnresolvedGroups, &ShardingGroup{})
-			if err := m.UnresolvedGroups[len(m.UnresolvedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &LoadStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Status = &SubTaskStatus_Load{v}
 			iNdEx = postIndex
 		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Synced = bool(v != 0)
-		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinlogType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6894,43 +8745,27 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinlogType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsBehindMaster", wireType)
-			}
-			m.SecondsBehindMaster = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SecondsBehindMaster |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			v := &SyncStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Status = &SubTaskStatus_Sync{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -6952,7 +8787,7 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceStatus) Unmarshal(dAtA []byte) error {
+func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6975,17 +8810,17 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskStatusList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6995,97 +8830,81 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Status = append(m.Status, &SubTaskStatus{})
+			if err := m.Status[len(m.Status)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7095,27 +8914,23 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RelayStatus == nil {
-				m.RelayStatus = &RelayStatus{}
-			}
-			if err := m.RelayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7138,7 +8953,7 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelayStatus) Unmarshal(dAtA []byte) error {
+func (m *DumpError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7161,15 +8976,15 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelayStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: DumpError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DumpError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7197,11 +9012,61 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7229,11 +9094,61 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncSQLError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncSQLError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelaySubDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7261,11 +9176,11 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelaySubDir = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedBinlogPosition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7293,11 +9208,11 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayBinlog = string(dAtA[iNdEx:postIndex])
+			m.FailedBinlogPosition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorSQL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7325,50 +9240,61 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayBinlogGtid = string(dAtA[iNdEx:postIndex])
+			m.ErrorSQL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayCatchUpMaster", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			m.RelayCatchUpMaster = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Stage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
 			}
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7395,10 +9321,8 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Errors = append(m.Errors, &SyncSQLError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7423,7 +9347,7 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
+func (m *SourceError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7446,15 +9370,15 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7482,13 +9406,13 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			m.Stage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7498,16 +9422,29 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceError", wireType)
 			}
-			m.Unit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7517,14 +9454,27 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Unit |= UnitType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7551,16 +9501,66 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
+			if m.RelayError == nil {
+				m.RelayError = &RelayError{}
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RelayError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedDDLLockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7588,11 +9588,61 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnresolvedDDLLockID = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubTaskError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubTaskError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubTaskError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7620,13 +9670,13 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = &SubTaskStatus_Msg{string(dAtA[iNdEx:postIndex])}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			var msglen int
+			m.Stage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7636,32 +9686,16 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Stage |= Stage(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &CheckStatus{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Status = &SubTaskStatus_Check{v}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			var msglen int
+			m.Unit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7671,32 +9705,16 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Unit |= UnitType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DumpStatus{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Status = &SubTaskStatus_Dump{v}
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7706,30 +9724,27 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LoadStatus{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Status = &SubTaskStatus_Load{v}
+			m.Error = &SubTaskError_Msg{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7756,65 +9771,15 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SyncStatus{}
+			v := &CheckError{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Status = &SubTaskStatus_Sync{v}
+			m.Error = &SubTaskError_Check{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskStatusList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7841,66 +9806,17 @@ func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = append(m.Status, &SubTaskStatus{})
-			if err := m.Status[len(m.Status)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DumpError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Error = &SubTaskError_Dump{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CheckError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CheckError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7910,79 +9826,32 @@ func (m *CheckError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
+			v := &LoadError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DumpError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DumpError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DumpError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Error = &SubTaskError_Load{v}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7992,23 +9861,26 @@ func (m *DumpError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			v := &SyncError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Error = &SubTaskError_Sync{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8031,7 +9903,7 @@ func (m *DumpError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadError) Unmarshal(dAtA []byte) error {
+func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8054,17 +9926,17 @@ func (m *LoadError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadError: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskErrorList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskErrorList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8074,23 +9946,25 @@ func (m *LoadError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Error = append(m.Error, &SubTaskError{})
+			if err := m.Error[len(m.Error)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8113,7 +9987,7 @@ func (m *LoadError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
+func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8136,17 +10010,17 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncSQLError: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncSQLError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCanceled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8156,29 +10030,17 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsCanceled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedBinlogPosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8188,29 +10050,31 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedBinlogPosition = string(dAtA[iNdEx:postIndex])
+			m.Errors = append(m.Errors, &ProcessError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorSQL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8220,23 +10084,25 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorSQL = string(dAtA[iNdEx:postIndex])
+			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
+			if m.Detail == nil {
+				m.Detail = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8259,7 +10125,7 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncError) Unmarshal(dAtA []byte) error {
+func (m *ProcessError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8282,17 +10148,36 @@ func (m *SyncError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncError: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+			}
+			m.ErrCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrClass", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8302,79 +10187,59 @@ func (m *SyncError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Errors = append(m.Errors, &SyncSQLError{})
-			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ErrClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrScope", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDmworker
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SourceError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SourceError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ErrScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrLevel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8402,11 +10267,11 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.ErrLevel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8434,11 +10299,11 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCause", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8466,13 +10331,13 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceError = string(dAtA[iNdEx:postIndex])
+			m.RawCause = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workaround", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8482,27 +10347,23 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RelayError == nil {
-				m.RelayError = &RelayError{}
-			}
-			if err := m.RelayError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Workaround = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8525,7 +10386,7 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelayError) Unmarshal(dAtA []byte) error {
+func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8535,28 +10396,99 @@ func (m *RelayError) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowDmworker
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeRelayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inactive = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RelayError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8584,7 +10516,7 @@ func (m *RelayError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.SubDir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8607,7 +10539,7 @@ func (m *RelayError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskError) Unmarshal(dAtA []byte) error {
+func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8630,15 +10562,34 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskError: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateWorkerSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateWorkerSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= SchemaOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8666,13 +10617,13 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.Stage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8682,33 +10633,27 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			m.Unit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Unit |= UnitType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8736,13 +10681,13 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = &SubTaskError_Msg{string(dAtA[iNdEx:postIndex])}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8752,32 +10697,29 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CheckError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Check{v}
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8787,32 +10729,29 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DumpError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Dump{v}
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8822,32 +10761,17 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &LoadError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Load{v}
-			iNdEx = postIndex
+			m.Flush = bool(v != 0)
 		case 8:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8857,27 +10781,12 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &SyncError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Sync{v}
-			iNdEx = postIndex
+			m.Sync = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -8899,7 +10808,7 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
+func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8922,17 +10831,87 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskErrorList: wiretype end group for non-group")
+			return fmt.Errorf("proto: V1SubTaskMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskErrorList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: V1SubTaskMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= TaskOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8942,24 +10921,24 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = append(m.Error, &SubTaskError{})
-			if err := m.Error[len(m.Error)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Task = append(m.Task[:0], dAtA[iNdEx:postIndex]...)
+			if m.Task == nil {
+				m.Task = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8983,7 +10962,7 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessResult) Unmarshal(dAtA []byte) error {
+func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9006,71 +10985,17 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateV1MetaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateV1MetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCanceled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsCanceled = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Errors = append(m.Errors, &ProcessError{})
-			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var byteLen int
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9080,26 +11005,11 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Op |= V1MetaOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
-			if m.Detail == nil {
-				m.Detail = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -9121,7 +11031,7 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessError) Unmarshal(dAtA []byte) error {
+func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9144,17 +11054,17 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessError: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateV1MetaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateV1MetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.ErrCode = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9164,14 +11074,15 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrCode |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Result = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9199,13 +11110,13 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrClass = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9215,29 +11126,176 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Meta == nil {
+				m.Meta = make(map[string]*V1SubTaskMeta)
+			}
+			var mapkey string
+			var mapvalue *V1SubTaskMeta
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDmworker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDmworker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDmworker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &V1SubTaskMeta{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDmworker(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Meta[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrScope = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrLevel", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandleWorkerErrorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandleWorkerErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9247,27 +11305,14 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Op |= ErrorOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrLevel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9295,11 +11340,11 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCause", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9327,11 +11372,11 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawCause = string(dAtA[iNdEx:postIndex])
+			m.BinlogPos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workaround", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sqls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9359,7 +11404,7 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workaround = string(dAtA[iNdEx:postIndex])
+			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9382,7 +11427,7 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
+func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9405,54 +11450,65 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeRelayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkerCfgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkerCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			m.Inactive = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Time = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
 			}
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerCfgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9480,11 +11536,61 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.Cfg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9512,7 +11618,7 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubDir = string(dAtA[iNdEx:postIndex])
+			m.TaskName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9535,7 +11641,7 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9558,32 +11664,13 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateWorkerSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateWorkerSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= SchemaOp(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
@@ -9615,7 +11702,7 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
@@ -9647,9 +11734,9 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9677,13 +11764,32 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedRowCount", wireType)
 			}
-			var stringLen uint64
+			m.ProcessedRowCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9693,29 +11799,16 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProcessedRowCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Table = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRowCount", wireType)
 			}
-			var stringLen uint64
+			m.PendingRowCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9725,29 +11818,16 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PendingRowCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRowCount", wireType)
 			}
-			var v int
+			m.ErrorRowCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9757,17 +11837,16 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ErrorRowCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Flush = bool(v != 0)
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedRowCount", wireType)
 			}
-			var v int
+			m.SkippedRowCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9777,12 +11856,11 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SkippedRowCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Sync = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -9804,7 +11882,7 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
+func (m *GetValidationStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9827,17 +11905,17 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: V1SubTaskMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidationStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: V1SubTaskMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.Op = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9847,33 +11925,15 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= TaskOp(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Result = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
-			}
-			m.Stage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9901,13 +11961,13 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9917,24 +11977,24 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = append(m.Task[:0], dAtA[iNdEx:postIndex]...)
-			if m.Task == nil {
-				m.Task = []byte{}
+			m.Validators = append(m.Validators, &ValidationStatus{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9958,7 +12018,7 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
+func (m *GetValidationErrorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9981,17 +12041,17 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateV1MetaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidationErrorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateV1MetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidationErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10001,11 +12061,24 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= V1MetaOp(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -10027,7 +12100,7 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidationError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10050,17 +12123,17 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateV1MetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateV1MetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10070,15 +12143,14 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10106,13 +12178,13 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcTable", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10121,177 +12193,30 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Meta == nil {
-				m.Meta = make(map[string]*V1SubTaskMeta)
-			}
-			var mapkey string
-			var mapvalue *V1SubTaskMeta
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDmworker
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDmworker
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDmworker
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &V1SubTaskMeta{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipDmworker(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Meta[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HandleWorkerErrorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandleWorkerErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			m.SrcTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstTable", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10301,14 +12226,27 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= ErrorOp(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10336,11 +12274,11 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = string(dAtA[iNdEx:postIndex])
+			m.RowKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10368,11 +12306,11 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinlogPos = string(dAtA[iNdEx:postIndex])
+			m.ExpectedData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sqls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10400,58 +12338,91 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
+			m.ActualData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerCfgRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -10473,7 +12444,7 @@ func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
+func (m *GetValidationErrorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10496,15 +12467,35 @@ func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerCfgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidationErrorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidationErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10532,7 +12523,41 @@ func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cfg = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &ValidationError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
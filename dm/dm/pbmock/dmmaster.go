@@ -116,6 +116,46 @@ func (mr *MockMasterClientMockRecorder) GetSubTaskCfg(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubTaskCfg", reflect.TypeOf((*MockMasterClient)(nil).GetSubTaskCfg), varargs...)
 }
 
+// GetValidationError mocks base method.
+func (m *MockMasterClient) GetValidationError(arg0 context.Context, arg1 *pb.GetValidationErrorRequest, arg2 ...grpc.CallOption) (*pb.GetValidationErrorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetValidationError", varargs...)
+	ret0, _ := ret[0].(*pb.GetValidationErrorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationError indicates an expected call of GetValidationError.
+func (mr *MockMasterClientMockRecorder) GetValidationError(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationError", reflect.TypeOf((*MockMasterClient)(nil).GetValidationError), varargs...)
+}
+
+// GetValidationStatus mocks base method.
+func (m *MockMasterClient) GetValidationStatus(arg0 context.Context, arg1 *pb.GetValidationStatusRequest, arg2 ...grpc.CallOption) (*pb.GetValidationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetValidationStatus", varargs...)
+	ret0, _ := ret[0].(*pb.GetValidationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationStatus indicates an expected call of GetValidationStatus.
+func (mr *MockMasterClientMockRecorder) GetValidationStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationStatus", reflect.TypeOf((*MockMasterClient)(nil).GetValidationStatus), varargs...)
+}
+
 // HandleError mocks base method.
 func (m *MockMasterClient) HandleError(arg0 context.Context, arg1 *pb.HandleErrorRequest, arg2 ...grpc.CallOption) (*pb.HandleErrorResponse, error) {
 	m.ctrl.T.Helper()
@@ -539,6 +579,36 @@ func (mr *MockMasterServerMockRecorder) GetSubTaskCfg(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubTaskCfg", reflect.TypeOf((*MockMasterServer)(nil).GetSubTaskCfg), arg0, arg1)
 }
 
+// GetValidationError mocks base method.
+func (m *MockMasterServer) GetValidationError(arg0 context.Context, arg1 *pb.GetValidationErrorRequest) (*pb.GetValidationErrorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidationError", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetValidationErrorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationError indicates an expected call of GetValidationError.
+func (mr *MockMasterServerMockRecorder) GetValidationError(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationError", reflect.TypeOf((*MockMasterServer)(nil).GetValidationError), arg0, arg1)
+}
+
+// GetValidationStatus mocks base method.
+func (m *MockMasterServer) GetValidationStatus(arg0 context.Context, arg1 *pb.GetValidationStatusRequest) (*pb.GetValidationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetValidationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationStatus indicates an expected call of GetValidationStatus.
+func (mr *MockMasterServerMockRecorder) GetValidationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationStatus", reflect.TypeOf((*MockMasterServer)(nil).GetValidationStatus), arg0, arg1)
+}
+
 // HandleError mocks base method.
 func (m *MockMasterServer) HandleError(arg0 context.Context, arg1 *pb.HandleErrorRequest) (*pb.HandleErrorResponse, error) {
 	m.ctrl.T.Helper()
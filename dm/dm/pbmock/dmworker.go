@@ -36,6 +36,46 @@ func (m *MockWorkerClient) EXPECT() *MockWorkerClientMockRecorder {
 	return m.recorder
 }
 
+// GetValidationError mocks base method.
+func (m *MockWorkerClient) GetValidationError(arg0 context.Context, arg1 *pb.GetValidationErrorRequest, arg2 ...grpc.CallOption) (*pb.GetValidationErrorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetValidationError", varargs...)
+	ret0, _ := ret[0].(*pb.GetValidationErrorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationError indicates an expected call of GetValidationError.
+func (mr *MockWorkerClientMockRecorder) GetValidationError(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationError", reflect.TypeOf((*MockWorkerClient)(nil).GetValidationError), varargs...)
+}
+
+// GetValidationStatus mocks base method.
+func (m *MockWorkerClient) GetValidationStatus(arg0 context.Context, arg1 *pb.GetValidationStatusRequest, arg2 ...grpc.CallOption) (*pb.GetValidationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetValidationStatus", varargs...)
+	ret0, _ := ret[0].(*pb.GetValidationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationStatus indicates an expected call of GetValidationStatus.
+func (mr *MockWorkerClientMockRecorder) GetValidationStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationStatus", reflect.TypeOf((*MockWorkerClient)(nil).GetValidationStatus), varargs...)
+}
+
 // GetWorkerCfg mocks base method.
 func (m *MockWorkerClient) GetWorkerCfg(arg0 context.Context, arg1 *pb.GetWorkerCfgRequest, arg2 ...grpc.CallOption) (*pb.GetWorkerCfgResponse, error) {
 	m.ctrl.T.Helper()
@@ -179,6 +219,36 @@ func (m *MockWorkerServer) EXPECT() *MockWorkerServerMockRecorder {
 	return m.recorder
 }
 
+// GetValidationError mocks base method.
+func (m *MockWorkerServer) GetValidationError(arg0 context.Context, arg1 *pb.GetValidationErrorRequest) (*pb.GetValidationErrorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidationError", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetValidationErrorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationError indicates an expected call of GetValidationError.
+func (mr *MockWorkerServerMockRecorder) GetValidationError(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationError", reflect.TypeOf((*MockWorkerServer)(nil).GetValidationError), arg0, arg1)
+}
+
+// GetValidationStatus mocks base method.
+func (m *MockWorkerServer) GetValidationStatus(arg0 context.Context, arg1 *pb.GetValidationStatusRequest) (*pb.GetValidationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetValidationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidationStatus indicates an expected call of GetValidationStatus.
+func (mr *MockWorkerServerMockRecorder) GetValidationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidationStatus", reflect.TypeOf((*MockWorkerServer)(nil).GetValidationStatus), arg0, arg1)
+}
+
 // GetWorkerCfg mocks base method.
 func (m *MockWorkerServer) GetWorkerCfg(arg0 context.Context, arg1 *pb.GetWorkerCfgRequest) (*pb.GetWorkerCfgResponse, error) {
 	m.ctrl.T.Helper()
@@ -966,3 +966,43 @@ func (s *Server) GetWorkerCfg(ctx context.Context, req *pb.GetWorkerCfgRequest)
 	resp.Cfg, err = s.cfg.Toml()
 	return resp, err
 }
+
+// GetValidationStatus implements WorkerServer.GetValidationStatus.
+func (s *Server) GetValidationStatus(ctx context.Context, req *pb.GetValidationStatusRequest) (*pb.GetValidationStatusResponse, error) {
+	log.L().Info("", zap.String("request", "GetValidationStatus"), zap.Stringer("payload", req))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call GetValidationStatus, because no mysql source is being handled in the worker")
+		return &pb.GetValidationStatusResponse{Msg: terror.ErrWorkerNoStart.Generate().Error()}, nil
+	}
+
+	status, err := w.GetValidationStatus(req.TaskName)
+	if err != nil {
+		return &pb.GetValidationStatusResponse{Msg: err.Error()}, nil
+	}
+	return &pb.GetValidationStatusResponse{
+		Result:     true,
+		Validators: []*pb.ValidationStatus{status},
+	}, nil
+}
+
+// GetValidationError implements WorkerServer.GetValidationError.
+func (s *Server) GetValidationError(ctx context.Context, req *pb.GetValidationErrorRequest) (*pb.GetValidationErrorResponse, error) {
+	log.L().Info("", zap.String("request", "GetValidationError"), zap.Stringer("payload", req))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call GetValidationError, because no mysql source is being handled in the worker")
+		return &pb.GetValidationErrorResponse{Msg: terror.ErrWorkerNoStart.Generate().Error()}, nil
+	}
+
+	errs, err := w.GetValidationError(ctx, req.TaskName)
+	if err != nil {
+		return &pb.GetValidationErrorResponse{Msg: err.Error()}, nil
+	}
+	return &pb.GetValidationErrorResponse{
+		Result: true,
+		Errors: errs,
+	}, nil
+}
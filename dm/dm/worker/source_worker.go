@@ -966,6 +966,40 @@ func (w *SourceWorker) OperateSchema(ctx context.Context, req *pb.OperateWorkerS
 	return st.OperateSchema(ctx, req)
 }
 
+// GetValidationStatus gets the status of the continuous validator of a subtask.
+func (w *SourceWorker) GetValidationStatus(taskName string) (*pb.ValidationStatus, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return nil, terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(taskName)
+	if st == nil {
+		return nil, terror.ErrWorkerSubTaskNotFound.Generate(taskName)
+	}
+
+	return st.GetValidationStatus()
+}
+
+// GetValidationError gets the row changes mismatched in the downstream of a subtask.
+func (w *SourceWorker) GetValidationError(ctx context.Context, taskName string) ([]*pb.ValidationError, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.closed.Load() {
+		return nil, terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	st := w.subTaskHolder.findSubTask(taskName)
+	if st == nil {
+		return nil, terror.ErrWorkerSubTaskNotFound.Generate(taskName)
+	}
+
+	return st.GetValidationError(ctx)
+}
+
 // copyConfigFromSource copies config items from source config and worker's relayEnabled to sub task.
 func copyConfigFromSource(cfg *config.SubTaskConfig, sourceCfg *config.SourceConfig, enableRelay bool) error {
 	cfg.From = sourceCfg.From
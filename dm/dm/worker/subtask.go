@@ -142,7 +142,8 @@ func (st *SubTask) initUnits(relay relay.Process) error {
 	// NOTE: because lightning not support init tls with raw certs bytes, we write the certs data to a file.
 	if st.cfg.NeedUseLightning() && st.cfg.To.Security != nil {
 		// NOTE: LoaderConfig.Dir is always not empty because we only dump certs when we use lightning.
-		if err := st.cfg.To.Security.DumpTLSContent(filepath.Join(st.cfg.LoaderConfig.Dir, "..")); err != nil {
+		// the certs are dumped to local disk even if the dumped files are in external storage.
+		if err := st.cfg.To.Security.DumpTLSContent(filepath.Join(st.cfg.LocalDir(), "..")); err != nil {
 			return terror.Annotatef(err, "fail to dump tls cert data for lightning, subtask %s ", st.cfg.Name)
 		}
 	}
@@ -574,6 +575,31 @@ func (st *SubTask) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchem
 	return syncUnit.OperateSchema(ctx, req)
 }
 
+// GetValidationStatus gets the status of the continuous validator.
+func (st *SubTask) GetValidationStatus() (*pb.ValidationStatus, error) {
+	syncUnit, ok := st.CurrUnit().(*syncer.Syncer)
+	if !ok {
+		return nil, terror.ErrWorkerOperSyncUnitOnly.Generate(st.CurrUnit().Type())
+	}
+
+	status, err := syncUnit.ValidationStatus()
+	if err != nil {
+		return nil, err
+	}
+	status.Stage = st.Stage()
+	return status, nil
+}
+
+// GetValidationError gets the row changes mismatched in the downstream found by the continuous validator.
+func (st *SubTask) GetValidationError(ctx context.Context) ([]*pb.ValidationError, error) {
+	syncUnit, ok := st.CurrUnit().(*syncer.Syncer)
+	if !ok {
+		return nil, terror.ErrWorkerOperSyncUnitOnly.Generate(st.CurrUnit().Type())
+	}
+
+	return syncUnit.ValidationErrors(ctx)
+}
+
 // UpdateFromConfig updates config for `From`.
 func (st *SubTask) UpdateFromConfig(cfg *config.SubTaskConfig) error {
 	st.Lock()
@@ -16,9 +16,9 @@ package loader
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 	"unsafe"
 
@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/errors"
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
 	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/parser/ast"
 )
 
@@ -189,21 +190,15 @@ func parseRowValues(str []byte, table *tableInfo, columnMapping *cm.Mapping) ([]
 }
 
 // exportStatement returns schema structure in sqlFile.
-func exportStatement(sqlFile string) ([]byte, error) {
-	fd, err := os.Open(sqlFile)
+func exportStatement(ctx context.Context, sqlFile string, fileStorage bstorage.ExternalStorage) ([]byte, error) {
+	content, err := fileStorage.ReadFile(ctx, sqlFile)
 	if err != nil {
 		return nil, terror.ErrLoadUnitReadSchemaFile.Delegate(err, sqlFile)
 	}
-	defer fd.Close()
 
-	br := bufio.NewReader(fd)
-	f, err := os.Stat(sqlFile)
-	if err != nil {
-		return nil, terror.ErrLoadUnitReadSchemaFile.Delegate(err, sqlFile)
-	}
-
-	data := make([]byte, 0, f.Size()+1)
-	buffer := make([]byte, 0, f.Size()+1)
+	br := bufio.NewReader(bytes.NewReader(content))
+	data := make([]byte, 0, len(content)+1)
+	buffer := make([]byte, 0, len(content)+1)
 	for {
 		line, err := br.ReadString('\n')
 		if errors.Cause(err) == io.EOF {
@@ -234,8 +229,8 @@ func tableName(schema, table string) string {
 	return fmt.Sprintf("`%s`.`%s`", schema, table)
 }
 
-func parseTable(ctx *tcontext.Context, r *router.Table, schema, table, file, sqlMode, sourceID string) (*tableInfo, error) {
-	statement, err := exportStatement(file)
+func parseTable(ctx *tcontext.Context, r *router.Table, schema, table, file, sqlMode, sourceID string, fileStorage bstorage.ExternalStorage) (*tableInfo, error) {
+	statement, err := exportStatement(ctx.Context(), file, fileStorage)
 	if err != nil {
 		return nil, err
 	}
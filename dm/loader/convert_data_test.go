@@ -14,10 +14,13 @@
 package loader
 
 import (
+	"context"
+
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
 	router "github.com/pingcap/tidb-tools/pkg/table-router"
 
 	tcontext "github.com/pingcap/ticdc/dm/pkg/context"
+	"github.com/pingcap/ticdc/dm/pkg/storage"
 
 	. "github.com/pingcap/check"
 )
@@ -165,10 +168,12 @@ func (t *testConvertDataSuite) TestParseTable(c *C) {
 		insertHeadStmt: "INSERT INTO `t` VALUES",
 	}
 
+	fileStorage, err := storage.CreateStorage(context.Background(), "./dumpfile")
+	c.Assert(err, IsNil)
 	r, err := router.NewTableRouter(false, rules)
 	c.Assert(err, IsNil)
 
-	tableInfo, err := parseTable(tcontext.Background(), r, "test1", "t2", "./dumpfile/test1.t2-schema.sql", "ANSI_QUOTES", "source-mysql-01")
+	tableInfo, err := parseTable(tcontext.Background(), r, "test1", "t2", "test1.t2-schema.sql", "ANSI_QUOTES", "source-mysql-01", fileStorage)
 	c.Assert(err, IsNil)
 	c.Assert(tableInfo, DeepEquals, expectedTableInfo)
 }
@@ -193,10 +198,12 @@ func (t *testConvertDataSuite) TestParseTableWithGeneratedColumn(c *C) {
 		insertHeadStmt: "INSERT INTO `t` (`id`,`t_json`) VALUES",
 	}
 
+	fileStorage, err := storage.CreateStorage(context.Background(), "./dumpfile")
+	c.Assert(err, IsNil)
 	r, err := router.NewTableRouter(false, rules)
 	c.Assert(err, IsNil)
 
-	tableInfo, err := parseTable(tcontext.Background(), r, "test1", "t3", "./dumpfile/test1.t3-schema.sql", "", "source-mysql-01")
+	tableInfo, err := parseTable(tcontext.Background(), r, "test1", "t3", "test1.t3-schema.sql", "", "source-mysql-01", fileStorage)
 	c.Assert(err, IsNil)
 	c.Assert(tableInfo, DeepEquals, expectedTableInfo)
 }
@@ -411,10 +418,12 @@ func (t *testConvertDataSuite) TestParseTableWithExtendColumn(c *C) {
 		extendVal:      []string{"t2", "test1", "source1"},
 	}
 
+	fileStorage, err := storage.CreateStorage(context.Background(), "./dumpfile")
+	c.Assert(err, IsNil)
 	r, err := router.NewTableRouter(false, rules)
 	c.Assert(err, IsNil)
 
-	tableInfo, err := parseTable(tcontext.Background(), r, "test1", "t2", "./dumpfile/test1.t2-schema.sql", "ANSI_QUOTES", "source1")
+	tableInfo, err := parseTable(tcontext.Background(), r, "test1", "t2", "test1.t2-schema.sql", "ANSI_QUOTES", "source1", fileStorage)
 	c.Assert(err, IsNil)
 	c.Assert(tableInfo, DeepEquals, expectedTableInfo)
 }
@@ -456,10 +465,12 @@ func (t *testConvertDataSuite) TestParseTableWithGeneratedColumnExtendColumn(c *
 		extendVal:      []string{"t3", "test1", "source1"},
 	}
 
+	fileStorage, err := storage.CreateStorage(context.Background(), "./dumpfile")
+	c.Assert(err, IsNil)
 	r, err := router.NewTableRouter(false, rules)
 	c.Assert(err, IsNil)
 
-	tableInfo, err := parseTable(tcontext.Background(), r, "test1", "t3", "./dumpfile/test1.t3-schema.sql", "", "source1")
+	tableInfo, err := parseTable(tcontext.Background(), r, "test1", "t3", "test1.t3-schema.sql", "", "source1", fileStorage)
 	c.Assert(err, IsNil)
 	c.Assert(tableInfo, DeepEquals, expectedTableInfo)
 }
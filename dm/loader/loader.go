@@ -19,7 +19,6 @@ import (
 	"context"
 	"encoding/hex"
 	"io"
-	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -35,6 +34,7 @@ import (
 	tcontext "github.com/pingcap/ticdc/dm/pkg/context"
 	fr "github.com/pingcap/ticdc/dm/pkg/func-rollback"
 	"github.com/pingcap/ticdc/dm/pkg/log"
+	"github.com/pingcap/ticdc/dm/pkg/storage"
 	"github.com/pingcap/ticdc/dm/pkg/terror"
 	"github.com/pingcap/ticdc/dm/pkg/utils"
 
@@ -43,6 +43,7 @@ import (
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb-tools/pkg/filter"
 	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
@@ -250,7 +251,7 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 			}()
 
 			// restore a table
-			if err := w.restoreDataFile(ctx, filepath.Join(w.cfg.Dir, job.dataFile), job.offset, job.info); err != nil {
+			if err := w.restoreDataFile(ctx, job.dataFile, job.offset, job.info); err != nil {
 				// expect pause rather than exit
 				err = terror.Annotatef(err, "restore data file (%v) failed", job.dataFile)
 				if !utils.IsContextCanceledError(err) {
@@ -286,14 +287,14 @@ func (w *Worker) restoreDataFile(ctx context.Context, filePath string, offset in
 
 func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, table *tableInfo) error {
 	var (
-		f   *os.File
+		f   bstorage.ExternalFileReader
 		err error
 		cur int64
 	)
 
 	baseFile := filepath.Base(file)
 
-	f, err = os.Open(file)
+	f, err = w.loader.fileStorage.Open(ctx, file)
 	if err != nil {
 		return terror.ErrLoadUnitDispatchSQLFromFile.Delegate(err)
 	}
@@ -303,13 +304,14 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 	if offset == uninitializedOffset {
 		offset = 0
 
-		finfo, err2 := f.Stat()
+		// external storage doesn't support stat, so we seek to the end to get the size.
+		size, err2 := f.Seek(0, io.SeekEnd)
 		if err2 != nil {
 			return terror.ErrLoadUnitDispatchSQLFromFile.Delegate(err2)
 		}
 
 		tctx := tcontext.NewContext(ctx, w.logger)
-		err2 = w.checkPoint.Init(tctx, baseFile, finfo.Size())
+		err2 = w.checkPoint.Init(tctx, baseFile, size)
 		failpoint.Inject("WaitLoaderStopAfterInitCheckpoint", func(v failpoint.Value) {
 			t := v.(int)
 			w.logger.Info("wait loader stop after init checkpoint")
@@ -430,6 +432,9 @@ type Loader struct {
 
 	fileJobQueue chan *fileJob
 
+	// storage of the dumped files, which may be a local disk or an external storage.
+	fileStorage bstorage.ExternalStorage
+
 	tableRouter   *router.Table
 	baList        *filter.Filter
 	columnMapping *cm.Mapping
@@ -581,7 +586,7 @@ func (l *Loader) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	defer cancel()
 
 	l.newFileJobQueue()
-	binlog, gtid, err := getMydumpMetadata(l.cli, l.cfg, l.workerName)
+	binlog, gtid, err := getMydumpMetadata(ctx, l.cli, l.cfg, l.workerName)
 	if err != nil {
 		loaderExitWithErrorCounter.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Inc()
 		pr <- pb.ProcessResult{
@@ -712,7 +717,7 @@ func (l *Loader) Restore(ctx context.Context) error {
 		return err
 	}
 
-	if err := l.prepare(); err != nil {
+	if err := l.prepare(ctx); err != nil {
 		l.logger.Error("scan directory failed", zap.String("directory", l.cfg.Dir), log.ShortError(err))
 		return err
 	}
@@ -763,7 +768,7 @@ func (l *Loader) Restore(ctx context.Context) error {
 				}
 			}
 			if l.cfg.CleanDumpFile {
-				cleanDumpFiles(l.cfg)
+				cleanDumpFiles(ctx, l.cfg)
 			}
 		}
 	} else if errors.Cause(err) != context.Canceled {
@@ -948,7 +953,7 @@ func (l *Loader) initAndStartWorkerPool(ctx context.Context) error {
 	return nil
 }
 
-func (l *Loader) prepareDBFiles(files map[string]struct{}) error {
+func (l *Loader) prepareDBFiles(files map[string]int64) error {
 	// reset some variables
 	l.db2Tables = make(map[string]Tables2DataFiles)
 	l.totalFileCount.Store(0) // reset
@@ -978,7 +983,7 @@ func (l *Loader) prepareDBFiles(files map[string]struct{}) error {
 	return nil
 }
 
-func (l *Loader) prepareTableFiles(files map[string]struct{}) error {
+func (l *Loader) prepareTableFiles(ctx context.Context, files map[string]int64) error {
 	var tablesNumber float64
 	for file := range files {
 		db, table, ok := utils.GetTableFromDumpFilename(file)
@@ -992,7 +997,7 @@ func (l *Loader) prepareTableFiles(files map[string]struct{}) error {
 		tables, ok := l.db2Tables[db]
 		if !ok {
 			l.logger.Warn("can't find schema create file, will generate one", zap.String("schema", db))
-			if err := generateSchemaCreateFile(l.cfg.Dir, db); err != nil {
+			if err := generateSchemaCreateFile(ctx, l.fileStorage, db); err != nil {
 				return err
 			}
 			l.db2Tables[db] = make(Tables2DataFiles)
@@ -1012,7 +1017,7 @@ func (l *Loader) prepareTableFiles(files map[string]struct{}) error {
 	return nil
 }
 
-func (l *Loader) prepareDataFiles(files map[string]struct{}) error {
+func (l *Loader) prepareDataFiles(files map[string]int64) error {
 	var dataFilesNumber float64
 
 	for file := range files {
@@ -1047,10 +1052,7 @@ func (l *Loader) prepareDataFiles(files map[string]struct{}) error {
 			return terror.ErrLoadUnitNoTableFile.Generate(file)
 		}
 
-		size, err := utils.GetFileSize(filepath.Join(l.cfg.Dir, file))
-		if err != nil {
-			return err
-		}
+		size := files[file]
 		l.totalDataSize.Add(size)
 		l.totalFileCount.Add(1) // for data
 		if _, ok := l.dbTableDataTotalSize[db]; !ok {
@@ -1075,7 +1077,7 @@ func (l *Loader) prepareDataFiles(files map[string]struct{}) error {
 	return nil
 }
 
-func (l *Loader) prepare() error {
+func (l *Loader) prepare(ctx context.Context) error {
 	begin := time.Now()
 	defer func() {
 		l.logger.Info("prepare loading", zap.Duration("cost time", time.Since(begin)))
@@ -1089,7 +1091,7 @@ func (l *Loader) prepare() error {
 	l.dbTableDataLastFinishedSize = make(map[string]map[string]*atomic.Int64)
 
 	// check if mydumper dir data exists.
-	if !utils.IsDirExists(l.cfg.Dir) {
+	if storage.IsLocalDiskPath(l.cfg.Dir) && !utils.IsDirExists(l.cfg.Dir) {
 		// compatibility with no `.name` suffix
 		dirSuffix := "." + l.cfg.Name
 		var trimmed bool
@@ -1106,8 +1108,17 @@ func (l *Loader) prepare() error {
 		}
 	}
 
+	// the storage is created after the dir is adjusted above.
+	if l.fileStorage == nil {
+		fileStorage, err := storage.CreateStorage(ctx, l.cfg.Dir)
+		if err != nil {
+			return terror.ErrLoadUnitDumpDirNotFound.Delegate(err, l.cfg.Dir)
+		}
+		l.fileStorage = fileStorage
+	}
+
 	// collect dir files.
-	files, err := utils.CollectDirFiles(l.cfg.Dir)
+	files, err := storage.CollectDirFiles(ctx, l.cfg.Dir, l.fileStorage)
 	if err != nil {
 		return err
 	}
@@ -1126,7 +1137,7 @@ func (l *Loader) prepare() error {
 	}
 
 	// Sql file for create table
-	if err := l.prepareTableFiles(files); err != nil {
+	if err := l.prepareTableFiles(ctx, files); err != nil {
 		return err
 	}
 
@@ -1170,7 +1181,7 @@ func (l *Loader) restoreTable(ctx context.Context, conn *DBConn, sqlFile, schema
 
 // restoreStruture creates schema or table.
 func (l *Loader) restoreStructure(ctx context.Context, conn *DBConn, sqlFile string, schema string, table string) error {
-	f, err := os.Open(sqlFile)
+	f, err := l.fileStorage.Open(ctx, sqlFile)
 	if err != nil {
 		return terror.ErrLoadUnitReadSchemaFile.Delegate(err)
 	}
@@ -1381,7 +1392,7 @@ func (l *Loader) restoreData(ctx context.Context) error {
 
 	// push database schema restoring jobs to the queue
 	for _, db := range dbs {
-		schemaFile := l.cfg.Dir + "/" + db + "-schema-create.sql" // cache friendly
+		schemaFile := db + "-schema-create.sql" // cache friendly
 		err = dbRestoreQueue.push(&restoreSchemaJob{
 			loader:   l,
 			database: db,
@@ -1423,9 +1434,9 @@ func (l *Loader) restoreData(ctx context.Context) error {
 tblSchemaLoop:
 	for _, db := range dbs {
 		for table := range l.db2Tables[db] {
-			schemaFile := l.cfg.Dir + "/" + db + "." + table + "-schema.sql" // cache friendly
+			schemaFile := db + "." + table + "-schema.sql" // cache friendly
 			if _, ok := l.tableInfos[tableName(db, table)]; !ok {
-				l.tableInfos[tableName(db, table)], err = parseTable(tctx, l.tableRouter, db, table, schemaFile, l.cfg.LoaderConfig.SQLMode, l.cfg.SourceID)
+				l.tableInfos[tableName(db, table)], err = parseTable(tctx, l.tableRouter, db, table, schemaFile, l.cfg.LoaderConfig.SQLMode, l.cfg.SourceID, l.fileStorage)
 				if err != nil {
 					err = terror.Annotatef(err, "parse table %s/%s", db, table)
 					break tblSchemaLoop
@@ -14,13 +14,12 @@
 package loader
 
 import (
+	"context"
 	"crypto/sha1"
 	"fmt"
-	"os"
-	"path"
-	"path/filepath"
 	"strings"
 
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
 	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/zap"
 
@@ -28,8 +27,8 @@ import (
 	"github.com/pingcap/ticdc/dm/pkg/dumpling"
 	"github.com/pingcap/ticdc/dm/pkg/ha"
 	"github.com/pingcap/ticdc/dm/pkg/log"
+	"github.com/pingcap/ticdc/dm/pkg/storage"
 	"github.com/pingcap/ticdc/dm/pkg/terror"
-	"github.com/pingcap/ticdc/dm/pkg/utils"
 )
 
 // SQLReplace works like strings.Replace but only supports one replacement.
@@ -84,14 +83,9 @@ func progress(a int64, b int64, finish bool) float64 {
 	return float64(a) / float64(b)
 }
 
-func generateSchemaCreateFile(dir string, schema string) error {
-	file, err := os.Create(path.Join(dir, fmt.Sprintf("%s-schema-create.sql", schema)))
-	if err != nil {
-		return terror.ErrLoadUnitCreateSchemaFile.Delegate(err)
-	}
-	defer file.Close()
-
-	_, err = fmt.Fprintf(file, "CREATE DATABASE `%s`;\n", escapeName(schema))
+func generateSchemaCreateFile(ctx context.Context, fileStorage bstorage.ExternalStorage, schema string) error {
+	content := fmt.Sprintf("CREATE DATABASE `%s`;\n", escapeName(schema))
+	err := fileStorage.WriteFile(ctx, fmt.Sprintf("%s-schema-create.sql", schema), []byte(content))
 	return terror.ErrLoadUnitCreateSchemaFile.Delegate(err)
 }
 
@@ -112,11 +106,16 @@ func getDBAndTableFromFilename(filename string) (string, string, error) {
 	return fields[0], fields[1], nil
 }
 
-func getMydumpMetadata(cli *clientv3.Client, cfg *config.SubTaskConfig, workerName string) (string, string, error) {
-	metafile := filepath.Join(cfg.LoaderConfig.Dir, "metadata")
-	loc, _, err := dumpling.ParseMetaData(metafile, cfg.Flavor)
+func getMydumpMetadata(ctx context.Context, cli *clientv3.Client, cfg *config.SubTaskConfig, workerName string) (string, string, error) {
+	metafile := "metadata"
+	fileStorage, err := storage.CreateStorage(ctx, cfg.LoaderConfig.Dir)
+	if err != nil {
+		return "", "", terror.ErrParseMydumperMeta.Generate(err, "")
+	}
+	loc, _, err := dumpling.ParseMetaData(ctx, cfg.LoaderConfig.Dir, metafile, cfg.Flavor, fileStorage)
 	if err != nil {
-		if os.IsNotExist(err) {
+		// dumped files in external storage can be loaded by any worker, so only check the worker for local disk.
+		if storage.IsNotExistError(err) && storage.IsLocalDiskPath(cfg.LoaderConfig.Dir) {
 			worker, _, err2 := ha.GetLoadTask(cli, cfg.Name, cfg.SourceID)
 			if err2 != nil {
 				log.L().Warn("get load task", log.ShortError(err2))
@@ -130,7 +129,7 @@ func getMydumpMetadata(cli *clientv3.Client, cfg *config.SubTaskConfig, workerNa
 			return "", "", nil
 		}
 
-		toPrint, err2 := os.ReadFile(metafile)
+		toPrint, err2 := fileStorage.ReadFile(ctx, metafile)
 		if err2 != nil {
 			toPrint = []byte(err2.Error())
 		}
@@ -142,16 +141,21 @@ func getMydumpMetadata(cli *clientv3.Client, cfg *config.SubTaskConfig, workerNa
 }
 
 // cleanDumpFiles is called when finish restoring data, to clean useless files.
-func cleanDumpFiles(cfg *config.SubTaskConfig) {
+func cleanDumpFiles(ctx context.Context, cfg *config.SubTaskConfig) {
 	log.L().Info("clean dump files")
 	if cfg.Mode == config.ModeFull {
 		// in full-mode all files won't be need in the future
-		if err := os.RemoveAll(cfg.Dir); err != nil {
+		if err := storage.RemoveAll(ctx, cfg.Dir, nil); err != nil {
 			log.L().Warn("error when remove loaded dump folder", zap.String("data folder", cfg.Dir), zap.Error(err))
 		}
 	} else {
 		// leave metadata file and table structure files, only delete data files
-		files, err := utils.CollectDirFiles(cfg.Dir)
+		fileStorage, err := storage.CreateStorage(ctx, cfg.Dir)
+		if err != nil {
+			log.L().Warn("fail to open dump folder", zap.String("data folder", cfg.Dir), zap.Error(err))
+			return
+		}
+		files, err := storage.CollectDirFiles(ctx, cfg.Dir, fileStorage)
 		if err != nil {
 			log.L().Warn("fail to collect files", zap.String("data folder", cfg.Dir), zap.Error(err))
 		}
@@ -161,7 +165,7 @@ func cleanDumpFiles(cfg *config.SubTaskConfig) {
 				if strings.HasSuffix(f, "-schema-create.sql") || strings.HasSuffix(f, "-schema.sql") {
 					continue
 				}
-				lastErr = os.Remove(filepath.Join(cfg.Dir, f))
+				lastErr = fileStorage.DeleteFile(ctx, f)
 			}
 		}
 		if lastErr != nil {
@@ -172,6 +176,10 @@ func cleanDumpFiles(cfg *config.SubTaskConfig) {
 
 // putLoadTask is called when start restoring data, to put load worker in etcd.
 func putLoadTask(cli *clientv3.Client, cfg *config.SubTaskConfig, workerName string) error {
+	// dumped files in external storage can be loaded by any worker, no need to bound the source to this worker.
+	if !storage.IsLocalDiskPath(cfg.Dir) {
+		return nil
+	}
 	_, err := ha.PutLoadTask(cli, cfg.Name, cfg.SourceID, workerName)
 	if err != nil {
 		return err
@@ -14,6 +14,7 @@
 package loader
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -21,6 +22,8 @@ import (
 	"testing"
 
 	. "github.com/pingcap/check"
+
+	"github.com/pingcap/ticdc/dm/pkg/storage"
 )
 
 func TestClient(t *testing.T) {
@@ -81,8 +84,10 @@ func (t *testUtilSuite) TestGenerateSchemaCreateFile(c *C) {
 			"CREATE DATABASE `loader``test`;\n",
 		},
 	}
+	fileStorage, err := storage.CreateStorage(context.Background(), dir)
+	c.Assert(err, IsNil)
 	for _, testCase := range testCases {
-		err := generateSchemaCreateFile(dir, testCase.schema)
+		err = generateSchemaCreateFile(context.Background(), fileStorage, testCase.schema)
 		c.Assert(err, IsNil)
 
 		file, err := os.Open(path.Join(dir, fmt.Sprintf("%s-schema-create.sql", testCase.schema)))
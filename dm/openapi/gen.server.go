@@ -104,6 +104,12 @@ type ServerInterface interface {
 	// get task status
 	// (GET /api/v1/tasks/{task-name}/status)
 	DMAPIGetTaskStatus(c *gin.Context, taskName string, params DMAPIGetTaskStatusParams)
+	// get row changes mismatched in the downstream of the task
+	// (GET /api/v1/tasks/{task-name}/validation/errors)
+	DMAPIGetTaskValidationErrors(c *gin.Context, taskName string)
+	// get status of the continuous validators of the task
+	// (GET /api/v1/tasks/{task-name}/validation/status)
+	DMAPIGetTaskValidationStatus(c *gin.Context, taskName string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -199,6 +205,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetSourceList(c *gin.Context) {
 
 	// ------------- Optional query parameter "with_status" -------------
 	if paramValue := c.Query("with_status"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "with_status", c.Request.URL.Query(), &params.WithStatus)
@@ -241,6 +248,7 @@ func (siw *ServerInterfaceWrapper) DMAPIDeleteSource(c *gin.Context) {
 
 	// ------------- Optional query parameter "force" -------------
 	if paramValue := c.Query("force"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "force", c.Request.URL.Query(), &params.Force)
@@ -461,6 +469,7 @@ func (siw *ServerInterfaceWrapper) DMAPIDeleteTask(c *gin.Context) {
 
 	// ------------- Optional query parameter "source_name_list" -------------
 	if paramValue := c.Query("source_name_list"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
@@ -742,6 +751,7 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskStatus(c *gin.Context) {
 
 	// ------------- Optional query parameter "source_name_list" -------------
 	if paramValue := c.Query("source_name_list"); paramValue != "" {
+
 	}
 
 	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
@@ -757,6 +767,46 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskStatus(c *gin.Context) {
 	siw.Handler.DMAPIGetTaskStatus(c, taskName, params)
 }
 
+// DMAPIGetTaskValidationErrors operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetTaskValidationErrors(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetTaskValidationErrors(c, taskName)
+}
+
+// DMAPIGetTaskValidationStatus operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetTaskValidationStatus(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetTaskValidationStatus(c, taskName)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL     string
@@ -831,91 +881,103 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/status", wrapper.DMAPIGetTaskStatus)
 
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/validation/errors", wrapper.DMAPIGetTaskValidationErrors)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/validation/status", wrapper.DMAPIGetTaskValidationStatus)
+
 	return router
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9bXPbNpp/Bae7D21HsiTbcRLf7AfHdrO5s52MrU5vp5NTIBCSsAYBGgDtajP+7zt4",
-	"IQmSIEX5JY1a74etQwJ4HjzvLwD1tYd4nHCGmZK9w689iZY4hubPY5pKhcU51P+vHySCJ1gogs1rGEXm",
-	"aYQlEiRRhLPeoXmKpQR8DtQSA5QKgZkCsVkEMB7hXr+Hf4dxQnHvsDfefb0z2hntjA/f7B6Me/2eWiX6",
-	"uVSCsEXvvt+DlNziOhzOKGEYSAVV6qAR6cD4EJRIcb7qjHOKIdPLUgwjHMCfSH8lswc3tMOiDMYG1WJ/",
-	"dpnAxu77PYFvUiJw1Dv8zc7MNptj17dE/pzP5rN/YqQ0KMecX7m4/gOZM+Mpi6aSpwLhabb7MkwzBNgh",
-	"QA/JmXVncK+DjVfyhg5GbQAVXDSD0i/XAjFjQxDqPLRLdOehJn0Z0xChgkwVGCo8gfL6Et+kWKo6YwWO",
-	"+S2exlhBS4A5TKnqHc4hlbhfIcjdEqullmIO7Dyg54EIKjiDEgPCQMTvmFQCwzh/3AuJtof6lBKL2X8J",
-	"PO8d9v5zWJiQobMfwysz/gLG+EyPvu/3FJTX62bprdfo6m/ZLRMi3gmmWGEL9xLLhDOJ6/TT07vvQuNT",
-	"7OE+APVUCC5+JWp5jqUMSqWGDvXfAOuxvX4FI/N0irSA1uaadwBZ4XWwCVN4gYUGbqfGctE0M3ZIrRPd",
-	"YqG+j0+IzO+xKjkGTZpmcmuZ0v8lCsdyHbXLDqegNhQCrsy/uYLUcLFCisp27Li+hd6+CWtAn34TzjA/",
-	"8yastD8h9nbBb4P2lfHdT4q4XfK50ddW4Qlpbo3e86P8tPROZ8Wa3wL7CZxRfKVEilQqWgy8RXCKjCud",
-	"yhtadubHl6dHk1MwOXp3dgq+qPEX8MMXEn0BhKkfxuMfwcXHCbj45ewMHP0y+Tj9cHF8eXp+ejHpf7r8",
-	"cH50+Q/wv6f/sDN+BMOfJv/xG7LqjqMpYRH+/TM4PvvlanJ6eXoCfhr+CE4v3n+4OP3bB8b4yTtwcvrz",
-	"0S9nE3D896PLq9PJ31I1fxPP9sHxx7Ozo8lp9u/pjLBQaOK2Vo9QolkwWFKaZIHh5vn6eMabnq3lUTXE",
-	"qjMOIycRNYdUROqUwwikjKiaK5wTRuQSR9PZSrknXMRQWfE52A86QR0XaIpRvvDEraCC9366UCQKDkoE",
-	"X+jQOPjSiOgGOFXoWNlVeT0PdHkrAcRDJP9oogsc0pA8gqwkAcgEI4oDG5lgYHgLhJtQYwpN5bIUa9r0",
-	"p7zqr4IoLE1aYcVUAzBJxhKj64QTpoDUT6ACJ+cAQWblgCgA5zr7EFgqKBRhCzPNhHnBQPSGThFnCrPA",
-	"3uQNBSuegjvIlLfDUuQfsADgCxoXJiDTUm0G+uAL2m1+tRd+9Qi9/++g4q8Yqm/2lySCGc15okhMpCII",
-	"yCUUkSajlh9tVcEdUUubDTnWcEZXIJU4AndLzAB0oSngCKVC6rSgac2TkzMQl8LRnDUVqff5FBLcT6kI",
-	"RcsCU7gClC8A0sumCUg4JWgFEGdzskhtKF0Pon9PiHBuLBPTUVVGzSAbiitiE9EcXK9f12uWUqpVo5Lw",
-	"e7ZH/ylurZ/L4e4djGqgJ0udbdnBWjATLAiPCIKUrqyKAGKT8oIARAK7ragP3OLgFtIUHwIDQvNJYsRZ",
-	"JB+GvcAxJGwqE4hwaQfjV1X8zwkjcRqDucAYREReAzPL4PD+3UPAh5KpS7339Q7EZ1pZDGwlw/MG5SXS",
-	"xKW6dgCYE6rZYnG3YlXYiR9sHWJG2M5I/2/cB+O3r9/+GFLQEtzcy5SBv598OMkqLxkiJYD4LRzP0e7u",
-	"AKPRm8F4jN8OZrsQDUa7+7sQjcej0WjvcDx4/Wb/bQgHQ5V2FCzhsrKPRujpEUBQoeU0TaZxXjdsLEqY",
-	"sSBNrIXKueN5xLr9t1AiElhZUzYiAiPFxUqbNoHrKiUV1xbK3/fOUKYzs2TI9oZrTRkRrVSWlrtMGdOT",
-	"18VXZWENCpG/3RCHm4ieoR0yvFfGB+RVjbqeWR9hSnWmRtIvMoL1IWclC7jCKBVErepgjGdyZUEpadm+",
-	"9w3f5gTTCNwRSsEMgyWJIsysx1pglUcK/kKlRcBc8NgMMZZ3rq1c3S6VDQjCQk0hpfwOR1PE6mgf8zjm",
-	"DFy4QubV1RnQc8icIGjjuZxYa4kjJZ0i2BzNeAtbU5WN9KUtKLN6Yb2TxqV/9pbT+/h0eg6sGRz+36vR",
-	"W/d3dWvroV7jVTPQ4wKe5koiyK3e2jVeZZYYeMDXwKuGG2VaBmhQRzCoHS7SeS94mgRy5IjmhcPujJ4T",
-	"IdWUcmS9TGiKDvFwtNmyCooFVsGhKdt8wVr6Z1bvF3uubSRH2wMYJKqtKtVNjX1eC+aYSTYLH9axxp1K",
-	"bJyaCbBSbTWMqZTWEIR8rlux7mWWPGgarZVxbZRwrySkGQmU8o6LqHHFfEB5yb39VwfB9bhoxs689NbZ",
-	"2xsdhKK/JAvA28o8NkrX8ukZ8ta6UDau3DNoxNaVF7p1f6yzrSvgYwqDqQwFKQ47/bKGoeBcrbdH3t6d",
-	"ODm+OZCeVPRLEt+sQC0+22uvNftsO2rQzXH7ZGuClwc/oY7G+raE9eWSx1gttTe/EzwUNmVBjsyRaeO3",
-	"n0M8TgYFTihBsEEWbVewYWGd7rnOowsU6QrY9qQri+Smr9pnHIw3lC0fkaDsKCiUoUqHkpCpwQDoQuam",
-	"klCHVCMv5oTSHpDHvA9NPhqyy4ZsqIH7GYo6+Cg60+1oVpLC3e645CmL82a9naF9YUE8JpVZi4EnI536",
-	"nrZ7Vep8+gJYWy4sdzzpLnY8WSt1f8gmSm2OWjhIOYw62iWvNu6dMqhwHcrrzBrVnV93S/aAJHaRS7+r",
-	"vHr+Lg2nszb667j9qxVDxfZN9T+8ff0KGEg+DqYC2g/FuQJLTm9xNDVhKkfX04YSf6vBzo6JBOkXPufR",
-	"bIUzert9BuWqIEdLoUvvGqRpoFPiDJtdN7DZmaYEYQtNlRAIv557tyRomVeFiATZ5I2S2VrprWORLGAt",
-	"EWZqqpKuDSBXA53O8JKwyKs7dZmbZ0mBToN+17qj0ojmHdl+D77Nzth1wMtO6U4DTw8WOnNt47kdUGE7",
-	"FBikbJCt4rO+Va1L6fLalNInhL/JEtf73SpjZfYEmVHVgxCdvBzWV6omsQops2m8Pbag1tSUrWvaxB2k",
-	"qhvPJjMxJ1TTT6QUu8OBRM+C9FNp9LpDCu8IO+OLn81il3qtUA0fsyVkCE/tAc1p1o5fQrbAa7uIXjJv",
-	"UyIg00RnTWDO7WFMd+4ziihIaLogrMu5TNNJtZiUQ7AoHrhjZZXiZP1UnMFAR1xZa62xcVAs2ni4sNnt",
-	"+wIhr8O5GmfTKDW5iQqstuR3mn5LyCJb45tTghSOzE5MrpnGWhn5LRZ3gtjuqDmZ9jnk4rWCT+Pg6TTN",
-	"jzu4MpV8zrUdgAprl+JBSbCUrovY6/eKlmIYmHWptorbRSJtlnpsx+cnHmKyEFDhXN6r1NZy5cYAM6bf",
-	"/aCO0fVzO7miA5W63AbbmJgJJ1DBd1Di7LBkA9UzzF0bNiP0PKVUb4QhgWPM7KEaSM1BjUKooBnUKb4p",
-	"UFij1BWBrO4/yJUqr8NmNWByQpVshY1S6oUlgCrr7lF8i2nNJJIF4wJbJxSoT+jHWfiZC0XLmBJpQRTT",
-	"Lhbc4eAOJ9WPMCRQKSxMZmRNdzMyTcMLvP7/RPBkPVb3DRz4OaXUybvWs0ASUeq58DnQkpjrl5aieoUI",
-	"cSaJVJihQGfImBOmBKcgszCEuXDFNHtsY5wLbdTm5nxsvhqAUqZCy2qZN6niIRLo5cK9RG3pdVIUEVE3",
-	"zTvDDP7UGdXaynbAVC0FhlH5XMJ+1dsYgtkJmn6IMxeVBUM9EjeuPD4ILm1nrF26SQI+MCQ2kwDPCDUI",
-	"gMAJnc6gQuWTReP6yQl/LR2pLQVn5F85KLMGwL9jlJpHWh9uUsgUMaDCxx4S2pF81Y08mIbN0WHu/Ftj",
-	"w6ZQIBQbFk6xXrCopCoFiNHeHI12D/YGu2/Q68F4jF8P4MGrvcEBGs3e7Eev3s73RofjwevR/nh/d68/",
-	"erX/ej/aQ97wN3uvdge7o71otrt/EEV70eF4MH49Ct7iKJflvFsZ5oU7b9EyM+FlCu0Hc7vnKf22FGOb",
-	"vFgpTGlAZSAwhdqitR900gqdu1LkeLwuvqja8HsbJ2y8TtUSlEO2RiJXd9Q52PIkeV1q6ePRxIZa7NZ8",
-	"QMgGiYr792P8kFF2TLUq1ti8NAtkkhfQdv26m7bL1r5qR4ny86KGtLUP7giNEBRRlo+VE57Z4KdHVixr",
-	"TaqmSqayde5wUN8BVxXEtbXB4giUwQ5JV9GJb8ojn5IZEccSMK7y5DjbsaywZfxACnYEoGYdzOM64gVJ",
-	"36LCpUypheBF4t5O8W3s9G/W6H9I6/6ZuuLtffAQ0ytNnLbCfUsA1dxarRvVAmJj48p1qCTIlFpx1+6V",
-	"bV2rdW2HB7SC25u/9yYR0bkhpCccBdLHk3PwMcHs6NMHcPLxWPNE0N5hb6lUIg+Hw4gjuZMQtkAw2UE8",
-	"Hv5rOVQkmg20cg2sQyScDaXVbhNXzLkpghNldlIDcIuFtLBf7eztjEw9K8EMJqR32NvTmmVEQi0NtkOY",
-	"kOHteOiu2QxtFda8cgY3v175ITLgjj59CF1RNCVme2fIzN4djVwimp1Vg4mtYOj9/FPaY1qFOW5TnNYr",
-	"kYYJFTVKEdJW5b7f239CNGpXUQOg55BQHBkxkmkcQ7HqHWpKAkdg/y54pk8KLqSWNTek91nPbmDM8Kv9",
-	"w/jveytvFNsyZYBTH+dzShi2ZLuwtaYEChhjy+XfasUvD70sgtLPtcD0svpqz8Oh5+uLrQ8X1OxyT/9z",
-	"TXD2A4bxO+Mot3St3OzvxMjMjnXUsOL+7LfRsMB93S3TMO+LBBtpmGPM8KtzDhtpmHNqHTTMR69Zwzwc",
-	"/toaVv6+RCsjo3gnQy6oWe+xOuHof64+XjSoUhktvVZ+IrwubhFHwIArsIo4qmDkYoIWdP4+OT/rhI4e",
-	"uAadpbLl8SZ0bHi53vQUt97XCbOG7IJWc8UkP7BoRPomxWLlyTRRy2k+IiDD4fZiQH6f1PAF7vgHhNS/",
-	"BUGz404VFlSHFKzIsi6Tccgm0tvPk1xlJxddFPyOR6sn22/2/YH6Bh00MNPg7mskH38DFL43G2RvYwOG",
-	"73zehthaV7LhV6/Qst6N+B9XWat0lM/MvcaUkZu0fEGn2aOU6z6dPErjSfH7fq3yxu15ZZ7YOj6k0p06",
-	"zE5VmjTO9SpC1sGs8Ei7sP9kMhP82M0WiKwVMgAfK7DDBKbSVjiN8WmxWp/0SHMQdQsE93MXV/u9MdXw",
-	"wjuaPE+ZPdmbHdp5LLMFlmncjduXZugLu5+R3ZYbz8lv7yOEHQJBe6G1Szj4DMxtvlPzrHFh5RLvlqTA",
-	"2bUh28xoikG7isfwq/2jCGE6CIvpAX5/stJvafg0gC/23hF8sB/0rFJaPhi7XUJq+2EPl1EFherksYr7",
-	"WdvisJ4h7avdUbsvdzo0svfb6CzdMebndJb5NZIuvjK/sfn9CFrrYZtvUlypfBlvSwyV/wlf/zvITyFS",
-	"POlou9wdv7+y6apcc/yzWK6IyOc2XUpAJufu+9XNUjZxw7am/PRMolY/mfBnkbVMEPLgiwNovzVm+ytr",
-	"pMvW7dZ5wOyzrc/cqKx9HTZABFODpO7D2N+PQ8mxKshtP7fd3hcw0dvE3j97Drmvfxb9j+wPuG+Ub0t3",
-	"ANpP4guVf1KzzNmqGg2/mtPmm7QFHOs3ssr+nbeAOc5x6GiMm07IBxLrwKdSAuX+2vfufbiP+rbKdva7",
-	"s8K9kSbXRdlMmGzJvkux/nuWp8/P2ff0SyX329sJeIBs2Jpyp9r+i3Rsq3S4xsEDxOORbYK8QfBupaXn",
-	"iEUPSyW+B6f10rj4oyLj1u7Fo6V4w25G3sd4EemX/srW6lKwyfLEqqTnzSjeMKPxf0rhRae+M53qN19e",
-	"ayJ5JgGdad7wEynbnLzVNU96Il6r+Kz3Py8a8qIh37pj1/JbUFvrAFvVMEmb1DD/7Z8XVdwY+F9FEZ++",
-	"GLH2F6f+LE2p4uexNtDX9qi121EN74uzf6Wq+kYVsG/gZbb0VIiR1kx6qtJpPgcgbjNpKt/2XvF0J+Ix",
-	"JMzc9e5pIrsFGn+5of16ecTRI++UD29Sgq4H9jidbWkNZP4jpSWx6oWMrfuW8TdB0qGXvx0o98uenvoF",
-	"kMwuC+bjsgf3n+//HQAA//8aVdY3n30AAA==",
+	"H4sIAAAAAAAC/+w9a1PjuJZ/Revdqp2ZSkgCNN3N1v1AA9O3d4HuAnpnb82wQbGVRBdZMpIMnenKf7+l",
+	"h23Zlh2H18AM98MdOpZ1js5L5yX5exCyOGEUUSmC3e+BCOcohvrPfZIKifgxVP+vfkg4SxCXGOnHMIr0",
+	"rxESIceJxIwGu/pXJARgUyDnCIQp54hKEOtJAGURCnoB+gbjhKBgNxhtvt0Ybgw3RrvvNndGQS+Qi0T9",
+	"LiTHdBYsewEk+AbV4TBKMEVASChTCw0LC8aFIHmK8lknjBEEqZqWIBghD/5YuDPpNdihHSalMNaoFusz",
+	"03gWtuwFHF2nmKMo2P3VvJktNseuZ4h8kb/NJv9EoVSgLHN+YfzqD2TOhKU0GguW8hCNs9WXYeohwAwB",
+	"akjOrFuNex1svBDXpD9sAyjhrBmUergSiB7rg1DnoZmiOw8V6cuY+gjlZSpHUKJzKK5O0XWKhKwzlqOY",
+	"3aBxjCQ0BJjClMhgdwqJQL0KQW7nSM6VFDNg3gPqPRBBCSdQIIApiNgtFZIjGOc/Bz7RdlAfE2ww+w+O",
+	"psFu8O+DwoQMrP0YnOnxJzBGR2r0shdIKK5WvaWWXqOru2Q7jY94B4ggiQzcUyQSRgWq00+93n0VCp9i",
+	"DUsP1EPOGf8Fy/kxEsIrlQo6VH8DpMYGvQpG+tdxyCLPu/oZCI3wWtiYSjRDXAE3r8Zi1vRmbJFaJbrF",
+	"RD0XHx+ZPyJZ2hgUaZrJrWRK/RdLFItV1C7NGxTUhpzDhf43k5BoLlZIUVmOGdcz0NsXYQzowy/CzPvY",
+	"izDS/oDYmwmfBu0zvXc/KOJmysdGX1mFB6S5MXqPj/LD0judFHM+BfbncELQmeRpKFPeYuANguNQb6Vj",
+	"cU3Km/n+6eHe+SE43/twdAgu5egS/HCJo0uAqfxhNPoRnHw+Bydfj47A3tfzz+NPJ/unh8eHJ+e9L6ef",
+	"jvdO/wH+5/Af5o0fweCn83/7NTTqjqIxphH6dgH2j76enR+eHh6AnwY/gsOTj59ODv/2iVJ28AEcHP68",
+	"9/XoHOz/fe/07PD8b6mcvosn22D/89HR3vlh9u/xBFOfa2KXVvdQoonXWZKKZJ7h+vfV/ozzejaXQ9UW",
+	"Vv0vJDjSW57eHR9K5CrTPoXQFSAfVHmq0z7qUo4YjCyYmpdQhE+EwQikFMuafzLFFIs5isaThbS/MB5D",
+	"aXDa2fZ6JjGSUIkxYTNnDYVoOs/HM4kj76CEsxlHQngf6nWvgVOFbJVVledzQJeX4kHcR/LP2uVDPrOV",
+	"u/WVyCxUfyhH3biLCGiFA9y+UGMKScW8FACYmLQ86y8cSyR0rGdETwFQ/wrnKLxKGKYSCPULlODgGISQ",
+	"GjnAEsCpRBxwJCTkEtOZfk373t7o4JqMQ0Ylop61iWsCFiwFt5BKZ4VBr90sg8twVNjlzHQq29wDl+Fm",
+	"86Mt/6N7GOP/8lrjBQ3ri/2aRDCjOUskjrGQOARiDnmkyKjkR6kquMVybkJUyxpGyQKkAkXgdo4ogDZe",
+	"ACwMUy5UrNY058HBEYhLMULOmorUu3zyCe6XlPtCGI4IXADCZiBU06YJSBjB4QKEjE7xLDXxTT2y+ZZg",
+	"jkRJTIdVGdWDTHwksckO5OCCXl2vaUqIUo1KFsaxPepPfgNJCe7WzrAG+nyOQDZYCWaCOGYRDiEhC6Mi",
+	"AJtMSUEALIBZVtQDdnJwA0mKdoEGofgkUMhoJO6GPUcxxHQsEhii0gpGb6r4H2OK4zQGU44QiLC4Avot",
+	"jcPHD3cB74twT9XaV28gLtPKYmDSS85uUJ4iTWz+wQwAU0wUWwzuRqwKO/GDSQ5NMN0Yqv+NemD0/u37",
+	"H30KWoKb7zJl4B/PPx1k6bAMkRJA9B6OpuHmZh+Fw3f90Qi97082Ydgfbm5vwnA0Gg6HW7uj/tt32+99",
+	"OGiqtKNgCJfl4hRCD49ACGU4H6fJOM6TuY2ZIj0WpImxUDl3nB2xbv8NlAh7ZlaUjTBHoWR8oUwbR3WV",
+	"EpJxFJXWvTEQ6URP6bO9/gRgRkQjlaXpTlNK1curnN6ysHqFyF2uj8NNRM/Q9hneM70H5Kmmup6ZPULn",
+	"T3Xiqld4mqvjgIpreYbClGO5qIPRO5PN1QpByva9p/k2xYhE4BYTAiYIzHEUIWp2rBmSuafgTlSaBEw5",
+	"i/UQbXmnMEQeu1Q2ICHicgwJYbcoGoe0jvY+i2NGwYnNLp+dHQH1Dp7iEBp/LifWSuIIQcYhbPZmnImN",
+	"qcpGutLmlVk1sVpJ49Q/O9OpdXw5PAbGDA7+783wvf27urTVUK/QohnofgFPcSXh+EYt7QotMksMHOAr",
+	"4FXdjTItPTSoI+jVDuvpfOQsTTyxV0TybG53Rk8xF3JMWGh2md3vfhcPRetNKyGfIekdmtL1J6zF5Hr2",
+	"XrHm2kJytB2AXqJq7fSYGvN7zZmjOgNQ7GEdCw+pQHpTU38nqbIa2lQKYwh8e66dsb7LzJmQTfgCW9vy",
+	"F7B8mpFAIW4ZjxpnzAeUp9zafrPjnY/xZuz0Q2eera3hjs/7SzIHvC19YLx0JZ+OIW9N1mXjyoWcRmz1",
+	"w64lObPZ1hXwPtnaVCDeiJ16WMOQMyZX2yNn7VacLN8sSEcqeiWJb1aglj3bqXk279lmVL/bxu2SrQle",
+	"7vz4ykyra0VmLxcsRnKudvNbznxuU+bkiByZNn67McT9ZJCjhOAQNsiiKdU2TKzCPTMg87bJApiasU2L",
+	"5KavWvztj9aULRcRr+xIyKWmSoeUkM7BAGhd5qaUUIdQI0/m+MIekPu8dw0+GqLLhmiogfsZisr5KNoF",
+	"2tGsBIWb3XHJQxa7mwUbA/PAgLhPKLMSA0dGOhWjTUmxVI52BbA2nV/uWNJd7FiyUur+kEWUak81d1Cl",
+	"MDvaJSc37rR+VLgOxVVmjeqbX3dLdocgdpZLv828Ovtd6g9njffXcflnCxoWy9fZf//y1SOgIbk4KEg+",
+	"DFLKkWDkBkVj7aay8GrckOJvNdhZ746Xfv7mm2YrnNHbrtMrVwU5WhJdatUgTT2VEmvYzLyexU4UJTCd",
+	"Kar4QLj53Ns5Dud5VggLkL28VjBbS711TJJ5rGWIqBzLpGsByOZAxxM0xzRy8k5d3s2jJE+lQT1rXVFp",
+	"RPOKTL0H3WSNjx3wMq90p4GjBzMVubbx3AyosB1yBFLaz2ZxWd+q1qVweWVI6RLCXWSJ671umbEye7zM",
+	"qOqBj05ODOsqVZNY+ZRZF97um1BrqpTXNe3cdrfVjWeTmZhioujHU4JsxyZWb0HypTR6VefIB0yP2Oxn",
+	"PdmpmsuXw0d0DmmIxqZrdpz1SMwhnaGVVUQnmDchERBpoqImlRMyRSk9LYgiAhKSzjDt0iyrK6kGkxIK",
+	"QRT3ba9fJTlZb1XUGAjJeFZaaywcFJM2dnw2b/uuQIgrf6zG6DhKdWwiPbPN2a2i3xzSyOT4pgSHEkV6",
+	"JQoCTWOljOwG8VuOTXVUdzlceGBpqzGOvS2Dih+3cKGghYwpOwAlUluKAyVBQtgqYtALipKiH5jZUk0W",
+	"t4tEmih134zP21BiPONQolzeq9RWcmXHAD2m1717Suv6sXm5ogOVvNwayzjXLxxACT9AgbIO1gaqZ5jH",
+	"ts/YEnqaEqIWQkOOYkRNpwYkunumECqoB3XybwoUVih1RSCr6/dypcprv1n1mBxfJlsirZRqYgGgzKp7",
+	"BN0gUjOJeEYZR2YTqs+mf87cz1woWsaUSAuimHSx4BYH2zFWb2FIoJSI68jImO5mZJqGF3j9/wFnyWqs",
+	"lg0c+DklxMq70rM6BuWaC5sCJYm5fikpqmeIQkYFFhLR0FMZ0uaESs4IyCwMptZd0cUeUxhnXBm1qW5a",
+	"zmcDUIiUK1kt8yaVzEcCNZ2/lqgsvQqKIszrpnljkMEfW6Nam9kMGMs5RzAq9yVsV3cbTTDzgqJfyKj1",
+	"yryuHo4bZx7teKfGcaepmyTgEw35ehLgGKEGAeAoIeOJqlqWF1DvnHDnUp7anDOKf89B6TkA+obCVP+k",
+	"9OE6hVRiDcrf9pCQjuSrLuTONGz2DvPNv9U3bHIFfL5hsSnWExaVUKUAMdyahsPNna3+5rvwrUq/ve3D",
+	"nTdb/Z1wOHm3Hb15P90aqvTbcHu0vbnVG77ZfrsdbYXO8Hdbbzb7m8OtaLK5vRNFW9HuqD96O/QerSmn",
+	"5QoszAPbb9HyZsLKFNr2xnaPk/ptScY27WIlN6UBlT5HBCqL1t7opBQ630pDy+NV/kXVhi+Nn7D2PFVL",
+	"UHbZGolcXVFnZ8uR5FWhpYtHExtqvltzg5BxEiVzDy25LqPoGGpVrLF+qCfIJM+j7epxN20XrXXVjhLl",
+	"xkUNYWtPNWFEIeRRFo+VA55J/6d7ZixrRaqmTKY0eW6/U98BV+nFtbXAYgmUwfZJV1GJb4ojH5IZEUMC",
+	"UCbz4DhbsaiwZXRHCnYEICcdzOMq4nlJ36LCpUipheBF4N5O8ZdY6V+v0H+X0v0jVcXb6+A+pldPYtSP",
+	"H4cyhWScHYooI8zZbdbN7EgGpuC/zz6f9ACKE7nIG2/ZrRZ8+p8SoG/GF/O59SjM/f4avOwxsIAbwGAB",
+	"IkSQRN5jwcY165D05Uhy1c2UUuk7vdFTy1cNT3U8E45jyBe69Yo5eBmMS3z9/luAo9+C3d+C0W/B8sEL",
+	"U+bd3CYVcC+jyehy41JOyOiyuRfEw3JFFJUOYBxMISaVXs/ssd8y6rzFHZCR2Ld2AoU0neYO42+MQPtY",
+	"X1EWk+Uub0UuuSoIF+yuimmvpCQ56coCZBfRroNNdVDT8aHg59JYV0STLhJASKwTBCJWsRuK7AEQS6O+",
+	"nqsfqVKwr6+8LuX+NNlNjnSeKcvZaXNldWuLqCpmdF3ILcSmC5Wp/tScsUryFGUxirotIOEsREKgqCvg",
+	"AhQUIKNizc51Ay6ucJJ0B628ggK8wDRUbjIDMaQL4A6EHAFLz46IPFF9W6STWom7sWW7V5ziX6tcX+ul",
+	"zCaparSVzaxg7BMFn1z2airn46RPmStdEW2V8JaMRHOvUj1KKSA2doLYlg8BMi9ZMts/JdraQFbV8e/Q",
+	"W9XeTbXULoBEnEJywELP9nNwDD4niO59+QQOPu8HvSDlJNgN5lImYncwiFgoNhJMZyFMNkIWD36fDySO",
+	"Jn1lmvsmwsSMDoSx6TpQnzIFRmJJkA/ADeLCwH6zsbUxVO+wBFGY4GA32FKuqvax5FxjO4AJHtyMBvYw",
+	"8cCUNfUjG8Hkl0h8ijS4vS+ffBcx6O3DHFHVb28OhzazmzV/w8SUBNR6/ilM33MR37R5oq0XP2gmVKxD",
+	"GiqtUUvffkA0ahdueEBbF0M9EGmsnKpgV1ESWAK7N95k+iThTChZs0OCC/V2A2MG380fOiBeGnkjSKIG",
+	"Tn2eTlWFxpDtxNiWBHIYI8PlX2vVJAe9LCWhflcCE2QFy8DBIXD1xRRcC2p2uY3ooiY42x57/8w4ygxd",
+	"K/cXdWJkZsc6alhxS8jTaJjnVpIXpmHOvUtraZhlzOC73RzW0jC7qXXQMBe9Zg1zcPhra1j5Fq1WRkbx",
+	"RoacV7M+InnAQhvR+lSpjJaaKz9iVRe3iIVAgyuwilhYwcj6BC3o/P38+KgTOmrgCnTmMiZt6BhXc7Xp",
+	"Ke72WSXMCrL1xfWZzTyU1CJ9nSK+cGQay/k4H+GRYX+/zvLCT56HMnyem4w8QuoeKyRYeFlQHVKwIktj",
+	"6hSeaCK9uYTN4GO1Hgn5gUWLB1uvndyzQAsNTBS4ZY3koydA4bnZIHPnDKDo1uWtj611JRt8dyoXq7cR",
+	"9wq5lUpH2ERfFJBSfJ2WT7w27yjlQkqnHaXx6NWyVytlMXMAiCWmMA6JsG382TEFHcbZ4r/POugZ7mkX",
+	"th9MZrxX+r0AkTVCBuB9BXaQwFSYkqE2Pi1W64saeWrzc89dcC+6bLXPjamaF85Zn2lKzVGZrAv2vszm",
+	"SKRxN26f6qGv7H5EdhtuPCa/nauWOziC5oaILu7gIzC3+ZDqo/qFlVsxXkgIbOlv5mr0QbuKx+C7+aNw",
+	"YToIi26qeX6y0mvpoGgAX6y9I/ho8tRSWj5p8rKE1DSY3F1GJeSy045VHHh+KRvWI4R9tUPfy+Wyiuzy",
+	"JW6W9lzQY26WeZG7y16ZX4HwfASttXv1SZIrlStMX4ihcj9U4H7t4SFEiiUdbZc9NP9XNl2VewP+LJYr",
+	"wuKxTZfkkIop4iuk7NwOezHpp0cStXpnwp9F1jJByJ0vBqC5vNPUV1ZIl8nbrdoBs8vpH7lQWbsD30ME",
+	"nYMk9vMfz2dDybEqyG0+KtJeF9Dem1r2IxUF6h9/+SPrA/ZLLC+lOgDNh3+4zO+oLnO2qkaD7+o/a5UF",
+	"LOvXsspuM5rHHOc4dDTGTUfOPIG15+4xT7q/9lUfF+69Lit7mfXuLHFPI1s2WVuYTMq+S7L+OcvTxWPW",
+	"Pd1UyfLlVgLuIBsmp9wpt/8qHS9VOmzh4A7icc8yQV4g+LBQ0rNHo7uFEs9h03otXPxRnnFr9eLeUrxm",
+	"NSOvY7yK9Gt95cXqkrfI8sCqpN6bELRmRON+m+hVp56ZTvWaT4M3kTyTgM40b/gQ3EsO3uqaJxwRr2V8",
+	"Vu8/rxryqiFPXbFr+eLli90AW9UwSZvUMP+Y3qsqrg38r6KID5+MWPkJxz9LUar43uQa+trutXZr1XCu",
+	"cP8rZdXXyoA9wS7zQrtCtLRm0rOOdBZXUAz0af1uglr9pPGzTs0+rsA0ft35hYiOex+Gc99J/Uai8qcX",
+	"7ihjaxjD2o0urzJW++72C5GxcqOawgfTlKX5JTGMixXipaZE/CbjfPnCigVLNyIWQ0z1dRXB8iKfoPFr",
+	"bu03ZEQsvOe1GIPrFIdXfdMRbKryfQt8WdkZA5+/KK6eDkmLXv60r8EvS6riQTI775yPy35YXiz/NQCj",
+	"ip2zSIsAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -127,6 +127,18 @@ type GetTaskTableStructureResponse struct {
 	TableName       string  `json:"table_name"`
 }
 
+// GetTaskValidationErrorsResponse defines model for GetTaskValidationErrorsResponse.
+type GetTaskValidationErrorsResponse struct {
+	Data  []ValidationError `json:"data"`
+	Total int               `json:"total"`
+}
+
+// GetTaskValidationStatusResponse defines model for GetTaskValidationStatusResponse.
+type GetTaskValidationStatusResponse struct {
+	Data  []ValidationStatus `json:"data"`
+	Total int                `json:"total"`
+}
+
 // status of load unit
 type LoadStatus struct {
 	FinishedBytes  int64  `json:"finished_bytes"`
@@ -472,6 +484,58 @@ type TaskTargetDataBase struct {
 	User string `json:"user"`
 }
 
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	// row in the downstream in JSON, empty if the row doesn't exist
+	ActualData string `json:"actual_data"`
+
+	// expected row in JSON, empty if the row is deleted
+	ExpectedData string `json:"expected_data"`
+	Id           int64  `json:"id"`
+	RetryCount   int    `json:"retry_count"`
+
+	// primary key of the row in JSON
+	RowKey string `json:"row_key"`
+
+	// source name
+	SourceName  string `json:"source_name"`
+	SourceTable string `json:"source_table"`
+
+	// retrying or failed
+	Status      string `json:"status"`
+	TargetTable string `json:"target_table"`
+
+	// last time the row is validated
+	Time string `json:"time"`
+}
+
+// ValidationStatus defines model for ValidationStatus.
+type ValidationStatus struct {
+	// row changes still mismatched after the row-error-delay
+	ErrorRowCount int64 `json:"error_row_count"`
+
+	// validation mode
+	Mode string `json:"mode"`
+
+	// row changes waiting to be validated or retried
+	PendingRowCount int64 `json:"pending_row_count"`
+
+	// row changes validated as matched in the downstream
+	ProcessedRowCount int64 `json:"processed_row_count"`
+
+	// row changes not validated since too many row changes are pending
+	SkippedRowCount int64 `json:"skipped_row_count"`
+
+	// source name
+	SourceName string `json:"source_name"`
+
+	// current stage of the subtask
+	Stage string `json:"stage"`
+
+	// task name
+	TaskName string `json:"task_name"`
+}
+
 // worker name list
 type WorkerNameList []string
 
@@ -32,3 +32,9 @@ func SyncerShardMeta(task string) string {
 func SyncerOnlineDDL(task string) string {
 	return task + "_onlineddl"
 }
+
+// ValidatorErrorChange returns the table name of the error changes found by
+// the continuous validator.
+func ValidatorErrorChange(task string) string {
+	return task + "_validator_error_change"
+}
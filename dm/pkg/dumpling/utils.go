@@ -15,30 +15,40 @@ package dumpling
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"fmt"
 	"io"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/docker/go-units"
 	"github.com/go-mysql-org/go-mysql/mysql"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
 
 	"github.com/pingcap/ticdc/dm/pkg/binlog"
 	"github.com/pingcap/ticdc/dm/pkg/gtid"
+	"github.com/pingcap/ticdc/dm/pkg/storage"
 	"github.com/pingcap/ticdc/dm/pkg/terror"
 )
 
 // ParseMetaData parses mydumper's output meta file and returns binlog location.
 // since v2.0.0, dumpling maybe configured to output master status after connection pool is established,
 // we return this location as well.
-func ParseMetaData(filename, flavor string) (*binlog.Location, *binlog.Location, error) {
+// the file is read from fileStorage, or from a storage created from dir if fileStorage is nil.
+func ParseMetaData(ctx context.Context, dir, filename, flavor string, fileStorage bstorage.ExternalStorage) (*binlog.Location, *binlog.Location, error) {
 	invalidErr := fmt.Errorf("file %s invalid format", filename)
-	fd, err := os.Open(filename)
+	var err error
+	if fileStorage == nil {
+		fileStorage, err = storage.CreateStorage(ctx, dir)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	content, err := fileStorage.ReadFile(ctx, filename)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer fd.Close()
 
 	var (
 		pos          mysql.Position
@@ -51,7 +61,7 @@ func ParseMetaData(filename, flavor string) (*binlog.Location, *binlog.Location,
 		locPtr2 *binlog.Location
 	)
 
-	br := bufio.NewReader(fd)
+	br := bufio.NewReader(bytes.NewReader(content))
 
 	parsePosAndGTID := func(pos *mysql.Position, gtid *string) error {
 		for {
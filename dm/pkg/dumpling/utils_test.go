@@ -14,7 +14,9 @@
 package dumpling
 
 import (
+	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
@@ -233,7 +235,7 @@ Finished dump at: 2020-09-30 12:16:49
 	for _, tc := range testCases {
 		err2 := os.WriteFile(f.Name(), []byte(tc.source), 0o644)
 		c.Assert(err2, IsNil)
-		loc, loc2, err2 := ParseMetaData(f.Name(), "mysql")
+		loc, loc2, err2 := ParseMetaData(context.Background(), filepath.Dir(f.Name()), filepath.Base(f.Name()), "mysql", nil)
 		c.Assert(err2, IsNil)
 		c.Assert(loc.Position, DeepEquals, tc.pos)
 		gs, _ := gtid.ParserGTID("mysql", tc.gsetStr)
@@ -252,6 +254,6 @@ Finished dump at: 2020-12-02 17:13:56
 `
 	err = os.WriteFile(f.Name(), []byte(noBinlogLoc), 0o644)
 	c.Assert(err, IsNil)
-	_, _, err = ParseMetaData(f.Name(), "mysql")
+	_, _, err = ParseMetaData(context.Background(), filepath.Dir(f.Name()), filepath.Base(f.Name()), "mysql", nil)
 	c.Assert(terror.ErrMetadataNoBinlogLoc.Equal(err), IsTrue)
 }
@@ -0,0 +1,148 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pingcap/errors"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
+)
+
+// IsLocalDiskPath returns whether the path is a local disk path without scheme, like `./dumped_data`.
+// `file://` or `local://` URLs are treated as external storage, which is shared by the DM-workers.
+func IsLocalDiskPath(rawURL string) bool {
+	u, err := bstorage.ParseRawURL(rawURL)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == ""
+}
+
+// IsS3Path returns whether the path is a S3 URL.
+func IsS3Path(rawURL string) bool {
+	u, err := bstorage.ParseRawURL(rawURL)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "s3"
+}
+
+// AdjustPath adds uniqueID as the suffix of the path (before the query of the URL).
+// the path with the suffix is not adjusted again, so it's safe to call it multiple times.
+func AdjustPath(rawURL string, uniqueID string) (string, error) {
+	suffix := "." + uniqueID
+	if IsLocalDiskPath(rawURL) {
+		if strings.HasSuffix(rawURL, suffix) {
+			return rawURL, nil
+		}
+		return rawURL + suffix, nil
+	}
+
+	u, err := bstorage.ParseRawURL(rawURL)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	p := strings.TrimRight(u.Path, "/")
+	if !strings.HasSuffix(p, suffix) {
+		u.Path = p + suffix
+	}
+	return u.String(), nil
+}
+
+// TrimPath removes the suffix uniqueID added by AdjustPath.
+func TrimPath(rawURL string, uniqueID string) (string, error) {
+	suffix := "." + uniqueID
+	if IsLocalDiskPath(rawURL) {
+		return strings.TrimSuffix(rawURL, suffix), nil
+	}
+
+	u, err := bstorage.ParseRawURL(rawURL)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	u.Path = strings.TrimSuffix(u.Path, suffix)
+	return u.String(), nil
+}
+
+// CreateStorage creates an external storage for the path, local disk path is created if not exists.
+func CreateStorage(ctx context.Context, rawURL string) (bstorage.ExternalStorage, error) {
+	backend, err := bstorage.ParseBackend(rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return bstorage.New(ctx, backend, &bstorage.ExternalStorageOptions{})
+}
+
+// CollectDirFiles returns the names and sizes of the files directly in the storage.
+// if fileStorage is nil, a storage is created from the path.
+func CollectDirFiles(ctx context.Context, rawURL string, fileStorage bstorage.ExternalStorage) (map[string]int64, error) {
+	var err error
+	if fileStorage == nil {
+		fileStorage, err = CreateStorage(ctx, rawURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	files := make(map[string]int64)
+	err = fileStorage.WalkDir(ctx, &bstorage.WalkOption{}, func(filePath string, size int64) error {
+		name := path.Base(filePath)
+		if name != filePath {
+			// only the files in the top level are collected, same as the files output by dumpling.
+			return nil
+		}
+		files[name] = size
+		return nil
+	})
+	return files, err
+}
+
+// RemoveAll removes all the files in the path, and the path itself if it's a local disk path.
+// if fileStorage is nil, a storage is created from the path.
+func RemoveAll(ctx context.Context, rawURL string, fileStorage bstorage.ExternalStorage) error {
+	if IsLocalDiskPath(rawURL) {
+		return os.RemoveAll(rawURL)
+	}
+
+	var err error
+	if fileStorage == nil {
+		fileStorage, err = CreateStorage(ctx, rawURL)
+		if err != nil {
+			return err
+		}
+	}
+	return fileStorage.WalkDir(ctx, &bstorage.WalkOption{}, func(filePath string, size int64) error {
+		return fileStorage.DeleteFile(ctx, filePath)
+	})
+}
+
+// IsNotExistError returns whether the error means the file doesn't exist, in local disk or S3.
+func IsNotExistError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if os.IsNotExist(errors.Cause(err)) {
+		return true
+	}
+	if aerr, ok := errors.Cause(err).(awserr.Error); ok { // nolint:errorlint
+		return aerr.Code() == s3.ErrCodeNoSuchKey
+	}
+	return false
+}
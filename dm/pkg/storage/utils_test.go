@@ -0,0 +1,105 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
+)
+
+func TestSuite(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testStorageSuite{})
+
+type testStorageSuite struct{}
+
+func (t *testStorageSuite) TestAdjustAndTrimPath(c *C) {
+	cases := []struct {
+		rawURL   string
+		adjusted string
+		isLocal  bool
+	}{
+		{"./dumped_data", "./dumped_data.task", true},
+		{"/tmp/dumped_data", "/tmp/dumped_data.task", true},
+		{"file:///tmp/dumped_data", "file:///tmp/dumped_data.task", false},
+		{"s3://bucket/dumped_data", "s3://bucket/dumped_data.task", false},
+		{"s3://bucket/dumped_data/", "s3://bucket/dumped_data.task", false},
+		{
+			"s3://bucket/dumped_data?endpoint=http://127.0.0.1:9000&access-key=ak",
+			"s3://bucket/dumped_data.task?endpoint=http://127.0.0.1:9000&access-key=ak",
+			false,
+		},
+	}
+	for _, cs := range cases {
+		c.Assert(IsLocalDiskPath(cs.rawURL), Equals, cs.isLocal)
+		adjusted, err := AdjustPath(cs.rawURL, "task")
+		c.Assert(err, IsNil)
+		c.Assert(adjusted, Equals, cs.adjusted)
+		// adjust again is a no-op.
+		adjusted2, err := AdjustPath(adjusted, "task")
+		c.Assert(err, IsNil)
+		c.Assert(adjusted2, Equals, adjusted)
+
+		trimmed, err := TrimPath(adjusted, "task")
+		c.Assert(err, IsNil)
+		adjusted3, err := AdjustPath(trimmed, "task")
+		c.Assert(err, IsNil)
+		c.Assert(adjusted3, Equals, adjusted)
+	}
+	c.Assert(IsS3Path("s3://bucket/dumped_data"), IsTrue)
+	c.Assert(IsS3Path("./dumped_data"), IsFalse)
+}
+
+func (t *testStorageSuite) TestCollectAndRemove(c *C) {
+	ctx := context.Background()
+	dir := c.MkDir()
+	c.Assert(os.WriteFile(filepath.Join(dir, "metadata"), []byte("meta"), 0o644), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "db.tbl.0.sql"), []byte("INSERT"), 0o644), IsNil)
+	c.Assert(os.Mkdir(filepath.Join(dir, "sub"), 0o755), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "sub", "ignored.sql"), []byte("x"), 0o644), IsNil)
+
+	for _, rawURL := range []string{dir, "file://" + dir} {
+		files, err := CollectDirFiles(ctx, rawURL, nil)
+		c.Assert(err, IsNil)
+		c.Assert(files, DeepEquals, map[string]int64{"metadata": 4, "db.tbl.0.sql": 6})
+	}
+
+	// the directory is kept for external storage.
+	c.Assert(RemoveAll(ctx, "file://"+dir, nil), IsNil)
+	_, err := os.Stat(dir)
+	c.Assert(err, IsNil)
+	files, err := CollectDirFiles(ctx, dir, nil)
+	c.Assert(err, IsNil)
+	c.Assert(files, HasLen, 0)
+
+	c.Assert(RemoveAll(ctx, dir, nil), IsNil)
+	_, err = os.Stat(dir)
+	c.Assert(IsNotExistError(err), IsTrue)
+}
+
+func (t *testStorageSuite) TestIsNotExistError(c *C) {
+	c.Assert(IsNotExistError(nil), IsFalse)
+	c.Assert(IsNotExistError(errors.New("other error")), IsFalse)
+	c.Assert(IsNotExistError(errors.Trace(os.ErrNotExist)), IsTrue)
+	c.Assert(IsNotExistError(awserr.New(s3.ErrCodeNoSuchKey, "no such key", nil)), IsTrue)
+}
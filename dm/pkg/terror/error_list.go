@@ -244,6 +244,12 @@ const (
 	codeConfigInvalidChunkFileSize
 	codeConfigOnlineDDLInvalidRegex
 	codeConfigOnlineDDLMistakeRegex
+	codeConfigValidatorCfgConflict
+	codeConfigValidatorCfgNotFound
+	codeConfigValidatorInvalidMode
+	codeConfigLoaderDirInvalid
+	codeConfigRelayArchiveInvalid
+	codeConfigStopAtInvalid
 )
 
 // Binlog operation error code list.
@@ -329,6 +335,8 @@ const (
 	codeRelayPurgeArgsNotValid
 	codePreviousGTIDsNotValid
 	codeRotateEventWithDifferentServerID
+	codeRelayArchiveFileFail
+	codeRelayRestoreFileFail
 )
 
 // Dump unit error code.
@@ -575,6 +583,7 @@ const (
 	codeWorkerFailConnectMaster
 	codeWorkerWaitRelayCatchupGTID
 	codeWorkerRelayConfigChanging
+	codeWorkerValidatorNotEnabled
 )
 
 // DM-tracer error code.
@@ -842,7 +851,7 @@ var (
 	ErrBinlogNotLogColumn = New(codeBinlogNotLogColumn, ClassBinlogOp, ScopeUpstream, LevelHigh, "upstream didn't log enough columns in binlog", "Please check if session `binlog_row_image` variable is not FULL, restart task to the location from where FULL binlog_row_image is used.")
 
 	// Config related error.
-	ErrConfigCheckItemNotSupport    = New(codeConfigCheckItemNotSupport, ClassConfig, ScopeInternal, LevelMedium, "checking item %s is not supported\n%s", "Please check `ignore-checking-items` config in task configuration file, which can be set including `all`/`dump_privilege`/`replication_privilege`/`version`/`binlog_enable`/`binlog_format`/`binlog_row_image`/`table_schema`/`schema_of_shard_tables`/`auto_increment_ID`.")
+	ErrConfigCheckItemNotSupport    = New(codeConfigCheckItemNotSupport, ClassConfig, ScopeInternal, LevelMedium, "checking item %s is not supported\n%s", "Please check `ignore-checking-items` config in task configuration file, which can be set including `all`/`dump_privilege`/`replication_privilege`/`version`/`binlog_enable`/`binlog_format`/`binlog_row_image`/`table_schema`/`schema_of_shard_tables`/`auto_increment_ID`/`target_privilege`/`foreign_key`/`trigger`/`stored_routine`/`spatial_column`/`charset`/`dump_disk_space`.")
 	ErrConfigTomlTransform          = New(codeConfigTomlTransform, ClassConfig, ScopeInternal, LevelMedium, "%s", "Please check the configuration file has correct TOML format.")
 	ErrConfigYamlTransform          = New(codeConfigYamlTransform, ClassConfig, ScopeInternal, LevelMedium, "%s", "Please check the configuration file has correct YAML format.")
 	ErrConfigTaskNameEmpty          = New(codeConfigTaskNameEmpty, ClassConfig, ScopeInternal, LevelMedium, "task name should not be empty", "Please check the `name` config in task configuration file.")
@@ -893,6 +902,12 @@ var (
 		"config '%s' regex pattern '%s' invalid, reason: %s", "Please check if params is correctly in the configuration file.")
 	ErrConfigOnlineDDLMistakeRegex = New(codeConfigOnlineDDLMistakeRegex, ClassConfig, ScopeInternal, LevelHigh,
 		"online ddl sql '%s' invalid, table %s fail to match '%s' online ddl regex", "Please update your `shadow-table-rules` or `trash-table-rules` in the configuration file.")
+	ErrConfigValidatorCfgConflict = New(codeConfigValidatorCfgConflict, ClassConfig, ScopeInternal, LevelMedium, "validator-config-name and validator should only specify one", "Please check the `validator-config-name` and `validator` config in task configuration file.")
+	ErrConfigValidatorCfgNotFound = New(codeConfigValidatorCfgNotFound, ClassConfig, ScopeInternal, LevelMedium, "mysql-instance(%d)'s validator config %s not exist in validators", "Please check the `validator-config-name` config in task configuration file.")
+	ErrConfigValidatorInvalidMode = New(codeConfigValidatorInvalidMode, ClassConfig, ScopeInternal, LevelMedium, "invalid validation mode %s", "Please check the `mode` config of the validator in task configuration file, it should be `none` or `full`.")
+	ErrConfigLoaderDirInvalid     = New(codeConfigLoaderDirInvalid, ClassConfig, ScopeInternal, LevelMedium, "loader's dir %s is invalid", "Please check the `dir` config in task configuration file, it should be a local path or an URL of external storage like `s3://bucket/prefix`.")
+	ErrConfigRelayArchiveInvalid  = New(codeConfigRelayArchiveInvalid, ClassConfig, ScopeInternal, LevelMedium, "relay log archive config with storage %s is invalid", "Please check the `purge.archive` config in source configuration file, `storage` should be an URL of external storage like `s3://bucket/prefix` and `expires-hours` should not be negative.")
+	ErrConfigStopAtInvalid        = New(codeConfigStopAtInvalid, ClassConfig, ScopeInternal, LevelMedium, "invalid stop-at %s", "Please use a binlog position like `mysql-bin.000001:4`, a GTID set or a time like `2006-01-02 15:04:05` for `--stop-at`, it's only supported by the task in `all` or `incremental` mode.")
 
 	// Binlog operation error.
 	ErrBinlogExtractPosition = New(codeBinlogExtractPosition, ClassBinlogOp, ScopeInternal, LevelHigh, "", "")
@@ -968,6 +983,8 @@ var (
 	ErrRelayPurgeArgsNotValid            = New(codeRelayPurgeArgsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "args (%T) %+v not valid", "")
 	ErrPreviousGTIDsNotValid             = New(codePreviousGTIDsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "previousGTIDs %s not valid", "")
 	ErrRotateEventWithDifferentServerID  = New(codeRotateEventWithDifferentServerID, ClassRelayUnit, ScopeInternal, LevelHigh, "receive fake rotate event with different server_id", "Please use `resume-relay` command if upstream database has changed")
+	ErrRelayArchiveFileFail              = New(codeRelayArchiveFileFail, ClassRelayUnit, ScopeInternal, LevelHigh, "archive relay log %s to external storage %s", "Please check the `purge.archive` config in source configuration file and whether the external storage is accessible.")
+	ErrRelayRestoreFileFail              = New(codeRelayRestoreFileFail, ClassRelayUnit, ScopeInternal, LevelHigh, "restore relay log %s from external storage %s", "Please check whether the external storage is accessible.")
 
 	// Dump unit error.
 	ErrDumpUnitRuntime        = New(codeDumpUnitRuntime, ClassDumpUnit, ScopeInternal, LevelHigh, "mydumper/dumpling runs with error, with output (may empty): %s", "")
@@ -1211,6 +1228,7 @@ var (
 	ErrWorkerTLSConfigNotValid              = New(codeWorkerTLSConfigNotValid, ClassDMWorker, ScopeInternal, LevelHigh, "TLS config not valid", "Please check the `ssl-ca`, `ssl-cert` and `ssl-key` config in worker configuration file.")
 	ErrWorkerFailConnectMaster              = New(codeWorkerFailConnectMaster, ClassDMWorker, ScopeInternal, LevelHigh, "cannot join with master endpoints: %v, error: %v", "Please check network connection of worker and check worker name is unique.")
 	ErrWorkerRelayConfigChanging            = New(codeWorkerRelayConfigChanging, ClassDMWorker, ScopeInternal, LevelLow, "relay config of worker %s is changed too frequently, last relay source %s:, new relay source %s", "Please try again later")
+	ErrWorkerValidatorNotEnabled            = New(codeWorkerValidatorNotEnabled, ClassDMWorker, ScopeInternal, LevelLow, "the validator of task %s is not enabled", "Please set the `mode` of the validator to `full` in task configuration file.")
 
 	// DM-tracer error.
 	ErrTracerParseFlagSet        = New(codeTracerParseFlagSet, ClassDMTracer, ScopeInternal, LevelMedium, "parse dm-tracer config flag set", "")
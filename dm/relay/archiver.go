@@ -0,0 +1,402 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/ticdc/dm/dm/config"
+	"github.com/pingcap/ticdc/dm/pkg/binlog"
+	"github.com/pingcap/ticdc/dm/pkg/log"
+	"github.com/pingcap/ticdc/dm/pkg/storage"
+	"github.com/pingcap/ticdc/dm/pkg/terror"
+	"github.com/pingcap/ticdc/dm/pkg/utils"
+)
+
+const (
+	// archiveIndexFilename is the name of the file in the root of external storage, it records
+	// the archived relay log files as `<UUID>/<filename> <modified unix time>` in every line.
+	archiveIndexFilename = "archive.index"
+
+	archiveBufferSize = 1024 * 1024
+)
+
+// relayArchiver archives relay log files to external storage before they're purged,
+// and restores them back to the relay directory when they're needed by BinlogReader.
+// the layout of external storage is the same as the relay directory, like
+//   archive.index
+//   server-uuid.index
+//   <UUID>/relay.meta
+//   <UUID>/<binlog filename>
+type relayArchiver struct {
+	mu sync.Mutex
+
+	cfg          config.ArchiveConfig
+	relayBaseDir string
+	redactedURL  string                              // URL of storage without query, which may contain access keys
+	storages     map[string]bstorage.ExternalStorage // sub directory => storage, "" for the root
+
+	logger log.Logger
+}
+
+// newRelayArchiver creates a relayArchiver, nil is returned if archive is not enabled.
+func newRelayArchiver(cfg config.ArchiveConfig, relayBaseDir string) *relayArchiver {
+	if len(cfg.Storage) == 0 {
+		return nil
+	}
+	redacted := cfg.Storage
+	if u, err := bstorage.ParseRawURL(cfg.Storage); err == nil {
+		u.RawQuery = ""
+		redacted = u.String()
+	}
+	return &relayArchiver{
+		cfg:          cfg,
+		relayBaseDir: relayBaseDir,
+		redactedURL:  redacted,
+		storages:     make(map[string]bstorage.ExternalStorage),
+		logger:       log.With(zap.String("component", "relay archiver"), zap.String("storage", redacted)),
+	}
+}
+
+// getStorage returns the storage for the sub directory, the storage is created lazily.
+func (a *relayArchiver) getStorage(ctx context.Context, subDir string) (bstorage.ExternalStorage, error) {
+	if s, ok := a.storages[subDir]; ok {
+		return s, nil
+	}
+	rawURL := a.cfg.Storage
+	if len(subDir) > 0 {
+		u, err := bstorage.ParseRawURL(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		u.Path = path.Join(u.Path, subDir)
+		rawURL = u.String()
+	}
+	s, err := storage.CreateStorage(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	a.storages[subDir] = s
+	return s, nil
+}
+
+// loadIndex loads the archived relay log files from archive.index.
+func (a *relayArchiver) loadIndex(ctx context.Context) (map[string]int64, error) {
+	s, err := a.getStorage(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int64)
+	data, err := s.ReadFile(ctx, archiveIndexFilename)
+	if err != nil {
+		if storage.IsNotExistError(err) {
+			return index, nil
+		}
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		ts, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		index[fields[0]] = ts
+	}
+	return index, scanner.Err()
+}
+
+// saveIndex saves the archived relay log files to archive.index.
+func (a *relayArchiver) saveIndex(ctx context.Context, index map[string]int64) error {
+	s, err := a.getStorage(ctx, "")
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(index))
+	for name := range index {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s %d\n", name, index[name])
+	}
+	return s.WriteFile(ctx, archiveIndexFilename, buf.Bytes())
+}
+
+// archive uploads the relay log files which are not archived yet, with the relay.meta of their
+// sub directories and the server-uuid.index.
+func (a *relayArchiver) archive(ctx context.Context, files []*subRelayFiles) error {
+	if len(files) == 0 {
+		return nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	startTime := time.Now()
+	index, err := a.loadIndex(ctx)
+	if err != nil {
+		return terror.ErrRelayArchiveFileFail.Delegate(err, archiveIndexFilename, a.redactedURL)
+	}
+
+	archived, err := a.archiveFiles(ctx, files, index)
+	if archived > 0 {
+		// the index is saved even if some files failed, so the archived files won't be uploaded again.
+		if err2 := a.saveIndex(ctx, index); err2 != nil && err == nil {
+			err = terror.ErrRelayArchiveFileFail.Delegate(err2, archiveIndexFilename, a.redactedURL)
+		}
+	}
+	a.logger.Info("archive relay log files", zap.Int("archived files", archived), zap.Duration("cost time", time.Since(startTime)), log.ShortError(err))
+	return err
+}
+
+func (a *relayArchiver) archiveFiles(ctx context.Context, files []*subRelayFiles, index map[string]int64) (int, error) {
+	archived := 0
+	for _, subRelay := range files {
+		uuid := filepath.Base(subRelay.dir)
+		s, err := a.getStorage(ctx, uuid)
+		if err != nil {
+			return archived, terror.ErrRelayArchiveFileFail.Delegate(err, subRelay.dir, a.redactedURL)
+		}
+		for _, f := range subRelay.files {
+			name := path.Join(uuid, filepath.Base(f))
+			if _, ok := index[name]; ok {
+				continue
+			}
+			fs, err := os.Stat(f)
+			if err != nil {
+				return archived, terror.ErrGetRelayLogStat.Delegate(err, f)
+			}
+			a.logger.Info("archiving relay log file", zap.String("file", f))
+			if err = uploadFile(ctx, s, f, filepath.Base(f)); err != nil {
+				return archived, terror.ErrRelayArchiveFileFail.Delegate(err, f, a.redactedURL)
+			}
+			index[name] = fs.ModTime().Unix()
+			archived++
+		}
+
+		// relay.meta records the position and GTID sets of the sub directory
+		metaPath := filepath.Join(subRelay.dir, utils.MetaFilename)
+		if utils.IsFileExists(metaPath) {
+			if err = uploadFile(ctx, s, metaPath, utils.MetaFilename); err != nil {
+				return archived, terror.ErrRelayArchiveFileFail.Delegate(err, metaPath, a.redactedURL)
+			}
+		}
+	}
+
+	s, err := a.getStorage(ctx, "")
+	if err != nil {
+		return archived, terror.ErrRelayArchiveFileFail.Delegate(err, utils.UUIDIndexFilename, a.redactedURL)
+	}
+	indexPath := filepath.Join(a.relayBaseDir, utils.UUIDIndexFilename)
+	if err = uploadFile(ctx, s, indexPath, utils.UUIDIndexFilename); err != nil {
+		return archived, terror.ErrRelayArchiveFileFail.Delegate(err, indexPath, a.redactedURL)
+	}
+	return archived, nil
+}
+
+// expire removes the archived relay log files which are older than ExpiresHours.
+func (a *relayArchiver) expire(ctx context.Context, now time.Time) error {
+	if a.cfg.ExpiresHours <= 0 {
+		return nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	index, err := a.loadIndex(ctx)
+	if err != nil {
+		return terror.ErrRelayArchiveFileFail.Delegate(err, archiveIndexFilename, a.redactedURL)
+	}
+
+	safeTime := now.Add(time.Duration(-a.cfg.ExpiresHours) * time.Hour).Unix()
+	expired := make(map[string]struct{})
+	for name, ts := range index {
+		if ts >= safeTime {
+			continue
+		}
+		uuid, filename := path.Split(name)
+		uuid = path.Clean(uuid)
+		s, err := a.getStorage(ctx, uuid)
+		if err == nil {
+			err = s.DeleteFile(ctx, filename)
+		}
+		if err != nil && !storage.IsNotExistError(err) {
+			err = terror.ErrRelayRemoveFileFail.Delegate(err, "archived file", name)
+			if len(expired) > 0 {
+				if err2 := a.saveIndex(ctx, index); err2 != nil {
+					a.logger.Warn("fail to save archive index", zap.Error(err2))
+				}
+			}
+			return err
+		}
+		a.logger.Info("removed expired archived relay log file", zap.String("file", name))
+		delete(index, name)
+		expired[uuid] = struct{}{}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	// remove relay.meta of the sub directories which have no archived relay log files
+	for name := range index {
+		delete(expired, path.Dir(name))
+	}
+	for uuid := range expired {
+		s, err := a.getStorage(ctx, uuid)
+		if err == nil {
+			err = s.DeleteFile(ctx, utils.MetaFilename)
+		}
+		if err != nil && !storage.IsNotExistError(err) {
+			a.logger.Warn("fail to remove archived relay meta", zap.String("directory", uuid), zap.Error(err))
+		}
+	}
+
+	if err = a.saveIndex(ctx, index); err != nil {
+		return terror.ErrRelayArchiveFileFail.Delegate(err, archiveIndexFilename, a.redactedURL)
+	}
+	return nil
+}
+
+// archivedFiles returns the archived relay log files in the sub directory, ordered by filename.
+func (a *relayArchiver) archivedFiles(ctx context.Context, uuid string) ([]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	index, err := a.loadIndex(ctx)
+	if err != nil {
+		return nil, terror.ErrRelayRestoreFileFail.Delegate(err, archiveIndexFilename, a.redactedURL)
+	}
+	files := make([]string, 0)
+	for name := range index {
+		if path.Dir(name) == uuid {
+			files = append(files, path.Base(name))
+		}
+	}
+	sortBinlogFiles(files)
+	return files, nil
+}
+
+// restoreFile downloads the archived relay log file to the relay directory if it doesn't exist locally.
+// it returns whether the file is downloaded.
+func (a *relayArchiver) restoreFile(ctx context.Context, uuid, filename string) (bool, error) {
+	localPath := filepath.Join(a.relayBaseDir, uuid, filename)
+	if utils.IsFileExists(localPath) {
+		return false, nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	name := path.Join(uuid, filename)
+	s, err := a.getStorage(ctx, uuid)
+	if err != nil {
+		return false, terror.ErrRelayRestoreFileFail.Delegate(err, name, a.redactedURL)
+	}
+	if err = os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+		return false, terror.ErrRelayMkdir.Delegate(err)
+	}
+	a.logger.Info("restoring archived relay log file", zap.String("file", name))
+	if err = downloadFile(ctx, s, filename, localPath); err != nil {
+		return false, terror.ErrRelayRestoreFileFail.Delegate(err, name, a.redactedURL)
+	}
+	return true, nil
+}
+
+// uploadFile uploads the local file to the storage in chunks.
+func uploadFile(ctx context.Context, s bstorage.ExternalStorage, localPath, name string) error {
+	fd, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	w, err := s.Create(ctx, name)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, archiveBufferSize)
+	for {
+		n, err := fd.Read(buf)
+		if n > 0 {
+			if _, err2 := w.Write(ctx, buf[:n]); err2 != nil {
+				_ = w.Close(ctx)
+				return err2
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = w.Close(ctx)
+			return err
+		}
+	}
+	return w.Close(ctx)
+}
+
+// downloadFile downloads the file in the storage to a temporary local file and renames it,
+// so the readers never see a partial relay log file.
+func downloadFile(ctx context.Context, s bstorage.ExternalStorage, name, localPath string) error {
+	r, err := s.Open(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	tmpPath := localPath + ".restoring"
+	fd, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyBuffer(fd, r, make([]byte, archiveBufferSize))
+	if err2 := fd.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, localPath)
+}
+
+// sortBinlogFiles sorts binlog filenames by their sequence numbers.
+func sortBinlogFiles(files []string) {
+	sort.Slice(files, func(i, j int) bool {
+		fi, err1 := binlog.ParseFilename(files[i])
+		fj, err2 := binlog.ParseFilename(files[j])
+		if err1 != nil || err2 != nil {
+			return files[i] < files[j]
+		}
+		return fi.LessThan(fj)
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/ticdc/dm/dm/config"
+	"github.com/pingcap/ticdc/dm/dm/pb"
+	"github.com/pingcap/ticdc/dm/pkg/log"
+	"github.com/pingcap/ticdc/dm/pkg/streamer"
+	"github.com/pingcap/ticdc/dm/pkg/utils"
+)
+
+func (t *testPurgerSuite) TestArchiveBeforePurge(c *C) {
+	ctx := context.Background()
+	baseDir := c.MkDir()
+	archiveDir := c.MkDir()
+
+	relayDirsPath, relayFilesPath, _ := t.genRelayLogFiles(c, baseDir, -1, -1)
+	c.Assert(t.genUUIDIndexFile(baseDir), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(relayDirsPath[0], utils.MetaFilename), []byte("meta"), 0o644), IsNil)
+
+	cfg := config.PurgeConfig{
+		Interval: 0, // disable automatically
+		Archive: config.ArchiveConfig{
+			Storage:      "file://" + archiveDir,
+			ExpiresHours: 1,
+		},
+	}
+	purger := NewPurger(cfg, baseDir, []Operator{t}, nil)
+
+	// archive strategy archives the inactive relay log files without purging them
+	purger.(*relayPurger).tryArchive(ctx)
+	c.Assert(utils.IsFileExists(relayFilesPath[0][0]), IsTrue)
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, t.uuids[0], t.relayFiles[0][0])), IsTrue)
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, t.uuids[0], utils.MetaFilename)), IsTrue)
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, t.uuids[1], t.relayFiles[1][1])), IsTrue)
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, t.uuids[1], t.relayFiles[1][2])), IsFalse) // active
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, utils.UUIDIndexFilename)), IsTrue)
+
+	// purge inactive relay log files, they're archived already
+	c.Assert(purger.Do(ctx, &pb.PurgeRelayRequest{Inactive: true}), IsNil)
+	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][0]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][2]), IsTrue)
+
+	archiver := newRelayArchiver(cfg.Archive, baseDir)
+	files, err := archiver.archivedFiles(ctx, t.uuids[0])
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, t.relayFiles[0])
+	files, err = archiver.archivedFiles(ctx, t.uuids[1])
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, t.relayFiles[1][:2])
+
+	// restore the purged file
+	restored, err := archiver.restoreFile(ctx, t.uuids[0], t.relayFiles[0][1])
+	c.Assert(err, IsNil)
+	c.Assert(restored, IsTrue)
+	content, err := os.ReadFile(relayFilesPath[0][1])
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "meaningless file content")
+	// existing file is not restored again
+	restored, err = archiver.restoreFile(ctx, t.uuids[1], t.relayFiles[1][2])
+	c.Assert(err, IsNil)
+	c.Assert(restored, IsFalse)
+
+	// nothing expired
+	c.Assert(archiver.expire(ctx, time.Now()), IsNil)
+	files, err = archiver.archivedFiles(ctx, t.uuids[0])
+	c.Assert(err, IsNil)
+	c.Assert(files, HasLen, 3)
+
+	// all archived files expired
+	c.Assert(archiver.expire(ctx, time.Now().Add(2*time.Hour)), IsNil)
+	for _, uuid := range t.uuids[:2] {
+		files, err = archiver.archivedFiles(ctx, uuid)
+		c.Assert(err, IsNil)
+		c.Assert(files, HasLen, 0)
+	}
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, t.uuids[0], t.relayFiles[0][0])), IsFalse)
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, t.uuids[0], utils.MetaFilename)), IsFalse)
+}
+
+func (t *testPurgerSuite) TestArchiverDisabled(c *C) {
+	c.Assert(newRelayArchiver(config.ArchiveConfig{}, c.MkDir()), IsNil)
+
+	purger := NewPurger(config.PurgeConfig{}, c.MkDir(), []Operator{t}, nil)
+	_, ok := purger.(*relayPurger).strategies[strategyArchive]
+	c.Assert(ok, IsFalse)
+}
+
+func (t *testReaderSuite) TestRestoreArchivedFiles(c *C) {
+	var (
+		ctx        = context.Background()
+		baseDir    = c.MkDir()
+		archiveDir = c.MkDir()
+		uuids      = []string{
+			"b60868af-5a6f-11e9-9ea3-0242ac160006.000001",
+			"b60868af-5a6f-11e9-9ea3-0242ac160007.000002",
+		}
+		filenames = []string{"mysql-bin.000001", "mysql-bin.000002", "mysql-bin.000010"}
+		archiver  = newRelayArchiver(config.ArchiveConfig{Storage: "file://" + archiveDir}, baseDir)
+	)
+	c.Assert(os.WriteFile(filepath.Join(baseDir, utils.UUIDIndexFilename), t.uuidListToBytes(c, uuids), 0o600), IsNil)
+
+	// archive all relay log files and purge them locally, except the last file in the last sub directory
+	files := make([]*subRelayFiles, 0, len(uuids))
+	for _, uuid := range uuids {
+		subDir := filepath.Join(baseDir, uuid)
+		c.Assert(os.MkdirAll(subDir, 0o700), IsNil)
+		sub := &subRelayFiles{dir: subDir}
+		for _, filename := range filenames {
+			fp := filepath.Join(subDir, filename)
+			c.Assert(os.WriteFile(fp, []byte(uuid+filename), 0o600), IsNil)
+			sub.files = append(sub.files, fp)
+		}
+		files = append(files, sub)
+	}
+	c.Assert(archiver.archive(ctx, files), IsNil)
+	c.Assert(os.RemoveAll(filepath.Join(baseDir, uuids[0])), IsNil)
+	c.Assert(os.Remove(filepath.Join(baseDir, uuids[1], filenames[0])), IsNil)
+	c.Assert(os.Remove(filepath.Join(baseDir, uuids[1], filenames[1])), IsNil)
+
+	cfg := &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor, ArchiveStorage: "file://" + archiveDir}
+	r := newBinlogReaderForTest(log.L(), cfg, false, "")
+	c.Assert(r.updateUUIDs(), IsNil)
+
+	// archived files are collected with the local ones
+	allFiles, err := r.collectAllBinlogFiles(uuids[0])
+	c.Assert(err, IsNil)
+	c.Assert(allFiles, DeepEquals, filenames)
+	allFiles, err = r.collectAllBinlogFiles(uuids[1])
+	c.Assert(err, IsNil)
+	c.Assert(allFiles, DeepEquals, filenames)
+
+	// the files from the position are collected with the archived ones
+	fromFiles, err := r.collectBinlogFilesFrom(uuids[0], filenames[1])
+	c.Assert(err, IsNil)
+	c.Assert(fromFiles, DeepEquals, filenames[1:])
+
+	// restore the second file in the first sub directory and the one after it
+	c.Assert(r.restoreArchivedFilesFrom(gmysql.Position{Name: "mysql-bin|000001.000002", Pos: 4}), IsNil)
+	c.Assert(utils.IsFileExists(filepath.Join(baseDir, uuids[0], filenames[0])), IsFalse)
+	for _, filename := range filenames[1:] {
+		content, err2 := os.ReadFile(filepath.Join(baseDir, uuids[0], filename))
+		c.Assert(err2, IsNil)
+		c.Assert(string(content), Equals, uuids[0]+filename)
+	}
+	// the files of the next sub directory are restored when the reader reaches them
+	c.Assert(utils.IsFileExists(filepath.Join(baseDir, uuids[1], filenames[0])), IsFalse)
+	c.Assert(utils.IsFileExists(filepath.Join(baseDir, uuids[1], filenames[1])), IsFalse)
+
+	// the restored files are protected from being purged while the reader is reading them
+	hub := streamer.GetReaderHub()
+	active := hub.EarliestActiveRelayLog()
+	c.Assert(active, NotNil)
+	c.Assert(active.TaskName, Equals, r.hubKey)
+	c.Assert(active.UUID, Equals, uuids[0])
+	c.Assert(active.Filename, Equals, filenames[1])
+	c.Assert(r.restoreArchivedFiles(uuids[1], filenames), IsNil)
+	c.Assert(utils.IsFileExists(filepath.Join(baseDir, uuids[1], filenames[0])), IsTrue)
+	c.Assert(utils.IsFileExists(filepath.Join(baseDir, uuids[1], filenames[1])), IsTrue)
+	active = hub.EarliestActiveRelayLog()
+	c.Assert(active.UUID, Equals, uuids[1])
+	c.Assert(active.Filename, Equals, filenames[0])
+	r.Close()
+	c.Assert(hub.EarliestActiveRelayLog(), IsNil)
+}
+
+func (t *testReaderSuite) TestNewReaderWithArchive(c *C) {
+	var (
+		baseDir    = c.MkDir()
+		archiveDir = c.MkDir()
+		readerCfg  = &BinlogReaderConfig{RelayDir: baseDir, Flavor: gmysql.MySQLFlavor}
+	)
+	relay := NewRealRelay(&Config{Flavor: gmysql.MySQLFlavor, RelayDir: baseDir, ArchiveStorage: "file://" + archiveDir})
+	r := relay.(*Relay).NewReader(log.L(), readerCfg)
+	defer r.Close()
+	// the reader reads the archived files of the relay, the config of the caller is not changed
+	c.Assert(r.archiver, NotNil)
+	c.Assert(r.cfg.ArchiveStorage, Equals, "file://"+archiveDir)
+	c.Assert(readerCfg.ArchiveStorage, Equals, "")
+}
@@ -38,6 +38,9 @@ type Config struct {
 
 	// for binlog reader retry
 	ReaderRetry ReaderRetryConfig `toml:"reader-retry" json:"reader-retry"`
+
+	// external storage which relay log files are archived to, it may contain access keys so it's not marshaled
+	ArchiveStorage string `toml:"-" json:"-"`
 }
 
 func (c *Config) String() string {
@@ -69,6 +72,7 @@ func FromSourceCfg(sourceCfg *config.SourceConfig) *Config {
 			BackoffJitter:   clone.Checker.BackoffJitter,
 			BackoffFactor:   clone.Checker.BackoffFactor,
 		},
+		ArchiveStorage: clone.Purge.Archive.Storage,
 	}
 	return cfg
 }
@@ -29,14 +29,17 @@ import (
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/google/uuid"
 	"github.com/pingcap/errors"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
+	"github.com/pingcap/ticdc/dm/dm/config"
 	"github.com/pingcap/ticdc/dm/pkg/binlog"
 	"github.com/pingcap/ticdc/dm/pkg/binlog/event"
 	"github.com/pingcap/ticdc/dm/pkg/binlog/reader"
 	tcontext "github.com/pingcap/ticdc/dm/pkg/context"
 	"github.com/pingcap/ticdc/dm/pkg/gtid"
 	"github.com/pingcap/ticdc/dm/pkg/log"
+	"github.com/pingcap/ticdc/dm/pkg/streamer"
 	"github.com/pingcap/ticdc/dm/pkg/terror"
 	"github.com/pingcap/ticdc/dm/pkg/utils"
 )
@@ -50,6 +53,9 @@ type BinlogReaderConfig struct {
 	RelayDir string
 	Timezone *time.Location
 	Flavor   string
+	// ArchiveStorage is the URL of external storage which relay log files are archived to,
+	// the archived files are restored to RelayDir when they're purged locally.
+	ArchiveStorage string
 }
 
 // BinlogReader is a binlog reader.
@@ -75,8 +81,16 @@ type BinlogReader struct {
 	relay    Process
 
 	currentUUID string // current UUID(with suffix)
+
+	archiver *relayArchiver // nil if not read from archived relay log files
+	// hubKey is the key of the relay log file being read in the reader hub,
+	// which protects the restored archived files from being purged.
+	hubKey string
 }
 
+// binlogReaderID is used to generate the unique hubKey of the BinlogReaders.
+var binlogReaderID atomic.Int64
+
 // newBinlogReader creates a new BinlogReader.
 func newBinlogReader(logger log.Logger, cfg *BinlogReaderConfig, relay Process) *BinlogReader {
 	ctx, cancel := context.WithCancel(context.Background()) // only can be canceled in `Close`
@@ -98,6 +112,8 @@ func newBinlogReader(logger log.Logger, cfg *BinlogReaderConfig, relay Process)
 		tctx:      newtctx,
 		notifyCh:  make(chan interface{}, 1),
 		relay:     relay,
+		archiver:  newRelayArchiver(config.ArchiveConfig{Storage: cfg.ArchiveStorage}, cfg.RelayDir),
+		hubKey:    fmt.Sprintf("binlog-reader-%d", binlogReaderID.Inc()),
 	}
 	binlogReader.relay.RegisterListener(binlogReader)
 	return binlogReader
@@ -179,7 +195,7 @@ func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error)
 		}
 
 		uuidDir := path.Join(r.cfg.RelayDir, uuid)
-		allFiles, err := CollectAllBinlogFiles(uuidDir)
+		allFiles, err := r.collectAllBinlogFiles(uuid)
 		if err != nil {
 			return nil, err
 		}
@@ -188,6 +204,12 @@ func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error)
 		for i := len(allFiles) - 1; i >= 0; i-- {
 			file := allFiles[i]
 			filePath := path.Join(uuidDir, file)
+			if r.archiver != nil {
+				// the file may be purged locally
+				if _, err = r.archiver.restoreFile(r.tctx.Ctx, uuid, file); err != nil {
+					return nil, err
+				}
+			}
 			// if input `gset` not contain previous_gtids_event's gset (complementary set of `gset` overlap with
 			// previous_gtids_event), that means there're some needed events in previous files.
 			// so we go to previous one
@@ -211,6 +233,104 @@ func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error)
 	return nil, terror.ErrNoRelayPosMatchGTID.Generate(gset.String())
 }
 
+// collectAllBinlogFiles collects the binlog files in the relay sub directory,
+// including the archived ones if reading from archived relay log files.
+func (r *BinlogReader) collectAllBinlogFiles(uuid string) ([]string, error) {
+	uuidDir := path.Join(r.cfg.RelayDir, uuid)
+	if r.archiver == nil {
+		return CollectAllBinlogFiles(uuidDir)
+	}
+
+	archived, err := r.archiver.archivedFiles(r.tctx.Ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.IsDirExists(uuidDir) {
+		// all files in the sub directory are purged locally
+		return archived, nil
+	}
+	local, err := CollectAllBinlogFiles(uuidDir)
+	if err != nil {
+		return nil, err
+	}
+
+	allFiles := make([]string, 0, len(local)+len(archived))
+	existed := make(map[string]struct{}, len(local))
+	for _, f := range local {
+		existed[f] = struct{}{}
+		allFiles = append(allFiles, f)
+	}
+	for _, f := range archived {
+		if _, ok := existed[f]; !ok {
+			allFiles = append(allFiles, f)
+		}
+	}
+	sortBinlogFiles(allFiles)
+	return allFiles, nil
+}
+
+// collectBinlogFilesFrom collects the binlog files not older than baseFile in the relay sub directory,
+// including the archived ones if reading from archived relay log files.
+func (r *BinlogReader) collectBinlogFilesFrom(uuid, baseFile string) ([]string, error) {
+	dir := path.Join(r.cfg.RelayDir, uuid)
+	if r.archiver == nil {
+		return CollectBinlogFilesCmp(dir, baseFile, FileCmpBiggerEqual)
+	}
+
+	bf, err := binlog.ParseFilename(baseFile)
+	if err != nil {
+		return nil, terror.Annotatef(err, "filename %s", baseFile)
+	}
+	allFiles, err := r.collectAllBinlogFiles(uuid)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]string, 0, len(allFiles))
+	for _, f := range allFiles {
+		parsed, err := binlog.ParseFilename(f)
+		if err != nil || parsed.BaseName != bf.BaseName || !parsed.GreaterThanOrEqualTo(bf) {
+			continue
+		}
+		results = append(results, f)
+	}
+	return results, nil
+}
+
+// restoreArchivedFiles restores the first file of files which is going to be read and the one after it if
+// they're purged locally, the files are restored lazily when the reader reaches them. The file going to be read
+// is set as the active relay log of the reader, so that it and the files after it are not purged.
+func (r *BinlogReader) restoreArchivedFiles(uuid string, files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+	if err := streamer.GetReaderHub().UpdateActiveRelayLog(r.hubKey, uuid, files[0]); err != nil {
+		return err
+	}
+	for i := 0; i < len(files) && i < 2; i++ {
+		restored, err := r.archiver.restoreFile(r.tctx.Ctx, uuid, files[i])
+		if err != nil {
+			return err
+		}
+		if restored {
+			r.tctx.L().Info("restored archived relay log file", zap.String("directory", uuid), zap.String("file", files[i]))
+		}
+	}
+	return nil
+}
+
+// restoreArchivedFilesFrom restores the archived relay log file of pos and the one after it.
+func (r *BinlogReader) restoreArchivedFilesFrom(pos mysql.Position) error {
+	currentUUID, _, realPos, err := binlog.ExtractPos(pos, r.uuids)
+	if err != nil {
+		return terror.Annotatef(err, "parse relay dir with pos %s", pos)
+	}
+	files, err := r.collectBinlogFilesFrom(currentUUID, realPos.Name)
+	if err != nil {
+		return err
+	}
+	return r.restoreArchivedFiles(currentUUID, files)
+}
+
 // StartSyncByPos start sync by pos
 // TODO:  thread-safe?
 func (r *BinlogReader) StartSyncByPos(pos mysql.Position) (reader.Streamer, error) {
@@ -227,6 +347,11 @@ func (r *BinlogReader) StartSyncByPos(pos mysql.Position) (reader.Streamer, erro
 	if err != nil {
 		return nil, err
 	}
+	if r.archiver != nil {
+		if err = r.restoreArchivedFilesFrom(pos); err != nil {
+			return nil, err
+		}
+	}
 	err = r.checkRelayPos(pos)
 	if err != nil {
 		return nil, err
@@ -269,6 +394,11 @@ func (r *BinlogReader) StartSyncByGTID(gset mysql.GTIDSet) (reader.Streamer, err
 	if err != nil {
 		return nil, err
 	}
+	if r.archiver != nil {
+		if err = r.restoreArchivedFilesFrom(*pos); err != nil {
+			return nil, err
+		}
+	}
 	r.tctx.L().Info("get pos by gtid", zap.Stringer("GTID Set", gset), zap.Stringer("Position", pos))
 
 	r.prevGset = gset
@@ -356,7 +486,20 @@ func (r *BinlogReader) getSwitchPath() (*SwitchPath, error) {
 	}
 
 	// try to get the first binlog file in next subdirectory
-	nextBinlogName, err := getFirstBinlogName(r.cfg.RelayDir, nextUUID)
+	var nextBinlogName string
+	if r.archiver != nil {
+		// the files of the next subdirectory may be purged locally
+		var allFiles []string
+		allFiles, err = r.collectAllBinlogFiles(nextUUID)
+		if err == nil && len(allFiles) == 0 {
+			err = terror.ErrBinlogFilesNotFound.Generate(path.Join(r.cfg.RelayDir, nextUUID))
+		}
+		if err == nil {
+			nextBinlogName = allFiles[0]
+		}
+	} else {
+		nextBinlogName, err = getFirstBinlogName(r.cfg.RelayDir, nextUUID)
+	}
 	if err != nil {
 		// because creating subdirectory and writing relay log file are not atomic
 		if terror.ErrBinlogFilesNotFound.Equal(err) {
@@ -380,7 +523,7 @@ func (r *BinlogReader) parseDirAsPossible(ctx context.Context, s *LocalStreamer,
 			return false, ctx.Err()
 		default:
 		}
-		files, err := CollectBinlogFilesCmp(dir, pos.Name, FileCmpBiggerEqual)
+		files, err := r.collectBinlogFilesFrom(r.currentUUID, pos.Name)
 		if err != nil {
 			return false, terror.Annotatef(err, "parse relay dir %s with pos %s", dir, pos)
 		} else if len(files) == 0 {
@@ -413,6 +556,11 @@ func (r *BinlogReader) parseDirAsPossible(ctx context.Context, s *LocalStreamer,
 				offset = binlog.FileHeaderLen // for other relay log file, start parse from 4
 				firstParse = true             // new relay log file need to parse
 			}
+			if r.archiver != nil {
+				if err = r.restoreArchivedFiles(r.currentUUID, files[i:]); err != nil {
+					return false, err
+				}
+			}
 			needSwitch, latestPos, err = r.parseFileAsPossible(ctx, s, relayLogFile, offset, dir, firstParse, i == len(files)-1)
 			if err != nil {
 				return false, terror.Annotatef(err, "parse relay log file %s from offset %d in dir %s", relayLogFile, offset, dir)
@@ -777,6 +925,9 @@ func (r *BinlogReader) Close() {
 	r.parser.Stop()
 	r.wg.Wait()
 	r.relay.UnRegisterListener(r)
+	if r.archiver != nil {
+		streamer.GetReaderHub().RemoveActiveRelayLog(r.hubKey)
+	}
 	r.tctx.L().Info("binlog reader closed")
 }
 
@@ -14,6 +14,7 @@
 package relay
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -35,6 +36,7 @@ const (
 	strategyFilename
 	strategyTime
 	strategySpace
+	strategyArchive
 )
 
 func (s strategyType) String() string {
@@ -47,6 +49,8 @@ func (s strategyType) String() string {
 		return "time strategy"
 	case strategySpace:
 		return "space strategy"
+	case strategyArchive:
+		return "archive strategy"
 	default:
 		return "unknown strategy"
 	}
@@ -62,7 +66,7 @@ type PurgeStrategy interface {
 	Check(args interface{}) (bool, error)
 
 	// Do does the purge process one time
-	Do(args interface{}) error
+	Do(ctx context.Context, args interface{}) error
 
 	// Purging indicates whether is doing purge
 	Purging() bool
@@ -131,14 +135,16 @@ func (fa *filenameArgs) String() string {
 // filenameStrategy represents a relay purge strategy by filename
 // similar to `PURGE BINARY LOGS TO`.
 type filenameStrategy struct {
-	purging atomic.Bool
+	purging  atomic.Bool
+	archiver *relayArchiver
 
 	logger log.Logger
 }
 
-func newFilenameStrategy() PurgeStrategy {
+func newFilenameStrategy(archiver *relayArchiver) PurgeStrategy {
 	return &filenameStrategy{
-		logger: log.With(zap.String("component", "relay purger"), zap.String("strategy", "file name")),
+		archiver: archiver,
+		logger:   log.With(zap.String("component", "relay purger"), zap.String("strategy", "file name")),
 	}
 }
 
@@ -147,7 +153,7 @@ func (s *filenameStrategy) Check(args interface{}) (bool, error) {
 	return false, nil
 }
 
-func (s *filenameStrategy) Do(args interface{}) error {
+func (s *filenameStrategy) Do(ctx context.Context, args interface{}) error {
 	if !s.purging.CAS(false, true) {
 		return terror.ErrRelayThisStrategyIsPurging.Generate()
 	}
@@ -158,7 +164,7 @@ func (s *filenameStrategy) Do(args interface{}) error {
 		return terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
 	}
 
-	return purgeRelayFilesBeforeFile(s.logger, fa.relayBaseDir, fa.uuids, fa.safeRelayLog)
+	return purgeRelayFilesBeforeFile(ctx, s.logger, s.archiver, fa.relayBaseDir, fa.uuids, fa.safeRelayLog)
 }
 
 func (s *filenameStrategy) Purging() bool {
@@ -191,14 +197,16 @@ func (ia *inactiveArgs) String() string {
 //   * not reading by sync unit and will not be read by any running tasks
 //     TODO zxc: judge tasks are running dumper / loader
 type inactiveStrategy struct {
-	purging atomic.Bool
+	purging  atomic.Bool
+	archiver *relayArchiver
 
 	logger log.Logger
 }
 
-func newInactiveStrategy() PurgeStrategy {
+func newInactiveStrategy(archiver *relayArchiver) PurgeStrategy {
 	return &inactiveStrategy{
-		logger: log.With(zap.String("component", "relay purger"), zap.String("strategy", "inactive binlog file")),
+		archiver: archiver,
+		logger:   log.With(zap.String("component", "relay purger"), zap.String("strategy", "inactive binlog file")),
 	}
 }
 
@@ -207,7 +215,7 @@ func (s *inactiveStrategy) Check(args interface{}) (bool, error) {
 	return false, nil
 }
 
-func (s *inactiveStrategy) Do(args interface{}) error {
+func (s *inactiveStrategy) Do(ctx context.Context, args interface{}) error {
 	if !s.purging.CAS(false, true) {
 		return terror.ErrRelayThisStrategyIsPurging.Generate()
 	}
@@ -218,7 +226,7 @@ func (s *inactiveStrategy) Do(args interface{}) error {
 		return terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
 	}
 
-	return purgeRelayFilesBeforeFile(s.logger, ia.relayBaseDir, ia.uuids, ia.activeRelayLog)
+	return purgeRelayFilesBeforeFile(ctx, s.logger, s.archiver, ia.relayBaseDir, ia.uuids, ia.activeRelayLog)
 }
 
 func (s *inactiveStrategy) Purging() bool {
@@ -248,14 +256,16 @@ func (sa *spaceArgs) String() string {
 
 // spaceStrategy represents a relay purge strategy by remain space in dm-worker node.
 type spaceStrategy struct {
-	purging atomic.Bool
+	purging  atomic.Bool
+	archiver *relayArchiver
 
 	logger log.Logger
 }
 
-func newSpaceStrategy() PurgeStrategy {
+func newSpaceStrategy(archiver *relayArchiver) PurgeStrategy {
 	return &spaceStrategy{
-		logger: log.With(zap.String("component", "relay purger"), zap.String("strategy", "space")),
+		archiver: archiver,
+		logger:   log.With(zap.String("component", "relay purger"), zap.String("strategy", "space")),
 	}
 }
 
@@ -274,7 +284,7 @@ func (s *spaceStrategy) Check(args interface{}) (bool, error) {
 	return storageSize.Available < requiredBytes, nil
 }
 
-func (s *spaceStrategy) Do(args interface{}) error {
+func (s *spaceStrategy) Do(ctx context.Context, args interface{}) error {
 	if !s.purging.CAS(false, true) {
 		return terror.ErrRelayThisStrategyIsPurging.Generate()
 	}
@@ -287,7 +297,7 @@ func (s *spaceStrategy) Do(args interface{}) error {
 
 	// NOTE: we purge all inactive relay log files when available space less than @remainSpace
 	// maybe we can refine this to purge only part of this files every time
-	return purgeRelayFilesBeforeFile(s.logger, sa.relayBaseDir, sa.uuids, sa.activeRelayLog)
+	return purgeRelayFilesBeforeFile(ctx, s.logger, s.archiver, sa.relayBaseDir, sa.uuids, sa.activeRelayLog)
 }
 
 func (s *spaceStrategy) Purging() bool {
@@ -318,14 +328,16 @@ func (ta *timeArgs) String() string {
 // timeStrategy represents a relay purge strategy by time
 // similar to `PURGE BINARY LOGS BEFORE` in MySQL.
 type timeStrategy struct {
-	purging atomic.Bool
+	purging  atomic.Bool
+	archiver *relayArchiver
 
 	logger log.Logger
 }
 
-func newTimeStrategy() PurgeStrategy {
+func newTimeStrategy(archiver *relayArchiver) PurgeStrategy {
 	return &timeStrategy{
-		logger: log.With(zap.String("component", "relay purger"), zap.String("strategy", "time")),
+		archiver: archiver,
+		logger:   log.With(zap.String("component", "relay purger"), zap.String("strategy", "time")),
 	}
 }
 
@@ -337,7 +349,7 @@ func (s *timeStrategy) Check(args interface{}) (bool, error) {
 func (s *timeStrategy) Stop() {
 }
 
-func (s *timeStrategy) Do(args interface{}) error {
+func (s *timeStrategy) Do(ctx context.Context, args interface{}) error {
 	if !s.purging.CAS(false, true) {
 		return terror.ErrRelayThisStrategyIsPurging.Generate()
 	}
@@ -348,7 +360,7 @@ func (s *timeStrategy) Do(args interface{}) error {
 		return terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
 	}
 
-	return purgeRelayFilesBeforeFileAndTime(s.logger, ta.relayBaseDir, ta.uuids, ta.activeRelayLog, ta.safeTime)
+	return purgeRelayFilesBeforeFileAndTime(ctx, s.logger, s.archiver, ta.relayBaseDir, ta.uuids, ta.activeRelayLog, ta.safeTime)
 }
 
 func (s *timeStrategy) Purging() bool {
@@ -358,3 +370,70 @@ func (s *timeStrategy) Purging() bool {
 func (s *timeStrategy) Type() strategyType {
 	return strategyTime
 }
+
+// archiveArgs represents args needed by archiveStrategy.
+type archiveArgs struct {
+	relayBaseDir   string
+	uuids          []string
+	activeRelayLog *streamer.RelayLogInfo // earliest active relay log info
+}
+
+func (aa *archiveArgs) SetActiveRelayLog(active *streamer.RelayLogInfo) {
+	aa.activeRelayLog = active
+}
+
+func (aa *archiveArgs) String() string {
+	return fmt.Sprintf("(RelayBaseDir: %s, UUIDs: %s, ActiveRelayLog: %s)",
+		aa.relayBaseDir, strings.Join(aa.uuids, ";"), aa.activeRelayLog)
+}
+
+// archiveStrategy represents a relay strategy which archives all inactive relay log files to external storage
+// and removes the expired archived files, it does not purge the local relay log files, which are purged by other
+// strategies after archived.
+type archiveStrategy struct {
+	purging  atomic.Bool
+	archiver *relayArchiver
+
+	logger log.Logger
+}
+
+func newArchiveStrategy(archiver *relayArchiver) PurgeStrategy {
+	return &archiveStrategy{
+		archiver: archiver,
+		logger:   log.With(zap.String("component", "relay purger"), zap.String("strategy", "archive")),
+	}
+}
+
+func (s *archiveStrategy) Check(args interface{}) (bool, error) {
+	// for archive strategy, we always try to archive new relay log files
+	return s.archiver != nil, nil
+}
+
+func (s *archiveStrategy) Do(ctx context.Context, args interface{}) error {
+	if !s.purging.CAS(false, true) {
+		return terror.ErrRelayThisStrategyIsPurging.Generate()
+	}
+	defer s.purging.Store(false)
+
+	aa, ok := args.(*archiveArgs)
+	if !ok {
+		return terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
+	}
+
+	files, err := getRelayFilesBeforeFile(s.logger, aa.relayBaseDir, aa.uuids, aa.activeRelayLog)
+	if err != nil {
+		return terror.Annotatef(err, "get relay files from directory %s before file %+v with UUIDs %v", aa.relayBaseDir, aa.activeRelayLog, aa.uuids)
+	}
+	if err = s.archiver.archive(ctx, files); err != nil {
+		return err
+	}
+	return s.archiver.expire(ctx, time.Now())
+}
+
+func (s *archiveStrategy) Purging() bool {
+	return s.purging.Load()
+}
+
+func (s *archiveStrategy) Type() strategyType {
+	return strategyArchive
+}
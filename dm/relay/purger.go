@@ -93,11 +93,17 @@ func NewRelayPurger(cfg config.PurgeConfig, baseRelayDir string, operators []Ope
 		logger:       log.With(zap.String("component", "relay purger")),
 	}
 
+	// relay log files are archived before purged if archive enabled
+	archiver := newRelayArchiver(cfg.Archive, baseRelayDir)
+
 	// add strategies
-	p.strategies[strategyInactive] = newInactiveStrategy()
-	p.strategies[strategyFilename] = newFilenameStrategy()
-	p.strategies[strategyTime] = newTimeStrategy()
-	p.strategies[strategySpace] = newSpaceStrategy()
+	p.strategies[strategyInactive] = newInactiveStrategy(archiver)
+	p.strategies[strategyFilename] = newFilenameStrategy(archiver)
+	p.strategies[strategyTime] = newTimeStrategy(archiver)
+	p.strategies[strategySpace] = newSpaceStrategy(archiver)
+	if archiver != nil {
+		p.strategies[strategyArchive] = newArchiveStrategy(archiver)
+	}
 
 	return p
 }
@@ -108,7 +114,7 @@ func (p *relayPurger) Start() {
 		return
 	}
 
-	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0) {
+	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0 && len(p.cfg.Archive.Storage) == 0) {
 		return // no need do purge in the background
 	}
 
@@ -137,7 +143,7 @@ func (p *relayPurger) run() {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			p.tryPurge()
+			p.tryPurge(ctx)
 		}
 	}
 }
@@ -177,7 +183,7 @@ func (p *relayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			relayBaseDir: p.baseRelayDir,
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return p.doPurge(ctx, ps, args)
 	case req.Time > 0:
 		ps := p.strategies[strategyTime]
 		args := &timeArgs{
@@ -185,7 +191,7 @@ func (p *relayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			safeTime:     time.Unix(req.Time, 0),
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return p.doPurge(ctx, ps, args)
 	case len(req.Filename) > 0:
 		ps := p.strategies[strategyFilename]
 		args := &filenameArgs{
@@ -194,30 +200,58 @@ func (p *relayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			subDir:       req.SubDir,
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return p.doPurge(ctx, ps, args)
 	default:
 		return terror.ErrRelayPurgeRequestNotValid.Generate(req)
 	}
 }
 
 // tryPurge tries to do purge by check condition first.
-func (p *relayPurger) tryPurge() {
+func (p *relayPurger) tryPurge(ctx context.Context) {
 	strategy, args, err := p.check()
 	if err != nil {
 		p.logger.Error("check whether need to purge relay log files in background", zap.Error(err))
 		return
 	}
-	if strategy == nil {
+	if strategy != nil {
+		err = p.doPurge(ctx, strategy, args)
+		if err != nil {
+			p.logger.Error("do purge", zap.Stringer("strategy", strategy.Type()), zap.Error(err))
+		}
+	}
+
+	// strategyArchive is not exclusive with other strategies, the remaining inactive
+	// relay log files are archived after purging
+	p.tryArchive(ctx)
+}
+
+// tryArchive archives the inactive relay log files and removes the expired archived files.
+func (p *relayPurger) tryArchive(ctx context.Context) {
+	ps, ok := p.strategies[strategyArchive]
+	if !ok {
+		return
+	}
+	uuids, err := utils.ParseUUIDIndex(p.indexPath)
+	if err != nil {
+		p.logger.Error("parse UUID index file", zap.String("file", p.indexPath), zap.Error(err))
+		return
+	}
+	args := &archiveArgs{
+		relayBaseDir: p.baseRelayDir,
+		uuids:        uuids,
+	}
+	need, err := ps.Check(args)
+	if err != nil || !need {
 		return
 	}
-	err = p.doPurge(strategy, args)
+	err = p.doPurge(ctx, ps, args)
 	if err != nil {
-		p.logger.Error("do purge", zap.Stringer("strategy", strategy.Type()), zap.Error(err))
+		p.logger.Error("do archive", zap.Stringer("strategy", ps.Type()), zap.Error(err))
 	}
 }
 
 // doPurge does the purging operation.
-func (p *relayPurger) doPurge(ps PurgeStrategy, args StrategyArgs) error {
+func (p *relayPurger) doPurge(ctx context.Context, ps PurgeStrategy, args StrategyArgs) error {
 	if !p.purgingStrategy.CAS(uint32(strategyNone), uint32(ps.Type())) {
 		return terror.ErrRelayOtherStrategyIsPurging.Generate(ps.Type())
 	}
@@ -238,7 +272,7 @@ func (p *relayPurger) doPurge(ps PurgeStrategy, args StrategyArgs) error {
 	args.SetActiveRelayLog(earliest)
 
 	p.logger.Info("start purging relay log files", zap.Stringer("type", ps.Type()), zap.Any("args", args))
-	return ps.Do(args)
+	return ps.Do(ctx, args)
 }
 
 func (p *relayPurger) check() (PurgeStrategy, StrategyArgs, error) {
@@ -288,6 +322,7 @@ func (p *relayPurger) check() (PurgeStrategy, StrategyArgs, error) {
 		}
 	}
 
+	// 5. strategyArchive is checked in tryArchive after other strategies
 	return nil, nil, nil
 }
 
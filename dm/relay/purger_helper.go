@@ -14,6 +14,7 @@
 package relay
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"time"
@@ -34,23 +35,23 @@ type subRelayFiles struct {
 }
 
 // purgeRelayFilesBeforeFile purge relay log files which are older than safeRelay.
-func purgeRelayFilesBeforeFile(logger log.Logger, relayBaseDir string, uuids []string, safeRelay *streamer.RelayLogInfo) error {
+func purgeRelayFilesBeforeFile(ctx context.Context, logger log.Logger, archiver *relayArchiver, relayBaseDir string, uuids []string, safeRelay *streamer.RelayLogInfo) error {
 	files, err := getRelayFilesBeforeFile(logger, relayBaseDir, uuids, safeRelay)
 	if err != nil {
 		return terror.Annotatef(err, "get relay files from directory %s before file %+v with UUIDs %v", relayBaseDir, safeRelay, uuids)
 	}
 
-	return purgeRelayFiles(logger, files)
+	return purgeRelayFiles(ctx, logger, archiver, files)
 }
 
 // purgeRelayFilesBeforeFileAndTime purge relay log files which are older than safeRelay and safeTime.
-func purgeRelayFilesBeforeFileAndTime(logger log.Logger, relayBaseDir string, uuids []string, safeRelay *streamer.RelayLogInfo, safeTime time.Time) error {
+func purgeRelayFilesBeforeFileAndTime(ctx context.Context, logger log.Logger, archiver *relayArchiver, relayBaseDir string, uuids []string, safeRelay *streamer.RelayLogInfo, safeTime time.Time) error {
 	files, err := getRelayFilesBeforeFileAndTime(logger, relayBaseDir, uuids, safeRelay, safeTime)
 	if err != nil {
 		return terror.Annotatef(err, "get relay files from directory %s before file %+v and time %v with UUIDs %v", relayBaseDir, safeRelay, safeTime, uuids)
 	}
 
-	return purgeRelayFiles(logger, files)
+	return purgeRelayFiles(ctx, logger, archiver, files)
 }
 
 // getRelayFilesBeforeFile gets a list of relay log files which are older than safeRelay.
@@ -159,12 +160,19 @@ func collectRelayFilesBeforeFileAndTime(logger log.Logger, relayBaseDir string,
 }
 
 // purgeRelayFiles purges relay log files and directories if them become empty.
-func purgeRelayFiles(logger log.Logger, files []*subRelayFiles) error {
+// if archiver is not nil, relay log files are archived to external storage before purged.
+func purgeRelayFiles(ctx context.Context, logger log.Logger, archiver *relayArchiver, files []*subRelayFiles) error {
 	startTime := time.Now()
 	defer func() {
 		logger.Info("purge relay log files", zap.Duration("cost time", time.Since(startTime)))
 	}()
 
+	if archiver != nil {
+		if err := archiver.archive(ctx, files); err != nil {
+			return err
+		}
+	}
+
 	for _, subRelay := range files {
 		for _, f := range subRelay.files {
 			logger.Info("purging relay log file", zap.String("file", f))
@@ -14,6 +14,7 @@
 package relay
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"time"
@@ -108,7 +109,7 @@ func (t *testPurgerSuite) TestPurgeRelayFilesBeforeFile(c *C) {
 	c.Assert(os.WriteFile(fakeMeta, []byte{}, 0o666), IsNil)
 
 	// purge all relay log files in first and second sub dir, and some in third sub dir
-	err = purgeRelayFilesBeforeFile(log.L(), baseDir, t.uuids, safeRelay)
+	err = purgeRelayFilesBeforeFile(context.Background(), log.L(), nil, baseDir, t.uuids, safeRelay)
 	c.Assert(err, IsNil)
 	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsFalse)
 	c.Assert(utils.IsDirExists(relayDirsPath[1]), IsFalse)
@@ -167,7 +168,7 @@ func (t *testPurgerSuite) TestPurgeRelayFilesBeforeFileAndTime(c *C) {
 	c.Assert(os.WriteFile(fakeMeta, []byte{}, 0o666), IsNil)
 
 	// purge all relay log files in first and second sub dir, and some in third sub dir
-	err = purgeRelayFilesBeforeFileAndTime(log.L(), baseDir, t.uuids, safeRelay, safeTime)
+	err = purgeRelayFilesBeforeFileAndTime(context.Background(), log.L(), nil, baseDir, t.uuids, safeRelay, safeTime)
 	c.Assert(err, IsNil)
 	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsFalse)
 	c.Assert(utils.IsDirExists(relayDirsPath[1]), IsTrue)
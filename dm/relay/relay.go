@@ -1225,7 +1225,13 @@ func (r *Relay) notify(e *replication.BinlogEvent) {
 }
 
 func (r *Relay) NewReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader {
-	return newBinlogReader(logger, cfg, r)
+	// copy the config to avoid changing the caller's one
+	readerCfg := *cfg
+	if len(readerCfg.ArchiveStorage) == 0 {
+		// read the archived relay log files of this relay
+		readerCfg.ArchiveStorage = r.cfg.ArchiveStorage
+	}
+	return newBinlogReader(logger, &readerCfg, r)
 }
 
 // RegisterListener implements Process.RegisterListener.
@@ -183,7 +183,7 @@ func (s *testCheckpointSuite) testGlobalCheckPoint(c *C, cp CheckPoint) {
 	pos1.Pos = 2044
 	s.cfg.Mode = config.ModeIncrement
 	s.cfg.Meta = &config.Meta{BinLogName: pos1.Name, BinLogPos: pos1.Pos}
-	err = cp.LoadMeta()
+	err = cp.LoadMeta(context.Background())
 	c.Assert(err, IsNil)
 	c.Assert(cp.GlobalPoint().Position, Equals, pos1)
 	c.Assert(cp.FlushedGlobalPoint().Position, Equals, pos1)
@@ -269,7 +269,7 @@ func (s *testCheckpointSuite) testGlobalCheckPoint(c *C, cp CheckPoint) {
 	c.Assert(err, IsNil)
 	s.cfg.Mode = config.ModeAll
 	s.cfg.Dir = dir
-	c.Assert(cp.LoadMeta(), IsNil)
+	c.Assert(cp.LoadMeta(context.Background()), IsNil)
 
 	// should flush because globalPointSaveTime is zero
 	s.mock.ExpectBegin()
@@ -308,7 +308,7 @@ SHOW MASTER STATUS: /* AFTER CONNECTION POOL ESTABLISHED */
 	GTID:
 `, pos1.Name, pos1.Pos, "slave_host", pos1.Name, pos1.Pos+1000, pos2.Name, pos2.Pos)), 0o644)
 	c.Assert(err, IsNil)
-	c.Assert(cp.LoadMeta(), IsNil)
+	c.Assert(cp.LoadMeta(context.Background()), IsNil)
 
 	// should flush because globalPointSaveTime is zero
 	s.mock.ExpectBegin()
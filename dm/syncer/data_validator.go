@@ -0,0 +1,872 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	tidbtypes "github.com/pingcap/tidb/types"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/ticdc/dm/dm/config"
+	"github.com/pingcap/ticdc/dm/dm/pb"
+	"github.com/pingcap/ticdc/dm/pkg/conn"
+	tcontext "github.com/pingcap/ticdc/dm/pkg/context"
+	"github.com/pingcap/ticdc/dm/pkg/cputil"
+	"github.com/pingcap/ticdc/dm/pkg/log"
+	"github.com/pingcap/ticdc/dm/pkg/terror"
+	"github.com/pingcap/ticdc/dm/syncer/dbconn"
+)
+
+const (
+	// status of the row changes recorded in the error change table.
+	validationErrStatusRetrying = "retrying"
+	validationErrStatusFailed   = "failed"
+
+	// validateBatchSize is the max number of rows fetched from the downstream in one query.
+	validateBatchSize = 100
+	// floatRelativeTolerance is the tolerance when comparing FLOAT/DOUBLE values,
+	// the values may lose precision when converted to strings.
+	floatRelativeTolerance = 1e-6
+	maxValidatorTimeout    = "1m"
+)
+
+// rowChange is a row change applied to the downstream by the syncer, which is
+// waiting to be validated.
+type rowChange struct {
+	sourceTable *filter.Table
+	targetTable *filter.Table
+	// keyColumns and keyValues identify the row in the downstream, they're
+	// sorted by the column names. keyTypes are the types of keyColumns, which
+	// are nil if the change is loaded from the error change table.
+	keyColumns []string
+	keyValues  []string
+	keyTypes   []*types.FieldType
+	// key identifies the row change in the pending set of the validator.
+	key string
+	// columns and data are the expected image of the row, a value of data is
+	// a string, a float64 for FLOAT/DOUBLE columns or nil for NULL. columns is
+	// empty if the row is deleted and is expected to be absent. columnTypes
+	// are the types of columns like keyTypes.
+	columns     []string
+	data        []interface{}
+	columnTypes []*types.FieldType
+
+	applied    time.Time // when the change is applied by the syncer
+	mismatched time.Time // when the first mismatch is found, zero if none
+	retryCount int
+	recorded   bool // whether the change is recorded in the error change table
+}
+
+func (r *rowChange) deleted() bool {
+	return len(r.columns) == 0
+}
+
+// selectColumns returns the columns read from the downstream, the key columns
+// are always the first ones.
+func (r *rowChange) selectColumns() []string {
+	columns := make([]string, 0, len(r.keyColumns)+len(r.columns))
+	columns = append(columns, r.keyColumns...)
+	return append(columns, r.columns...)
+}
+
+// groupKey returns the key of the rows which can be read in one query.
+func (r *rowChange) groupKey() string {
+	return r.targetTable.String() + "|" + strings.Join(r.selectColumns(), ",")
+}
+
+// match compares the expected image with the actual values read from the
+// downstream, actual is nil if the row doesn't exist.
+func (r *rowChange) match(actual []sql.NullString) bool {
+	if r.deleted() || actual == nil {
+		return r.deleted() && actual == nil
+	}
+	for i, expected := range r.data {
+		value := actual[len(r.keyColumns)+i]
+		switch v := expected.(type) {
+		case nil:
+			if value.Valid {
+				return false
+			}
+		case float64:
+			if !value.Valid {
+				return false
+			}
+			f, err := strconv.ParseFloat(value.String, 64)
+			if err != nil || math.Abs(f-v) > floatRelativeTolerance*math.Max(math.Abs(v), 1) {
+				return false
+			}
+		case string:
+			if !value.Valid {
+				return false
+			}
+			actualValue := value.String
+			if r.columnTypes != nil {
+				actualValue = normalizeValue(actualValue, r.columnTypes[i])
+			}
+			if actualValue != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (r *rowChange) rowKeyJSON() string {
+	m := make(map[string]string, len(r.keyColumns))
+	for i, col := range r.keyColumns {
+		m[col] = r.keyValues[i]
+	}
+	data, _ := json.Marshal(m)
+	return string(data)
+}
+
+func (r *rowChange) expectedJSON() interface{} {
+	if r.deleted() {
+		return nil
+	}
+	m := make(map[string]interface{}, len(r.columns))
+	for i, col := range r.columns {
+		m[col] = r.data[i]
+	}
+	data, _ := json.Marshal(m)
+	return string(data)
+}
+
+func (r *rowChange) actualJSON(actual []sql.NullString) interface{} {
+	if actual == nil {
+		return nil
+	}
+	columns := r.selectColumns()
+	m := make(map[string]*string, len(columns))
+	for i, col := range columns {
+		if actual[i].Valid {
+			v := actual[i].String
+			m[col] = &v
+		} else {
+			m[col] = nil
+		}
+	}
+	data, _ := json.Marshal(m)
+	return string(data)
+}
+
+// genRowChanges generates the row changes expected in the downstream after the
+// DML is applied. Tables without a primary key or a not null unique key are not
+// validated.
+func genRowChanges(dml *DML, targetTable *filter.Table, applied time.Time) []*rowChange {
+	if dml == nil || dml.downstreamTableInfo == nil || dml.downstreamTableInfo.AbsoluteUKIndexInfo == nil {
+		return nil
+	}
+	newRowChange := func(values []interface{}, deleted bool) *rowChange {
+		keyColumns, keyValues, keyTypes, ok := rowKey(dml.downstreamTableInfo.AbsoluteUKIndexInfo, dml.columns, values)
+		if !ok {
+			return nil
+		}
+		r := &rowChange{
+			sourceTable: dml.sourceTable,
+			targetTable: targetTable,
+			keyColumns:  keyColumns,
+			keyValues:   keyValues,
+			keyTypes:    keyTypes,
+			key:         targetTable.String() + "|" + genKey(toInterfaces(keyValues)),
+			applied:     applied,
+		}
+		if !deleted {
+			r.columns, r.data, r.columnTypes = rowImage(dml.columns, values)
+		}
+		return r
+	}
+
+	var changes []*rowChange
+	switch dml.op {
+	case insert:
+		changes = append(changes, newRowChange(dml.values, false))
+	case update:
+		if dml.updateIdentify() {
+			changes = append(changes, newRowChange(dml.oldValues, true))
+		}
+		changes = append(changes, newRowChange(dml.values, false))
+	case del:
+		changes = append(changes, newRowChange(dml.values, true))
+	}
+	result := changes[:0]
+	for _, r := range changes {
+		if r != nil {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// rowKey returns the names, the normalized values and the types of the key
+// columns sorted by the names.
+func rowKey(index *model.IndexInfo, columns []*model.ColumnInfo, values []interface{}) ([]string, []string, []*types.FieldType, bool) {
+	type keyColumn struct {
+		name, value string
+		tp          *types.FieldType
+	}
+	keys := make([]keyColumn, 0, len(index.Columns))
+	for _, idxCol := range index.Columns {
+		if idxCol.Offset >= len(columns) || idxCol.Offset >= len(values) ||
+			columns[idxCol.Offset].Name.L != idxCol.Name.L || values[idxCol.Offset] == nil {
+			return nil, nil, nil, false
+		}
+		col := columns[idxCol.Offset]
+		value := normalizeValue(columnValue(values[idxCol.Offset], &col.FieldType), &col.FieldType)
+		keys = append(keys, keyColumn{col.Name.O, value, &col.FieldType})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].name < keys[j].name })
+	names := make([]string, 0, len(keys))
+	keyValues := make([]string, 0, len(keys))
+	keyTypes := make([]*types.FieldType, 0, len(keys))
+	for _, k := range keys {
+		names = append(names, k.name)
+		keyValues = append(keyValues, k.value)
+		keyTypes = append(keyTypes, k.tp)
+	}
+	return names, keyValues, keyTypes, true
+}
+
+// rowImage converts the values of a row to the expected image, the columns
+// whose values in binlog differ from the ones read from the downstream are
+// skipped, such as ENUM, SET, BIT and JSON.
+func rowImage(columns []*model.ColumnInfo, values []interface{}) ([]string, []interface{}, []*types.FieldType) {
+	names := make([]string, 0, len(columns))
+	data := make([]interface{}, 0, len(columns))
+	columnTypes := make([]*types.FieldType, 0, len(columns))
+	for i, col := range columns {
+		if i >= len(values) {
+			break
+		}
+		var value interface{}
+		switch col.Tp {
+		case mysql.TypeEnum, mysql.TypeSet, mysql.TypeBit, mysql.TypeJSON:
+			continue
+		case mysql.TypeFloat, mysql.TypeDouble:
+			if values[i] != nil {
+				f, err := strconv.ParseFloat(columnValue(values[i], &col.FieldType), 64)
+				if err != nil {
+					continue
+				}
+				value = f
+			}
+		default:
+			if values[i] != nil {
+				value = normalizeValue(columnValue(values[i], &col.FieldType), &col.FieldType)
+			}
+		}
+		names = append(names, col.Name.O)
+		data = append(data, value)
+		columnTypes = append(columnTypes, &col.FieldType)
+	}
+	return names, data, columnTypes
+}
+
+// normalizeValue converts a value in binlog or read from the downstream to
+// the same format by the column type, so they can be compared as strings.
+// DECIMAL values are formatted in the scale of the column, DATETIME and
+// TIMESTAMP values in the fractional seconds precision of the column, FLOAT
+// and DOUBLE values in the shortest format, and BINARY values are padded
+// with zero bytes like the downstream. The value is returned as is if it
+// can't be parsed.
+func normalizeValue(value string, ft *types.FieldType) string {
+	switch ft.Tp {
+	case mysql.TypeNewDecimal:
+		var d, rounded tidbtypes.MyDecimal
+		if ft.Decimal < 0 || d.FromString([]byte(value)) != nil ||
+			d.Round(&rounded, ft.Decimal, tidbtypes.ModeHalfEven) != nil {
+			return value
+		}
+		return string(rounded.ToString())
+	case mysql.TypeDatetime, mysql.TypeTimestamp:
+		fsp := ft.Decimal
+		if fsp < int(tidbtypes.MinFsp) || fsp > int(tidbtypes.MaxFsp) {
+			fsp = int(tidbtypes.MinFsp)
+		}
+		// TIMESTAMP values are parsed as DATETIME values, the time zone is not
+		// needed since only the format is changed.
+		t, err := tidbtypes.ParseTime(&stmtctx.StatementContext{}, value, mysql.TypeDatetime, int8(fsp))
+		if err != nil {
+			return value
+		}
+		return t.String()
+	case mysql.TypeFloat, mysql.TypeDouble:
+		bitSize := 64
+		if ft.Tp == mysql.TypeFloat {
+			bitSize = 32
+		}
+		f, err := strconv.ParseFloat(value, bitSize)
+		if err != nil {
+			return value
+		}
+		return strconv.FormatFloat(f, 'f', -1, bitSize)
+	case mysql.TypeString:
+		if ft.Charset == charset.CharsetBin && len(value) < ft.Flen {
+			return value + strings.Repeat("\x00", ft.Flen-len(value))
+		}
+	}
+	return value
+}
+
+func toInterfaces(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+	return result
+}
+
+// DataValidator validates the row changes applied by the syncer continuously.
+// It reads the changed rows from the downstream by the primary key after a
+// delay and compares them with the expected images, mismatched rows are
+// recorded in the error change table and retried until RowErrorDelay passes.
+type DataValidator struct {
+	cfg    *config.SubTaskConfig
+	tctx   *tcontext.Context
+	vcfg   config.ValidatorConfig
+	source string
+
+	errTableName string
+	db           *conn.BaseDB
+	dbConn       *dbconn.DBConn // used to update the error change table
+	workers      []*validateWorker
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+
+	processedRowCount atomic.Int64
+	errorRowCount     atomic.Int64
+	pendingRowCount   atomic.Int64
+	// skippedRowCount counts the row changes not validated, since there are
+	// already MaxPendingRowCount row changes pending, or they can't be read
+	// from the downstream until RowErrorDelay passes.
+	skippedRowCount atomic.Int64
+	skipping        atomic.Bool
+
+	closed atomic.Bool
+}
+
+// NewDataValidator creates a new DataValidator.
+func NewDataValidator(cfg *config.SubTaskConfig) *DataValidator {
+	logger := log.With(zap.String("task", cfg.Name), zap.String("unit", "continuous validator"))
+	v := &DataValidator{
+		cfg:          cfg,
+		tctx:         tcontext.Background().WithLogger(logger),
+		vcfg:         cfg.ValidatorCfg,
+		source:       cfg.SourceID,
+		errTableName: dbutil.TableName(cfg.MetaSchema, cputil.ValidatorErrorChange(cfg.Name)),
+	}
+	for i := 0; i < v.vcfg.WorkerCount; i++ {
+		v.workers = append(v.workers, &validateWorker{
+			v:       v,
+			pending: make(map[string]*rowChange),
+		})
+	}
+	return v
+}
+
+// Init creates the connections to the downstream and the error change table,
+// and loads the row changes still retrying.
+func (v *DataValidator) Init(tctx *tcontext.Context) (err error) {
+	dbCfg := v.cfg.To
+	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxValidatorTimeout)
+	db, dbConns, err := dbconn.CreateConns(tctx, v.cfg, &dbCfg, len(v.workers)+1)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			dbconn.CloseBaseDB(tctx, db)
+		}
+	}()
+	v.db = db
+	v.dbConn = dbConns[0]
+	for i, w := range v.workers {
+		w.conn = dbConns[i+1]
+	}
+
+	if err = v.createTable(tctx); err != nil {
+		return err
+	}
+	return v.loadErrorChanges(tctx)
+}
+
+func (v *DataValidator) createTable(tctx *tcontext.Context) error {
+	sqls := []string{
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(v.cfg.MetaSchema)),
+		`CREATE TABLE IF NOT EXISTS ` + v.errTableName + ` (
+			id BIGINT AUTO_INCREMENT PRIMARY KEY,
+			source VARCHAR(32) NOT NULL,
+			src_schema_name VARCHAR(128) NOT NULL,
+			src_table_name VARCHAR(128) NOT NULL,
+			dst_schema_name VARCHAR(128) NOT NULL,
+			dst_table_name VARCHAR(128) NOT NULL,
+			row_pk TEXT NOT NULL,
+			expected_data JSON,
+			actual_data JSON,
+			status VARCHAR(16) NOT NULL,
+			retry_count INT NOT NULL DEFAULT 0,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+			INDEX idx_source_table (source, dst_schema_name, dst_table_name)
+		)`,
+	}
+	_, err := v.dbConn.ExecuteSQL(tctx, sqls)
+	v.tctx.L().Info("create validator error change table", zap.Strings("statements", sqls))
+	return err
+}
+
+// loadErrorChanges loads the row changes still retrying, and the count of the
+// failed ones.
+func (v *DataValidator) loadErrorChanges(tctx *tcontext.Context) error {
+	query := `SELECT src_schema_name, src_table_name, dst_schema_name, dst_table_name, row_pk, expected_data, status, retry_count FROM ` +
+		v.errTableName + ` WHERE source = ?`
+	rows, err := v.dbConn.QuerySQL(tctx, query, v.source)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	var (
+		now       = time.Now()
+		failed    int64
+		srcSchema string
+		srcTable  string
+		dstSchema string
+		dstTable  string
+		rowPK     string
+		expected  sql.NullString
+		status    string
+		retry     int
+	)
+	for rows.Next() {
+		if err = rows.Scan(&srcSchema, &srcTable, &dstSchema, &dstTable, &rowPK, &expected, &status, &retry); err != nil {
+			return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+		if status != validationErrStatusRetrying {
+			failed++
+			continue
+		}
+		r := &rowChange{
+			sourceTable: &filter.Table{Schema: srcSchema, Name: srcTable},
+			targetTable: &filter.Table{Schema: dstSchema, Name: dstTable},
+			applied:     now,
+			mismatched:  now,
+			retryCount:  retry,
+			recorded:    true,
+		}
+		if err = r.unmarshal(rowPK, expected); err != nil {
+			v.tctx.L().Warn("ignore invalid error change", zap.String("row key", rowPK), zap.Error(err))
+			continue
+		}
+		v.workerOf(r.key).add(r)
+	}
+	if err = rows.Err(); err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	v.errorRowCount.Store(failed)
+	return nil
+}
+
+func (r *rowChange) unmarshal(rowPK string, expected sql.NullString) error {
+	var keys map[string]string
+	if err := json.Unmarshal([]byte(rowPK), &keys); err != nil {
+		return err
+	}
+	for col := range keys {
+		r.keyColumns = append(r.keyColumns, col)
+	}
+	sort.Strings(r.keyColumns)
+	for _, col := range r.keyColumns {
+		r.keyValues = append(r.keyValues, keys[col])
+	}
+	r.key = r.targetTable.String() + "|" + genKey(toInterfaces(r.keyValues))
+
+	if !expected.Valid {
+		return nil
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(expected.String), &data); err != nil {
+		return err
+	}
+	for col := range data {
+		r.columns = append(r.columns, col)
+	}
+	sort.Strings(r.columns)
+	for _, col := range r.columns {
+		r.data = append(r.data, data[col])
+	}
+	return nil
+}
+
+// Start starts the validate workers, it's called when the syncer starts processing.
+func (v *DataValidator) Start(ctx context.Context) {
+	ctx, v.cancel = context.WithCancel(ctx)
+	for _, w := range v.workers {
+		v.wg.Add(1)
+		go func(w *validateWorker) {
+			defer v.wg.Done()
+			w.run(v.tctx.WithContext(ctx))
+		}(w)
+	}
+	v.tctx.L().Info("continuous validator started", zap.Int("worker count", len(v.workers)))
+}
+
+// Stop stops the validate workers, the pending row changes are kept and
+// validated after the validator is started again.
+func (v *DataValidator) Stop() {
+	if v.cancel != nil {
+		v.cancel()
+	}
+	v.wg.Wait()
+}
+
+// Close stops the validator and closes the connections, it's safe to be
+// called more than once.
+func (v *DataValidator) Close() {
+	if v.closed.Swap(true) {
+		return
+	}
+	v.Stop()
+	dbconn.CloseBaseDB(v.tctx, v.db)
+}
+
+// AddJobs adds the row changes of the DML jobs applied to the downstream.
+func (v *DataValidator) AddJobs(jobs []*job) {
+	now := time.Now()
+	for _, j := range jobs {
+		for _, r := range genRowChanges(j.dml, j.targetTable, now) {
+			v.workerOf(r.key).add(r)
+		}
+	}
+}
+
+func (v *DataValidator) workerOf(key string) *validateWorker {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return v.workers[h.Sum32()%uint32(len(v.workers))]
+}
+
+// Status returns the status of the validator.
+func (v *DataValidator) Status() *pb.ValidationStatus {
+	return &pb.ValidationStatus{
+		Task:              v.cfg.Name,
+		Source:            v.source,
+		Mode:              v.vcfg.Mode,
+		ProcessedRowCount: v.processedRowCount.Load(),
+		PendingRowCount:   v.pendingRowCount.Load(),
+		ErrorRowCount:     v.errorRowCount.Load(),
+		SkippedRowCount:   v.skippedRowCount.Load(),
+	}
+}
+
+// GetErrors returns the row changes recorded in the error change table.
+func (v *DataValidator) GetErrors(tctx *tcontext.Context) ([]*pb.ValidationError, error) {
+	query := `SELECT id, src_schema_name, src_table_name, dst_schema_name, dst_table_name, row_pk, expected_data, actual_data, status, retry_count, update_time FROM ` +
+		v.errTableName + ` WHERE source = ? ORDER BY id`
+	rows, err := v.dbConn.QuerySQL(tctx, query, v.source)
+	if err != nil {
+		return nil, terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	var result []*pb.ValidationError
+	for rows.Next() {
+		var (
+			e                                      = &pb.ValidationError{Source: v.source}
+			srcSchema, srcTable, dstSchema, dstTbl string
+			expected, actual                       sql.NullString
+		)
+		err = rows.Scan(&e.Id, &srcSchema, &srcTable, &dstSchema, &dstTbl, &e.RowKey, &expected, &actual, &e.Status, &e.RetryCount, &e.Time)
+		if err != nil {
+			return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+		e.SrcTable = dbutil.TableName(srcSchema, srcTable)
+		e.DstTable = dbutil.TableName(dstSchema, dstTbl)
+		e.ExpectedData = expected.String
+		e.ActualData = actual.String
+		result = append(result, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	return result, nil
+}
+
+// validateWorker validates a part of the row changes partitioned by the keys.
+type validateWorker struct {
+	v    *DataValidator
+	conn *dbconn.DBConn
+
+	sync.Mutex
+	pending map[string]*rowChange
+}
+
+// add adds a row change, it replaces the older change of the same row. A new
+// row is skipped if there are too many row changes pending.
+func (w *validateWorker) add(r *rowChange) {
+	w.Lock()
+	defer w.Unlock()
+	if old, ok := w.pending[r.key]; ok {
+		r.recorded = old.recorded
+		r.retryCount = old.retryCount
+		w.pending[r.key] = r
+		return
+	}
+	if w.v.pendingRowCount.Load() >= int64(w.v.vcfg.MaxPendingRowCount) {
+		skipped := w.v.skippedRowCount.Inc()
+		if !w.v.skipping.Swap(true) {
+			w.v.tctx.L().Warn("too many row changes pending, skip validating the new row changes",
+				zap.Int("max pending row count", w.v.vcfg.MaxPendingRowCount))
+		}
+		w.v.tctx.L().Debug("skip validating the row change", zap.Stringer("table", r.targetTable),
+			zap.String("row key", r.rowKeyJSON()), zap.Int64("skipped row count", skipped))
+		return
+	}
+	w.pending[r.key] = r
+	w.v.pendingRowCount.Inc()
+}
+
+// remove removes the row change of the key, the caller must hold the lock.
+func (w *validateWorker) remove(key string) {
+	delete(w.pending, key)
+	if w.v.pendingRowCount.Dec() < int64(w.v.vcfg.MaxPendingRowCount) && w.v.skipping.Swap(false) {
+		w.v.tctx.L().Info("row changes are validated again", zap.Int64("skipped row count", w.v.skippedRowCount.Load()))
+	}
+}
+
+func (w *validateWorker) run(tctx *tcontext.Context) {
+	ticker := time.NewTicker(w.v.vcfg.ValidateInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-tctx.Context().Done():
+			return
+		case <-ticker.C:
+			w.validate(tctx)
+		}
+	}
+}
+
+// validate validates the pending row changes applied before ValidateInterval.
+func (w *validateWorker) validate(tctx *tcontext.Context) {
+	now := time.Now()
+	groups := make(map[string][]*rowChange)
+	w.Lock()
+	for _, r := range w.pending {
+		if now.Sub(r.applied) >= w.v.vcfg.ValidateInterval.Duration {
+			groups[r.groupKey()] = append(groups[r.groupKey()], r)
+		}
+	}
+	w.Unlock()
+
+	for _, rows := range groups {
+		for len(rows) > 0 {
+			n := validateBatchSize
+			if n > len(rows) {
+				n = len(rows)
+			}
+			batch := rows[:n]
+			rows = rows[n:]
+
+			actual, err := w.fetch(tctx, batch)
+			if err != nil {
+				if tctx.Context().Err() != nil {
+					return
+				}
+				w.v.tctx.L().Warn("fail to read rows from downstream", zap.Stringer("table", batch[0].targetTable), zap.Error(err))
+				w.dropExpired(batch, now)
+				continue
+			}
+			if err = w.handleResult(tctx, batch, actual, now); err != nil && tctx.Context().Err() == nil {
+				w.v.tctx.L().Warn("fail to update validator error changes", zap.Error(err))
+			}
+		}
+	}
+}
+
+// fetch reads the rows from the downstream, the result maps the row keys to
+// the selected columns.
+func (w *validateWorker) fetch(tctx *tcontext.Context, rows []*rowChange) (map[string][]sql.NullString, error) {
+	first := rows[0]
+	columns := first.selectColumns()
+	quoted := make([]string, 0, len(columns))
+	for _, col := range columns {
+		quoted = append(quoted, dbutil.ColumnName(col))
+	}
+	// the keys read from the downstream are normalized in the same way, the
+	// types are unknown only if all the changes are loaded from the error
+	// change table, whose keys are already normalized.
+	var keyTypes []*types.FieldType
+	for _, r := range rows {
+		if r.keyTypes != nil {
+			keyTypes = r.keyTypes
+			break
+		}
+	}
+	keyHolder := "(" + strings.TrimSuffix(strings.Repeat("?,", len(first.keyColumns)), ",") + ")"
+	holders := make([]string, 0, len(rows))
+	args := make([]interface{}, 0, len(rows)*len(first.keyColumns))
+	for _, r := range rows {
+		holders = append(holders, keyHolder)
+		args = append(args, toInterfaces(r.keyValues)...)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE (%s) IN (%s)",
+		strings.Join(quoted, ", "), dbutil.TableName(first.targetTable.Schema, first.targetTable.Name),
+		strings.Join(quoted[:len(first.keyColumns)], ", "), strings.Join(holders, ", "))
+
+	result, err := w.conn.QuerySQL(tctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	actual := make(map[string][]sql.NullString, len(rows))
+	for result.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err = result.Scan(dest...); err != nil {
+			return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+		}
+		keyValues := make([]interface{}, 0, len(first.keyColumns))
+		for i, v := range values[:len(first.keyColumns)] {
+			if keyTypes != nil {
+				keyValues = append(keyValues, normalizeValue(v.String, keyTypes[i]))
+			} else {
+				keyValues = append(keyValues, v.String)
+			}
+		}
+		actual[first.targetTable.String()+"|"+genKey(keyValues)] = values
+	}
+	return actual, terror.DBErrorAdapt(result.Err(), terror.ErrDBDriverError)
+}
+
+// dropExpired drops the row changes which can't be read from the downstream
+// longer than RowErrorDelay, e.g. the table is dropped or the columns are changed.
+// The dropped row changes are counted as skipped.
+func (w *validateWorker) dropExpired(rows []*rowChange, now time.Time) {
+	w.Lock()
+	defer w.Unlock()
+	dropped := 0
+	for _, r := range rows {
+		if w.pending[r.key] == r && now.Sub(r.applied) >= w.v.vcfg.RowErrorDelay.Duration {
+			w.remove(r.key)
+			dropped++
+		}
+	}
+	if dropped > 0 {
+		w.v.skippedRowCount.Add(int64(dropped))
+		w.v.tctx.L().Warn("skip validating the row changes which can't be read from downstream",
+			zap.Stringer("table", rows[0].targetTable), zap.Int("count", dropped))
+	}
+}
+
+func (w *validateWorker) handleResult(tctx *tcontext.Context, rows []*rowChange, actual map[string][]sql.NullString, now time.Time) error {
+	var (
+		sqls []string
+		args [][]interface{}
+	)
+	insertSQL := `INSERT INTO ` + w.v.errTableName + ` (source, src_schema_name, src_table_name, dst_schema_name, dst_table_name,
+		row_pk, expected_data, actual_data, status, retry_count) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	updateSQL := `UPDATE ` + w.v.errTableName + ` SET expected_data = ?, actual_data = ?, status = ?, retry_count = ?
+		WHERE source = ? AND dst_schema_name = ? AND dst_table_name = ? AND row_pk = ? AND status = ?`
+	deleteSQL := `DELETE FROM ` + w.v.errTableName + `
+		WHERE source = ? AND dst_schema_name = ? AND dst_table_name = ? AND row_pk = ? AND status = ?`
+
+	w.Lock()
+	for _, r := range rows {
+		if w.pending[r.key] != r {
+			// replaced by a newer change
+			continue
+		}
+		values := actual[r.key]
+		if r.match(values) {
+			w.remove(r.key)
+			w.v.processedRowCount.Inc()
+			if r.recorded {
+				sqls = append(sqls, deleteSQL)
+				args = append(args, []interface{}{w.v.source, r.targetTable.Schema, r.targetTable.Name, r.rowKeyJSON(), validationErrStatusRetrying})
+			}
+			continue
+		}
+
+		r.retryCount++
+		if r.mismatched.IsZero() {
+			r.mismatched = now
+		}
+		status := validationErrStatusRetrying
+		if now.Sub(r.mismatched) >= w.v.vcfg.RowErrorDelay.Duration {
+			status = validationErrStatusFailed
+			w.remove(r.key)
+			w.v.errorRowCount.Inc()
+			w.v.tctx.L().Warn("row change mismatched in downstream", zap.Stringer("table", r.targetTable),
+				zap.String("row key", r.rowKeyJSON()), zap.Int("retry count", r.retryCount))
+		}
+		if r.recorded {
+			sqls = append(sqls, updateSQL)
+			args = append(args, []interface{}{r.expectedJSON(), r.actualJSON(values), status, r.retryCount,
+				w.v.source, r.targetTable.Schema, r.targetTable.Name, r.rowKeyJSON(), validationErrStatusRetrying})
+		} else {
+			sqls = append(sqls, insertSQL)
+			args = append(args, []interface{}{w.v.source, r.sourceTable.Schema, r.sourceTable.Name, r.targetTable.Schema, r.targetTable.Name,
+				r.rowKeyJSON(), r.expectedJSON(), r.actualJSON(values), status, r.retryCount})
+			r.recorded = true
+		}
+	}
+	w.Unlock()
+
+	if len(sqls) == 0 {
+		return nil
+	}
+	_, err := w.conn.ExecuteSQL(tctx, sqls, args...)
+	return err
+}
+
+// ValidationStatus returns the status of the continuous validator.
+func (s *Syncer) ValidationStatus() (*pb.ValidationStatus, error) {
+	if s.validator == nil {
+		return nil, terror.ErrWorkerValidatorNotEnabled.Generate(s.cfg.Name)
+	}
+	return s.validator.Status(), nil
+}
+
+// ValidationErrors returns the row changes mismatched in the downstream.
+func (s *Syncer) ValidationErrors(ctx context.Context) ([]*pb.ValidationError, error) {
+	if s.validator == nil {
+		return nil, terror.ErrWorkerValidatorNotEnabled.Generate(s.cfg.Name)
+	}
+	return s.validator.GetErrors(s.tctx.WithContext(ctx))
+}
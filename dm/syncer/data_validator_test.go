@@ -0,0 +1,253 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/ticdc/dm/dm/config"
+	"github.com/pingcap/ticdc/dm/pkg/conn"
+	tcontext "github.com/pingcap/ticdc/dm/pkg/context"
+	"github.com/pingcap/ticdc/dm/pkg/retry"
+	"github.com/pingcap/ticdc/dm/pkg/schema"
+	"github.com/pingcap/ticdc/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestGenRowChanges(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	sourceTable := &filter.Table{Schema: "test", Name: "tb1"}
+	targetTable := &filter.Table{Schema: "test", Name: "tb"}
+	ti, err := createTableInfo(p, se, 0, "create table test.tb(id int primary key, name varchar(24), score double, e enum('a', 'b'))")
+	c.Assert(err, IsNil)
+	downTi := schema.GetDownStreamTi(ti, ti)
+	now := time.Now()
+
+	dml := newDML(insert, false, "`test`.`tb`", sourceTable, nil, []interface{}{1, "a", 1.5, 1}, nil, nil, ti.Columns, ti, nil, downTi)
+	changes := genRowChanges(dml, targetTable, now)
+	c.Assert(changes, HasLen, 1)
+	c.Assert(changes[0].keyColumns, DeepEquals, []string{"id"})
+	c.Assert(changes[0].keyValues, DeepEquals, []string{"1"})
+	// the enum column is skipped
+	c.Assert(changes[0].columns, DeepEquals, []string{"id", "name", "score"})
+	c.Assert(changes[0].data, DeepEquals, []interface{}{"1", "a", 1.5})
+	c.Assert(changes[0].deleted(), IsFalse)
+
+	// update the primary key, the old row is expected to be deleted
+	dml = newDML(update, false, "`test`.`tb`", sourceTable, []interface{}{1, "a", 1.5, 1}, []interface{}{2, nil, 1.5, 1}, nil, nil, ti.Columns, ti, nil, downTi)
+	changes = genRowChanges(dml, targetTable, now)
+	c.Assert(changes, HasLen, 2)
+	c.Assert(changes[0].deleted(), IsTrue)
+	c.Assert(changes[0].keyValues, DeepEquals, []string{"1"})
+	c.Assert(changes[1].keyValues, DeepEquals, []string{"2"})
+	c.Assert(changes[1].data, DeepEquals, []interface{}{"2", nil, 1.5})
+
+	dml = newDML(del, false, "`test`.`tb`", sourceTable, nil, []interface{}{2, nil, 1.5, 1}, nil, nil, ti.Columns, ti, nil, downTi)
+	changes = genRowChanges(dml, targetTable, now)
+	c.Assert(changes, HasLen, 1)
+	c.Assert(changes[0].deleted(), IsTrue)
+	c.Assert(changes[0].match(nil), IsTrue)
+
+	// tables without primary key or not null unique key are not validated
+	ti, err = createTableInfo(p, se, 0, "create table test.tb(id int, name varchar(24))")
+	c.Assert(err, IsNil)
+	dml = newDML(insert, false, "`test`.`tb`", sourceTable, nil, []interface{}{1, "a"}, nil, nil, ti.Columns, ti, nil, schema.GetDownStreamTi(ti, ti))
+	c.Assert(genRowChanges(dml, targetTable, now), HasLen, 0)
+}
+
+func (s *testSyncerSuite) TestRowChangeMatch(c *C) {
+	r := &rowChange{
+		keyColumns: []string{"id"},
+		keyValues:  []string{"1"},
+		columns:    []string{"id", "name", "score"},
+		data:       []interface{}{"1", nil, 0.1},
+	}
+	str := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: true}
+	}
+	c.Assert(r.match(nil), IsFalse)
+	c.Assert(r.match([]sql.NullString{str("1"), str("1"), {}, str("0.1")}), IsTrue)
+	c.Assert(r.match([]sql.NullString{str("1"), str("1"), {}, str("0.100000001")}), IsTrue)
+	c.Assert(r.match([]sql.NullString{str("1"), str("1"), {}, str("0.11")}), IsFalse)
+	c.Assert(r.match([]sql.NullString{str("1"), str("1"), str(""), str("0.1")}), IsFalse)
+	c.Assert(r.match([]sql.NullString{str("1"), str("1"), {}, {}}), IsFalse)
+}
+
+func (s *testSyncerSuite) TestNormalizeValue(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 0, `create table test.tb(
+		id int primary key, d decimal(10, 2), dt datetime(3), ts timestamp, f float, db double, b binary(4), s char(4))`)
+	c.Assert(err, IsNil)
+	cases := []struct {
+		column   int
+		value    string
+		expected string
+	}{
+		{0, "1", "1"},
+		{1, "1.5", "1.50"},
+		{1, "1.50", "1.50"},
+		{1, "-0.005", "-0.01"},
+		{2, "2021-12-01 08:00:00", "2021-12-01 08:00:00.000"},
+		{2, "2021-12-01 08:00:00.5", "2021-12-01 08:00:00.500"},
+		{3, "2021-12-01 08:00:00", "2021-12-01 08:00:00"},
+		{4, "1.100000023841858", "1.1"},
+		{5, "1e20", "100000000000000000000"},
+		{6, "ab", "ab\x00\x00"},
+		{6, "ab\x00\x00", "ab\x00\x00"},
+		{7, "ab", "ab"},
+		// the value is returned as is if it can't be parsed
+		{1, "abc", "abc"},
+	}
+	for _, cs := range cases {
+		c.Assert(normalizeValue(cs.value, &ti.Columns[cs.column].FieldType), Equals, cs.expected, Commentf("%v", cs))
+	}
+
+	// the keys and the values are normalized before being compared
+	sourceTable := &filter.Table{Schema: "test", Name: "tb1"}
+	ti, err = createTableInfo(p, se, 0, "create table test.tb(id decimal(10, 2) primary key, dt datetime(3))")
+	c.Assert(err, IsNil)
+	dml := newDML(insert, false, "`test`.`tb`", sourceTable, nil, []interface{}{"1.5", "2021-12-01 08:00:00"}, nil, nil, ti.Columns, ti, nil, schema.GetDownStreamTi(ti, ti))
+	changes := genRowChanges(dml, sourceTable, time.Now())
+	c.Assert(changes, HasLen, 1)
+	c.Assert(changes[0].keyValues, DeepEquals, []string{"1.50"})
+	str := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: true}
+	}
+	c.Assert(changes[0].match([]sql.NullString{str("1.50"), str("1.50"), str("2021-12-01 08:00:00.000")}), IsTrue)
+	c.Assert(changes[0].match([]sql.NullString{str("1.50"), str("1.50"), str("2021-12-01 08:00:00.001")}), IsFalse)
+}
+
+func (s *testSyncerSuite) TestDataValidator(c *C) {
+	cfg := &config.SubTaskConfig{
+		Name:       "test",
+		SourceID:   "source1",
+		MetaSchema: "dm_meta",
+		ValidatorCfg: config.ValidatorConfig{
+			Mode:               config.ValidationFull,
+			WorkerCount:        1,
+			MaxPendingRowCount: 2,
+		},
+	}
+	v := NewDataValidator(cfg)
+	v.vcfg.RowErrorDelay.Duration = time.Hour
+
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	tctx := tcontext.Background()
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+	v.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	v.workers[0].conn = v.dbConn
+
+	p := parser.New()
+	se := mock.NewContext()
+	sourceTable := &filter.Table{Schema: "test", Name: "tb1"}
+	targetTable := &filter.Table{Schema: "test", Name: "tb"}
+	ti, err := createTableInfo(p, se, 0, "create table test.tb(id int primary key, name varchar(24))")
+	c.Assert(err, IsNil)
+	downTi := schema.GetDownStreamTi(ti, ti)
+	insertJob := func(id int, name string) *job {
+		dml := newDML(insert, false, "`test`.`tb`", sourceTable, nil, []interface{}{id, name}, nil, nil, ti.Columns, ti, nil, downTi)
+		return &job{tp: insert, dml: dml, targetTable: targetTable}
+	}
+
+	// one row matches and one mismatches
+	v.AddJobs([]*job{insertJob(1, "a"), insertJob(2, "b")})
+	c.Assert(v.Status().PendingRowCount, Equals, int64(2))
+	// the new rows are skipped if too many rows are pending
+	v.AddJobs([]*job{insertJob(4, "d")})
+	status := v.Status()
+	c.Assert(status.PendingRowCount, Equals, int64(2))
+	c.Assert(status.SkippedRowCount, Equals, int64(1))
+	c.Assert(v.skipping.Load(), IsTrue)
+	selectSQL := "SELECT `id`, `id`, `name` FROM `test`.`tb` WHERE \\(`id`\\) IN \\(\\(\\?\\), \\(\\?\\)\\)"
+	dbMock.ExpectQuery(selectSQL).WillReturnRows(sqlmock.NewRows([]string{"id", "id", "name"}).AddRow(1, 1, "a").AddRow(2, 2, "c"))
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("INSERT INTO `dm_meta`.`test_validator_error_change`").WithArgs(
+		"source1", "test", "tb1", "test", "tb", `{"id":"2"}`, `{"id":"2","name":"b"}`, `{"id":"2","name":"c"}`, validationErrStatusRetrying, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	dbMock.ExpectCommit()
+	v.workers[0].validate(tctx)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	status = v.Status()
+	c.Assert(status.ProcessedRowCount, Equals, int64(1))
+	c.Assert(status.PendingRowCount, Equals, int64(1))
+	c.Assert(v.skipping.Load(), IsFalse)
+
+	// the mismatched row matches after retry
+	selectSQL = "SELECT `id`, `id`, `name` FROM `test`.`tb` WHERE \\(`id`\\) IN \\(\\(\\?\\)\\)"
+	dbMock.ExpectQuery(selectSQL).WithArgs("2").WillReturnRows(sqlmock.NewRows([]string{"id", "id", "name"}).AddRow(2, 2, "b"))
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("DELETE FROM `dm_meta`.`test_validator_error_change`").WithArgs(
+		"source1", "test", "tb", `{"id":"2"}`, validationErrStatusRetrying).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	dbMock.ExpectCommit()
+	v.workers[0].validate(tctx)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	status = v.Status()
+	c.Assert(status.ProcessedRowCount, Equals, int64(2))
+	c.Assert(status.PendingRowCount, Equals, int64(0))
+
+	// the deleted row still exists after the row error delay
+	v.vcfg.RowErrorDelay.Duration = 0
+	delDML := newDML(del, false, "`test`.`tb`", sourceTable, nil, []interface{}{3, "c"}, nil, nil, ti.Columns, ti, nil, downTi)
+	v.AddJobs([]*job{{tp: del, dml: delDML, targetTable: targetTable}})
+	dbMock.ExpectQuery("SELECT `id` FROM `test`.`tb` WHERE \\(`id`\\) IN \\(\\(\\?\\)\\)").WithArgs("3").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("INSERT INTO `dm_meta`.`test_validator_error_change`").WithArgs(
+		"source1", "test", "tb1", "test", "tb", `{"id":"3"}`, nil, `{"id":"3"}`, validationErrStatusFailed, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	dbMock.ExpectCommit()
+	v.workers[0].validate(tctx)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	status = v.Status()
+	c.Assert(status.PendingRowCount, Equals, int64(0))
+	c.Assert(status.ErrorRowCount, Equals, int64(1))
+
+	dbMock.ExpectQuery("SELECT id, src_schema_name, src_table_name, dst_schema_name, dst_table_name, row_pk, expected_data, actual_data, status, retry_count, update_time FROM `dm_meta`.`test_validator_error_change`").
+		WithArgs("source1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "src_schema_name", "src_table_name", "dst_schema_name", "dst_table_name", "row_pk", "expected_data", "actual_data", "status", "retry_count", "update_time"}).
+			AddRow(1, "test", "tb1", "test", "tb", `{"id": "3"}`, nil, `{"id": "3"}`, validationErrStatusFailed, 1, "2021-12-01 00:00:00"))
+	errs, err := v.GetErrors(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].SrcTable, Equals, "`test`.`tb1`")
+	c.Assert(errs[0].ExpectedData, Equals, "")
+	c.Assert(errs[0].Status, Equals, validationErrStatusFailed)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+
+	// the rows which can't be read from the downstream are skipped after the row error delay
+	v.AddJobs([]*job{insertJob(5, "e")})
+	dbMock.ExpectQuery("SELECT `id`, `id`, `name` FROM `test`.`tb` WHERE \\(`id`\\) IN \\(\\(\\?\\)\\)").WithArgs("5").
+		WillReturnError(errors.New("table not exists"))
+	v.workers[0].validate(tctx)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	status = v.Status()
+	c.Assert(status.PendingRowCount, Equals, int64(0))
+	c.Assert(status.SkippedRowCount, Equals, int64(2))
+
+	// Close is idempotent
+	v.Close()
+	v.Close()
+}
@@ -18,8 +18,6 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
-	"os"
-	"path"
 	"reflect"
 	"strconv"
 	"strings"
@@ -46,7 +44,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pingcap/ticdc/dm/dm/config"
-	common2 "github.com/pingcap/ticdc/dm/dm/ctl/common"
 	"github.com/pingcap/ticdc/dm/dm/pb"
 	"github.com/pingcap/ticdc/dm/dm/unit"
 	"github.com/pingcap/ticdc/dm/pkg/binlog"
@@ -61,6 +58,7 @@ import (
 	parserpkg "github.com/pingcap/ticdc/dm/pkg/parser"
 	"github.com/pingcap/ticdc/dm/pkg/schema"
 	"github.com/pingcap/ticdc/dm/pkg/shardddl/pessimism"
+	"github.com/pingcap/ticdc/dm/pkg/storage"
 	"github.com/pingcap/ticdc/dm/pkg/streamer"
 	"github.com/pingcap/ticdc/dm/pkg/terror"
 	"github.com/pingcap/ticdc/dm/pkg/utils"
@@ -227,6 +225,12 @@ type Syncer struct {
 	lastCheckpointFlushedTime time.Time
 
 	relay relay.Process
+
+	// validator validates the row changes applied to the downstream, nil if disabled.
+	validator *DataValidator
+
+	// stopAt is the parsed stop-at of subtask, nil if never stop.
+	stopAt *config.StopAt
 }
 
 // NewSyncer creates a new Syncer.
@@ -267,6 +271,9 @@ func NewSyncer(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, relay rel
 	}
 	syncer.lastCheckpointFlushedTime = time.Time{}
 	syncer.relay = relay
+	if cfg.ValidatorCfg.Mode == config.ValidationFull {
+		syncer.validator = NewDataValidator(cfg)
+	}
 	return syncer
 }
 
@@ -310,6 +317,14 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 		return
 	}
 
+	if len(s.cfg.StopAt) > 0 {
+		// time in stop-at without time zone is in the same time zone as the task
+		s.stopAt, err = config.ParseStopAt(s.cfg.StopAt, s.cfg.Flavor, s.timezone)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = s.setSyncCfg()
 	if err != nil {
 		return err
@@ -398,6 +413,13 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	rollbackHolder.Add(fr.FuncRollback{Name: "close-checkpoint", Fn: s.checkpoint.Close})
 
+	if s.validator != nil {
+		if err = s.validator.Init(tctx); err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-validator", Fn: s.validator.Close})
+	}
+
 	err = s.checkpoint.Load(tctx)
 	if err != nil {
 		return err
@@ -644,16 +666,21 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 		<-newCtx.Done() // ctx or newCtx
 	}()
 
-	err := s.Run(newCtx)
-	if err != nil {
-		// returned error rather than sent to runFatalChan
-		// cancel goroutines created in s.Run
-		cancel()
+	if s.validator != nil {
+		s.validator.Start(newCtx)
 	}
+
+	err := s.Run(newCtx)
+	// returned error rather than sent to runFatalChan, or reached stop-at of subtask
+	// cancel goroutines created in s.Run
+	cancel()
 	s.closeJobChans()   // Run returned, all jobs sent, we can close s.jobs
 	s.wg.Wait()         // wait for sync goroutine to return
 	close(runFatalChan) // Run returned, all potential fatal sent to s.runFatalChan
 	wg.Wait()           // wait for receive all fatal from s.runFatalChan
+	if s.validator != nil {
+		s.validator.Stop()
+	}
 
 	if err != nil {
 		if utils.IsContextCanceledError(err) {
@@ -1261,6 +1288,9 @@ func (s *Syncer) successFunc(queueID int, statementsCnt int, jobs []*job) {
 	for _, sqlJob := range jobs {
 		s.addCount(true, queueBucket, sqlJob.tp, 1, sqlJob.targetTable)
 	}
+	if s.validator != nil {
+		s.validator.AddJobs(jobs)
+	}
 	s.updateReplicationJobTS(nil, dmlWorkerJobIdx(queueID))
 	metrics.ReplicationTransactionBatch.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID, queueBucket, "statements").Observe(float64(statementsCnt))
 	metrics.ReplicationTransactionBatch.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID, queueBucket, "rows").Observe(float64(len(jobs)))
@@ -1336,7 +1366,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		return err
 	} else if fresh {
 		// for fresh task, we try to load checkpoints from meta (file or config item)
-		err = s.checkpoint.LoadMeta()
+		err = s.checkpoint.LoadMeta(runCtx)
 		if err != nil {
 			return err
 		}
@@ -1376,7 +1406,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	}
 	if cleanDumpFile {
 		tctx.L().Info("try to remove all dump files")
-		if err = os.RemoveAll(s.cfg.Dir); err != nil {
+		if err = storage.RemoveAll(ctx, s.cfg.Dir, nil); err != nil {
 			tctx.L().Warn("error when remove loaded dump folder", zap.String("data folder", s.cfg.Dir), zap.Error(err))
 		}
 	}
@@ -1587,6 +1617,11 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		s.currentLocationMu.currentLocation = currentLocation
 		s.currentLocationMu.Unlock()
 
+		// check stop-at before fetching the next event, so we don't need to wait for it
+		if shardingReSync == nil && len(shardingReSyncCh) == 0 && s.reachStopAt(lastLocation, nil) {
+			return s.finishAtStopAt(tctx, lastLocation)
+		}
+
 		// fetch from sharding resync channel if needed, and redirect global
 		// stream to current binlog position recorded by ShardingReSync
 		if shardingReSync == nil && len(shardingReSyncCh) > 0 {
@@ -1713,6 +1748,10 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 		tctx.L().Debug("receive binlog event", zap.Reflect("header", e.Header))
 
+		if shardingReSync == nil && len(shardingReSyncCh) == 0 && s.reachStopAt(lastLocation, e.Header) {
+			return s.finishAtStopAt(tctx, lastLocation)
+		}
+
 		// TODO: support all event
 		// we calculate startLocation and endLocation(currentLocation) for Query event here
 		// set startLocation empty for other events to avoid misuse
@@ -1884,6 +1923,52 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	}
 }
 
+// reachStopAt returns whether all binlog events before the stop-at of subtask are replicated.
+// lastLocation is the end location of the last replicated transaction or DDL, header is the header
+// of the coming binlog event, or nil if it's not fetched yet.
+// For a time stop-at, the binlog events whose timestamp is not later than it (in seconds) are
+// replicated, and the syncer stops before the first binlog event later than it. Because the
+// upstream may be idle, a heartbeat event received after stop-at by the wall clock also stops
+// the syncer, the upstream only sends it when there are no more binlog events to send.
+func (s *Syncer) reachStopAt(lastLocation binlog.Location, header *replication.EventHeader) bool {
+	if s.stopAt == nil {
+		return false
+	}
+	// only stop between transactions
+	s.waitTransactionLock.Lock()
+	isTransactionEnd := s.isTransactionEnd
+	s.waitTransactionLock.Unlock()
+	if !isTransactionEnd {
+		return false
+	}
+
+	switch {
+	case s.stopAt.Pos != nil:
+		return binlog.ComparePosition(lastLocation.Position, *s.stopAt.Pos) >= 0
+	case s.stopAt.GTID != nil:
+		gset := lastLocation.GetGTID()
+		return gset != nil && len(gset.String()) > 0 && gset.Contain(s.stopAt.GTID)
+	case s.stopAt.Time != nil:
+		if header == nil {
+			return false
+		}
+		// heartbeat events have no timestamp, and fake rotate events are ignored.
+		if header.EventType == replication.HEARTBEAT_EVENT {
+			return time.Now().After(*s.stopAt.Time)
+		}
+		return header.Timestamp > 0 && int64(header.Timestamp) > s.stopAt.Time.Unix()
+	}
+	return false
+}
+
+// finishAtStopAt flushes all jobs and checkpoints when stop-at of subtask is reached,
+// then Run returns without error and the subtask becomes Finished.
+func (s *Syncer) finishAtStopAt(tctx *tcontext.Context, lastLocation binlog.Location) error {
+	tctx.L().Info("all binlog events before stop-at are replicated, stop replicating",
+		zap.String("stop-at", s.cfg.StopAt), zap.Stringer("location", lastLocation))
+	return s.flushJobs()
+}
+
 type eventContext struct {
 	tctx                *tcontext.Context
 	header              *replication.EventHeader
@@ -2834,7 +2919,12 @@ func (s *Syncer) genRouter() error {
 func (s *Syncer) loadTableStructureFromDump(ctx context.Context) error {
 	logger := s.tctx.L()
 
-	files, err := utils.CollectDirFiles(s.cfg.Dir)
+	fileStorage, err := storage.CreateStorage(ctx, s.cfg.Dir)
+	if err != nil {
+		logger.Warn("fail to open dump directory", zap.Error(err))
+		return err
+	}
+	files, err := storage.CollectDirFiles(ctx, s.cfg.Dir, fileStorage)
 	if err != nil {
 		logger.Warn("fail to get dump files", zap.Error(err))
 		return err
@@ -2874,12 +2964,11 @@ func (s *Syncer) loadTableStructureFromDump(ctx context.Context) error {
 
 	for _, dbAndFile := range tableFiles {
 		db, file := dbAndFile[0], dbAndFile[1]
-		filepath := path.Join(s.cfg.Dir, file)
-		content, err2 := common2.GetFileContent(filepath)
+		content, err2 := fileStorage.ReadFile(ctx, file)
 		if err2 != nil {
 			logger.Warn("fail to read file for creating table in schema tracker",
 				zap.String("db", db),
-				zap.String("file", filepath),
+				zap.String("file", file),
 				zap.Error(err))
 			setFirstErr(err2)
 			continue
@@ -2893,7 +2982,7 @@ func (s *Syncer) loadTableStructureFromDump(ctx context.Context) error {
 			err = s.schemaTracker.Exec(ctx, db, string(stmt))
 			if err != nil {
 				logger.Warn("fail to create table for dump files",
-					zap.Any("file", filepath),
+					zap.Any("file", file),
 					zap.ByteString("statement", stmt),
 					zap.Error(err))
 				setFirstErr(err)
@@ -3039,6 +3128,10 @@ func (s *Syncer) Close() {
 
 	s.checkpoint.Close()
 
+	if s.validator != nil {
+		s.validator.Close()
+	}
+
 	if err := s.schemaTracker.Close(); err != nil {
 		s.tctx.L().Error("fail to close schema tracker", log.ShortError(err))
 	}
@@ -1183,6 +1183,215 @@ func (s *testSyncerSuite) TestExitSafeModeByConfig(c *C) {
 	c.Assert(failpoint.Disable("github.com/pingcap/ticdc/dm/syncer/SafeModeInitPhaseSeconds"), IsNil)
 }
 
+func (s *testSyncerSuite) TestReachStopAt(c *C) {
+	var (
+		syncer   = &Syncer{isTransactionEnd: true}
+		uuid     = "3ccc475b-2343-11e7-be21-6c0b84d59f30"
+		stopTime = time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+		newLoc   = func(name string, pos uint32, gs string) binlog.Location {
+			gset, err := gtid.ParserGTID(mysql.MySQLFlavor, gs)
+			c.Assert(err, IsNil)
+			return binlog.InitLocation(mysql.Position{Name: name, Pos: pos}, gset)
+		}
+		newHeader = func(ts time.Time) *replication.EventHeader {
+			return &replication.EventHeader{Timestamp: uint32(ts.Unix())}
+		}
+	)
+
+	// never stop if stop-at is not set
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000010", 4, ""), newHeader(stopTime.Add(time.Hour))), IsFalse)
+
+	// stop at position
+	syncer.stopAt = &config.StopAt{Pos: &mysql.Position{Name: "mysql-bin.000003", Pos: 1000}}
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000002", 5000, ""), nil), IsFalse)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000003", 999, ""), nil), IsFalse)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000003", 1000, ""), nil), IsTrue)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000004", 4, ""), nil), IsTrue)
+	// the uuid suffix of the relay log file is ignored
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin|000002.000002", 5000, ""), nil), IsFalse)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin|000001.000003", 999, ""), nil), IsFalse)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin|000001.000003", 1000, ""), nil), IsTrue)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin|000002.000004", 4, ""), nil), IsTrue)
+
+	// stop at GTID
+	stopGTID, err := gtid.ParserGTID(mysql.MySQLFlavor, uuid+":1-14")
+	c.Assert(err, IsNil)
+	syncer.stopAt = &config.StopAt{GTID: stopGTID}
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000003", 1000, ""), nil), IsFalse)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000003", 1000, uuid+":1-13"), nil), IsFalse)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000003", 1000, uuid+":1-14"), nil), IsTrue)
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000003", 1000, uuid+":1-20"), nil), IsTrue)
+
+	// stop at time, the binlog events at stop-at are replicated, and the first binlog event later
+	// than stop-at stops the syncer
+	syncer.stopAt = &config.StopAt{Time: &stopTime}
+	loc := newLoc("mysql-bin.000003", 1000, "")
+	c.Assert(syncer.reachStopAt(loc, nil), IsFalse)
+	c.Assert(syncer.reachStopAt(loc, newHeader(stopTime.Add(-time.Second))), IsFalse)
+	c.Assert(syncer.reachStopAt(loc, newHeader(stopTime)), IsFalse)
+	// fake rotate events have no timestamp
+	c.Assert(syncer.reachStopAt(loc, &replication.EventHeader{EventType: replication.ROTATE_EVENT}), IsFalse)
+	c.Assert(syncer.reachStopAt(loc, newHeader(stopTime.Add(time.Second))), IsTrue)
+	// heartbeat events stop the syncer on an idle upstream once stop-at is passed by the wall clock
+	heartbeat := &replication.EventHeader{EventType: replication.HEARTBEAT_EVENT}
+	c.Assert(syncer.reachStopAt(loc, heartbeat), IsTrue)
+	futureTime := time.Now().Add(time.Hour)
+	syncer.stopAt = &config.StopAt{Time: &futureTime}
+	c.Assert(syncer.reachStopAt(loc, heartbeat), IsFalse)
+	syncer.stopAt = &config.StopAt{Time: &stopTime}
+
+	// never stop in the middle of a transaction
+	syncer.isTransactionEnd = false
+	c.Assert(syncer.reachStopAt(loc, newHeader(stopTime.Add(time.Second))), IsFalse)
+	syncer.stopAt = &config.StopAt{Pos: &mysql.Position{Name: "mysql-bin.000003", Pos: 1000}}
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000004", 4, ""), nil), IsFalse)
+	syncer.stopAt = &config.StopAt{GTID: stopGTID}
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000003", 1000, uuid+":1-20"), nil), IsFalse)
+	syncer.isTransactionEnd = true
+	c.Assert(syncer.reachStopAt(newLoc("mysql-bin.000003", 1000, uuid+":1-20"), nil), IsTrue)
+}
+
+func (s *testSyncerSuite) TestRunFinishAtStopAt(c *C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s.mockGetServerUnixTS(mock)
+
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+	checkPointDB, checkPointMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	checkPointDBConn, err := checkPointDB.Conn(context.Background())
+	c.Assert(err, IsNil)
+
+	testJobs.jobs = testJobs.jobs[:0]
+
+	s.cfg.RouteRules = nil
+	s.cfg.BAList = &filter.Rules{
+		DoDBs: []string{"test_1"},
+		DoTables: []*filter.Table{
+			{Schema: "test_1", Name: "t_1"},
+		},
+	}
+
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.SafeMode = true
+	syncer := NewSyncer(cfg, nil, nil)
+	syncer.fromDB = &dbconn.UpStreamConn{BaseDB: conn.NewBaseDB(db)}
+	syncer.toDBConns = []*dbconn.DBConn{
+		{Cfg: s.cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})},
+		{Cfg: s.cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})},
+	}
+	syncer.ddlDBConn = &dbconn.DBConn{Cfg: s.cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	syncer.downstreamTrackConn = &dbconn.DBConn{Cfg: s.cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	mock.ExpectBegin()
+	mock.ExpectExec(fmt.Sprintf("SET SESSION SQL_MODE = '%s'", pmysql.DefaultSQLMode)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery("SHOW CREATE TABLE " + "`test_1`.`t_1`").WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).
+			AddRow("t_1", "create table t_1(id int primary key, name varchar(24))"))
+
+	syncer.schemaTracker, err = schema.NewTracker(context.Background(), s.cfg.Name, defaultTestSessionCfg, syncer.ddlDBConn)
+	syncer.exprFilterGroup = NewExprFilterGroup(utils.NewSessionCtx(nil), nil)
+	c.Assert(err, IsNil)
+
+	c.Assert(syncer.genRouter(), IsNil)
+
+	syncer.setupMockCheckpoint(c, checkPointDBConn, checkPointMock)
+
+	syncer.reset()
+
+	events := mockBinlogEvents{
+		mockBinlogEvent{typ: DBCreate, args: []interface{}{"test_1"}},
+		mockBinlogEvent{typ: TableCreate, args: []interface{}{"test_1", "create table test_1.t_1(id int primary key, name varchar(24))"}},
+		mockBinlogEvent{typ: Write, args: []interface{}{uint64(8), "test_1", "t_1", []byte{mysql.MYSQL_TYPE_LONG, mysql.MYSQL_TYPE_STRING}, [][]interface{}{{int32(1), "a"}}}},
+	}
+	generatedEvents := s.generateEvents(events, c)
+	// stop at the end of the transaction of the insert, the events after it are not replicated
+	stopPos := binlog.MinPosition
+	stopPos.Pos = generatedEvents[len(generatedEvents)-1].Header.LogPos
+	syncer.stopAt = &config.StopAt{Pos: &stopPos}
+
+	events = mockBinlogEvents{
+		mockBinlogEvent{typ: Delete, args: []interface{}{uint64(8), "test_1", "t_1", []byte{mysql.MYSQL_TYPE_LONG, mysql.MYSQL_TYPE_STRING}, [][]interface{}{{int32(1), "a"}}}},
+	}
+	generatedEvents = append(generatedEvents, s.generateEvents(events, c)...)
+
+	mockStreamerProducer := &MockStreamProducer{generatedEvents}
+	mockStreamer, err := mockStreamerProducer.generateStreamer(binlog.NewLocation(""))
+	c.Assert(err, IsNil)
+	syncer.streamerController = &StreamerController{
+		streamerProducer: mockStreamerProducer,
+		streamer:         mockStreamer,
+	}
+
+	syncer.addJobFunc = syncer.addJobToMemory
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resultCh := make(chan pb.ProcessResult, 1)
+
+	c.Assert(failpoint.Enable("github.com/pingcap/ticdc/dm/syncer/SafeModeInitPhaseSeconds", "return(0)"), IsNil)
+	//nolint:errcheck
+	defer failpoint.Disable("github.com/pingcap/ticdc/dm/syncer/SafeModeInitPhaseSeconds")
+	go syncer.Process(ctx, resultCh)
+
+	// Run returns by itself at stop-at, the subtask is finished rather than canceled or paused
+	var result pb.ProcessResult
+	select {
+	case result = <-resultCh:
+	case <-time.After(10 * time.Second):
+		c.Fatal("syncer doesn't stop at stop-at")
+	}
+	c.Assert(result.IsCanceled, IsFalse)
+	c.Assert(result.Errors, HasLen, 0)
+
+	expectJobs := []*expectJob{
+		{
+			flush,
+			nil,
+			nil,
+		}, {
+			ddl,
+			[]string{"CREATE DATABASE IF NOT EXISTS `test_1`"},
+			nil,
+		}, {
+			flush,
+			nil,
+			nil,
+		}, {
+			ddl,
+			[]string{"CREATE TABLE IF NOT EXISTS `test_1`.`t_1` (`id` INT PRIMARY KEY,`name` VARCHAR(24))"},
+			nil,
+		}, {
+			insert,
+			[]string{"REPLACE INTO `test_1`.`t_1` (`id`,`name`) VALUES (?,?)"},
+			[][]interface{}{{int32(1), "a"}},
+		}, {
+			// all jobs are flushed at stop-at, and again when Run exits
+			flush,
+			nil,
+			nil,
+		}, {
+			flush,
+			nil,
+			nil,
+		},
+	}
+	testJobs.Lock()
+	checkJobs(c, testJobs.jobs, expectJobs)
+	testJobs.jobs = testJobs.jobs[:0]
+	testJobs.Unlock()
+
+	syncer.Close()
+	c.Assert(syncer.isClosed(), IsTrue)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		c.Errorf("db unfulfilled expectations: %s", err)
+	}
+}
+
 func (s *testSyncerSuite) TestRemoveMetadataIsFine(c *C) {
 	cfg, err := s.cfg.Clone()
 	c.Assert(err, IsNil)
@@ -1195,11 +1404,11 @@ func (s *testSyncerSuite) TestRemoveMetadataIsFine(c *C) {
 	filename := filepath.Join(s.cfg.Dir, "metadata")
 	err = os.WriteFile(filename, []byte("SHOW MASTER STATUS:\n\tLog: BAD METADATA"), 0o644)
 	c.Assert(err, IsNil)
-	c.Assert(syncer.checkpoint.LoadMeta(), NotNil)
+	c.Assert(syncer.checkpoint.LoadMeta(context.Background()), NotNil)
 
 	err = os.WriteFile(filename, []byte("SHOW MASTER STATUS:\n\tLog: mysql-bin.000003\n\tPos: 1234\n\tGTID:\n\n"), 0o644)
 	c.Assert(err, IsNil)
-	c.Assert(syncer.checkpoint.LoadMeta(), IsNil)
+	c.Assert(syncer.checkpoint.LoadMeta(context.Background()), IsNil)
 
 	c.Assert(os.Remove(filename), IsNil)
 
@@ -0,0 +1,150 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/redo"
+	"github.com/pingcap/ticdc/cdc/redo/reader"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+)
+
+// RedoDumpConfig is the configuration of dumping redo logs.
+type RedoDumpConfig struct {
+	// StartTs and EndTs are the range (StartTs, EndTs] of the commit ts of
+	// the events to dump, 0 means the checkpoint ts and the resolved ts in
+	// the redo meta.
+	StartTs uint64
+	EndTs   uint64
+	// FilterRules are the table filter rules of the events to dump.
+	FilterRules []string
+	// SkipRows and SkipDDLs skip the row events and the DDL events.
+	SkipRows bool
+	SkipDDLs bool
+}
+
+// dumpEvent is an event printed by Dump.
+type dumpEvent struct {
+	Type string                 `json:"type"`
+	Row  *model.RowChangedEvent `json:"row,omitempty"`
+	DDL  *model.DDLEvent        `json:"ddl,omitempty"`
+}
+
+// Dump writes the events in the redo logs as JSON lines to w, in the order of
+// the commit ts.
+func (ra *RedoApplier) Dump(ctx context.Context, cfg *RedoDumpConfig, w io.Writer) error {
+	rd, err := createRedoReader(ctx, ra.cfg)
+	if err != nil {
+		return err
+	}
+	defer rd.Close() //nolint:errcheck
+
+	checkpointTs, resolvedTs, err := rd.ReadMeta(ctx)
+	if err != nil {
+		return err
+	}
+	startTs, endTs := checkpointTs, resolvedTs
+	if cfg.StartTs != 0 {
+		startTs = cfg.StartTs
+	}
+	if cfg.EndTs != 0 {
+		endTs = cfg.EndTs
+	}
+	if startTs >= endTs {
+		return cerror.ErrRedoConfigInvalid.GenWithStack(
+			"start-ts %d must be less than end-ts %d", startTs, endTs)
+	}
+	if err := rd.ResetReader(ctx, startTs, endTs); err != nil {
+		return err
+	}
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	if len(cfg.FilterRules) > 0 {
+		replicaConfig.Filter.Rules = cfg.FilterRules
+	}
+	ft, err := filter.NewFilter(replicaConfig)
+	if err != nil {
+		return err
+	}
+
+	var rows []*model.RowChangedEvent
+	var ddls []*model.DDLEvent
+	rowsDone, ddlsDone := cfg.SkipRows, cfg.SkipDDLs
+	enc := json.NewEncoder(w)
+	for {
+		if len(rows) == 0 && !rowsDone {
+			redoLogs, err := rd.ReadNextLog(ctx, readBatch)
+			if err != nil {
+				return err
+			}
+			rowsDone = len(redoLogs) == 0
+			for _, redoLog := range redoLogs {
+				row := redo.LogToRow(redoLog)
+				if !ft.ShouldIgnoreTable(row.Table.Schema, row.Table.Table) {
+					rows = append(rows, row)
+				}
+			}
+			continue
+		}
+		if len(ddls) == 0 && !ddlsDone {
+			redoDDLs, err := rd.ReadNextDDL(ctx, readBatch)
+			if err != nil {
+				return err
+			}
+			ddlsDone = len(redoDDLs) == 0
+			for _, redoDDL := range redoDDLs {
+				ddl := redoDDL.DDL
+				if ddl.TableInfo == nil || !ft.ShouldIgnoreTable(ddl.TableInfo.Schema, ddl.TableInfo.Table) {
+					ddls = append(ddls, ddl)
+				}
+			}
+			continue
+		}
+		if len(rows) == 0 && len(ddls) == 0 {
+			return nil
+		}
+
+		// the DDL goes first if it has the same commit ts with the rows.
+		var event *dumpEvent
+		if len(ddls) > 0 && (len(rows) == 0 || ddls[0].CommitTs <= rows[0].CommitTs) {
+			event = &dumpEvent{Type: "ddl", DDL: ddls[0]}
+			ddls = ddls[1:]
+		} else {
+			event = &dumpEvent{Type: "row", Row: rows[0]}
+			rows = rows[1:]
+		}
+		if err := enc.Encode(event); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// Verify checks the integrity of the redo logs in local or s3 storage.
+func (ra *RedoApplier) Verify(ctx context.Context) (*reader.VerifyReport, error) {
+	storageType, readerCfg, err := ra.cfg.toLogReaderConfig()
+	if err != nil {
+		return nil, err
+	}
+	if !redo.IsValidConsistentStorage(storageType) || storageType == "blackhole" {
+		return nil, cerror.ErrConsistentStorage.GenWithStackByArgs(storageType)
+	}
+	return reader.VerifyLogs(ctx, readerCfg)
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/redo"
+	"github.com/pingcap/ticdc/cdc/redo/reader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDump(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	redoLogCh := make(chan *model.RedoRowChangedEvent, 1024)
+	ddlEventCh := make(chan *model.RedoDDLEvent, 1024)
+	createMockReader := func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return NewMockReader(1000, 2000, redoLogCh, ddlEventCh), nil
+	}
+	createRedoReaderBak := createRedoReader
+	createRedoReader = createMockReader
+	defer func() {
+		createRedoReader = createRedoReaderBak
+	}()
+
+	for _, dml := range []*model.RowChangedEvent{
+		{CommitTs: 1100, Table: &model.TableName{Schema: "test", Table: "t1"}},
+		{CommitTs: 1200, Table: &model.TableName{Schema: "test", Table: "t2"}},
+		{CommitTs: 1300, Table: &model.TableName{Schema: "test", Table: "t1"}},
+		{CommitTs: 1700, Table: &model.TableName{Schema: "test", Table: "t1"}},
+	} {
+		redoLogCh <- redo.RowToRedo(dml)
+	}
+	for _, ddl := range []*model.DDLEvent{
+		{CommitTs: 1300, TableInfo: &model.SimpleTableInfo{Schema: "test", Table: "t1"}},
+		{CommitTs: 1400, TableInfo: &model.SimpleTableInfo{Schema: "test", Table: "t2"}},
+	} {
+		ddlEventCh <- redo.DDLToRedo(ddl)
+	}
+	close(redoLogCh)
+	close(ddlEventCh)
+
+	cfg := &RedoApplierConfig{Storage: "local:///tmp/redo"}
+	dumpCfg := &RedoDumpConfig{
+		EndTs:       1500,
+		FilterRules: []string{"test.t1"},
+	}
+	var buf bytes.Buffer
+	err := NewRedoApplier(cfg).Dump(ctx, dumpCfg, &buf)
+	require.Nil(t, err)
+
+	type event struct {
+		tp       string
+		commitTs uint64
+	}
+	var events []event
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		e := &dumpEvent{}
+		require.Nil(t, dec.Decode(e))
+		if e.Type == "row" {
+			require.Equal(t, "t1", e.Row.Table.Table)
+			events = append(events, event{e.Type, e.Row.CommitTs})
+		} else {
+			require.Equal(t, "t1", e.DDL.TableInfo.Table)
+			events = append(events, event{e.Type, e.DDL.CommitTs})
+		}
+	}
+	// the DDL goes before the rows with the same commit ts.
+	require.Equal(t, []event{{"row", 1100}, {"ddl", 1300}, {"row", 1300}}, events)
+
+	dumpCfg = &RedoDumpConfig{StartTs: 1500, EndTs: 1500}
+	err = NewRedoApplier(cfg).Dump(ctx, dumpCfg, &buf)
+	require.Regexp(t, ".*start-ts 1500 must be less than end-ts 1500.*", err)
+}
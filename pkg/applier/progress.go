@@ -0,0 +1,131 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"net/url"
+	"strings"
+
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/sink"
+	"github.com/pingcap/ticdc/pkg/cyclic/mark"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/security"
+)
+
+// progressTableName is the table in the downstream which records the
+// progress of the redo appliers.
+const progressTableName = "redo_apply_progress"
+
+// progressMarker reads and writes the apply progress in the downstream. The
+// progress is a ts before which all the redo logs have been applied, so an
+// interrupted apply can be resumed from it.
+type progressMarker struct {
+	db *sql.DB
+	// id identifies the redo logs being applied.
+	id string
+}
+
+func newProgressMarker(ctx context.Context, sinkURIStr string, id string) (*progressMarker, error) {
+	sinkURI, err := url.Parse(sinkURIStr)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	scheme := strings.ToLower(sinkURI.Scheme)
+	if scheme != "mysql" && scheme != "tidb" && scheme != "mysql+ssl" && scheme != "tidb+ssl" {
+		return nil, cerror.ErrSinkURIInvalid.GenWithStack(
+			"the apply progress can't be written into the sink scheme (%s)", sinkURI.Scheme)
+	}
+
+	dsn := dmysql.NewConfig()
+	dsn.User = sinkURI.User.Username()
+	dsn.Passwd, _ = sinkURI.User.Password()
+	if dsn.User == "" {
+		dsn.User = "root"
+	}
+	port := sinkURI.Port()
+	if port == "" {
+		port = "4000"
+	}
+	dsn.Net = "tcp"
+	dsn.Addr = net.JoinHostPort(sinkURI.Hostname(), port)
+	if sinkURI.Query().Get("ssl-ca") != "" {
+		credential := security.Credential{
+			CAPath:   sinkURI.Query().Get("ssl-ca"),
+			CertPath: sinkURI.Query().Get("ssl-cert"),
+			KeyPath:  sinkURI.Query().Get("ssl-key"),
+		}
+		tlsCfg, err := credential.ToTLSConfig()
+		if err != nil {
+			return nil, cerror.ErrMySQLConnectionError.Wrap(err).GenWithStack("fail to open MySQL connection")
+		}
+		name := "cdc_mysql_tls_redo_applier"
+		if err := dmysql.RegisterTLSConfig(name, tlsCfg); err != nil {
+			return nil, cerror.ErrMySQLConnectionError.Wrap(err).GenWithStack("fail to open MySQL connection")
+		}
+		dsn.TLSConfig = name
+	}
+	db, err := sink.GetDBConnImpl(ctx, dsn.FormatDSN())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	m := &progressMarker{db: db, id: id}
+	if err := m.createTable(ctx); err != nil {
+		db.Close() //nolint:errcheck
+		return nil, errors.Trace(err)
+	}
+	return m, nil
+}
+
+func (m *progressMarker) createTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE DATABASE IF NOT EXISTS "+mark.SchemaName)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+	}
+	_, err = m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+mark.SchemaName+"."+progressTableName+
+		" (id varchar(255) NOT NULL, checkpoint_ts bigint unsigned NOT NULL, PRIMARY KEY (id))")
+	return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+}
+
+// load returns the progress, the second return value is false if there is
+// no progress recorded.
+func (m *progressMarker) load(ctx context.Context) (uint64, bool, error) {
+	var ts uint64
+	err := m.db.QueryRowContext(ctx, "SELECT checkpoint_ts FROM "+mark.SchemaName+"."+progressTableName+
+		" WHERE id = ?", m.id).Scan(&ts)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return ts, true, nil
+}
+
+// save records the progress, the caller must ensure that all the redo logs
+// before the ts have been written to the downstream.
+func (m *progressMarker) save(ctx context.Context, ts uint64) error {
+	_, err := m.db.ExecContext(ctx, "REPLACE INTO "+mark.SchemaName+"."+progressTableName+
+		" (id, checkpoint_ts) VALUES (?, ?)", m.id, ts)
+	return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+}
+
+func (m *progressMarker) close() error {
+	return cerror.WrapError(cerror.ErrMySQLConnectionError, m.db.Close())
+}
@@ -15,12 +15,16 @@ package applier
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/url"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/cdc/model"
 	"github.com/pingcap/ticdc/cdc/redo"
+	"github.com/pingcap/ticdc/cdc/redo/common"
 	"github.com/pingcap/ticdc/cdc/redo/reader"
 	"github.com/pingcap/ticdc/cdc/sink"
 	"github.com/pingcap/ticdc/pkg/config"
@@ -43,14 +47,28 @@ type RedoApplierConfig struct {
 	SinkURI string
 	Storage string
 	Dir     string
+	// UntilTs is the ts up to which the redo logs are applied, 0 means the
+	// resolved ts in the redo meta.
+	UntilTs uint64
+	// FilterRules are the table filter rules of the tables to apply, all the
+	// tables are applied if it is empty.
+	FilterRules []string
+	// Resume enables writing the apply progress into the downstream, and an
+	// interrupted apply is resumed from the progress.
+	Resume bool
+	// EncryptionKeyFile and EncryptionMasterKey are the key used to decrypt
+	// the encrypted redo logs, at most one of them can be specified.
+	EncryptionKeyFile   string
+	EncryptionMasterKey string
 }
 
 // RedoApplier implements a redo log applier
 type RedoApplier struct {
 	cfg *RedoApplierConfig
 
-	rd    reader.RedoLogReader
-	errCh chan error
+	rd       reader.RedoLogReader
+	progress *progressMarker
+	errCh    chan error
 }
 
 // NewRedoApplier creates a new RedoApplier instance
@@ -67,9 +85,14 @@ func (rac *RedoApplierConfig) toLogReaderConfig() (string, *reader.LogReaderConf
 	if err != nil {
 		return "", nil, cerror.WrapError(cerror.ErrConsistentStorage, err)
 	}
+	codecCfg, err := common.NewCodecConfig("", rac.EncryptionKeyFile, rac.EncryptionMasterKey)
+	if err != nil {
+		return "", nil, err
+	}
 	cfg := &reader.LogReaderConfig{
 		Dir:       uri.Path,
 		S3Storage: redo.IsS3StorageEnabled(uri.Scheme),
+		Codec:     codecCfg,
 	}
 	if cfg.S3Storage {
 		cfg.S3URI = *uri
@@ -79,6 +102,35 @@ func (rac *RedoApplierConfig) toLogReaderConfig() (string, *reader.LogReaderConf
 	return uri.Scheme, cfg, nil
 }
 
+// progressID identifies the redo logs and the tables to apply in the
+// progress marker, the query string of the storage is dropped because it may
+// contain credentials. The filter rules are hashed into the id, so an apply
+// with different filter rules doesn't resume from the progress of the other
+// tables.
+func (rac *RedoApplierConfig) progressID() string {
+	id := rac.Storage
+	if uri, err := url.Parse(rac.Storage); err == nil {
+		id = uri.Scheme + "://" + uri.Host + uri.Path
+	}
+	if len(rac.FilterRules) == 0 {
+		return id
+	}
+	hash := sha256.Sum256([]byte(strings.Join(rac.FilterRules, "\n")))
+	return id + "#filter-" + hex.EncodeToString(hash[:8])
+}
+
+// targetTs returns the ts up to which the redo logs are applied.
+func (rac *RedoApplierConfig) targetTs(checkpointTs, resolvedTs uint64) (uint64, error) {
+	if rac.UntilTs == 0 || rac.UntilTs >= resolvedTs {
+		return resolvedTs, nil
+	}
+	if rac.UntilTs <= checkpointTs {
+		return 0, cerror.ErrRedoConfigInvalid.GenWithStack(
+			"until-ts %d must be greater than the checkpoint-ts %d of redo logs", rac.UntilTs, checkpointTs)
+	}
+	return rac.UntilTs, nil
+}
+
 func (ra *RedoApplier) catchError(ctx context.Context) error {
 	for {
 		select {
@@ -95,17 +147,41 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	err = ra.rd.ResetReader(ctx, checkpointTs, resolvedTs)
+	targetTs, err := ra.cfg.targetTs(checkpointTs, resolvedTs)
+	if err != nil {
+		return err
+	}
+	startTs := checkpointTs
+	if ra.progress != nil {
+		progressTs, ok, err := ra.progress.load(ctx)
+		if err != nil {
+			return err
+		}
+		if ok && progressTs >= targetTs {
+			log.Info("redo log has been applied", zap.Uint64("progress-ts", progressTs),
+				zap.Uint64("target-ts", targetTs))
+			ra.rd.Close() //nolint:errcheck
+			return errApplyFinished
+		}
+		if ok && progressTs > startTs {
+			startTs = progressTs
+		}
+	}
+	err = ra.rd.ResetReader(ctx, startTs, targetTs)
 	if err != nil {
 		return err
 	}
-	log.Info("apply redo log starts", zap.Uint64("checkpoint-ts", checkpointTs), zap.Uint64("resolved-ts", resolvedTs))
+	log.Info("apply redo log starts", zap.Uint64("checkpoint-ts", checkpointTs),
+		zap.Uint64("resolved-ts", resolvedTs), zap.Uint64("start-ts", startTs), zap.Uint64("target-ts", targetTs))
 
 	// MySQL sink will use the following replication config
 	// - EnableOldValue: default true
 	// - ForceReplicate: default false
-	// - filter: default []string{"*.*"}
+	// - filter: default []string{"*.*"}, or the filter rules of the applier
 	replicaConfig := config.GetDefaultReplicaConfig()
+	if len(ra.cfg.FilterRules) > 0 {
+		replicaConfig.Filter.Rules = ra.cfg.FilterRules
+	}
 	ft, err := filter.NewFilter(replicaConfig)
 	if err != nil {
 		return err
@@ -125,9 +201,11 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	// transaction are flushed in a single batch.
 	// lastSafeResolvedTs records the max resolved ts of a closed transaction.
 	// Closed transaction means all events of this transaction have been received.
-	lastSafeResolvedTs := checkpointTs - 1
+	lastSafeResolvedTs := startTs - 1
 	// lastResolvedTs records the max resolved ts we have seen from redo logs.
-	lastResolvedTs := checkpointTs
+	lastResolvedTs := startTs
+	// savedTs records the last progress written into the downstream.
+	savedTs := startTs
 	cachedRows := make([]*model.RowChangedEvent, 0, emitBatch)
 	for {
 		redoLogs, err := ra.rd.ReadNextLog(ctx, readBatch)
@@ -151,16 +229,31 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 				lastSafeResolvedTs, lastResolvedTs = lastResolvedTs, redoLog.Row.CommitTs
 			}
 		}
-		_, err = s.FlushRowChangedEvents(ctx, lastSafeResolvedTs)
+		// The cached rows are emitted before flushing, otherwise the ts
+		// returned by the sink may cover the rows not emitted yet.
+		err = s.EmitRowChangedEvents(ctx, cachedRows...)
 		if err != nil {
 			return err
 		}
+		cachedRows = make([]*model.RowChangedEvent, 0, emitBatch)
+		flushedTs, err := s.FlushRowChangedEvents(ctx, lastSafeResolvedTs)
+		if err != nil {
+			return err
+		}
+		// The ts returned by the sink is the ts before which all the rows
+		// have been written.
+		if ra.progress != nil && flushedTs > savedTs {
+			if err := ra.progress.save(ctx, flushedTs); err != nil {
+				return err
+			}
+			savedTs = flushedTs
+		}
 	}
 	err = s.EmitRowChangedEvents(ctx, cachedRows...)
 	if err != nil {
 		return err
 	}
-	_, err = s.FlushRowChangedEvents(ctx, resolvedTs)
+	_, err = s.FlushRowChangedEvents(ctx, targetTs)
 	if err != nil {
 		return err
 	}
@@ -168,6 +261,11 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if ra.progress != nil {
+		if err := ra.progress.save(ctx, targetTs); err != nil {
+			return err
+		}
+	}
 	return errApplyFinished
 }
 
@@ -198,6 +296,13 @@ func (ra *RedoApplier) Apply(ctx context.Context) error {
 	}
 	ra.rd = rd
 	ra.errCh = make(chan error, 1024)
+	if ra.cfg.Resume {
+		ra.progress, err = newProgressMarker(ctx, ra.cfg.SinkURI, ra.cfg.progressID())
+		if err != nil {
+			return err
+		}
+		defer ra.progress.close() //nolint:errcheck
+	}
 
 	wg, ctx := errgroup.WithContext(ctx)
 	wg.Go(func() error {
@@ -16,7 +16,9 @@ package applier
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -32,6 +34,8 @@ import (
 type MockReader struct {
 	checkpointTs uint64
 	resolvedTs   uint64
+	startTs      uint64
+	endTs        uint64
 	redoLogCh    chan *model.RedoRowChangedEvent
 	ddlEventCh   chan *model.RedoDDLEvent
 }
@@ -53,6 +57,7 @@ func NewMockReader(
 
 // ResetReader implements LogReader.ReadLog
 func (br *MockReader) ResetReader(ctx context.Context, startTs, endTs uint64) error {
+	br.startTs, br.endTs = startTs, endTs
 	return nil
 }
 
@@ -67,6 +72,9 @@ func (br *MockReader) ReadNextLog(ctx context.Context, maxNumberOfMessages uint6
 			if !ok {
 				return cached, nil
 			}
+			if redoLog.Row.CommitTs <= br.startTs || redoLog.Row.CommitTs > br.endTs {
+				continue
+			}
 			cached = append(cached, redoLog)
 			if len(cached) >= int(maxNumberOfMessages) {
 				return cached, nil
@@ -238,3 +246,203 @@ func TestApplyMeetSinkError(t *testing.T) {
 	err = ap.Apply(ctx)
 	require.Regexp(t, "CDC:ErrMySQLConnectionError", err)
 }
+
+// tsArg matches any ts argument, and records the last one.
+type tsArg struct {
+	mu sync.Mutex
+	ts uint64
+}
+
+func (a *tsArg) Match(v driver.Value) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ts, ok := v.(int64)
+	if ok {
+		a.ts = uint64(ts)
+	}
+	return ok
+}
+
+func (a *tsArg) get() uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.ts
+}
+
+func TestProgressID(t *testing.T) {
+	cfg := &RedoApplierConfig{Storage: "s3://bucket/redo?access-key=secret"}
+	require.Equal(t, "s3://bucket/redo", cfg.progressID())
+
+	// the applies of different tables don't share the progress.
+	cfg.FilterRules = []string{"test.t1"}
+	id := cfg.progressID()
+	require.Regexp(t, "^s3://bucket/redo#filter-[0-9a-f]{16}$", id)
+	cfg.FilterRules = []string{"test.t1", "test.t2"}
+	require.NotEqual(t, id, cfg.progressID())
+	cfg.FilterRules = []string{"test.t1"}
+	require.Equal(t, id, cfg.progressID())
+}
+
+func mockProgressDB(t *testing.T, id string, progressTs uint64, saved *tsArg) *sql.DB {
+	db, mock, err := sqlmock.New()
+	require.Nil(t, err)
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS tidb_cdc").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS tidb_cdc.redo_apply_progress").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT checkpoint_ts FROM tidb_cdc.redo_apply_progress WHERE id = ?").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"checkpoint_ts"}).AddRow(progressTs))
+	// The progress may be saved while applying, and must be saved after
+	// the apply finishes.
+	for i := 0; i < 2; i++ {
+		mock.ExpectExec("REPLACE INTO tidb_cdc.redo_apply_progress").
+			WithArgs(id, saved).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectClose()
+	return db
+}
+
+func TestApplyResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	checkpointTs := uint64(1000)
+	resolvedTs := uint64(2000)
+	redoLogCh := make(chan *model.RedoRowChangedEvent, 1024)
+	ddlEventCh := make(chan *model.RedoDDLEvent, 1024)
+	createMockReader := func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return NewMockReader(checkpointTs, resolvedTs, redoLogCh, ddlEventCh), nil
+	}
+
+	saved := &tsArg{}
+	dbIndex := 0
+	mockGetDBConn := func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		defer func() {
+			dbIndex++
+		}()
+		switch dbIndex {
+		case 0:
+			// the rows before the progress have been applied.
+			return mockProgressDB(t, "local:///tmp/redo#filter-d8e3d8e2a7fd6a65", 1200, saved), nil
+		case 1:
+			db, mock, err := sqlmock.New()
+			require.Nil(t, err)
+			columns := []string{"Variable_name", "Value"}
+			mock.ExpectQuery("show session variables like 'allow_auto_random_explicit_insert';").WillReturnRows(
+				sqlmock.NewRows(columns).AddRow("allow_auto_random_explicit_insert", "0"),
+			)
+			mock.ExpectQuery("show session variables like 'tidb_txn_mode';").WillReturnRows(
+				sqlmock.NewRows(columns).AddRow("tidb_txn_mode", "pessimistic"),
+			)
+			mock.ExpectClose()
+			return db, nil
+		}
+		// only the row of test.t1 between the progress and the until ts
+		// is applied.
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.Nil(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec("REPLACE INTO `test`.`t1`(`a`,`b`) VALUES (?,?)").
+			WithArgs(2, "3").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectClose()
+		return db, nil
+	}
+
+	getDBConnBak := sink.GetDBConnImpl
+	sink.GetDBConnImpl = mockGetDBConn
+	createRedoReaderBak := createRedoReader
+	createRedoReader = createMockReader
+	defer func() {
+		createRedoReader = createRedoReaderBak
+		sink.GetDBConnImpl = getDBConnBak
+	}()
+
+	newRow := func(table string, a int, b string, commitTs uint64) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			StartTs:  commitTs - 10,
+			CommitTs: commitTs,
+			Table:    &model.TableName{Schema: "test", Table: table},
+			Columns: []*model.Column{
+				{Name: "a", Value: a, Flag: model.HandleKeyFlag},
+				{Name: "b", Value: b},
+			},
+		}
+	}
+	for _, dml := range []*model.RowChangedEvent{
+		newRow("t1", 1, "2", 1100),
+		newRow("t1", 2, "3", 1300),
+		newRow("t2", 3, "4", 1400),
+		newRow("t1", 4, "5", 1600),
+	} {
+		redoLogCh <- redo.RowToRedo(dml)
+	}
+	close(redoLogCh)
+	close(ddlEventCh)
+
+	cfg := &RedoApplierConfig{
+		Storage:     "local:///tmp/redo?key=value",
+		SinkURI:     "mysql://127.0.0.1:4000/?worker-count=1&max-txn-row=1",
+		UntilTs:     1500,
+		FilterRules: []string{"test.t1"},
+		Resume:      true,
+	}
+	ap := NewRedoApplier(cfg)
+	err := ap.Apply(ctx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1500), saved.get())
+}
+
+func TestApplyResumeFinished(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	createMockReader := func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return NewMockReader(1000, 2000, nil, nil), nil
+	}
+	mockGetDBConn := func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		db, mock, err := sqlmock.New()
+		require.Nil(t, err)
+		mock.ExpectExec("CREATE DATABASE IF NOT EXISTS tidb_cdc").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS tidb_cdc.redo_apply_progress").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT checkpoint_ts FROM tidb_cdc.redo_apply_progress WHERE id = ?").
+			WillReturnRows(sqlmock.NewRows([]string{"checkpoint_ts"}).AddRow(2000))
+		mock.ExpectClose()
+		return db, nil
+	}
+
+	getDBConnBak := sink.GetDBConnImpl
+	sink.GetDBConnImpl = mockGetDBConn
+	createRedoReaderBak := createRedoReader
+	createRedoReader = createMockReader
+	defer func() {
+		createRedoReader = createRedoReaderBak
+		sink.GetDBConnImpl = getDBConnBak
+	}()
+
+	// the sink is not created because all the redo logs have been applied.
+	cfg := &RedoApplierConfig{
+		Storage: "local:///tmp/redo",
+		SinkURI: "mysql://127.0.0.1:4000/",
+		Resume:  true,
+	}
+	err := NewRedoApplier(cfg).Apply(ctx)
+	require.Nil(t, err)
+
+	cfg.UntilTs = 900
+	err = NewRedoApplier(cfg).Apply(ctx)
+	require.Regexp(t, ".*until-ts 900 must be greater than the checkpoint-ts 1000.*", err)
+
+	cfg = &RedoApplierConfig{
+		Storage: "local:///tmp/redo",
+		SinkURI: "kafka://127.0.0.1:9092/test",
+		Resume:  true,
+	}
+	err = NewRedoApplier(cfg).Apply(ctx)
+	require.Regexp(t, ".*ErrSinkURIInvalid.*", err)
+}
@@ -61,8 +61,14 @@ type changefeedCommonOptions struct {
 	cyclicReplicaID        uint64
 	cyclicFilterReplicaIDs []uint
 	cyclicSyncDDL          bool
+	cyclicUseTxnSource     bool
 	syncPointEnabled       bool
 	syncPointInterval      time.Duration
+	maxRowsPerSecond       uint64
+	maxBytesPerSecond      uint64
+	pauseSchedule          string
+	resumeSchedule         string
+	scheduleTimeZone       string
 }
 
 // newChangefeedCommonOptions creates new changefeed common options.
@@ -87,11 +93,37 @@ func (o *changefeedCommonOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().Uint64Var(&o.cyclicReplicaID, "cyclic-replica-id", 0, "(Experimental) Cyclic replication replica ID of changefeed")
 	cmd.PersistentFlags().UintSliceVar(&o.cyclicFilterReplicaIDs, "cyclic-filter-replica-ids", []uint{}, "(Experimental) Cyclic replication filter replica ID of changefeed")
 	cmd.PersistentFlags().BoolVar(&o.cyclicSyncDDL, "cyclic-sync-ddl", true, "(Experimental) Cyclic replication sync DDL of changefeed")
+	cmd.PersistentFlags().BoolVar(&o.cyclicUseTxnSource, "cyclic-use-txn-source", false, "(Experimental) Cyclic replication tags the transactions by the TiDB transaction source instead of the mark tables")
 	cmd.PersistentFlags().BoolVar(&o.syncPointEnabled, "sync-point", false, "(Experimental) Set and Record syncpoint in replication(default off)")
 	cmd.PersistentFlags().DurationVar(&o.syncPointInterval, "sync-interval", 10*time.Minute, "(Experimental) Set the interval for syncpoint in replication(default 10min)")
+	cmd.PersistentFlags().Uint64Var(&o.maxRowsPerSecond, "max-rows-per-second", 0, "Max rows replicated per second by the changefeed on each capture, 0 means unlimited")
+	cmd.PersistentFlags().Uint64Var(&o.maxBytesPerSecond, "max-bytes-per-second", 0, "Max bytes replicated per second by the changefeed on each capture, 0 means unlimited")
+	cmd.PersistentFlags().StringVar(&o.pauseSchedule, "pause-schedule", "", "Cron expression of the times to pause the changefeed, e.g. \"0 2 * * *\"")
+	cmd.PersistentFlags().StringVar(&o.resumeSchedule, "resume-schedule", "", "Cron expression of the times to resume the changefeed paused by the pause-schedule, e.g. \"0 4 * * *\"")
+	cmd.PersistentFlags().StringVar(&o.scheduleTimeZone, "schedule-time-zone", "", "Time zone of the pause-schedule and resume-schedule, the time zone of the owner is used by default")
 	_ = cmd.PersistentFlags().MarkHidden("sort-dir")
 }
 
+// getPauseSchedule returns the pause schedule specified by the flags, it
+// returns nil if the changefeed is not paused periodically.
+func (o *changefeedCommonOptions) getPauseSchedule() *model.PauseSchedule {
+	if o.pauseSchedule == "" && o.resumeSchedule == "" {
+		return nil
+	}
+	return &model.PauseSchedule{
+		Pause:    o.pauseSchedule,
+		Resume:   o.resumeSchedule,
+		TimeZone: o.scheduleTimeZone,
+	}
+}
+
+// validatePauseSchedule checks the cron expressions of the schedule. The cli
+// doesn't know the GC TTL of the owner, so the owner checks the changefeed is
+// not paused longer than it.
+func validatePauseSchedule(schedule *model.PauseSchedule) error {
+	return schedule.Validate(0)
+}
+
 // strictDecodeConfig do strictDecodeFile check and only verify the rules for now.
 func (o *changefeedCommonOptions) strictDecodeConfig(component string, cfg *config.ReplicaConfig) error {
 	err := util.StrictDecodeFile(o.configFile, component, cfg)
@@ -240,6 +272,13 @@ func (o *createChangefeedOptions) completeCfg(ctx context.Context, cmd *cobra.Co
 		cfg.CheckGCSafePoint = false
 	}
 
+	if cmd.Flags().Changed("max-rows-per-second") {
+		cfg.Sink.MaxRowsPerSecond = o.commonChangefeedOptions.maxRowsPerSecond
+	}
+	if cmd.Flags().Changed("max-bytes-per-second") {
+		cfg.Sink.MaxBytesPerSecond = o.commonChangefeedOptions.maxBytesPerSecond
+	}
+
 	if o.commonChangefeedOptions.cyclicReplicaID != 0 || len(o.commonChangefeedOptions.cyclicFilterReplicaIDs) != 0 {
 		if !(o.commonChangefeedOptions.cyclicReplicaID != 0 && len(o.commonChangefeedOptions.cyclicFilterReplicaIDs) != 0) {
 			return errors.New("invalid cyclic config, please make sure using " +
@@ -256,6 +295,7 @@ func (o *createChangefeedOptions) completeCfg(ctx context.Context, cmd *cobra.Co
 			ReplicaID:       o.commonChangefeedOptions.cyclicReplicaID,
 			FilterReplicaID: filter,
 			SyncDDL:         o.commonChangefeedOptions.cyclicSyncDDL,
+			UseTxnSource:    o.commonChangefeedOptions.cyclicUseTxnSource,
 			// TODO(neil) enable ID bucket.
 		}
 	}
@@ -288,6 +328,12 @@ func (o *createChangefeedOptions) validate(ctx context.Context, cmd *cobra.Comma
 		return errors.New("Creating changefeed with `--sort-dir`, it's invalid")
 	}
 
+	if schedule := o.commonChangefeedOptions.getPauseSchedule(); schedule != nil {
+		if err := validatePauseSchedule(schedule); err != nil {
+			return err
+		}
+	}
+
 	switch o.commonChangefeedOptions.sortEngine {
 	case model.SortUnified, model.SortInMemory:
 	case model.SortInFile:
@@ -315,6 +361,7 @@ func (o *createChangefeedOptions) getInfo(cmd *cobra.Command) *model.ChangeFeedI
 		SyncPointEnabled:  o.commonChangefeedOptions.syncPointEnabled,
 		SyncPointInterval: o.commonChangefeedOptions.syncPointInterval,
 		CreatorVersion:    version.ReleaseVersion,
+		PauseSchedule:     o.commonChangefeedOptions.getPauseSchedule(),
 	}
 
 	if info.Engine == model.SortInFile {
@@ -408,7 +455,10 @@ func (o *createChangefeedOptions) run(ctx context.Context, cmd *cobra.Command) e
 		}
 	}
 
-	if o.cfg.Cyclic.IsEnabled() && !cyclic.IsTablesPaired(eligibleTables) {
+	if err := o.cfg.Cyclic.ValidateTxnSource(); err != nil {
+		return err
+	}
+	if o.cfg.Cyclic.IsEnabled() && !o.cfg.Cyclic.UseTxnSource && !cyclic.IsTablesPaired(eligibleTables) {
 		return errors.New("normal tables and mark tables are not paired, " +
 			"please run `cdc cli changefeed cyclic create-marktables`")
 	}
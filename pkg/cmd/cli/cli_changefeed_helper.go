@@ -25,6 +25,8 @@ import (
 	"github.com/pingcap/ticdc/cdc/entry"
 	"github.com/pingcap/ticdc/cdc/kv"
 	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/cdc/sink/columnselector"
+	"github.com/pingcap/ticdc/cdc/sink/dispatcher"
 	"github.com/pingcap/ticdc/pkg/cmd/util"
 	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/etcd"
@@ -101,10 +103,21 @@ func getTables(cliPdAddr string, credential *security.Credential, cfg *config.Re
 		return nil, nil, errors.Trace(err)
 	}
 
+	columnSelectors, err := columnselector.New(cfg)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
 	for _, tableInfo := range snap.Tables() {
 		if filter.ShouldIgnoreTable(tableInfo.TableName.Schema, tableInfo.TableName.Table) {
 			continue
 		}
+		if err := columnSelectors.VerifyTable(tableInfo); err != nil {
+			return nil, nil, err
+		}
+		if err := dispatcher.VerifyTable(cfg, tableInfo); err != nil {
+			return nil, nil, err
+		}
 		if !tableInfo.IsEligible(false /* forceReplicate */) {
 			ineligibleTables = append(ineligibleTables, tableInfo.TableName)
 		} else {
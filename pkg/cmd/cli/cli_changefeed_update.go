@@ -14,8 +14,11 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/pingcap/ticdc/pkg/etcd"
 
@@ -36,6 +39,7 @@ import (
 type updateChangefeedOptions struct {
 	etcdClient *etcd.CDCEtcdClient
 
+	pdAddr     string
 	credential *security.Credential
 
 	commonChangefeedOptions *changefeedCommonOptions
@@ -70,6 +74,7 @@ func (o *updateChangefeedOptions) complete(f factory.Factory) error {
 
 	o.etcdClient = etcdClient
 
+	o.pdAddr = f.GetPdAddr()
 	o.credential = f.GetCredential()
 
 	return nil
@@ -86,9 +91,7 @@ func (o *updateChangefeedOptions) run(cmd *cobra.Command) error {
 	}
 	// Note that the correctness of the logic here depends on the return value of `/capture/owner/changefeed/query` interface.
 	// TODO: Using error codes instead of string containing judgments
-	if err == nil && !strings.Contains(resp, `"state": "stopped"`) {
-		return errors.Errorf("can only update changefeed config when it is stopped\nstatus: %s", resp)
-	}
+	running := err == nil && !strings.Contains(resp, `"state": "stopped"`)
 
 	old, err := o.etcdClient.GetChangeFeedInfo(ctx, o.changefeedID)
 	if err != nil {
@@ -108,6 +111,12 @@ func (o *updateChangefeedOptions) run(cmd *cobra.Command) error {
 		cmd.Printf("changefeed config is the same with the old one, do nothing\n")
 		return nil
 	}
+	if running && !canUpdateOnline(changelog) {
+		return errors.Errorf("can only update changefeed config when it is stopped\nstatus: %s", resp)
+	}
+	if err := o.verifyTables(ctx, old, newInfo); err != nil {
+		return err
+	}
 	cmd.Printf("Diff of changefeed config:\n")
 	for _, change := range changelog {
 		cmd.Printf("%+v\n", change)
@@ -140,6 +149,31 @@ func (o *updateChangefeedOptions) run(cmd *cobra.Command) error {
 	return nil
 }
 
+// onlineUpdatableConfigs are the paths of the changefeed configs which can be
+// updated while the changefeed is running.
+var onlineUpdatableConfigs = [][]string{
+	{"Config", "Sink", "MaxRowsPerSecond"},
+	{"Config", "Sink", "MaxBytesPerSecond"},
+}
+
+// canUpdateOnline returns true if all the changes can be applied without
+// stopping the changefeed.
+func canUpdateOnline(changelog diff.Changelog) bool {
+	for _, change := range changelog {
+		found := false
+		for _, path := range onlineUpdatableConfigs {
+			if strings.Join(change.Path, ".") == strings.Join(path, ".") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // applyChanges applies the new changes to the old changefeed.
 func (o *updateChangefeedOptions) applyChanges(oldInfo *model.ChangeFeedInfo, cmd *cobra.Command) (*model.ChangeFeedInfo, error) {
 	newInfo, err := oldInfo.Clone()
@@ -147,6 +181,7 @@ func (o *updateChangefeedOptions) applyChanges(oldInfo *model.ChangeFeedInfo, cm
 		return nil, err
 	}
 
+	scheduleChanged := false
 	cmd.Flags().Visit(func(flag *pflag.Flag) {
 		switch flag.Name {
 		case "target-ts":
@@ -185,10 +220,29 @@ func (o *updateChangefeedOptions) applyChanges(oldInfo *model.ChangeFeedInfo, cm
 			newInfo.Config.Cyclic.FilterReplicaID = filter
 		case "cyclic-sync-ddl":
 			newInfo.Config.Cyclic.SyncDDL = o.commonChangefeedOptions.cyclicSyncDDL
+		case "cyclic-use-txn-source":
+			newInfo.Config.Cyclic.UseTxnSource = o.commonChangefeedOptions.cyclicUseTxnSource
 		case "sync-point":
 			newInfo.SyncPointEnabled = o.commonChangefeedOptions.syncPointEnabled
 		case "sync-interval":
 			newInfo.SyncPointInterval = o.commonChangefeedOptions.syncPointInterval
+		case "max-rows-per-second":
+			newInfo.Config.Sink.MaxRowsPerSecond = o.commonChangefeedOptions.maxRowsPerSecond
+		case "max-bytes-per-second":
+			newInfo.Config.Sink.MaxBytesPerSecond = o.commonChangefeedOptions.maxBytesPerSecond
+		case "pause-schedule", "resume-schedule", "schedule-time-zone":
+			if newInfo.PauseSchedule == nil {
+				newInfo.PauseSchedule = new(model.PauseSchedule)
+			}
+			switch flag.Name {
+			case "pause-schedule":
+				newInfo.PauseSchedule.Pause = o.commonChangefeedOptions.pauseSchedule
+			case "resume-schedule":
+				newInfo.PauseSchedule.Resume = o.commonChangefeedOptions.resumeSchedule
+			case "schedule-time-zone":
+				newInfo.PauseSchedule.TimeZone = o.commonChangefeedOptions.scheduleTimeZone
+			}
+			scheduleChanged = true
 		case "sort-dir":
 			log.Warn("this flag cannot be updated and will be ignored", zap.String("flagName", flag.Name))
 		case "changefeed-id", "no-confirm", "cyclic-filter-replica-ids":
@@ -209,9 +263,46 @@ func (o *updateChangefeedOptions) applyChanges(oldInfo *model.ChangeFeedInfo, cm
 		return nil, err
 	}
 
+	if scheduleChanged {
+		if newInfo.PauseSchedule.Pause == "" && newInfo.PauseSchedule.Resume == "" {
+			newInfo.PauseSchedule = nil
+		} else {
+			if err := validatePauseSchedule(newInfo.PauseSchedule); err != nil {
+				return nil, err
+			}
+			// the events of the new schedule are handled from now on
+			newInfo.PauseSchedule.CheckedTime = time.Time{}
+		}
+	}
+	if newInfo.Config != nil {
+		if err := newInfo.Config.Cyclic.ValidateTxnSource(); err != nil {
+			return nil, err
+		}
+		if err := newInfo.Config.Sink.ValidateRateLimit(); err != nil {
+			return nil, err
+		}
+	}
+
 	return newInfo, nil
 }
 
+// verifyTables checks the tables with the new filter and sink config if any
+// of them is changed, the columns of the dispatchers and the column selectors
+// must exist in the tables at the checkpoint, which the changefeed resumes from.
+func (o *updateChangefeedOptions) verifyTables(ctx context.Context, old, newInfo *model.ChangeFeedInfo) error {
+	if newInfo.Config == nil || (old.Config != nil &&
+		reflect.DeepEqual(old.Config.Filter, newInfo.Config.Filter) &&
+		reflect.DeepEqual(old.Config.Sink, newInfo.Config.Sink)) {
+		return nil
+	}
+	status, _, err := o.etcdClient.GetChangeFeedStatus(ctx, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	_, _, err = getTables(o.pdAddr, o.credential, newInfo.Config, status.CheckpointTs)
+	return err
+}
+
 // newCmdPauseChangefeed creates the `cli changefeed update` command.
 func newCmdUpdateChangefeed(f factory.Factory) *cobra.Command {
 	commonChangefeedOptions := newChangefeedCommonOptions()
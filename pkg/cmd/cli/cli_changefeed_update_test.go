@@ -21,7 +21,9 @@ import (
 	"github.com/pingcap/check"
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/util/testleak"
+	"github.com/r3labs/diff"
 )
 
 type changefeedUpdateSuite struct{}
@@ -71,6 +73,68 @@ func (s *changefeedUpdateSuite) TestApplyChanges(c *check.C) {
 	c.Assert(strings.Contains(string(file), "this flag cannot be updated and will be ignored"), check.IsTrue)
 }
 
+func (s *changefeedUpdateSuite) TestUpdateRateLimitOnline(c *check.C) {
+	defer testleak.AfterTest(c)()
+
+	cmd := NewCmdCli()
+	commonChangefeedOptions := newChangefeedCommonOptions()
+	o := newUpdateChangefeedOptions(commonChangefeedOptions)
+	o.addFlags(cmd)
+
+	oldInfo := &model.ChangeFeedInfo{SinkURI: "blackhole://", Config: config.GetDefaultReplicaConfig()}
+	c.Assert(cmd.ParseFlags([]string{"--max-rows-per-second=1000", "--max-bytes-per-second=1048576"}), check.IsNil)
+	newInfo, err := o.applyChanges(oldInfo, cmd)
+	c.Assert(err, check.IsNil)
+	c.Assert(newInfo.Config.Sink.MaxRowsPerSecond, check.Equals, uint64(1000))
+	c.Assert(newInfo.Config.Sink.MaxBytesPerSecond, check.Equals, uint64(1048576))
+	changelog, err := diff.Diff(oldInfo, newInfo)
+	c.Assert(err, check.IsNil)
+	c.Assert(changelog, check.HasLen, 2)
+	c.Assert(canUpdateOnline(changelog), check.IsTrue)
+
+	// the other configs can't be updated while the changefeed is running
+	c.Assert(cmd.ParseFlags([]string{"--sink-uri=mysql://root@downstream-tidb:4000"}), check.IsNil)
+	newInfo, err = o.applyChanges(oldInfo, cmd)
+	c.Assert(err, check.IsNil)
+	changelog, err = diff.Diff(oldInfo, newInfo)
+	c.Assert(err, check.IsNil)
+	c.Assert(changelog, check.HasLen, 3)
+	c.Assert(canUpdateOnline(changelog), check.IsFalse)
+}
+
+func (s *changefeedUpdateSuite) TestUpdatePauseSchedule(c *check.C) {
+	defer testleak.AfterTest(c)()
+
+	cmd := NewCmdCli()
+	commonChangefeedOptions := newChangefeedCommonOptions()
+	o := newUpdateChangefeedOptions(commonChangefeedOptions)
+	o.addFlags(cmd)
+
+	oldInfo := &model.ChangeFeedInfo{SinkURI: "blackhole://", Config: config.GetDefaultReplicaConfig()}
+	c.Assert(cmd.ParseFlags([]string{"--pause-schedule=0 2 * * *", "--resume-schedule=0 4 * * *"}), check.IsNil)
+	newInfo, err := o.applyChanges(oldInfo, cmd)
+	c.Assert(err, check.IsNil)
+	c.Assert(newInfo.PauseSchedule, check.DeepEquals, &model.PauseSchedule{Pause: "0 2 * * *", Resume: "0 4 * * *"})
+
+	// the invalid cron expression is rejected
+	oldInfo = newInfo
+	c.Assert(cmd.ParseFlags([]string{"--resume-schedule=0 4 * *"}), check.IsNil)
+	_, err = o.applyChanges(oldInfo, cmd)
+	c.Assert(err, check.ErrorMatches, ".*ErrInvalidPauseSchedule.*")
+
+	// the pause longer than the GC TTL is checked by the owner
+	c.Assert(cmd.ParseFlags([]string{"--resume-schedule=0 4 * * 1"}), check.IsNil)
+	newInfo, err = o.applyChanges(oldInfo, cmd)
+	c.Assert(err, check.IsNil)
+	c.Assert(newInfo.PauseSchedule.Resume, check.Equals, "0 4 * * 1")
+
+	// remove the schedule
+	c.Assert(cmd.ParseFlags([]string{"--pause-schedule=", "--resume-schedule="}), check.IsNil)
+	newInfo, err = o.applyChanges(oldInfo, cmd)
+	c.Assert(err, check.IsNil)
+	c.Assert(newInfo.PauseSchedule, check.IsNil)
+}
+
 func initTestLogger(filename string) (func(), error) {
 	logConfig := &log.Config{
 		File: log.FileLogConfig{
@@ -22,7 +22,10 @@ import (
 // applyRedoOptions defines flags for the `redo apply` command.
 type applyRedoOptions struct {
 	options
-	sinkURI string
+	sinkURI     string
+	untilTs     uint64
+	filterRules []string
+	resume      bool
 }
 
 // newapplyRedoOptions creates new applyRedoOptions for the `redo apply` command.
@@ -34,6 +37,9 @@ func newapplyRedoOptions() *applyRedoOptions {
 // flags related to template printing to it.
 func (o *applyRedoOptions) addFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.sinkURI, "sink-uri", "", "target database sink-uri")
+	cmd.Flags().Uint64Var(&o.untilTs, "until-ts", 0, "apply redo logs up to the ts, the resolved ts of redo logs is used by default")
+	cmd.Flags().StringSliceVar(&o.filterRules, "filter", nil, "table filter rules of the tables to apply, eg, \"test.*\"")
+	cmd.Flags().BoolVar(&o.resume, "resume", false, "write the apply progress into the target database, and resume from it if it exists")
 	// the possible error returned from MarkFlagRequired is `no such flag`
 	cmd.MarkFlagRequired("sink-uri") //nolint:errcheck
 }
@@ -43,9 +49,14 @@ func (o *applyRedoOptions) run(cmd *cobra.Command) error {
 	ctx := cmdcontext.GetDefaultContext()
 
 	cfg := &applier.RedoApplierConfig{
-		Storage: o.storage,
-		SinkURI: o.sinkURI,
-		Dir:     o.dir,
+		Storage:             o.storage,
+		SinkURI:             o.sinkURI,
+		Dir:                 o.dir,
+		UntilTs:             o.untilTs,
+		FilterRules:         o.filterRules,
+		Resume:              o.resume,
+		EncryptionKeyFile:   o.encryptionKeyFile,
+		EncryptionMasterKey: o.encryptionMasterKey,
 	}
 	ap := applier.NewRedoApplier(cfg)
 	err := ap.Apply(ctx)
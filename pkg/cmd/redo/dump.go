@@ -0,0 +1,86 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"github.com/pingcap/ticdc/pkg/applier"
+	cmdcontext "github.com/pingcap/ticdc/pkg/cmd/context"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// dumpOptions defines flags for the `redo dump` command.
+type dumpOptions struct {
+	options
+	startTs     uint64
+	endTs       uint64
+	filterRules []string
+	eventType   string
+}
+
+// newDumpOptions creates new dumpOptions for the `redo dump` command.
+func newDumpOptions() *dumpOptions {
+	return &dumpOptions{}
+}
+
+func (o *dumpOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(&o.startTs, "start-ts", 0, "dump the events whose commit ts are greater than the ts, the checkpoint ts of redo logs is used by default")
+	cmd.Flags().Uint64Var(&o.endTs, "end-ts", 0, "dump the events whose commit ts are not greater than the ts, the resolved ts of redo logs is used by default")
+	cmd.Flags().StringSliceVar(&o.filterRules, "filter", nil, "table filter rules of the events to dump, eg, \"test.*\"")
+	cmd.Flags().StringVar(&o.eventType, "type", "all", "type of the events to dump (etc: row|ddl|all)")
+}
+
+// run runs the `redo dump` command.
+func (o *dumpOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	dumpCfg := &applier.RedoDumpConfig{
+		StartTs:     o.startTs,
+		EndTs:       o.endTs,
+		FilterRules: o.filterRules,
+	}
+	switch o.eventType {
+	case "all":
+	case "row":
+		dumpCfg.SkipDDLs = true
+	case "ddl":
+		dumpCfg.SkipRows = true
+	default:
+		return cerror.ErrRedoConfigInvalid.GenWithStack("invalid event type %s", o.eventType)
+	}
+
+	cfg := &applier.RedoApplierConfig{
+		Storage:             o.storage,
+		Dir:                 o.dir,
+		EncryptionKeyFile:   o.encryptionKeyFile,
+		EncryptionMasterKey: o.encryptionMasterKey,
+	}
+	return applier.NewRedoApplier(cfg).Dump(ctx, dumpCfg, cmd.OutOrStdout())
+}
+
+// newCmdDump creates the `redo dump` command.
+func newCmdDump(opt *options) *cobra.Command {
+	o := newDumpOptions()
+	command := &cobra.Command{
+		Use:   "dump",
+		Short: "Dump the events in redo logs as JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.options = *opt
+			return o.run(cmd)
+		},
+	}
+	o.addFlags(command)
+
+	return command
+}
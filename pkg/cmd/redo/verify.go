@@ -0,0 +1,70 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"github.com/pingcap/ticdc/pkg/applier"
+	cmdcontext "github.com/pingcap/ticdc/pkg/cmd/context"
+	"github.com/pingcap/ticdc/pkg/cmd/util"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// verifyOptions defines flags for the `redo verify` command.
+type verifyOptions struct {
+	options
+}
+
+// newVerifyOptions creates new verifyOptions for the `redo verify` command.
+func newVerifyOptions() *verifyOptions {
+	return &verifyOptions{}
+}
+
+// run runs the `redo verify` command.
+func (o *verifyOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	cfg := &applier.RedoApplierConfig{
+		Storage:             o.storage,
+		Dir:                 o.dir,
+		EncryptionKeyFile:   o.encryptionKeyFile,
+		EncryptionMasterKey: o.encryptionMasterKey,
+	}
+	report, err := applier.NewRedoApplier(cfg).Verify(ctx)
+	if err != nil {
+		return err
+	}
+	if err := util.JSONPrint(cmd, report); err != nil {
+		return err
+	}
+	if report.HasFatal() {
+		return cerror.ErrRedoFileOp.GenWithStack("redo logs are corrupted, see the issues in the report")
+	}
+	return nil
+}
+
+// newCmdVerify creates the `redo verify` command.
+func newCmdVerify(opt *options) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the integrity of redo logs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o := newVerifyOptions()
+			o.options = *opt
+			return o.run(cmd)
+		},
+	}
+
+	return command
+}
@@ -180,6 +180,11 @@ func (s *utilsSuite) TestAndWriteExampleReplicaTOML(c *check.C) {
 	c.Assert(cfg.Filter, check.DeepEquals, &config.FilterConfig{
 		IgnoreTxnStartTs: []uint64{1, 2},
 		Rules:            []string{"*.*", "!test.*"},
+		EventFilters: []*config.EventFilterRule{{
+			Matcher:                  []string{"test1.orders"},
+			IgnoreInsertValueExpr:    "status = 'draft'",
+			IgnoreUpdateNewValueExpr: "status = 'draft'",
+		}},
 	})
 	c.Assert(cfg.Mounter, check.DeepEquals, &config.MounterConfig{
 		WorkerNum: 16,
@@ -188,6 +193,8 @@ func (s *utilsSuite) TestAndWriteExampleReplicaTOML(c *check.C) {
 		DispatchRules: []*config.DispatchRule{
 			{Dispatcher: "ts", Matcher: []string{"test1.*", "test2.*"}},
 			{Dispatcher: "rowid", Matcher: []string{"test3.*", "test4.*"}},
+			{Dispatcher: "table", Matcher: []string{"test5.*"}, Topic: "cdc_{schema}_{table}"},
+			{Dispatcher: "columns", Matcher: []string{"test6.*"}, Columns: []string{"tenant_id"}},
 		},
 		ColumnSelectors: []*config.ColumnSelector{
 			{Matcher: []string{"test1.*", "test2.*"}, Columns: []string{"column1", "column2"}},
@@ -201,6 +208,10 @@ func (s *utilsSuite) TestAndWriteExampleReplicaTOML(c *check.C) {
 		FilterReplicaID: []uint64{2, 3},
 		SyncDDL:         true,
 	})
+	c.Assert(cfg.Scheduler, check.DeepEquals, &config.SchedulerConfig{
+		Tp:          "table-number",
+		PollingTime: -1,
+	})
 }
 
 func (s *utilsSuite) TestAndWriteExampleServerTOML(c *check.C) {
@@ -19,4 +19,12 @@ type ConsistentConfig struct {
 	MaxLogSize        int64  `toml:"max-log-size" json:"max-log-size"`
 	FlushIntervalInMs int64  `toml:"flush-interval" json:"flush-interval"`
 	Storage           string `toml:"storage" json:"storage"`
+	// Compression is the compression algorithm of redo logs, none, zstd or snappy.
+	Compression string `toml:"compression" json:"compression,omitempty"`
+	// EncryptionKeyFile is the file of the hex encoded AES key used to encrypt
+	// redo logs, it must exist on all the captures.
+	EncryptionKeyFile string `toml:"encryption-key-file" json:"encryption-key-file,omitempty"`
+	// EncryptionMasterKey is the hex encoded AES key used to encrypt redo logs,
+	// it can't be used with EncryptionKeyFile at the same time.
+	EncryptionMasterKey string `toml:"encryption-master-key" json:"encryption-master-key,omitempty"`
 }
@@ -26,13 +26,41 @@ type CyclicConfig struct {
 	FilterReplicaID []uint64 `toml:"filter-replica-ids" json:"filter-replica-ids"`
 	IDBuckets       int      `toml:"id-buckets" json:"id-buckets"`
 	SyncDDL         bool     `toml:"sync-ddl" json:"sync-ddl"`
+	// UseTxnSource tags the transactions written to the downstream with the
+	// replica ID as the TiDB transaction source, and filters the transactions
+	// by their sources instead of the mark tables.
+	UseTxnSource bool `toml:"use-txn-source" json:"use-txn-source,omitempty"`
 }
 
+// MaxTxnSourceReplicaID is the max replica ID when the transaction source is
+// used, TiDB reserves 4 bits of the transaction source for TiCDC.
+const MaxTxnSourceReplicaID = 15
+
 // IsEnabled returns whether cyclic replication is enabled or not.
 func (c *CyclicConfig) IsEnabled() bool {
 	return c != nil && c.Enable
 }
 
+// IsTxnSourceUsed returns whether cyclic replication is enabled and filters
+// the transactions by their sources.
+func (c *CyclicConfig) IsTxnSourceUsed() bool {
+	return c.IsEnabled() && c.UseTxnSource
+}
+
+// ValidateTxnSource checks the replica IDs fit in the transaction source.
+func (c *CyclicConfig) ValidateTxnSource() error {
+	if !c.IsTxnSourceUsed() {
+		return nil
+	}
+	for _, id := range append([]uint64{c.ReplicaID}, c.FilterReplicaID...) {
+		if id == 0 || id > MaxTxnSourceReplicaID {
+			return errors.Errorf("the replica ID %d should be in [1, %d] when the transaction source is used",
+				id, MaxTxnSourceReplicaID)
+		}
+	}
+	return nil
+}
+
 // Marshal returns the json marshal format of a ReplicationConfig
 func (c *CyclicConfig) Marshal() (string, error) {
 	cfg, err := json.Marshal(c)
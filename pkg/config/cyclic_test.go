@@ -0,0 +1,40 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCyclicConfigValidateTxnSource(t *testing.T) {
+	t.Parallel()
+	var c *CyclicConfig
+	require.False(t, c.IsTxnSourceUsed())
+	require.Nil(t, c.ValidateTxnSource())
+
+	// the replica IDs aren't limited if the mark tables are used
+	c = &CyclicConfig{Enable: true, ReplicaID: 100, FilterReplicaID: []uint64{200}}
+	require.False(t, c.IsTxnSourceUsed())
+	require.Nil(t, c.ValidateTxnSource())
+
+	c.UseTxnSource = true
+	require.True(t, c.IsTxnSourceUsed())
+	require.Regexp(t, ".*replica ID 100 should be in \\[1, 15\\].*", c.ValidateTxnSource())
+	c.ReplicaID = 1
+	require.Regexp(t, ".*replica ID 200 should be in \\[1, 15\\].*", c.ValidateTxnSource())
+	c.FilterReplicaID = []uint64{2, 15}
+	require.Nil(t, c.ValidateTxnSource())
+}
@@ -24,4 +24,18 @@ type FilterConfig struct {
 	*filter.MySQLReplicationRules
 	IgnoreTxnStartTs []uint64           `toml:"ignore-txn-start-ts" json:"ignore-txn-start-ts"`
 	DDLAllowlist     []model.ActionType `toml:"ddl-allow-list" json:"ddl-allow-list,omitempty"`
+	EventFilters     []*EventFilterRule `toml:"event-filters" json:"event-filters,omitempty"`
+}
+
+// EventFilterRule ignores the row changed events of the matched tables by SQL
+// expressions, a row is ignored if the expression of its type is true.
+// The expression of an update event is evaluated against both the old values
+// and the new values, and the row is ignored only if all the specified
+// expressions are true.
+type EventFilterRule struct {
+	Matcher                  []string `toml:"matcher" json:"matcher"`
+	IgnoreInsertValueExpr    string   `toml:"ignore-insert-value-expr" json:"ignore-insert-value-expr"`
+	IgnoreUpdateNewValueExpr string   `toml:"ignore-update-new-value-expr" json:"ignore-update-new-value-expr"`
+	IgnoreUpdateOldValueExpr string   `toml:"ignore-update-old-value-expr" json:"ignore-update-old-value-expr"`
+	IgnoreDeleteValueExpr    string   `toml:"ignore-delete-value-expr" json:"ignore-delete-value-expr"`
 }
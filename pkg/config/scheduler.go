@@ -18,4 +18,16 @@ type SchedulerConfig struct {
 	Tp string `toml:"type" json:"type"`
 	// PollingTime represents the polling cycle of checking the skewness of workload and try to do schedule if needed
 	PollingTime int `toml:"polling-time" json:"polling-time"`
+	// Tolerance is the ratio by which the throughput of a capture can exceed
+	// its share before the throughput scheduler moves tables away from it.
+	Tolerance float64 `toml:"tolerance" json:"tolerance,omitempty"`
+	// SplitTables is the table filter rules of the tables which are split into
+	// key ranges, the key ranges are replicated by different captures.
+	// It's only supported by the MQ sinks. A table is split only when its
+	// replication starts, the split tables are not split again or rebalanced
+	// when captures join later.
+	SplitTables []string `toml:"split-tables" json:"split-tables,omitempty"`
+	// SplitSpanNum is the max number of the key ranges a table is split into,
+	// 0 means the number of the captures.
+	SplitSpanNum int `toml:"split-span-num" json:"split-span-num,omitempty"`
 }
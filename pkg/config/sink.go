@@ -13,17 +13,49 @@
 
 package config
 
+import (
+	"math"
+
+	"github.com/pingcap/errors"
+)
+
+// MaxRateLimit is the max value of MaxRowsPerSecond and MaxBytesPerSecond,
+// the limit is also the burst of the rate limiter, which is an int.
+const MaxRateLimit = math.MaxInt32
+
 // SinkConfig represents sink config for a changefeed
 type SinkConfig struct {
 	DispatchRules   []*DispatchRule   `toml:"dispatchers" json:"dispatchers"`
 	Protocol        string            `toml:"protocol" json:"protocol"`
 	ColumnSelectors []*ColumnSelector `toml:"column-selectors" json:"column-selectors"`
+	// MaxRowsPerSecond and MaxBytesPerSecond limit the rows and the bytes
+	// replicated by all the tables of the changefeed on a capture, zero
+	// means unlimited.
+	MaxRowsPerSecond  uint64 `toml:"max-rows-per-second" json:"max-rows-per-second,omitempty"`
+	MaxBytesPerSecond uint64 `toml:"max-bytes-per-second" json:"max-bytes-per-second,omitempty"`
+}
+
+// ValidateRateLimit checks the rate limits fit in the rate limiters.
+func (s *SinkConfig) ValidateRateLimit() error {
+	if s.MaxRowsPerSecond > MaxRateLimit {
+		return errors.Errorf("max-rows-per-second %d should not be larger than %d", s.MaxRowsPerSecond, MaxRateLimit)
+	}
+	if s.MaxBytesPerSecond > MaxRateLimit {
+		return errors.Errorf("max-bytes-per-second %d should not be larger than %d", s.MaxBytesPerSecond, MaxRateLimit)
+	}
+	return nil
 }
 
 // DispatchRule represents partition rule for a table
 type DispatchRule struct {
 	Matcher    []string `toml:"matcher" json:"matcher"`
 	Dispatcher string   `toml:"dispatcher" json:"dispatcher"`
+	// Topic is an expression like "cdc_{schema}_{table}" that routes the
+	// matched tables to their own topics, empty means the sink-uri topic.
+	Topic string `toml:"topic" json:"topic"`
+	// Columns are the columns whose values are hashed to pick the partition,
+	// only used by the "columns" dispatcher.
+	Columns []string `toml:"columns" json:"columns"`
 }
 
 type ColumnSelector struct {
@@ -0,0 +1,32 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinkConfigValidateRateLimit(t *testing.T) {
+	t.Parallel()
+	c := &SinkConfig{}
+	require.Nil(t, c.ValidateRateLimit())
+	c.MaxRowsPerSecond, c.MaxBytesPerSecond = MaxRateLimit, MaxRateLimit
+	require.Nil(t, c.ValidateRateLimit())
+	c.MaxRowsPerSecond = MaxRateLimit + 1
+	require.Regexp(t, ".*max-rows-per-second 2147483648 should not be larger than 2147483647.*", c.ValidateRateLimit())
+	c.MaxRowsPerSecond, c.MaxBytesPerSecond = 0, 1<<40
+	require.Regexp(t, ".*max-bytes-per-second 1099511627776 should not be larger than 2147483647.*", c.ValidateRateLimit())
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+// maxSearchYears bounds the search of the next matched time, an expression
+// like "0 0 30 2 *" never matches.
+const maxSearchYears = 5
+
+type bounds struct {
+	min, max uint
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	// both 0 and 7 are Sunday
+	dowBounds = bounds{0, 7}
+)
+
+var shortcuts = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule is a parsed cron expression with the standard five fields: minute,
+// hour, day of month, month and day of week. Each field is a comma separated
+// list of "*", "a" or "a-b", optionally followed by a step like "*/15".
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// if either day field is "*", a day matches if both fields match,
+	// otherwise it matches if any of the fields matches.
+	domStar, dowStar bool
+}
+
+// Parse parses a cron expression.
+func Parse(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if s, ok := shortcuts[expr]; ok {
+		expr = s
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("cron expression %q should have 5 fields, got %d", expr, len(fields))
+	}
+	s := &Schedule{
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}
+	var err error
+	for i, f := range []struct {
+		bits   *uint64
+		bounds bounds
+	}{
+		{&s.minute, minuteBounds},
+		{&s.hour, hourBounds},
+		{&s.dom, domBounds},
+		{&s.month, monthBounds},
+		{&s.dow, dowBounds},
+	} {
+		if *f.bits, err = parseField(fields[i], f.bounds); err != nil {
+			return nil, errors.Annotatef(err, "invalid cron expression %q", expr)
+		}
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangeAndStep := strings.SplitN(part, "/", 2)
+		lo, hi := b.min, b.max
+		if rangeAndStep[0] != "*" {
+			loHi := strings.SplitN(rangeAndStep[0], "-", 2)
+			var err error
+			if lo, err = parseNumber(loHi[0], b); err != nil {
+				return 0, err
+			}
+			switch {
+			case len(loHi) == 2:
+				if hi, err = parseNumber(loHi[1], b); err != nil {
+					return 0, err
+				}
+			case len(rangeAndStep) == 1:
+				hi = lo
+			}
+		}
+		step := uint(1)
+		if len(rangeAndStep) == 2 {
+			n, err := strconv.ParseUint(rangeAndStep[1], 10, 8)
+			if err != nil || n == 0 {
+				return 0, errors.Errorf("invalid step %q", rangeAndStep[1])
+			}
+			step = uint(n)
+		}
+		if lo > hi {
+			return 0, errors.Errorf("invalid range %q", part)
+		}
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << i
+		}
+	}
+	return bits, nil
+}
+
+func parseNumber(s string, b bounds) (uint, error) {
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || uint(n) < b.min || uint(n) > b.max {
+		return 0, errors.Errorf("%q is not a number in [%d, %d]", s, b.min, b.max)
+	}
+	return uint(n), nil
+}
+
+// Next returns the first matched time after t in the location of t, it
+// returns the zero time if no time is matched in the next few years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + maxSearchYears
+	for t.Year() <= yearLimit {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, expr := range []string{
+		"* * * * *",
+		"0 2 * * *",
+		"*/15 0-6,22,23 1 1-12/2 1-5",
+		"30 4 * * 7",
+		"@daily",
+	} {
+		_, err := Parse(expr)
+		require.Nil(t, err, expr)
+	}
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@every",
+	} {
+		_, err := Parse(expr)
+		require.NotNil(t, err, expr)
+	}
+}
+
+func TestNext(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.Nil(t, err)
+	// 2021-11-05 is a Friday
+	now := time.Date(2021, 11, 5, 10, 30, 20, 0, loc)
+	testCases := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2021, 11, 5, 10, 31, 0, 0, loc)},
+		{"0 2 * * *", time.Date(2021, 11, 6, 2, 0, 0, 0, loc)},
+		{"45 10 * * *", time.Date(2021, 11, 5, 10, 45, 0, 0, loc)},
+		{"*/20 * * * *", time.Date(2021, 11, 5, 10, 40, 0, 0, loc)},
+		{"0 0 * * 0", time.Date(2021, 11, 7, 0, 0, 0, 0, loc)},
+		{"0 0 * * 7", time.Date(2021, 11, 7, 0, 0, 0, 0, loc)},
+		{"0 0 1 * *", time.Date(2021, 12, 1, 0, 0, 0, 0, loc)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, loc)},
+		// either the day of month or the day of week matches
+		{"0 0 20 * 1", time.Date(2021, 11, 8, 0, 0, 0, 0, loc)},
+		{"@yearly", time.Date(2022, 1, 1, 0, 0, 0, 0, loc)},
+	}
+	for _, tc := range testCases {
+		s, err := Parse(tc.expr)
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, s.Next(now), tc.expr)
+	}
+
+	// never matches
+	s, err := Parse("0 0 30 2 *")
+	require.Nil(t, err)
+	require.True(t, s.Next(now).IsZero())
+}
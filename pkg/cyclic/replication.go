@@ -17,6 +17,9 @@
 // CDC needs to watch DMLs to mark tables and ignore all DDLs to mark tables.
 //
 // Note for now, mark tables must be create manually.
+//
+// Among TiDB clusters, the transactions can be tagged by the TiDB transaction
+// source instead, see TxnSourceSessionVar.
 package cyclic
 
 import (
@@ -84,6 +87,12 @@ func (c *Cyclic) ReplicaID() uint64 {
 	return c.config.ReplicaID
 }
 
+// UseTxnSource returns whether the transactions are tagged by the transaction
+// source instead of the mark tables.
+func (c *Cyclic) UseTxnSource() bool {
+	return c.config.UseTxnSource
+}
+
 // NewCyclic creates a cyclic
 func NewCyclic(config *config.CyclicConfig) *Cyclic {
 	if config == nil || config.ReplicaID == 0 {
@@ -0,0 +1,33 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclic
+
+import "github.com/pingcap/ticdc/pkg/config"
+
+// TxnSourceSessionVar is the session variable of TiDB that tags the
+// transactions of the session with a transaction source. The MySQL sink sets
+// it to the replica ID, and the transactions replicated by the changefeed carry
+// the replica ID in their change logs, so the changefeed in the opposite
+// direction can filter them without the mark tables.
+const TxnSourceSessionVar = "tidb_cdc_write_source"
+
+// cdcWriteSourceMask is the bits of the transaction source set by
+// TxnSourceSessionVar, the other bits are reserved by TiDB.
+const cdcWriteSourceMask = config.MaxTxnSourceReplicaID
+
+// ExtractReplicaIDFromTxnSource returns the replica ID the transaction is
+// tagged with, 0 if the transaction isn't written by a changefeed.
+func ExtractReplicaIDFromTxnSource(txnSource uint64) uint64 {
+	return txnSource & cdcWriteSourceMask
+}
@@ -60,6 +60,9 @@ var (
 	ErrDecodeFailed      = errors.Normalize("decode failed: %s", errors.RFCCodeText("CDC:ErrDecodeFailed"))
 	ErrFilterRuleInvalid = errors.Normalize("filter rule is invalid", errors.RFCCodeText("CDC:ErrFilterRuleInvalid"))
 
+	ErrExpressionFilterEvaluate = errors.Normalize("evaluate the expression of event filter failed", errors.RFCCodeText("CDC:ErrExpressionFilterEvaluate"))
+	ErrExpressionColumnNotFound = errors.Normalize("the expression %s of event filter refers to a column not in table %s", errors.RFCCodeText("CDC:ErrExpressionColumnNotFound"))
+
 	// internal errors
 	ErrAdminStopProcessor = errors.Normalize("stop processor by admin command", errors.RFCCodeText("CDC:ErrAdminStopProcessor"))
 	// ErrVersionIncompatible is an error for running CDC on an incompatible Cluster.
@@ -78,8 +81,11 @@ var (
 	ErrKafkaNewSaramaProducer    = errors.Normalize("new sarama producer", errors.RFCCodeText("CDC:ErrKafkaNewSaramaProducer"))
 	ErrKafkaInvalidClientID      = errors.Normalize("invalid kafka client ID '%s'", errors.RFCCodeText("CDC:ErrKafkaInvalidClientID"))
 	ErrKafkaInvalidVersion       = errors.Normalize("invalid kafka version", errors.RFCCodeText("CDC:ErrKafkaInvalidVersion"))
+	ErrKafkaTransaction          = errors.Normalize("kafka transaction failed", errors.RFCCodeText("CDC:ErrKafkaTransaction"))
 	ErrPulsarNewProducer         = errors.Normalize("new pulsar producer", errors.RFCCodeText("CDC:ErrPulsarNewProducer"))
 	ErrPulsarSendMessage         = errors.Normalize("pulsar send message failed", errors.RFCCodeText("CDC:ErrPulsarSendMessage"))
+	ErrWebhookInvalidConfig      = errors.Normalize("webhook sink config invalid", errors.RFCCodeText("CDC:ErrWebhookInvalidConfig"))
+	ErrWebhookSendMessage        = errors.Normalize("webhook send message failed", errors.RFCCodeText("CDC:ErrWebhookSendMessage"))
 	ErrFileSinkCreateDir         = errors.Normalize("file sink create dir", errors.RFCCodeText("CDC:ErrFileSinkCreateDir"))
 	ErrFileSinkFileOp            = errors.Normalize("file sink file operation", errors.RFCCodeText("CDC:ErrFileSinkFileOp"))
 	ErrRedoConfigInvalid         = errors.Normalize("redo log config invalid", errors.RFCCodeText("CDC:ErrRedoConfigInvalid"))
@@ -88,6 +94,8 @@ var (
 	ErrRedoFileOp                = errors.Normalize("redo file operation", errors.RFCCodeText("CDC:ErrRedoFileOp"))
 	ErrRedoMetaFileNotFound      = errors.Normalize("no redo meta file found in dir: %s", errors.RFCCodeText("CDC:ErrRedoMetaFileNotFound"))
 	ErrRedoMetaInitialize        = errors.Normalize("initialize meta for redo log", errors.RFCCodeText("CDC:ErrRedoMetaInitialize"))
+	ErrRedoEncodeFailed          = errors.Normalize("encode redo log failed", errors.RFCCodeText("CDC:ErrRedoEncodeFailed"))
+	ErrRedoDecodeFailed          = errors.Normalize("decode redo log failed", errors.RFCCodeText("CDC:ErrRedoDecodeFailed"))
 	ErrFileSizeExceed            = errors.Normalize("rawData size %d exceeds maximum file size %d", errors.RFCCodeText("CDC:ErrFileSizeExceed"))
 	ErrFileSinkMetaAlreadyExists = errors.Normalize("file sink meta file already exists", errors.RFCCodeText("CDC:ErrFileSinkMetaAlreadyExists"))
 	ErrS3SinkWriteStorage        = errors.Normalize("write to storage", errors.RFCCodeText("CDC:ErrS3SinkWriteStorage"))
@@ -120,6 +128,17 @@ var (
 	ErrOldValueNotEnabled        = errors.Normalize("old value is not enabled", errors.RFCCodeText("CDC:ErrOldValueNotEnabled"))
 	ErrSinkInvalidConfig         = errors.Normalize("sink config invalid", errors.RFCCodeText("CDC:ErrSinkInvalidConfig"))
 	ErrCraftCodecInvalidData     = errors.Normalize("craft codec invalid data", errors.RFCCodeText("CDC:ErrCraftCodecInvalidData"))
+	ErrColumnSelectorInvalid     = errors.Normalize("column selector is invalid", errors.RFCCodeText("CDC:ErrColumnSelectorInvalid"))
+	ErrDispatcherTopicInvalid    = errors.Normalize("dispatcher topic expression is invalid", errors.RFCCodeText("CDC:ErrDispatcherTopicInvalid"))
+	ErrDispatcherColumnsInvalid  = errors.Normalize("dispatcher columns are invalid", errors.RFCCodeText("CDC:ErrDispatcherColumnsInvalid"))
+	ErrDebeziumEncodeFailed      = errors.Normalize("debezium encode failed", errors.RFCCodeText("CDC:ErrDebeziumEncodeFailed"))
+	ErrDebeziumDecodeFailed      = errors.Normalize("debezium decode failed", errors.RFCCodeText("CDC:ErrDebeziumDecodeFailed"))
+	ErrProtobufCodecInvalidData  = errors.Normalize("protobuf codec invalid data", errors.RFCCodeText("CDC:ErrProtobufCodecInvalidData"))
+	ErrProtobufCodecRowTooLarge  = errors.Normalize("protobuf codec single row too large", errors.RFCCodeText("CDC:ErrProtobufCodecRowTooLarge"))
+	ErrCSVEncodeFailed           = errors.Normalize("csv encode failed", errors.RFCCodeText("CDC:ErrCSVEncodeFailed"))
+	ErrParquetEncodeFailed       = errors.Normalize("parquet encode failed", errors.RFCCodeText("CDC:ErrParquetEncodeFailed"))
+	ErrStorageSinkInitialize     = errors.Normalize("new storage sink", errors.RFCCodeText("CDC:ErrStorageSinkInitialize"))
+	ErrStorageSinkWriteFile      = errors.Normalize("storage sink write file", errors.RFCCodeText("CDC:ErrStorageSinkWriteFile"))
 
 	// utilities related errors
 	ErrToTLSConfigFailed         = errors.Normalize("generate tls config failed", errors.RFCCodeText("CDC:ErrToTLSConfigFailed"))
@@ -148,6 +167,7 @@ var (
 	ErrUnmarshalFailed       = errors.Normalize("unmarshal failed", errors.RFCCodeText("CDC:ErrUnmarshalFailed"))
 	ErrInvalidChangefeedID   = errors.Normalize(`bad changefeed id, please match the pattern "^[a-zA-Z0-9]+(\-[a-zA-Z0-9]+)*$, the length should no more than %d", eg, "simple-changefeed-task"`, errors.RFCCodeText("CDC:ErrInvalidChangefeedID"))
 	ErrInvalidEtcdKey        = errors.Normalize("invalid key: %s", errors.RFCCodeText("CDC:ErrInvalidEtcdKey"))
+	ErrInvalidPauseSchedule  = errors.Normalize("invalid pause schedule: %s", errors.RFCCodeText("CDC:ErrInvalidPauseSchedule"))
 
 	// schema storage errors
 	ErrSchemaStorageUnresolved = errors.Normalize("can not found schema snapshot, the specified ts(%d) is more than resolvedTs(%d)", errors.RFCCodeText("CDC:ErrSchemaStorageUnresolved"))
@@ -272,4 +292,13 @@ var (
 	// p2p error
 	ErrPeerMessageIllegalMeta          = errors.Normalize("peer-to-peer message server received an RPC call with illegal metadata", errors.RFCCodeText("CDC:ErrPeerMessageIllegalMeta"))
 	ErrPeerMessageInternalSenderClosed = errors.Normalize("peer-to-peer message server tries to send to a closed stream. Internal only.", errors.RFCCodeText("CDC:ErrPeerMessageInternalSenderClosed"))
+	ErrPeerMessageReceiverMismatch     = errors.Normalize("peer-to-peer message receiver ID mismatch, expected %s, got %s", errors.RFCCodeText("CDC:ErrPeerMessageReceiverMismatch"))
+	ErrPeerMessageStaleConnection      = errors.Normalize("peer-to-peer message stale connection from %s, epoch %d is not newer than %d", errors.RFCCodeText("CDC:ErrPeerMessageStaleConnection"))
+	ErrPeerMessageTopicCongested       = errors.Normalize("peer-to-peer message topic %s is congested", errors.RFCCodeText("CDC:ErrPeerMessageTopicCongested"))
+	ErrPeerMessageHandlerExists        = errors.Normalize("peer-to-peer message handler of topic %s already exists", errors.RFCCodeText("CDC:ErrPeerMessageHandlerExists"))
+	ErrPeerMessageDecodeError          = errors.Normalize("failed to decode peer-to-peer message of topic %s", errors.RFCCodeText("CDC:ErrPeerMessageDecodeError"))
+	ErrPeerMessageEncodeError          = errors.Normalize("failed to encode peer-to-peer message of topic %s", errors.RFCCodeText("CDC:ErrPeerMessageEncodeError"))
+	ErrPeerMessageSendTryAgain         = errors.Normalize("peer-to-peer message client is busy, try again later", errors.RFCCodeText("CDC:ErrPeerMessageSendTryAgain"))
+	ErrPeerMessageClientClosed         = errors.Normalize("peer-to-peer message client has been closed", errors.RFCCodeText("CDC:ErrPeerMessageClientClosed"))
+	ErrPeerMessageConnectionClosed     = errors.Normalize("peer-to-peer message connection is closed by the server, reason %s: %s", errors.RFCCodeText("CDC:ErrPeerMessageConnectionClosed"))
 )
@@ -0,0 +1,273 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	filterV2 "github.com/pingcap/tidb-tools/pkg/table-filter"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/mock"
+)
+
+// exprFilterRule is a parsed event filter rule.
+type exprFilterRule struct {
+	matcher filterV2.Filter
+	config  *config.EventFilterRule
+}
+
+// tableExprs are the expressions of a rule built with a table info.
+type tableExprs struct {
+	insert    expression.Expression
+	updateOld expression.Expression
+	updateNew expression.Expression
+	delete    expression.Expression
+}
+
+// versionedTableExprs are the expressions of the rules matching a table,
+// which are built with the version of the table info.
+type versionedTableExprs struct {
+	version uint64
+	exprs   []*tableExprs
+}
+
+// exprEvaluatorPoolSize is the max number of the idle evaluators kept in the
+// pool, which is larger than the default number of the mounter workers.
+const exprEvaluatorPoolSize = 64
+
+// exprFilter ignores the row changed events by the SQL expressions of the
+// event filter rules. The rows are evaluated concurrently by the mounter
+// workers, each of them takes an evaluator from the pool, so no lock is
+// needed during the evaluation.
+type exprFilter struct {
+	rules      []*exprFilterRule
+	evaluators chan *exprEvaluator
+}
+
+// exprEvaluator evaluates the expressions of the rules. Neither the session
+// context nor the built expressions are safe to be used concurrently, so an
+// evaluator is used by one goroutine at a time. The expressions are built
+// once for every table, and rebuilt when the table info changes.
+type exprEvaluator struct {
+	rules   []*exprFilterRule
+	sessCtx sessionctx.Context
+	// exprs caches the expressions of the rules matching the tables, the key
+	// is the physical table ID.
+	exprs map[int64]*versionedTableExprs
+}
+
+// verifyExprFilterRules checks the syntax of the expressions in the event
+// filter rules. The columns in the expressions are not checked, because the
+// table info is unknown before the rows arrive.
+func verifyExprFilterRules(cfg *config.ReplicaConfig) error {
+	p := parser.New()
+	for _, rule := range cfg.Filter.EventFilters {
+		if len(rule.Matcher) == 0 {
+			return cerror.ErrFilterRuleInvalid.GenWithStack("the matcher of event filter rule is empty")
+		}
+		if _, err := filterV2.Parse(rule.Matcher); err != nil {
+			return cerror.WrapError(cerror.ErrFilterRuleInvalid, err)
+		}
+		if rule.IgnoreUpdateOldValueExpr != "" && !cfg.EnableOldValue {
+			return cerror.ErrFilterRuleInvalid.GenWithStack(
+				"ignore-update-old-value-expr requires enable-old-value to be true")
+		}
+		for _, expr := range []string{
+			rule.IgnoreInsertValueExpr, rule.IgnoreUpdateNewValueExpr,
+			rule.IgnoreUpdateOldValueExpr, rule.IgnoreDeleteValueExpr,
+		} {
+			if expr == "" {
+				continue
+			}
+			if _, err := p.ParseOneStmt("select "+expr, "", ""); err != nil {
+				return cerror.ErrFilterRuleInvalid.Wrap(err).GenWithStack(
+					"invalid expression %s in event filter rule", expr)
+			}
+		}
+	}
+	return nil
+}
+
+func newExprFilter(cfg *config.ReplicaConfig) (*exprFilter, error) {
+	if err := verifyExprFilterRules(cfg); err != nil {
+		return nil, errors.Trace(err)
+	}
+	f := &exprFilter{
+		evaluators: make(chan *exprEvaluator, exprEvaluatorPoolSize),
+	}
+	for _, rule := range cfg.Filter.EventFilters {
+		matcher, err := filterV2.Parse(rule.Matcher)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err)
+		}
+		if !cfg.CaseSensitive {
+			matcher = filterV2.CaseInsensitive(matcher)
+		}
+		f.rules = append(f.rules, &exprFilterRule{matcher: matcher, config: rule})
+	}
+	return f, nil
+}
+
+// shouldSkipDML returns true if the row matches the expressions of any rule
+// of its table. An update event is regarded as an insert event if the old
+// values are not provided.
+func (f *exprFilter) shouldSkipDML(row *model.RowChangedEvent, tableInfo *model.TableInfo) (bool, error) {
+	e := f.getEvaluator()
+	defer f.putEvaluator(e)
+	return e.shouldSkipDML(row, tableInfo)
+}
+
+// getEvaluator takes an idle evaluator from the pool, or creates a new one if
+// all of them are in use.
+func (f *exprFilter) getEvaluator() *exprEvaluator {
+	select {
+	case e := <-f.evaluators:
+		return e
+	default:
+		return &exprEvaluator{
+			rules:   f.rules,
+			sessCtx: mock.NewContext(),
+			exprs:   make(map[int64]*versionedTableExprs),
+		}
+	}
+}
+
+// putEvaluator puts the evaluator back to the pool, it's dropped if the pool
+// is full.
+func (f *exprFilter) putEvaluator(e *exprEvaluator) {
+	select {
+	case f.evaluators <- e:
+	default:
+	}
+}
+
+func (f *exprEvaluator) shouldSkipDML(row *model.RowChangedEvent, tableInfo *model.TableInfo) (bool, error) {
+	exprsList, err := f.getExprs(row, tableInfo)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	for _, exprs := range exprsList {
+		var skip bool
+		switch {
+		case row.IsDelete():
+			skip, err = f.eval(exprs.delete, row.PreColumns, tableInfo)
+		case len(row.PreColumns) == 0:
+			skip, err = f.eval(exprs.insert, row.Columns, tableInfo)
+		case exprs.updateOld == nil && exprs.updateNew == nil:
+		case exprs.updateOld == nil:
+			skip, err = f.eval(exprs.updateNew, row.Columns, tableInfo)
+		case exprs.updateNew == nil:
+			skip, err = f.eval(exprs.updateOld, row.PreColumns, tableInfo)
+		default:
+			skip, err = f.eval(exprs.updateOld, row.PreColumns, tableInfo)
+			if err == nil && skip {
+				skip, err = f.eval(exprs.updateNew, row.Columns, tableInfo)
+			}
+		}
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		if skip {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getExprs returns the expressions of the rules matching the table of the row.
+// The cached expressions, including an empty list, are rebuilt when the
+// version of the table info changes.
+func (f *exprEvaluator) getExprs(row *model.RowChangedEvent, tableInfo *model.TableInfo) ([]*tableExprs, error) {
+	tableID := row.Table.TableID
+	if cached, ok := f.exprs[tableID]; ok && cached.version == tableInfo.TableInfoVersion {
+		return cached.exprs, nil
+	}
+	var exprsList []*tableExprs
+	for _, rule := range f.rules {
+		if !rule.matcher.MatchTable(row.Table.Schema, row.Table.Table) {
+			continue
+		}
+		exprs := &tableExprs{}
+		for _, item := range []struct {
+			expr   string
+			target *expression.Expression
+		}{
+			{rule.config.IgnoreInsertValueExpr, &exprs.insert},
+			{rule.config.IgnoreUpdateOldValueExpr, &exprs.updateOld},
+			{rule.config.IgnoreUpdateNewValueExpr, &exprs.updateNew},
+			{rule.config.IgnoreDeleteValueExpr, &exprs.delete},
+		} {
+			if item.expr == "" {
+				continue
+			}
+			expr, err := f.buildExpr(item.expr, tableInfo.TableInfo)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			*item.target = expr
+		}
+		exprsList = append(exprsList, exprs)
+	}
+	f.exprs[tableID] = &versionedTableExprs{version: tableInfo.TableInfoVersion, exprs: exprsList}
+	return exprsList, nil
+}
+
+// buildExpr builds the expression with the table info, the columns in the
+// expression are resolved against the columns of the table.
+func (f *exprEvaluator) buildExpr(expr string, tableInfo *timodel.TableInfo) (expression.Expression, error) {
+	e, err := expression.ParseSimpleExprWithTableInfo(f.sessCtx, expr, tableInfo)
+	if err != nil {
+		if core.ErrUnknownColumn.Equal(err) {
+			return nil, cerror.ErrExpressionColumnNotFound.Wrap(err).GenWithStackByArgs(expr, tableInfo.Name.O)
+		}
+		return nil, cerror.WrapError(cerror.ErrExpressionFilterEvaluate, err)
+	}
+	return e, nil
+}
+
+// eval evaluates the expression against the columns, the values of the
+// columns are cast to the types of the table columns. The columns of the row
+// are in the order of model.TableInfo.RowColumnsOffset.
+func (f *exprEvaluator) eval(expr expression.Expression, cols []*model.Column, tableInfo *model.TableInfo) (bool, error) {
+	if expr == nil {
+		return false, nil
+	}
+	columns := tableInfo.Cols()
+	datums := make([]types.Datum, 0, len(columns))
+	for _, colInfo := range columns {
+		var value interface{}
+		if offset, ok := tableInfo.RowColumnsOffset[colInfo.ID]; ok && offset < len(cols) && cols[offset] != nil {
+			value = cols[offset].Value
+		}
+		d, err := table.CastValue(f.sessCtx, types.NewDatum(value), colInfo, false, false)
+		if err != nil {
+			return false, cerror.WrapError(cerror.ErrExpressionFilterEvaluate, err)
+		}
+		datums = append(datums, d)
+	}
+	skip, _, err := expression.EvalBool(f.sessCtx, []expression.Expression{expr}, chunk.MutRowFromDatums(datums).ToRow())
+	if err != nil {
+		return false, cerror.WrapError(cerror.ErrExpressionFilterEvaluate, err)
+	}
+	return skip, nil
+}
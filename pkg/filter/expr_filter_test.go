@@ -0,0 +1,243 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"sync"
+	"testing"
+
+	cdcmodel "github.com/pingcap/ticdc/cdc/model"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTableInfo(t *testing.T, sql string, version uint64) *cdcmodel.TableInfo {
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	require.Nil(t, err)
+	info, err := ddl.MockTableInfo(mock.NewContext(), stmt.(*ast.CreateTableStmt), 1)
+	require.Nil(t, err)
+	return cdcmodel.WrapTableInfo(1, "test", version, info)
+}
+
+func newTestOrderColumns(id int64, status string, amount string) []*cdcmodel.Column {
+	return []*cdcmodel.Column{
+		{Name: "id", Type: mysql.TypeLong, Value: id},
+		{Name: "status", Type: mysql.TypeVarchar, Value: []byte(status)},
+		{Name: "amount", Type: mysql.TypeNewDecimal, Value: amount},
+		{Name: "created", Type: mysql.TypeDatetime, Value: "2021-11-01 10:00:00"},
+	}
+}
+
+func TestShouldIgnoreDMLEventByExpr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Filter.EventFilters = []*config.EventFilterRule{{
+		Matcher:               []string{"test.orders"},
+		IgnoreInsertValueExpr: "status = 'draft'",
+		IgnoreDeleteValueExpr: "amount < 10 and created < '2021-12-01'",
+	}, {
+		Matcher:                  []string{"test.*"},
+		IgnoreUpdateOldValueExpr: "status = 'paid'",
+		IgnoreUpdateNewValueExpr: "status = 'shipped'",
+	}}
+	f, err := NewFilter(cfg)
+	require.Nil(t, err)
+
+	tableInfo := newTestTableInfo(t,
+		"create table orders(id int primary key, status varchar(10), amount decimal(10, 2), created datetime)", 1)
+	table := &cdcmodel.TableName{Schema: "test", Table: "orders", TableID: 1}
+
+	testCases := []struct {
+		row      *cdcmodel.RowChangedEvent
+		info     *cdcmodel.TableInfo
+		expected bool
+	}{{
+		row:      &cdcmodel.RowChangedEvent{Table: table, Columns: newTestOrderColumns(1, "draft", "1.00")},
+		info:     tableInfo,
+		expected: true,
+	}, {
+		row:      &cdcmodel.RowChangedEvent{Table: table, Columns: newTestOrderColumns(1, "paid", "1.00")},
+		info:     tableInfo,
+		expected: false,
+	}, {
+		row:      &cdcmodel.RowChangedEvent{Table: table, PreColumns: newTestOrderColumns(1, "paid", "9.99")},
+		info:     tableInfo,
+		expected: true,
+	}, {
+		row:      &cdcmodel.RowChangedEvent{Table: table, PreColumns: newTestOrderColumns(1, "paid", "10.00")},
+		info:     tableInfo,
+		expected: false,
+	}, {
+		row: &cdcmodel.RowChangedEvent{
+			Table:      table,
+			PreColumns: newTestOrderColumns(1, "paid", "1.00"),
+			Columns:    newTestOrderColumns(1, "shipped", "1.00"),
+		},
+		info:     tableInfo,
+		expected: true,
+	}, {
+		// both the old value and the new value expressions must be true.
+		row: &cdcmodel.RowChangedEvent{
+			Table:      table,
+			PreColumns: newTestOrderColumns(1, "draft", "1.00"),
+			Columns:    newTestOrderColumns(1, "shipped", "1.00"),
+		},
+		info:     tableInfo,
+		expected: false,
+	}, {
+		row: &cdcmodel.RowChangedEvent{
+			Table:   &cdcmodel.TableName{Schema: "other", Table: "orders", TableID: 3},
+			Columns: newTestOrderColumns(1, "draft", "1.00"),
+		},
+		info:     tableInfo,
+		expected: false,
+	}}
+	for i, tc := range testCases {
+		ignore, err := f.ShouldIgnoreDMLEventByExpr(tc.row, tc.info)
+		require.Nil(t, err, i)
+		require.Equal(t, tc.expected, ignore, i)
+	}
+
+	// the expressions are rebuilt after the table info changes, and an
+	// expression referring to an unknown column fails.
+	newTableInfo := newTestTableInfo(t,
+		"create table orders(id int primary key, state varchar(10), amount decimal(10, 2), created datetime)", 2)
+	row := &cdcmodel.RowChangedEvent{Table: table, Columns: []*cdcmodel.Column{
+		{Name: "id", Type: mysql.TypeLong, Value: int64(1)},
+		{Name: "state", Type: mysql.TypeVarchar, Value: []byte("draft")},
+	}}
+	_, err = f.ShouldIgnoreDMLEventByExpr(row, newTableInfo)
+	require.Regexp(t, ".*ErrExpressionColumnNotFound.*status = 'draft'.*", err)
+
+	// the table matches no rule before it's renamed, the empty expressions
+	// are rebuilt after the rename.
+	tableInfo = newTestTableInfo(t,
+		"create table orders(id int primary key, status varchar(10), amount decimal(10, 2), created datetime)", 3)
+	renamed := &cdcmodel.RowChangedEvent{
+		Table:   &cdcmodel.TableName{Schema: "other", Table: "orders", TableID: 4},
+		Columns: newTestOrderColumns(1, "draft", "1.00"),
+	}
+	e := f.exprFilter.getEvaluator()
+	ignore, err := e.shouldSkipDML(renamed, tableInfo)
+	require.Nil(t, err)
+	require.False(t, ignore)
+	tableInfo = newTestTableInfo(t,
+		"create table orders(id int primary key, status varchar(10), amount decimal(10, 2), created datetime)", 4)
+	renamed.Table = &cdcmodel.TableName{Schema: "test", Table: "orders", TableID: 4}
+	ignore, err = e.shouldSkipDML(renamed, tableInfo)
+	require.Nil(t, err)
+	require.True(t, ignore)
+}
+
+func TestShouldIgnoreDMLEventByExprColumnOrder(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Filter.EventFilters = []*config.EventFilterRule{{
+		Matcher:               []string{"test.orders"},
+		IgnoreInsertValueExpr: "STATUS = 'draft'",
+	}}
+	f, err := NewFilter(cfg)
+	require.Nil(t, err)
+	tableInfo := newTestTableInfo(t,
+		"create table orders(id int primary key, status varchar(10), amount decimal(10, 2), created datetime)", 1)
+	table := &cdcmodel.TableName{Schema: "test", Table: "orders", TableID: 1}
+
+	// the columns are resolved by the table info, the missing columns are NULL.
+	cols := newTestOrderColumns(1, "draft", "1.00")
+	cols[2] = nil
+	ignore, err := f.ShouldIgnoreDMLEventByExpr(&cdcmodel.RowChangedEvent{Table: table, Columns: cols}, tableInfo)
+	require.Nil(t, err)
+	require.True(t, ignore)
+	ignore, err = f.ShouldIgnoreDMLEventByExpr(&cdcmodel.RowChangedEvent{Table: table, Columns: cols[:1]}, tableInfo)
+	require.Nil(t, err)
+	require.False(t, ignore)
+}
+
+func TestShouldIgnoreDMLEventByExprConcurrently(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Filter.EventFilters = []*config.EventFilterRule{{
+		Matcher:               []string{"test.orders"},
+		IgnoreInsertValueExpr: "status = 'draft' and amount < 10",
+	}}
+	f, err := NewFilter(cfg)
+	require.Nil(t, err)
+	tableInfo := newTestTableInfo(t,
+		"create table orders(id int primary key, status varchar(10), amount decimal(10, 2), created datetime)", 1)
+	table := &cdcmodel.TableName{Schema: "test", Table: "orders", TableID: 1}
+
+	// the rows are evaluated by the evaluators in the pool without a lock
+	var wg sync.WaitGroup
+	for i := 0; i < 2*exprEvaluatorPoolSize; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			status := "draft"
+			if i%2 == 0 {
+				status = "paid"
+			}
+			row := &cdcmodel.RowChangedEvent{Table: table, Columns: newTestOrderColumns(int64(i), status, "1.00")}
+			for j := 0; j < 10; j++ {
+				ignore, err := f.ShouldIgnoreDMLEventByExpr(row, tableInfo)
+				require.Nil(t, err)
+				require.Equal(t, i%2 == 1, ignore)
+			}
+		}(i)
+	}
+	wg.Wait()
+	require.LessOrEqual(t, len(f.exprFilter.evaluators), exprEvaluatorPoolSize)
+}
+
+func TestVerifyExprFilterRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		rule           *config.EventFilterRule
+		enableOldValue bool
+		err            string
+	}{{
+		rule: &config.EventFilterRule{Matcher: []string{"test.*"}, IgnoreInsertValueExpr: "a = 1"},
+	}, {
+		rule: &config.EventFilterRule{IgnoreInsertValueExpr: "a = 1"},
+		err:  ".*matcher of event filter rule is empty.*",
+	}, {
+		rule: &config.EventFilterRule{Matcher: []string{"test.*"}, IgnoreInsertValueExpr: "a = "},
+		err:  ".*invalid expression a =  in event filter rule.*",
+	}, {
+		rule: &config.EventFilterRule{Matcher: []string{"test.*"}, IgnoreUpdateOldValueExpr: "a = 1"},
+		err:  ".*requires enable-old-value.*",
+	}, {
+		rule:           &config.EventFilterRule{Matcher: []string{"test.*"}, IgnoreUpdateOldValueExpr: "a = 1"},
+		enableOldValue: true,
+	}}
+	for _, tc := range testCases {
+		cfg := config.GetDefaultReplicaConfig()
+		cfg.EnableOldValue = tc.enableOldValue
+		cfg.Filter.EventFilters = []*config.EventFilterRule{tc.rule}
+		_, err := NewFilter(cfg)
+		if tc.err == "" {
+			require.Nil(t, err)
+		} else {
+			require.Regexp(t, tc.err, err)
+		}
+	}
+}
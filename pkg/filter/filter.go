@@ -14,6 +14,8 @@
 package filter
 
 import (
+	"github.com/pingcap/errors"
+	cdcmodel "github.com/pingcap/ticdc/cdc/model"
 	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/cyclic/mark"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
@@ -28,6 +30,8 @@ type Filter struct {
 	ignoreTxnStartTs []uint64
 	ddlAllowlist     []model.ActionType
 	isCyclicEnabled  bool
+	// exprFilter is nil if there are no event filter rules.
+	exprFilter *exprFilter
 }
 
 // VerifyRules checks the filter rules in the configuration
@@ -61,11 +65,19 @@ func NewFilter(cfg *config.ReplicaConfig) (*Filter, error) {
 	if !cfg.CaseSensitive {
 		f = filterV2.CaseInsensitive(f)
 	}
+	var ef *exprFilter
+	if len(cfg.Filter.EventFilters) != 0 {
+		ef, err = newExprFilter(cfg)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 	return &Filter{
 		filter:           f,
 		ignoreTxnStartTs: cfg.Filter.IgnoreTxnStartTs,
 		ddlAllowlist:     cfg.Filter.DDLAllowlist,
 		isCyclicEnabled:  cfg.Cyclic.IsEnabled(),
+		exprFilter:       ef,
 	}, nil
 }
 
@@ -97,6 +109,16 @@ func (f *Filter) ShouldIgnoreDMLEvent(ts uint64, schema, table string) bool {
 	return f.shouldIgnoreStartTs(ts) || f.ShouldIgnoreTable(schema, table)
 }
 
+// ShouldIgnoreDMLEventByExpr returns true if the row matches the SQL
+// expressions of the event filter rules. The table info must be the one used
+// to mount the row.
+func (f *Filter) ShouldIgnoreDMLEventByExpr(row *cdcmodel.RowChangedEvent, tableInfo *cdcmodel.TableInfo) (bool, error) {
+	if f.exprFilter == nil {
+		return false, nil
+	}
+	return f.exprFilter.shouldSkipDML(row, tableInfo)
+}
+
 // ShouldIgnoreDDLEvent removes DDLs that's not wanted by this change feed.
 // CDC only supports filtering by database/table now.
 func (f *Filter) ShouldIgnoreDDLEvent(ts uint64, ddlType model.ActionType, schema, table string) bool {
@@ -0,0 +1,322 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/security"
+	"github.com/pingcap/ticdc/proto/p2p"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+)
+
+// MessageClientConfig is used to configure MessageClient.
+type MessageClientConfig struct {
+	// SendChannelSize is the number of messages which can be buffered before
+	// SendMessage blocks.
+	SendChannelSize int
+	// MaxBatchCount is the maximum number of messages sent in a packet.
+	MaxBatchCount int
+	// RetryInterval is the interval between two connection attempts.
+	RetryInterval time.Duration
+	// ClientVersion is the version of the client, it is sent to the server.
+	ClientVersion string
+	// AdvertisedAddr is the address of the client, it is sent to the server.
+	AdvertisedAddr string
+}
+
+// topicEntry is the state of a topic in MessageClient.
+type topicEntry struct {
+	// nextSeq is protected by MessageClient.sendMu.
+	nextSeq Seq
+
+	// ack and unacked are protected by MessageClient.mu.
+	ack Seq
+	// unacked are the messages sent but not acked yet, in the order of their
+	// sequence numbers. They are resent after a reconnection.
+	unacked []*p2p.MessageEntry
+}
+
+// MessageClient sends messages to a MessageServer through a gRPC stream. The
+// messages of a topic are numbered by sequence numbers, and kept until the
+// server acks them, so they are resent after the stream is reconnected.
+type MessageClient struct {
+	senderID NodeID
+	config   *MessageClientConfig
+
+	// sendMu serializes the senders, so the sequence numbers of a topic are
+	// in the order of the messages in sendCh.
+	sendMu sync.Mutex
+	sendCh chan *p2p.MessageEntry
+
+	mu     sync.Mutex
+	topics map[Topic]*topicEntry
+
+	epoch    atomic.Int64
+	isClosed atomic.Bool
+	closeCh  chan struct{}
+
+	// dialer is used to connect the server if it is not nil, it is used by
+	// tests to connect in-process servers.
+	dialer func(context.Context, string) (net.Conn, error)
+}
+
+// NewMessageClient creates a new MessageClient.
+func NewMessageClient(senderID NodeID, config *MessageClientConfig) *MessageClient {
+	return &MessageClient{
+		senderID: senderID,
+		config:   config,
+		sendCh:   make(chan *p2p.MessageEntry, config.SendChannelSize),
+		topics:   make(map[Topic]*topicEntry),
+		closeCh:  make(chan struct{}),
+	}
+}
+
+// SendMessage sends a message of the topic, it blocks if the messages buffered
+// reach SendChannelSize. It returns the sequence number of the message.
+func (c *MessageClient) SendMessage(ctx context.Context, topic Topic, value interface{}) (Seq, error) {
+	return c.sendMessage(ctx, topic, value, false)
+}
+
+// TrySendMessage tries to send a message of the topic without blocking, it
+// returns ErrPeerMessageSendTryAgain if the messages buffered reach
+// SendChannelSize.
+func (c *MessageClient) TrySendMessage(ctx context.Context, topic Topic, value interface{}) (Seq, error) {
+	return c.sendMessage(ctx, topic, value, true)
+}
+
+func (c *MessageClient) sendMessage(ctx context.Context, topic Topic, value interface{}, nonblocking bool) (Seq, error) {
+	content, err := marshalMessage(value)
+	if err != nil {
+		return 0, cerror.ErrPeerMessageEncodeError.Wrap(err).GenWithStackByArgs(topic)
+	}
+
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+
+	if c.isClosed.Load() {
+		return 0, cerror.ErrPeerMessageClientClosed.GenWithStackByArgs()
+	}
+	c.mu.Lock()
+	tp, ok := c.topics[topic]
+	if !ok {
+		tp = &topicEntry{}
+		c.topics[topic] = tp
+	}
+	c.mu.Unlock()
+
+	entry := &p2p.MessageEntry{
+		Topic:    topic,
+		Content:  content,
+		Sequence: tp.nextSeq + 1,
+	}
+	if nonblocking {
+		select {
+		case c.sendCh <- entry:
+		default:
+			return 0, cerror.ErrPeerMessageSendTryAgain.GenWithStackByArgs()
+		}
+	} else {
+		select {
+		case <-ctx.Done():
+			return 0, errors.Trace(ctx.Err())
+		case <-c.closeCh:
+			return 0, cerror.ErrPeerMessageClientClosed.GenWithStackByArgs()
+		case c.sendCh <- entry:
+		}
+	}
+	// The sequence number is consumed only if the message is sent, so there
+	// is no gap in the sequence numbers.
+	tp.nextSeq = entry.Sequence
+	return entry.Sequence, nil
+}
+
+// CurrentAck returns the sequence number of the last message of the topic
+// acked by the server.
+func (c *MessageClient) CurrentAck(topic Topic) (Seq, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tp, ok := c.topics[topic]
+	if !ok {
+		return 0, false
+	}
+	return tp.ack, true
+}
+
+// Run connects the server and sends the messages until the context is done,
+// the stream is reconnected if it fails. The client can't be used after Run
+// returns.
+func (c *MessageClient) Run(
+	ctx context.Context, addr string, receiverID NodeID, credential *security.Credential,
+) error {
+	defer func() {
+		// The blocked senders hold sendMu, so it is not taken here.
+		if !c.isClosed.Swap(true) {
+			close(c.closeCh)
+		}
+	}()
+
+	for {
+		err := c.runOnce(ctx, addr, receiverID, credential)
+		if cerror.ErrPeerMessageReceiverMismatch.Equal(err) {
+			return errors.Trace(err)
+		}
+		if ctx.Err() != nil {
+			return errors.Trace(ctx.Err())
+		}
+		log.Warn("peer message stream failed, retrying",
+			zap.String("addr", addr), zap.String("receiver", receiverID), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-time.After(c.config.RetryInterval):
+		}
+	}
+}
+
+func (c *MessageClient) runOnce(
+	ctx context.Context, addr string, receiverID NodeID, credential *security.Credential,
+) error {
+	dialOption, err := credential.ToGRPCDialOption()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	dialOptions := []grpc.DialOption{dialOption}
+	if c.dialer != nil {
+		dialOptions = append(dialOptions, grpc.WithContextDialer(c.dialer))
+	}
+	conn, err := grpc.DialContext(ctx, addr, dialOptions...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer conn.Close()
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	stream, err := p2p.NewCDCPeerToPeerClient(conn).SendMessage(egCtx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	epoch := c.epoch.Inc()
+	err = stream.Send(&p2p.MessagePacket{
+		Meta: &p2p.StreamMeta{
+			SenderId:             c.senderID,
+			ReceiverId:           receiverID,
+			Epoch:                epoch,
+			ClientVersion:        c.config.ClientVersion,
+			SenderAdvertisedAddr: c.config.AdvertisedAddr,
+		},
+		Entries: c.unackedEntries(),
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	log.Info("peer message stream connected",
+		zap.String("addr", addr), zap.String("receiver", receiverID), zap.Int64("epoch", epoch))
+
+	eg.Go(func() error {
+		return c.runSender(egCtx, stream)
+	})
+	eg.Go(func() error {
+		return c.runReceiver(stream)
+	})
+	return eg.Wait()
+}
+
+func (c *MessageClient) unackedEntries() []*p2p.MessageEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var entries []*p2p.MessageEntry
+	for _, tp := range c.topics {
+		entries = append(entries, tp.unacked...)
+	}
+	return entries
+}
+
+func (c *MessageClient) runSender(ctx context.Context, stream p2p.CDCPeerToPeer_SendMessageClient) error {
+	for {
+		var entry *p2p.MessageEntry
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case entry = <-c.sendCh:
+		}
+
+		entries := []*p2p.MessageEntry{entry}
+	batch:
+		for len(entries) < c.config.MaxBatchCount {
+			select {
+			case entry = <-c.sendCh:
+				entries = append(entries, entry)
+			default:
+				break batch
+			}
+		}
+
+		// The messages are recorded before being sent, so they are resent if
+		// the stream fails.
+		c.mu.Lock()
+		for _, entry := range entries {
+			tp := c.topics[entry.Topic]
+			tp.unacked = append(tp.unacked, entry)
+		}
+		c.mu.Unlock()
+
+		if err := stream.Send(&p2p.MessagePacket{Entries: entries}); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+func (c *MessageClient) runReceiver(stream p2p.CDCPeerToPeer_SendMessageClient) error {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		switch resp.ExitReason {
+		case p2p.ExitReason_UNKNOWN, p2p.ExitReason_OK:
+		case p2p.ExitReason_CAPTURE_ID_MISMATCH:
+			// The server has a different ID, retrying doesn't help.
+			return cerror.ErrPeerMessageReceiverMismatch.GenWithStack("%s", resp.ErrorMessage)
+		default:
+			return cerror.ErrPeerMessageConnectionClosed.GenWithStackByArgs(resp.ExitReason, resp.ErrorMessage)
+		}
+
+		c.mu.Lock()
+		for _, ack := range resp.Ack {
+			tp, ok := c.topics[ack.Topic]
+			if !ok || ack.LastSeq <= tp.ack {
+				continue
+			}
+			tp.ack = ack.LastSeq
+			i := 0
+			for i < len(tp.unacked) && tp.unacked[i].Sequence <= ack.LastSeq {
+				i++
+			}
+			tp.unacked = tp.unacked[i:]
+		}
+		c.mu.Unlock()
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/security"
+	"github.com/pingcap/ticdc/proto/p2p"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testMessage struct {
+	Value int `json:"value"`
+}
+
+func newTestServerConfig() *MessageServerConfig {
+	return &MessageServerConfig{
+		MaxPendingMessageCountPerTopic: 1024,
+		SendChannelSize:                16,
+		AckInterval:                    10 * time.Millisecond,
+	}
+}
+
+func newTestClientConfig() *MessageClientConfig {
+	return &MessageClientConfig{
+		SendChannelSize: 16,
+		MaxBatchCount:   4,
+		RetryInterval:   10 * time.Millisecond,
+		ClientVersion:   "test",
+	}
+}
+
+// testServer runs a MessageServer on an in-process listener, the gRPC server
+// can be restarted with the same MessageServer.
+type testServer struct {
+	server *MessageServer
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu         sync.Mutex
+	lis        *bufconn.Listener
+	grpcServer *grpc.Server
+}
+
+func newTestServer(t *testing.T, serverID NodeID) *testServer {
+	s := &testServer{server: NewMessageServer(serverID, newTestServerConfig())}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		_ = s.server.Run(ctx)
+	}()
+	s.start()
+	return s
+}
+
+func (s *testServer) start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lis = bufconn.Listen(1024 * 1024)
+	s.grpcServer = grpc.NewServer()
+	p2p.RegisterCDCPeerToPeerServer(s.grpcServer, s.server)
+	lis, grpcServer := s.lis, s.grpcServer
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		_ = grpcServer.Serve(lis)
+	}()
+}
+
+func (s *testServer) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.grpcServer.Stop()
+}
+
+func (s *testServer) close() {
+	s.stop()
+	s.cancel()
+	s.wg.Wait()
+}
+
+func (s *testServer) dial(ctx context.Context, _ string) (net.Conn, error) {
+	s.mu.Lock()
+	lis := s.lis
+	s.mu.Unlock()
+	return lis.Dial()
+}
+
+// collectMessages registers a handler which records the values of the
+// messages of the topic.
+func collectMessages(t *testing.T, server *MessageServer, topic Topic) func() []int {
+	var mu sync.Mutex
+	var values []int
+	_, err := server.SyncAddHandler(context.Background(), topic, &testMessage{},
+		func(senderID NodeID, value interface{}) error {
+			require.Equal(t, "client-1", senderID)
+			mu.Lock()
+			defer mu.Unlock()
+			values = append(values, value.(*testMessage).Value)
+			return nil
+		})
+	require.Nil(t, err)
+	return func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]int(nil), values...)
+	}
+}
+
+func runTestClient(t *testing.T, client *MessageClient, receiverID NodeID) (cancel func(), errCh <-chan error) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	ch := make(chan error, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ch <- client.Run(ctx, "bufnet", receiverID, &security.Credential{})
+	}()
+	return func() {
+		cancelFn()
+		<-done
+	}, ch
+}
+
+func waitAck(t *testing.T, client *MessageClient, topic Topic, seq Seq) {
+	require.Eventually(t, func() bool {
+		ack, ok := client.CurrentAck(topic)
+		return ok && ack == seq
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestMessageClientBasic(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, "server-1")
+	defer server.close()
+	// The messages sent before the handler is registered are buffered.
+	client := NewMessageClient("client-1", newTestClientConfig())
+	client.dialer = server.dial
+	cancel, _ := runTestClient(t, client, "server-1")
+	defer cancel()
+
+	ctx := context.Background()
+	for i := 1; i <= 10; i++ {
+		seq, err := client.SendMessage(ctx, "topic-1", &testMessage{Value: i})
+		require.Nil(t, err)
+		require.Equal(t, Seq(i), seq)
+	}
+	require.Eventually(t, func() bool {
+		server.server.mu.Lock()
+		defer server.server.mu.Unlock()
+		return len(server.server.pending["topic-1"]) == 10
+	}, 5*time.Second, 10*time.Millisecond)
+
+	values := collectMessages(t, server.server, "topic-1")
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, values())
+	for i := 11; i <= 100; i++ {
+		_, err := client.SendMessage(ctx, "topic-1", &testMessage{Value: i})
+		require.Nil(t, err)
+	}
+	waitAck(t, client, "topic-1", 100)
+	expected := make([]int, 0, 100)
+	for i := 1; i <= 100; i++ {
+		expected = append(expected, i)
+	}
+	require.Equal(t, expected, values())
+
+	_, err := server.server.SyncAddHandler(ctx, "topic-1", &testMessage{}, nil)
+	require.Regexp(t, ".*ErrPeerMessageHandlerExists.*", err)
+	require.Nil(t, server.server.SyncRemoveHandler(ctx, "topic-1"))
+}
+
+func TestMessageClientResend(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, "server-1")
+	defer server.close()
+	values := collectMessages(t, server.server, "topic-1")
+	client := NewMessageClient("client-1", newTestClientConfig())
+	client.dialer = server.dial
+	cancel, _ := runTestClient(t, client, "server-1")
+	defer cancel()
+
+	ctx := context.Background()
+	for i := 1; i <= 10; i++ {
+		_, err := client.SendMessage(ctx, "topic-1", &testMessage{Value: i})
+		require.Nil(t, err)
+	}
+	waitAck(t, client, "topic-1", 10)
+
+	// The messages sent while the server is down are resent after the
+	// client reconnects, without duplicates.
+	server.stop()
+	for i := 11; i <= 20; i++ {
+		_, err := client.SendMessage(ctx, "topic-1", &testMessage{Value: i})
+		require.Nil(t, err)
+	}
+	server.start()
+	waitAck(t, client, "topic-1", 20)
+	expected := make([]int, 0, 20)
+	for i := 1; i <= 20; i++ {
+		expected = append(expected, i)
+	}
+	require.Equal(t, expected, values())
+}
+
+func TestMessageClientReceiverMismatch(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, "server-1")
+	defer server.close()
+	client := NewMessageClient("client-1", newTestClientConfig())
+	client.dialer = server.dial
+	cancel, errCh := runTestClient(t, client, "server-2")
+	defer cancel()
+
+	select {
+	case err := <-errCh:
+		require.Regexp(t, ".*ErrPeerMessageReceiverMismatch.*", err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "client is expected to exit")
+	}
+	_, err := client.SendMessage(context.Background(), "topic-1", &testMessage{})
+	require.Regexp(t, ".*ErrPeerMessageClientClosed.*", err)
+}
+
+func TestMessageClientTrySend(t *testing.T) {
+	t.Parallel()
+
+	config := newTestClientConfig()
+	config.SendChannelSize = 2
+	// The client is not running, so the messages are never consumed.
+	client := NewMessageClient("client-1", config)
+	ctx := context.Background()
+	for i := 1; i <= 2; i++ {
+		seq, err := client.TrySendMessage(ctx, "topic-1", &testMessage{Value: i})
+		require.Nil(t, err)
+		require.Equal(t, Seq(i), seq)
+	}
+	_, err := client.TrySendMessage(ctx, "topic-1", &testMessage{Value: 3})
+	require.Regexp(t, ".*ErrPeerMessageSendTryAgain.*", err)
+
+	// SendMessage blocks until the context is done.
+	cctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = client.SendMessage(cctx, "topic-1", &testMessage{Value: 3})
+	require.Regexp(t, ".*context deadline exceeded.*", err)
+
+	// The sequence number is not consumed by the failed messages.
+	<-client.sendCh
+	seq, err := client.TrySendMessage(ctx, "topic-1", &testMessage{Value: 3})
+	require.Nil(t, err)
+	require.Equal(t, Seq(3), seq)
+}
+
+func TestMessageServerCongested(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, "server-1")
+	defer server.close()
+	server.server.config.MaxPendingMessageCountPerTopic = 5
+	client := NewMessageClient("client-1", newTestClientConfig())
+	client.dialer = server.dial
+	cancel, _ := runTestClient(t, client, "server-1")
+	defer cancel()
+
+	ctx := context.Background()
+	for i := 1; i <= 8; i++ {
+		_, err := client.SendMessage(ctx, "topic-1", &testMessage{Value: i})
+		require.Nil(t, err)
+	}
+	// The pending messages are dropped when the topic is congested, and
+	// resent after the handler is registered.
+	time.Sleep(100 * time.Millisecond)
+	values := collectMessages(t, server.server, "topic-1")
+	waitAck(t, client, "topic-1", 8)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, values())
+}
@@ -0,0 +1,166 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/security"
+	"go.uber.org/zap"
+)
+
+// MessageRouter manages the MessageClients to the peers in the cluster.
+type MessageRouter interface {
+	// AddPeer adds or updates the address of a peer.
+	AddPeer(id NodeID, addr string)
+	// RemovePeer removes a peer, and closes the client to it.
+	RemovePeer(id NodeID)
+	// GetClient returns the client to the peer, it returns nil if the peer
+	// is unknown. The client is created and connected lazily.
+	GetClient(target NodeID) *MessageClient
+	// Err returns a channel of the errors which stop the clients.
+	Err() <-chan error
+	// Close closes all the clients.
+	Close()
+	// Wait waits for all the clients to exit.
+	Wait()
+}
+
+type routerClient struct {
+	client *MessageClient
+	cancel context.CancelFunc
+}
+
+type messageRouterImpl struct {
+	selfID     NodeID
+	credential *security.Credential
+	config     *MessageClientConfig
+
+	mu        sync.Mutex
+	isClosed  bool
+	addresses map[NodeID]string
+	clients   map[NodeID]*routerClient
+
+	wg    sync.WaitGroup
+	errCh chan error
+
+	// dialer is passed to the clients, see MessageClient.dialer.
+	dialer func(context.Context, string) (net.Conn, error)
+}
+
+// NewMessageRouter creates a new MessageRouter.
+func NewMessageRouter(selfID NodeID, credential *security.Credential, config *MessageClientConfig) MessageRouter {
+	return &messageRouterImpl{
+		selfID:     selfID,
+		credential: credential,
+		config:     config,
+		addresses:  make(map[NodeID]string),
+		clients:    make(map[NodeID]*routerClient),
+		errCh:      make(chan error, 1),
+	}
+}
+
+func (m *messageRouterImpl) AddPeer(id NodeID, addr string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if oldAddr, ok := m.addresses[id]; ok && oldAddr != addr {
+		// The client connects the old address, it is recreated lazily.
+		m.removeClient(id)
+	}
+	m.addresses[id] = addr
+}
+
+func (m *messageRouterImpl) RemovePeer(id NodeID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.addresses, id)
+	m.removeClient(id)
+}
+
+func (m *messageRouterImpl) removeClient(id NodeID) {
+	if c, ok := m.clients[id]; ok {
+		c.cancel()
+		delete(m.clients, id)
+	}
+}
+
+func (m *messageRouterImpl) GetClient(target NodeID) *MessageClient {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.isClosed {
+		return nil
+	}
+	if c, ok := m.clients[target]; ok {
+		return c.client
+	}
+	addr, ok := m.addresses[target]
+	if !ok {
+		log.Warn("peer not found in message router", zap.String("target", target))
+		return nil
+	}
+
+	client := NewMessageClient(m.selfID, m.config)
+	client.dialer = m.dialer
+	ctx, cancel := context.WithCancel(context.Background())
+	m.clients[target] = &routerClient{client: client, cancel: cancel}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		err := client.Run(ctx, addr, target, m.credential)
+		if errors.Cause(err) == context.Canceled {
+			return
+		}
+		log.Warn("peer message client exited",
+			zap.String("target", target), zap.String("addr", addr), zap.Error(err))
+		// The client can't be used anymore, a new one is created by the
+		// next GetClient.
+		m.mu.Lock()
+		if c, ok := m.clients[target]; ok && c.client == client {
+			c.cancel()
+			delete(m.clients, target)
+		}
+		m.mu.Unlock()
+		select {
+		case m.errCh <- err:
+		default:
+		}
+	}()
+	return client
+}
+
+func (m *messageRouterImpl) Err() <-chan error {
+	return m.errCh
+}
+
+func (m *messageRouterImpl) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.isClosed = true
+	for id := range m.clients {
+		m.removeClient(id)
+	}
+}
+
+func (m *messageRouterImpl) Wait() {
+	m.wg.Wait()
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/security"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageRouter(t *testing.T) {
+	t.Parallel()
+
+	servers := map[NodeID]*testServer{
+		"server-1": newTestServer(t, "server-1"),
+		"server-2": newTestServer(t, "server-2"),
+	}
+	defer func() {
+		for _, server := range servers {
+			server.close()
+		}
+	}()
+	values := map[NodeID]func() []int{}
+	for id, server := range servers {
+		values[id] = collectMessages(t, server.server, "topic-1")
+	}
+
+	router := NewMessageRouter("client-1", &security.Credential{}, newTestClientConfig()).(*messageRouterImpl)
+	// The address is the ID of the server, so the dialer can find it.
+	router.dialer = func(ctx context.Context, addr string) (net.Conn, error) {
+		return servers[addr].dial(ctx, addr)
+	}
+	defer router.Wait()
+	defer router.Close()
+
+	require.Nil(t, router.GetClient("server-1"))
+	router.AddPeer("server-1", "server-1")
+	router.AddPeer("server-2", "server-2")
+
+	ctx := context.Background()
+	for id := range servers {
+		client := router.GetClient(id)
+		require.NotNil(t, client)
+		require.Same(t, client, router.GetClient(id))
+		for i := 1; i <= 3; i++ {
+			_, err := client.SendMessage(ctx, "topic-1", &testMessage{Value: i})
+			require.Nil(t, err)
+		}
+		waitAck(t, client, "topic-1", 3)
+		require.Equal(t, []int{1, 2, 3}, values[id]())
+	}
+
+	client := router.GetClient("server-2")
+	router.RemovePeer("server-2")
+	require.Nil(t, router.GetClient("server-2"))
+	require.Eventually(t, func() bool {
+		_, err := client.TrySendMessage(ctx, "topic-1", &testMessage{})
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	router.Close()
+	require.Nil(t, router.GetClient("server-1"))
+	select {
+	case err := <-router.Err():
+		require.FailNow(t, "unexpected error", err)
+	default:
+	}
+}
@@ -0,0 +1,392 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package p2p
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/proto/p2p"
+	"go.uber.org/zap"
+)
+
+// MessageServerConfig is used to configure MessageServer.
+type MessageServerConfig struct {
+	// MaxPendingMessageCountPerTopic is the maximum number of messages buffered
+	// for a topic whose handler has not been registered yet.
+	MaxPendingMessageCountPerTopic int
+	// SendChannelSize is the size of the channel of responses to each peer.
+	SendChannelSize int
+	// AckInterval is the interval at which the acks are sent to the peers.
+	AckInterval time.Duration
+}
+
+// messageHandler processes the messages of a topic.
+type messageHandler struct {
+	// mu serializes the messages of the topic, so the handler function is
+	// never called concurrently.
+	mu      sync.Mutex
+	removed bool
+
+	tpl   reflect.Type
+	fn    func(NodeID, interface{}) error
+	errCh chan error
+}
+
+// pendingMessage is a message received before the handler of its topic is
+// registered.
+type pendingMessage struct {
+	senderID NodeID
+	entry    *p2p.MessageEntry
+}
+
+// cdcPeer is a connected client.
+type cdcPeer struct {
+	senderID NodeID
+	epoch    int64
+	handle   *streamHandle
+}
+
+// MessageServer receives the messages sent by MessageClient, and dispatches
+// them to the handlers registered for the topics. Each message is acked once
+// it is handled, so the client can discard it.
+type MessageServer struct {
+	serverID NodeID
+	config   *MessageServerConfig
+	acks     *ackManager
+
+	mu       sync.Mutex
+	handlers map[Topic]*messageHandler
+	pending  map[Topic][]pendingMessage
+	peers    map[NodeID]*cdcPeer
+}
+
+// NewMessageServer creates a new MessageServer.
+func NewMessageServer(serverID NodeID, config *MessageServerConfig) *MessageServer {
+	return &MessageServer{
+		serverID: serverID,
+		config:   config,
+		acks:     newAckManager(),
+		handlers: make(map[Topic]*messageHandler),
+		pending:  make(map[Topic][]pendingMessage),
+		peers:    make(map[NodeID]*cdcPeer),
+	}
+}
+
+// Run sends the acks to the peers periodically until the context is done.
+func (s *MessageServer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.config.AckInterval)
+	defer ticker.Stop()
+	defer s.closeAllPeers()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-ticker.C:
+		}
+		s.sendAcks(ctx)
+	}
+}
+
+func (s *MessageServer) sendAcks(ctx context.Context) {
+	s.mu.Lock()
+	peers := make([]*cdcPeer, 0, len(s.peers))
+	for _, peer := range s.peers {
+		peers = append(peers, peer)
+	}
+	s.mu.Unlock()
+
+	for _, peer := range peers {
+		var acks []*p2p.Ack
+		s.acks.Range(peer.senderID, func(topic Topic, seq Seq) bool {
+			acks = append(acks, &p2p.Ack{Topic: topic, LastSeq: seq})
+			return true
+		})
+		if len(acks) == 0 {
+			continue
+		}
+		// A slow peer must not block the acks to the others.
+		sendCtx, cancel := context.WithTimeout(ctx, s.config.AckInterval)
+		err := peer.handle.Send(sendCtx, p2p.SendMessageResponse{Ack: acks})
+		cancel()
+		if err != nil {
+			log.Debug("failed to send acks to peer",
+				zap.String("peer", peer.senderID), zap.Error(err))
+		}
+	}
+}
+
+func (s *MessageServer) closeAllPeers() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for senderID, peer := range s.peers {
+		peer.handle.Close()
+		delete(s.peers, senderID)
+	}
+}
+
+// SyncAddHandler registers a handler for the topic. The messages are
+// unmarshaled into new values of the type of tpl before being passed to fn.
+// The messages of the topic received before the handler is registered are
+// handled before SyncAddHandler returns. The returned channel receives the
+// errors returned by fn.
+func (s *MessageServer) SyncAddHandler(
+	ctx context.Context, topic Topic, tpl interface{}, fn func(NodeID, interface{}) error,
+) (<-chan error, error) {
+	tp := reflect.TypeOf(tpl)
+	if tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	handler := &messageHandler{
+		tpl:   tp,
+		fn:    fn,
+		errCh: make(chan error, 1),
+	}
+	// Lock the handler before it is visible, so the messages received
+	// afterwards are handled after the pending ones.
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	s.mu.Lock()
+	if _, ok := s.handlers[topic]; ok {
+		s.mu.Unlock()
+		return nil, cerror.ErrPeerMessageHandlerExists.GenWithStackByArgs(topic)
+	}
+	s.handlers[topic] = handler
+	pending := s.pending[topic]
+	delete(s.pending, topic)
+	s.mu.Unlock()
+
+	for _, msg := range pending {
+		s.handleEntry(handler, msg.senderID, msg.entry)
+	}
+	return handler.errCh, nil
+}
+
+// SyncRemoveHandler removes the handler of the topic, it returns after the
+// message being handled, if any, is finished.
+func (s *MessageServer) SyncRemoveHandler(ctx context.Context, topic Topic) error {
+	s.mu.Lock()
+	handler, ok := s.handlers[topic]
+	delete(s.handlers, topic)
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	handler.mu.Lock()
+	handler.removed = true
+	close(handler.errCh)
+	handler.mu.Unlock()
+
+	s.acks.RemoveTopic(topic)
+	return nil
+}
+
+// handleEntry calls the handler for the message, the caller must hold the
+// lock of the handler.
+func (s *MessageServer) handleEntry(handler *messageHandler, senderID NodeID, entry *p2p.MessageEntry) {
+	if entry.Sequence <= s.acks.Get(senderID, entry.Topic) {
+		// The message is resent by the client after a reconnection.
+		return
+	}
+	value := reflect.New(handler.tpl).Interface()
+	var err error
+	if err = unmarshalMessage(entry.Content, value); err != nil {
+		err = cerror.ErrPeerMessageDecodeError.Wrap(err).GenWithStackByArgs(entry.Topic)
+	} else {
+		err = handler.fn(senderID, value)
+	}
+	if err != nil {
+		// The failed message is acked as well, otherwise it would be resent
+		// forever.
+		select {
+		case handler.errCh <- err:
+		default:
+			log.Warn("handler of peer message failed",
+				zap.String("topic", entry.Topic), zap.Error(err))
+		}
+	}
+	s.acks.Set(senderID, entry.Topic, entry.Sequence)
+}
+
+// dispatch handles the message, or buffers it if the handler of its topic is
+// not registered yet.
+func (s *MessageServer) dispatch(senderID NodeID, entry *p2p.MessageEntry) error {
+	for {
+		s.mu.Lock()
+		handler, ok := s.handlers[entry.Topic]
+		if !ok {
+			defer s.mu.Unlock()
+			pending := s.pending[entry.Topic]
+			if len(pending) >= s.config.MaxPendingMessageCountPerTopic {
+				// Drops the messages from the sender, they will be resent
+				// because they are not acked.
+				kept := pending[:0]
+				for _, msg := range pending {
+					if msg.senderID != senderID {
+						kept = append(kept, msg)
+					}
+				}
+				s.pending[entry.Topic] = kept
+				return cerror.ErrPeerMessageTopicCongested.GenWithStackByArgs(entry.Topic)
+			}
+			s.pending[entry.Topic] = append(pending, pendingMessage{senderID: senderID, entry: entry})
+			return nil
+		}
+		s.mu.Unlock()
+
+		handler.mu.Lock()
+		if handler.removed {
+			// The handler is removed after it is found, try again.
+			handler.mu.Unlock()
+			continue
+		}
+		s.handleEntry(handler, senderID, entry)
+		handler.mu.Unlock()
+		return nil
+	}
+}
+
+// registerPeer registers the stream of a client, the stream of the same
+// client with an older epoch is closed.
+func (s *MessageServer) registerPeer(handle *streamHandle) error {
+	meta := handle.GetStreamMeta()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if peer, ok := s.peers[meta.SenderId]; ok {
+		if peer.epoch >= meta.Epoch {
+			return cerror.ErrPeerMessageStaleConnection.GenWithStackByArgs(meta.SenderId, meta.Epoch, peer.epoch)
+		}
+		log.Info("peer reconnected, closing the old stream",
+			zap.String("peer", meta.SenderId), zap.Int64("oldEpoch", peer.epoch), zap.Int64("newEpoch", meta.Epoch))
+		peer.handle.Close()
+	}
+	s.peers[meta.SenderId] = &cdcPeer{
+		senderID: meta.SenderId,
+		epoch:    meta.Epoch,
+		handle:   handle,
+	}
+	return nil
+}
+
+func (s *MessageServer) deregisterPeer(handle *streamHandle) {
+	senderID := handle.GetStreamMeta().SenderId
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if peer, ok := s.peers[senderID]; ok && peer.handle == handle {
+		delete(s.peers, senderID)
+	}
+}
+
+// SendMessage implements the gRPC service CDCPeerToPeer.
+func (s *MessageServer) SendMessage(stream MessageServerStream) error {
+	packet, err := stream.Recv()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	meta := packet.GetMeta()
+	if meta == nil {
+		return cerror.ErrPeerMessageIllegalMeta.GenWithStackByArgs()
+	}
+	if meta.ReceiverId != s.serverID {
+		err := cerror.ErrPeerMessageReceiverMismatch.GenWithStackByArgs(s.serverID, meta.ReceiverId)
+		_ = stream.Send(&p2p.SendMessageResponse{
+			ExitReason:   p2p.ExitReason_CAPTURE_ID_MISMATCH,
+			ErrorMessage: err.Error(),
+		})
+		return err
+	}
+
+	sendCh := make(chan p2p.SendMessageResponse, s.config.SendChannelSize)
+	handle := newStreamHandle(meta, sendCh)
+	if err := s.registerPeer(handle); err != nil {
+		_ = stream.Send(&p2p.SendMessageResponse{
+			ExitReason:   p2p.ExitReason_STALE_CONNECTION,
+			ErrorMessage: err.Error(),
+		})
+		return errors.Trace(err)
+	}
+	defer s.deregisterPeer(handle)
+	log.Info("peer connected", zap.String("peer", meta.SenderId),
+		zap.Int64("epoch", meta.Epoch), zap.String("addr", meta.SenderAdvertisedAddr))
+
+	senderDone := make(chan error, 1)
+	go func() {
+		// The sender exits after the handle is closed and the responses
+		// are drained.
+		for resp := range sendCh {
+			resp := resp
+			if err := stream.Send(&resp); err != nil {
+				senderDone <- errors.Trace(err)
+				return
+			}
+		}
+		senderDone <- nil
+	}()
+	recvDone := make(chan error, 1)
+	go func() {
+		recvDone <- s.receive(stream, handle, packet.Entries)
+	}()
+
+	select {
+	case err = <-recvDone:
+		handle.Close()
+		if senderErr := <-senderDone; err == nil {
+			err = senderErr
+		}
+	case err = <-senderDone:
+		// The handle is closed by a newer stream of the peer, or the server
+		// is closed.
+		handle.Close()
+		if err == nil {
+			err = cerror.ErrPeerMessageInternalSenderClosed.GenWithStackByArgs()
+		}
+	}
+	return err
+}
+
+// receive handles the messages from the stream until the stream is closed.
+func (s *MessageServer) receive(stream MessageServerStream, handle *streamHandle, entries []*p2p.MessageEntry) error {
+	senderID := handle.GetStreamMeta().SenderId
+	for {
+		for _, entry := range entries {
+			if err := s.dispatch(senderID, entry); err != nil {
+				if cerror.ErrPeerMessageTopicCongested.Equal(err) {
+					_ = handle.Send(stream.Context(), p2p.SendMessageResponse{
+						ExitReason:   p2p.ExitReason_CONGESTED,
+						ErrorMessage: err.Error(),
+					})
+				}
+				return errors.Trace(err)
+			}
+		}
+		packet, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Trace(err)
+		}
+		entries = packet.Entries
+	}
+}
@@ -232,3 +232,16 @@ func ToComparableSpan(span Span) ComparableSpan {
 func ToComparableKey(key []byte) []byte {
 	return codec.EncodeBytes(nil, key)
 }
+
+// FromComparableSpan returns the raw span of a memcomparable span.
+func FromComparableSpan(span ComparableSpan) (Span, error) {
+	_, start, err := codec.DecodeBytes(span.Start, nil)
+	if err != nil {
+		return Span{}, cerror.WrapError(cerror.ErrCodecDecode, err)
+	}
+	_, end, err := codec.DecodeBytes(span.End, nil)
+	if err != nil {
+		return Span{}, cerror.WrapError(cerror.ErrCodecDecode, err)
+	}
+	return Span{Start: start, End: end}, nil
+}
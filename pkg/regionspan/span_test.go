@@ -143,3 +143,15 @@ func TestSpanClone(t *testing.T) {
 	require.Equal(t, "[01, 02)", sp.String())
 	require.Equal(t, "[01, 09)", sp2.String())
 }
+
+func TestFromComparableSpan(t *testing.T) {
+	t.Parallel()
+
+	span := GetTableSpan(123)
+	raw, err := FromComparableSpan(ToComparableSpan(span))
+	require.Nil(t, err)
+	require.Equal(t, span, raw)
+
+	_, err = FromComparableSpan(ComparableSpan{Start: []byte{1}, End: []byte{2}})
+	require.Regexp(t, ".*ErrCodecDecode.*", err)
+}
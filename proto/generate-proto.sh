@@ -5,8 +5,10 @@ echo "generate canal & craft benchmark protocol code..."
 [ ! -d ./canal ] && mkdir ./canal
 [ ! -d ./cdclog ] && mkdir ./cdclog
 [ ! -d ./benchmark ] && mkdir ./benchmark
+[ ! -d ./event ] && mkdir ./event
 
 protoc --gofast_out=./canal EntryProtocol.proto
 protoc --gofast_out=./canal CanalProtocol.proto
 protoc --gofast_out=./benchmark CraftBenchmark.proto
+protoc --gofast_out=./event CDCEvent.proto
 protoc --gofast_out=plugins=grpc:./p2p CDCPeerToPeer.proto
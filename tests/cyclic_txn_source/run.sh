@@ -0,0 +1,105 @@
+#!/bin/bash
+
+set -e
+
+CUR=$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)
+source $CUR/../_utils/test_prepare
+WORK_DIR=$OUT_DIR/$TEST_NAME
+CDC_BINARY=cdc.test
+SINK_TYPE=$1
+
+function run() {
+	# kafka is not supported yet.
+	if [ "$SINK_TYPE" == "kafka" ]; then
+		return
+	fi
+
+	rm -rf $WORK_DIR && mkdir -p $WORK_DIR
+
+	start_tidb_cluster --workdir $WORK_DIR
+
+	# the transaction source is only supported by the newer TiDB.
+	run_sql "SHOW VARIABLES LIKE 'tidb_cdc_write_source';" ${UP_TIDB_HOST} ${UP_TIDB_PORT}
+	if ! grep -q "tidb_cdc_write_source" "$OUT_DIR/sql_res.$TEST_NAME.txt"; then
+		echo "[$(date)] tidb_cdc_write_source is not supported by the TiDB, skip $TEST_NAME"
+		return
+	fi
+
+	run_cdc_server \
+		--workdir $WORK_DIR \
+		--binary $CDC_BINARY \
+		--logsuffix "_${TEST_NAME}_upsteam" \
+		--pd "http://${UP_PD_HOST_1}:${UP_PD_PORT_1}" \
+		--addr "127.0.0.1:8300"
+
+	run_cdc_server \
+		--workdir $WORK_DIR \
+		--binary $CDC_BINARY \
+		--logsuffix "_${TEST_NAME}_downsteam" \
+		--pd "http://${DOWN_PD_HOST}:${DOWN_PD_PORT}" \
+		--addr "127.0.0.1:8301"
+
+	cd $WORK_DIR
+
+	# no mark tables are created, the tables are replicated in both directions.
+	run_sql "CREATE table test.simple(id1 int, id2 int, source int, primary key (id1, id2));" ${UP_TIDB_HOST} ${UP_TIDB_PORT}
+	run_sql "CREATE table test.simple(id1 int, id2 int, source int, primary key (id1, id2));" ${DOWN_TIDB_HOST} ${DOWN_TIDB_PORT}
+
+	start_ts=$(run_cdc_cli_tso_query ${UP_PD_HOST_1} ${UP_PD_PORT_1})
+
+	run_cdc_cli changefeed create --start-ts=$start_ts \
+		--sink-uri="mysql://root@${DOWN_TIDB_HOST}:${DOWN_TIDB_PORT}/?safe-mode=false" \
+		--pd "http://${UP_PD_HOST_1}:${UP_PD_PORT_1}" \
+		--cyclic-replica-id 1 \
+		--cyclic-filter-replica-ids 2 \
+		--cyclic-sync-ddl=false \
+		--cyclic-use-txn-source
+
+	run_cdc_cli changefeed create --start-ts=$start_ts \
+		--sink-uri="mysql://root@${UP_TIDB_HOST}:${UP_TIDB_PORT}/?safe-mode=false" \
+		--pd "http://${DOWN_PD_HOST}:${DOWN_PD_PORT}" \
+		--cyclic-replica-id 2 \
+		--cyclic-filter-replica-ids 1 \
+		--cyclic-sync-ddl=false \
+		--cyclic-use-txn-source
+
+	for i in $(seq 11 20); do {
+		sqlup="START TRANSACTION;"
+		sqldown="START TRANSACTION;"
+		for j in $(seq 21 24); do {
+			if [ $((j % 2)) -eq 0 ]; then
+				sqldown+="INSERT INTO test.simple(id1, id2, source) VALUES (${i}, ${j}, 2);"
+			else
+				sqlup+="INSERT INTO test.simple(id1, id2, source) VALUES (${i}, ${j}, 1);"
+			fi
+		}; done
+		sqlup+="COMMIT;"
+		sqldown+="COMMIT;"
+
+		run_sql "${sqlup}" ${UP_TIDB_HOST} ${UP_TIDB_PORT}
+		run_sql "${sqldown}" ${DOWN_TIDB_HOST} ${DOWN_TIDB_PORT}
+	}; done
+
+	# a row inserted in the upstream and updated in the downstream.
+	run_sql "INSERT INTO test.simple(id1, id2, source) VALUES (100, 100, 0);" ${UP_TIDB_HOST} ${UP_TIDB_PORT}
+	sleep 5
+	run_sql "UPDATE test.simple SET source = source + 1 WHERE id1 = 100;" ${DOWN_TIDB_HOST} ${DOWN_TIDB_PORT}
+
+	# Sleep a while to make sure all changes has been replicated.
+	sleep 10
+
+	check_sync_diff $WORK_DIR $CUR/conf/diff_config.toml
+	run_sql "SELECT source FROM test.simple WHERE id1 = 100;" ${UP_TIDB_HOST} ${UP_TIDB_PORT} &&
+		check_contains "source: 1"
+
+	# no mark tables are needed.
+	run_sql "SELECT count(*) AS mark_tables FROM information_schema.tables WHERE table_schema = 'tidb_cdc' AND table_name LIKE 'repl_mark_%';" ${UP_TIDB_HOST} ${UP_TIDB_PORT} &&
+		check_contains "mark_tables: 0"
+
+	cleanup_process $CDC_BINARY
+}
+
+trap stop_tidb_cluster EXIT
+run $*
+check_logs $WORK_DIR
+echo "[$(date)] <<<<<< run test case $TEST_NAME success! >>>>>>"